@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"os"
 	"stoke/internal/cfg"
+	"stoke/internal/ent"
 	_ "stoke/internal/ent/runtime"
+	"stoke/internal/key"
+	"stoke/internal/lifecycle"
 	"stoke/internal/usr"
 	"stoke/internal/web"
 
@@ -54,10 +57,11 @@ func main() {
 	}
 
 	if *dbInitFile != "" {
-		config.Users.UserInitFile = *dbInitFile
+		config.OverrideUserInitFile(*dbInitFile)
 	}
 
-	rootCtx := config.WithContext(context.Background())
+	lc := lifecycle.NewManager(config.Server.ShutdownDrain())
+	rootCtx := config.WithContext(lc.WithContext(context.Background()))
 	logger := zerolog.Ctx(rootCtx)
 
 	logger.Debug().
@@ -91,20 +95,23 @@ func main() {
 		logger.Fatal().Err(err).Msg("Could not initialize telemetry")
 	}
 
-	if server.TLSConfig != nil {
-		if err := server.ListenAndServeTLS("","") ; err != nil {
-			logger.Error().Err(err).Msg("An error occurred with the TLS server")
-		}
-	} else {
-		if err := server.ListenAndServe(); err != nil {
-			logger.Error().Err(err).Msg("An error occurred with the http server")
-		}
+	if fed, ok := key.IssuerFromCtx(rootCtx).(*key.FederatedTokenIssuer); ok {
+		lc.OnStop("cluster", fed.Deregister)
 	}
+	lc.OnStop("telemetry", func(ctx context.Context) error {
+		var err error
+		for _, f := range shutdownFuncs {
+			err = errors.Join(err, f(ctx))
+		}
+		return err
+	})
+	lc.OnStop("database", func(context.Context) error {
+		return ent.FromContext(rootCtx).Close()
+	})
 
-	err = nil
-	for _, f := range shutdownFuncs {
-		err = errors.Join(err, f(rootCtx))
-	}
+	config.WatchForReload(*configFile, rootCtx)
+
+	err = lc.Serve(rootCtx, server)
 
 	logger.Info().Err(err).Msg("Stoke Server Terminated.")
 }
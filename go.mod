@@ -5,6 +5,7 @@ go 1.24.0
 require (
 	entgo.io/contrib v0.3.5
 	entgo.io/ent v0.13.1
+	github.com/beevik/etree v1.8.1
 	github.com/ghodss/yaml v1.0.0
 	github.com/go-faster/errors v0.7.1
 	github.com/go-faster/jx v1.1.0
@@ -16,6 +17,7 @@ require (
 	github.com/ogen-go/ogen v1.0.0
 	github.com/prometheus/client_golang v1.19.0
 	github.com/rs/zerolog v1.32.0
+	github.com/russellhaering/goxmldsig v1.6.1
 	github.com/vincentfree/opentelemetry/otelzerolog v0.0.10
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.49.0
 	go.opentelemetry.io/otel v1.25.0
@@ -60,6 +62,7 @@ require (
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.19.0 // indirect
 	github.com/hashicorp/hcl/v2 v2.15.0 // indirect
+	github.com/jonboulle/clockwork v0.5.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mitchellh/go-wordwrap v1.0.1 // indirect
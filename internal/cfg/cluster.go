@@ -1,9 +1,13 @@
 package cfg
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
-// Cluster holds HA/cluster options. When Enabled is true, key persistence is disabled
-// and /api/pkeys returns a merged JWKS from all discovered peers.
+// Cluster holds HA/cluster options. In the default "federated" key mode, key persistence is disabled
+// and /api/pkeys returns a merged JWKS from all discovered peers. In "shared" key mode all replicas
+// sign with the same keys stored in the database and a leader elected through a database lease rotates them.
 type Cluster struct {
 	Enabled     bool     `json:"enabled"`
 	Discovery   string   `json:"discovery"`    // "static" (default) or "k8s" (future)
@@ -12,6 +16,21 @@ type Cluster struct {
 	// InstanceID is a unique identifier for this replica (e.g. "stoke1", "stoke2"). When set,
 	// signing key kids are prefixed so merged JWKS from multiple replicas keeps all keys distinct.
 	InstanceID string `json:"instance_id"`
+	// KeyMode is "federated" (default) or "shared"
+	KeyMode string `json:"key_mode"`
+	// LeaseSec is how long the key management lease is held before it must be renewed in shared key mode.
+	// Must be longer than RefreshSec; default three times RefreshSec
+	LeaseSec int `json:"lease_sec"`
+}
+
+const (
+	KeyModeFederated = "federated"
+	KeyModeShared    = "shared"
+)
+
+// SharedKeys returns true if replicas sign with a shared key set stored in the database
+func (c *Cluster) SharedKeys() bool {
+	return c != nil && c.Enabled && c.KeyMode == KeyModeShared
 }
 
 type clusterCtxKey struct{}
@@ -21,9 +40,30 @@ func (c Cluster) withContext(ctx context.Context) context.Context {
 	if c2.RefreshSec <= 0 {
 		c2.RefreshSec = 30
 	}
+	if c2.KeyMode == "" {
+		c2.KeyMode = KeyModeFederated
+	}
+	if c2.LeaseSec <= 0 {
+		c2.LeaseSec = 3 * c2.RefreshSec
+	}
 	return context.WithValue(ctx, clusterCtxKey{}, &c2)
 }
 
+// validate rejects shared key mode settings that would let the key management lease expire between renewals
+func (c Cluster) validate() error {
+	if !c.SharedKeys() || c.LeaseSec <= 0 {
+		return nil
+	}
+	refreshSec := c.RefreshSec
+	if refreshSec <= 0 {
+		refreshSec = 30
+	}
+	if c.LeaseSec <= refreshSec {
+		return fmt.Errorf("cluster.lease_sec (%d) must be longer than cluster.refresh_sec (%d) so the key management lease is renewed before it expires", c.LeaseSec, refreshSec)
+	}
+	return nil
+}
+
 // ClusterFromContext returns the Cluster from ctx, or nil if not set.
 func ClusterFromContext(ctx context.Context) *Cluster {
 	v := ctx.Value(clusterCtxKey{})
@@ -2,10 +2,7 @@ package cfg
 
 import (
 	"context"
-	"fmt"
-	"os"
-
-	"github.com/ghodss/yaml"
+	"sync/atomic"
 )
 
 type Config struct {
@@ -16,22 +13,41 @@ type Config struct {
 	Users     Users     `json:"users,omitempty"`
 	Telemetry Telemetry `json:"telemetry,omitempty"`
 	Cluster   Cluster   `json:"cluster,omitempty"`
+
+	// Users configuration applied by Reload
+	reloadedUsers atomic.Pointer[Users]
+	// Database init file given on the command line. Replaces users.user_init_file, also on reload
+	userInitFileOverride string
 }
 
 func FromFile(filename string) *Config {
-	content, err := os.ReadFile(filename)
+	conf, err := readConfig(filename)
 	if err != nil {
-		panic(fmt.Sprintf("Could not read config file: %s, %v", filename, err))
-	}
-	conf := &Config{}
-	if err = yaml.Unmarshal(content, conf); err != nil {
-		panic(fmt.Sprintf("Could not read config yaml: %s, %v", filename, err))
+		panic(err.Error())
 	}
 	// We want to do this here to make sure we are populating in the context (though we are using storing the pointer)
 	conf.Tokens.ParseDurations()
+	if err := conf.Cluster.validate(); err != nil {
+		panic(err.Error())
+	}
 	return conf
 }
 
+// OverrideUserInitFile uses filename instead of users.user_init_file, including when the configuration is reloaded
+func (c *Config) OverrideUserInitFile(filename string) {
+	c.userInitFileOverride = filename
+	c.Users.UserInitFile = filename
+}
+
+// CurrentUsers returns the users configuration, including changes applied by Reload.
+// Code running after the server starts must use it instead of reading Users.
+func (c *Config) CurrentUsers() *Users {
+	if users := c.reloadedUsers.Load(); users != nil {
+		return users
+	}
+	return &c.Users
+}
+
 func (c *Config) WithContext(ctx context.Context) context.Context {
 	confCtx := context.WithValue(ctx, configCtxKey, c)
 	confCtx = c.Logging.withContext(confCtx)
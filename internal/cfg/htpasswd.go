@@ -0,0 +1,28 @@
+package cfg
+
+import (
+	"context"
+	"fmt"
+	"stoke/internal/usr"
+)
+
+type HtpasswdProviderConfig struct {
+	// Name of this htpasswd provider
+	Name         string `json:"name"`
+	// htpasswd file of username:hash lines. Only bcrypt and argon2 hashes are supported
+	PasswordFile string `json:"htpasswd_file"`
+	// YAML file with group memberships and user profiles (OPTIONAL)
+	GroupsFile   string `json:"groups_file"`
+}
+
+func (h HtpasswdProviderConfig) TypeSpec() string {
+	return "HTPASSWD:" + h.Name
+}
+
+func (h HtpasswdProviderConfig) CreateProvider(ctx context.Context) (foreignProvider, error) {
+	provider := usr.NewHtpasswdUserProvider(h.Name, h.PasswordFile, h.GroupsFile)
+	if err := provider.CheckHealth(ctx); err != nil {
+		return nil, fmt.Errorf("Could not load htpasswd provider files: %w", err)
+	}
+	return provider, nil
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 	"io"
 	"os"
+	"stoke/internal/cluster"
 	"stoke/internal/usr"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/go-ldap/ldap/v3"
 	"github.com/rs/zerolog"
@@ -19,6 +22,8 @@ type LDAPProviderConfig struct {
 	Name                  string
 	// URL (starting with ldap:// or ldaps://) of the ldap server
 	ServerURL             string `json:"server_url"`
+	// Additional servers to fail over to, in order of preference (OPTIONAL)
+	ServerURLs            []string `json:"server_urls"`
 	// Readonly bind user distinguished name used to look up users in ldap
 	BindUserDN            string `json:"bind_user_dn"`
 	// Read-only bind user password
@@ -31,6 +36,12 @@ type LDAPProviderConfig struct {
 	GroupFilter           string `json:"group_filter_template"`
 	// LDAP group name attribute used to match groups
 	GroupNameField        string `json:"ldap_group_name_field"`
+	// How to resolve nested group membership: none, in_chain (Active Directory) or recursive. Defaults to none
+	NestedGroups          string `json:"nested_groups"`
+	// Filter template used with in_chain. Defaults to an Active Directory LDAP_MATCHING_RULE_IN_CHAIN filter on {{ .UserDN }}
+	NestedGroupFilter     string `json:"nested_group_filter_template"`
+	// Maximum number of parent group levels searched with recursive. Defaults to 10
+	NestedGroupDepth      int    `json:"nested_group_depth"`
 
 	// LDAP root user search query
 	UserSearchRoot        string `json:"user_search_root"`
@@ -43,6 +54,8 @@ type LDAPProviderConfig struct {
 	LastNameField         string `json:"ldap_last_name_field"`
 	// LDAP field to pull from ldap as the email
 	EmailField            string `json:"ldap_email_field"`
+	// LDAP field holding the user's stable id, i.e. objectGUID or entryUUID. Defaults to the DN (OPTIONAL)
+	SubjectField          string `json:"ldap_subject_field"`
 
 	// Timeout for LDAP searches
 	SearchTimeout         int    `json:"search_timeout"`
@@ -51,13 +64,52 @@ type LDAPProviderConfig struct {
 	LDAPCACert            string `json:"ldap_ca_cert"`
 	// Skip verifying the certificate
 	SkipCertificateVerify bool   `json:"skip_certificate_verify"`
+	// Upgrade ldap:// connections to TLS with StartTLS
+	StartTLS              bool   `json:"start_tls"`
+
+	// Maximum number of open connections to the ldap servers. Defaults to 8
+	PoolSize              int    `json:"pool_size"`
+	// Seconds a pooled connection may be idle before it is closed. Defaults to 300
+	PoolIdleTimeout       int    `json:"pool_idle_timeout"`
+	// Seconds to skip a server after failing to connect to it. Defaults to 30
+	ServerRetryInterval   int    `json:"server_retry_interval"`
+
+	// How password changes are made in the directory: password_modify (default, RFC 3062), unicode_pwd (Active Directory) or disabled
+	PasswordChange        string `json:"password_change"`
+	// Allow forced password resets of directory users, made as the bind user. Defaults to false
+	AllowAdminReset       bool   `json:"allow_admin_reset"`
+
+	// Seconds between syncing users with the directory. 0 (default) only syncs users when they log in
+	SyncInterval          int    `json:"sync_interval"`
+	// Log what a sync would change without changing anything
+	SyncDryRun            bool   `json:"sync_dry_run"`
+	// What a sync does with users that are gone or disabled in the directory: none (default), remove_groups or delete
+	Deprovision           string `json:"deprovision"`
+	// Percentage of synced users that may be deprovisioned at once. Syncs that find more gone or disabled deprovision nobody. Defaults to 10
+	SyncMaxDeprovisionPercent int `json:"sync_max_deprovision_percent"`
+	// LDAP filter template that matches the user when their account is disabled. Use {{ .Username }} or {{ .UserDN }}
+	DisabledFilter        string `json:"disabled_filter_template"`
+
+	// Rules users must satisfy to log in and the groups new users start with (OPTIONAL)
+	Provisioning          ProvisioningConfig `json:"provisioning"`
+	// Attributes of the user's entry to add to issued tokens as claims (OPTIONAL)
+	AttributeClaims       []LDAPAttributeClaimConfig `json:"attribute_claims"`
+}
+
+type LDAPAttributeClaimConfig struct {
+	// Attribute of the user's entry, i.e. departmentNumber
+	Attribute string `json:"attribute"`
+	// Claim name to use in the stoke token. Defaults to the attribute
+	As        string `json:"as"`
+	// Whether to store the values in the database as provider managed groups
+	Persist   bool   `json:"persist"`
 }
 
 func (l LDAPProviderConfig) TypeSpec() string {
 	return "LDAP:" + l.Name
 }
 
-func (l LDAPProviderConfig) CreateProvider(ctx context.Context) foreignProvider {
+func (l LDAPProviderConfig) CreateProvider(ctx context.Context) (foreignProvider, error) {
 	logger := zerolog.Ctx(ctx).With().
 		Str("component", "cfg.LdapProviderConfig.CreateProvider").
 		Logger()
@@ -65,24 +117,100 @@ func (l LDAPProviderConfig) CreateProvider(ctx context.Context) foreignProvider
 	groupFilterTemplate := template.New("group-filter")
 	groupFilterTemplate, err := groupFilterTemplate.Parse(l.GroupFilter)
 	if err != nil {
-		logger.Fatal().
-			Err(err).
-			Str("groupFilterTemplate", l.GroupFilter).
-			Msg("Could not parse group filter template")
+		return nil, fmt.Errorf("Could not parse group filter template %s: %w", l.GroupFilter, err)
 	}
 
 	userFilterTemplate := template.New("user-filter")
 	userFilterTemplate, err = userFilterTemplate.Parse(l.UserFilter)
 	if err != nil {
-		logger.Fatal().
-			Err(err).
-			Str("userFilterTemplate", l.UserFilter).
-			Msg("Could not parse user filter template")
+		return nil, fmt.Errorf("Could not parse user filter template %s: %w", l.UserFilter, err)
+	}
+
+	var nestedGroups usr.LDAPNestedGroups
+	switch strings.ToLower(l.NestedGroups) {
+	case "", "none":
+		nestedGroups = usr.LDAP_NESTED_NONE
+	case "in_chain":
+		nestedGroups = usr.LDAP_NESTED_IN_CHAIN
+	case "recursive":
+		nestedGroups = usr.LDAP_NESTED_RECURSIVE
+	default:
+		return nil, fmt.Errorf("Unknown nested_groups value %q. Must be none, in_chain or recursive", l.NestedGroups)
+	}
+
+	var nestedGroupFilterTemplate *template.Template
+	if l.NestedGroupFilter != "" {
+		nestedGroupFilterTemplate, err = template.New("nested-group-filter").Parse(l.NestedGroupFilter)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse nested group filter template %s: %w", l.NestedGroupFilter, err)
+		}
+	}
+
+	var deprovision usr.LDAPDeprovision
+	switch strings.ToLower(l.Deprovision) {
+	case "", "none":
+		deprovision = usr.LDAP_DEPROVISION_NONE
+	case "remove_groups":
+		deprovision = usr.LDAP_DEPROVISION_REMOVE_GROUPS
+	case "delete":
+		deprovision = usr.LDAP_DEPROVISION_DELETE
+	default:
+		return nil, fmt.Errorf("Unknown deprovision value %q. Must be none, remove_groups or delete", l.Deprovision)
+	}
+
+	var passwordChange usr.LDAPPasswordChange
+	switch strings.ToLower(l.PasswordChange) {
+	case "", "password_modify":
+		passwordChange = usr.LDAP_PASSWORD_MODIFY
+	case "unicode_pwd":
+		passwordChange = usr.LDAP_PASSWORD_UNICODEPWD
+	case "disabled":
+		passwordChange = usr.LDAP_PASSWORD_DISABLED
+	default:
+		return nil, fmt.Errorf("Unknown password_change value %q. Must be password_modify, unicode_pwd or disabled", l.PasswordChange)
+	}
+
+	var disabledFilterTemplate *template.Template
+	if l.DisabledFilter != "" {
+		disabledFilterTemplate, err = template.New("disabled-filter").Parse(l.DisabledFilter)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse disabled filter template %s: %w", l.DisabledFilter, err)
+		}
+	}
+
+	serverURLs := l.ServerURLs
+	if l.ServerURL != "" {
+		serverURLs = append([]string{ l.ServerURL }, serverURLs...)
+	}
+	if len(serverURLs) == 0 {
+		return nil, fmt.Errorf("server_url or server_urls is required")
+	}
+
+	provisioning, err := l.Provisioning.rules()
+	if err != nil {
+		return nil, err
+	}
+	var attributeClaims []usr.LDAPAttributeClaim
+	for _, a := range l.AttributeClaims {
+		if a.Attribute == "" {
+			return nil, fmt.Errorf("Attribute claims require attribute")
+		}
+		attributeClaims = append(attributeClaims, usr.LDAPAttributeClaim{
+			Attribute: a.Attribute,
+			As:        a.As,
+			Persist:   a.Persist,
+		})
 	}
 
 	var dialOpts []ldap.DialOpt
+	var tlsConfig *tls.Config
+
+	usesLDAPS := false
+	for _, u := range serverURLs {
+		usesLDAPS = usesLDAPS || strings.HasPrefix(u, "ldaps://")
+	}
 
-	if strings.HasPrefix(l.ServerURL, "ldaps://") {
+	if usesLDAPS || l.StartTLS {
 		certPool, err := x509.SystemCertPool()
 		if err != nil {
 			logger.Error().
@@ -95,28 +223,22 @@ func (l LDAPProviderConfig) CreateProvider(ctx context.Context) foreignProvider
 		if l.LDAPCACert != "" {
 			publicCerts, err := readPublicCertFile(l.LDAPCACert)
 			if err != nil {
-				logger.Fatal().
-					Err(err).
-					Str("ldapCert", l.LDAPCACert).
-					Msg("Could not read ldap cert file")
+				return nil, fmt.Errorf("Could not read ldap cert file %s: %w", l.LDAPCACert, err)
 			}
 			for _, cert := range publicCerts {
 				certPool.AddCert(cert)
 			}
 		}
-		dialOpts = append(dialOpts,
-			ldap.DialWithTLSConfig(
-				&tls.Config{
-					ClientCAs: certPool,
-					InsecureSkipVerify: l.SkipCertificateVerify,
-				},
-			),
-		)
+		tlsConfig = &tls.Config{
+			RootCAs: certPool,
+			InsecureSkipVerify: l.SkipCertificateVerify,
+		}
+		dialOpts = append(dialOpts, ldap.DialWithTLSConfig(tlsConfig))
 	}
 
-	return usr.NewLDAPUserProvider(
+	provider := usr.NewLDAPUserProvider(
 		l.Name,
-		l.ServerURL,
+		serverURLs[0],
 		l.BindUserDN,
 		l.BindUserPassword,
 		l.GroupSearchRoot,
@@ -130,7 +252,32 @@ func (l LDAPProviderConfig) CreateProvider(ctx context.Context) foreignProvider
 		userFilterTemplate,
 		dialOpts...,
 	)
+	provider.ServerURLs = serverURLs
+	provider.NestedGroups = nestedGroups
+	provider.NestedGroupFilter = nestedGroupFilterTemplate
+	provider.NestedGroupDepth = l.NestedGroupDepth
+	provider.SubjectField = l.SubjectField
+	provider.Provisioning = provisioning
+	provider.AttributeClaims = attributeClaims
+	provider.PoolSize = l.PoolSize
+	provider.PoolIdleTimeout = time.Duration(l.PoolIdleTimeout) * time.Second
+	provider.ServerRetryInterval = time.Duration(l.ServerRetryInterval) * time.Second
+	if l.StartTLS {
+		provider.StartTLS = tlsConfig
+	}
+
+	provider.PasswordChange = passwordChange
+	provider.AllowAdminReset = l.AllowAdminReset
+	provider.SyncInterval = time.Duration(l.SyncInterval) * time.Second
+	provider.SyncDryRun = l.SyncDryRun
+	provider.Deprovision = deprovision
+	provider.SyncMaxDeprovisionPercent = l.SyncMaxDeprovisionPercent
+	provider.DisabledFilter = disabledFilterTemplate
+	if cl := ClusterFromContext(ctx); cl != nil && cl.Enabled && l.SyncInterval > 0 {
+		provider.SyncElector = cluster.NewDBLease("ldap-sync:" + l.Name, cl.InstanceID, provider.SyncInterval)
+	}
 
+	return provider, nil
 }
 
 func readPublicCertFile(name string) ([]*x509.Certificate, error) {
@@ -0,0 +1,118 @@
+package cfg
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"stoke/internal/usr"
+	"strings"
+)
+
+type OAuth2ProviderConfig struct {
+	// Name of this OAuth2 provider. Used in the login URL
+	Name              string `json:"name"`
+
+	// URL to use to authenticate users with the provider
+	AuthorizationURL  string `json:"auth_url"`
+	// URL to use to exchange authorization codes for access tokens
+	TokenURL          string `json:"token_url"`
+	// APIs to call with the access token to gather user fields, i.e. https://api.github.com/user
+	UserInfo          []OAuth2UserInfoConfig `json:"user_info"`
+
+	// Dotted path of the user info field to use as first name
+	FirstNameField    string `json:"first_name_field"`
+	// Dotted path of the user info field to use as last name
+	LastNameField     string `json:"last_name_field"`
+	// Dotted path of the user info field to use as email
+	EmailField        string `json:"email_field"`
+	// Dotted path of the user info field to use as username. Defaults to the email (OPTIONAL)
+	UsernameField     string `json:"username_field"`
+	// Dotted path of the user info field holding the user's stable id, i.e. id. Defaults to the username (OPTIONAL)
+	SubjectField      string `json:"subject_field"`
+	// Dotted paths of user info fields that are matched to group links as path=value, i.e. orgs.login (OPTIONAL)
+	GroupFields       []string `json:"group_fields"`
+	// Rules users must satisfy to log in and the groups new users start with (OPTIONAL)
+	Provisioning      ProvisioningConfig `json:"provisioning"`
+
+	// The client secret used to authenticate to the provider
+	ClientSecret      string   `json:"client_secret"`
+	// Client ID that matches what is registered with the provider
+	ClientID          string   `json:"client_id"`
+	// Redirect URI that matches what is registered with the provider
+	RedirectURI       string   `json:"redirect_uri"`
+	// Scopes to include in authorization requests
+	Scopes            []string `json:"scopes"`
+
+	// Extra arguments that are added to the authorization request (OPTIONAL)
+	ExtraArguments    map[string]string `json:"extra_arguments"`
+	// Absolute urls that users may be sent to with the next parameter after authenticating. Relative paths are always allowed (OPTIONAL)
+	AllowedRedirects  []string `json:"allowed_redirects"`
+	// Where to redirect after login when no next url is given. Must be a relative path or an allowed redirect (OPTIONAL)
+	CompletionURL     string   `json:"completion_url"`
+	// Accept access tokens of the provider as the password of /api/login. Any application the provider issued a token to can then log its user in (OPTIONAL)
+	AcceptAccessTokens bool    `json:"accept_access_tokens"`
+}
+
+type OAuth2UserInfoConfig struct {
+	// URL to call with the access token
+	URL string `json:"url"`
+	// Field to store the response under. Object responses are merged into the top level when empty.
+	// Required for array responses, i.e. https://api.github.com/user/orgs
+	As  string `json:"as"`
+}
+
+func (o OAuth2ProviderConfig) TypeSpec() string {
+	return "OAUTH2:" + o.Name
+}
+
+func (o OAuth2ProviderConfig) CreateProvider(ctx context.Context) (foreignProvider, error) {
+	authURL, err := url.Parse(o.AuthorizationURL)
+	if err != nil || o.AuthorizationURL == "" {
+		return nil, fmt.Errorf("Authorization URL is required: %s", o.AuthorizationURL)
+	}
+	tokenURL, err := url.Parse(o.TokenURL)
+	if err != nil || o.TokenURL == "" {
+		return nil, fmt.Errorf("Token URL is required: %s", o.TokenURL)
+	}
+
+	if len(o.UserInfo) == 0 {
+		return nil, fmt.Errorf("At least one user_info url is required")
+	}
+	var userInfo []usr.OAuth2UserInfo
+	for _, info := range o.UserInfo {
+		u, err := url.Parse(info.URL)
+		if err != nil || info.URL == "" {
+			return nil, fmt.Errorf("Could not parse user info url: %s", info.URL)
+		}
+		userInfo = append(userInfo, usr.OAuth2UserInfo{ URL: u, As: info.As })
+	}
+
+	if o.EmailField == "" {
+		return nil, fmt.Errorf("email_field is required")
+	}
+	provisioning, err := o.Provisioning.rules()
+	if err != nil {
+		return nil, err
+	}
+	if err := usr.CheckCompletionURL(o.CompletionURL, o.AllowedRedirects); err != nil {
+		return nil, err
+	}
+
+	provider := usr.NewOAuth2UserProvider(
+		o.Name, strings.Join(o.Scopes, " "), o.RedirectURI,
+		o.FirstNameField, o.LastNameField, o.EmailField, o.UsernameField,
+		o.ClientID, o.ClientSecret,
+		o.ExtraArguments,
+		authURL, tokenURL,
+		userInfo,
+		o.GroupFields,
+		MuxFromContext(ctx),
+	)
+	provider.AllowedRedirects = o.AllowedRedirects
+	provider.CompletionURL = o.CompletionURL
+	provider.AcceptAccessTokens = o.AcceptAccessTokens
+	provider.SubjectField = o.SubjectField
+	provider.Provisioning = provisioning
+
+	return provider, nil
+}
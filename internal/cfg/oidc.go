@@ -3,9 +3,11 @@ package cfg
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
 	"stoke/internal/usr"
 	"strings"
 
@@ -25,6 +27,14 @@ type OIDCProviderConfig struct {
 	AuthorizationURL string `json:"auth_url"`
 	// URL of the UserInfo Endpoint
 	UserInfoURL       string `json:"user_info_url"`
+	// URL of the provider's signing keys. Used to verify id tokens. Set from the discovery url when available
+	JWKSURL           string `json:"jwks_url"`
+	// Expected issuer of id and logout tokens. Required with a jwks_url. Set from the discovery url when available
+	Issuer            string `json:"issuer"`
+	// Accept id tokens without checking their signature when there is no jwks_url. Anyone can forge such tokens; only for testing (OPTIONAL)
+	InsecureSkipIDTokenVerification bool `json:"insecure_skip_id_token_verification"`
+	// Provider endpoint for RP-initiated logout. Set from the discovery url when available
+	EndSessionURL     string `json:"end_session_url"`
 
 	// Authentication flow type to use. May be code, implicit or hybrid
 	AuthFlowType      string `json:"auth_flow_type"`
@@ -47,17 +57,60 @@ type OIDCProviderConfig struct {
 	// Scopes to include in Authentication requests
 	Scopes            []string `json:"scopes"`
 
+	// Where the provider sends users after logging out, must be registered with the provider (OPTIONAL)
+	PostLogoutRedirectURI string `json:"post_logout_redirect_uri"`
+	// Track provider sessions and accept logout tokens at /oidc/<name>/backchannel_logout. Requires a jwks_url (OPTIONAL)
+	BackChannelLogout bool   `json:"backchannel_logout"`
+
+	// Rules to map provider claims to group links and pass claims through to issued tokens (OPTIONAL)
+	ClaimMapping      OIDCClaimMappingConfig `json:"claim_mapping"`
+	// Rules users must satisfy to log in and the groups new users start with (OPTIONAL)
+	Provisioning      ProvisioningConfig `json:"provisioning"`
+
 	// Extra authentication requirements that are added to the request (OPTIONAL)
 	ExtraArguments    map[string]string `json:"extra_arguments"`
-	// State secret, must be the same accross deployments. Random generated bytes if not specified (OPTIONAL)
-	StateSecret       string `json:"state_secret"`
+	// Absolute urls that users may be sent to with the next parameter after authenticating.
+	// A next url must match the scheme and host and start with the path of one of these. Relative paths are always allowed (OPTIONAL)
+	AllowedRedirects  []string `json:"allowed_redirects"`
+	// Finish logins on the server and redirect to the next url with a one-time code instead of passing provider tokens to the browser (OPTIONAL)
+	ServerCompletion  bool   `json:"server_completion"`
+	// Where to redirect after a server completed login when no next url is given. Must be a relative path or an allowed redirect (OPTIONAL)
+	CompletionURL     string `json:"completion_url"`
+}
+
+type OIDCClaimMappingConfig struct {
+	// Rules that apply group links based on provider claims.
+	// Group links of the form claim=value and nested.claim=value always match scalar claims and array elements.
+	Groups      []OIDCGroupMapping `json:"groups"`
+	// Provider claims to copy into issued tokens
+	Passthrough []OIDCClaimPassthrough `json:"passthrough"`
+}
+
+type OIDCGroupMapping struct {
+	// Dotted path to the provider claim, i.e. realm_access.roles
+	Claim string `json:"claim"`
+	// Value the claim must equal or, for array claims, contain
+	Value string `json:"value"`
+	// Regular expression the claim or an array element must match. Used instead of value
+	Match string `json:"match"`
+	// Resource spec of the group links to apply when the claim matches
+	Link  string `json:"link"`
+}
+
+type OIDCClaimPassthrough struct {
+	// Dotted path to the provider claim
+	Claim   string `json:"claim"`
+	// Claim name to use in the stoke token. Defaults to the last element of the claim path
+	As      string `json:"as"`
+	// Whether to store the claim in the database as a provider managed group
+	Persist bool   `json:"persist"`
 }
 
 func (o OIDCProviderConfig) TypeSpec() string {
 	return "OIDC:" + o.Name
 }
 
-func (o OIDCProviderConfig) CreateProvider(ctx context.Context) foreignProvider {
+func (o OIDCProviderConfig) CreateProvider(ctx context.Context) (foreignProvider, error) {
 	logger := zerolog.Ctx(ctx).With().
 		Str("provider_name", o.Name).
 		Str("token_url", o.TokenURL).
@@ -68,12 +121,14 @@ func (o OIDCProviderConfig) CreateProvider(ctx context.Context) foreignProvider
 		Logger()
 
 	if o.DiscoveryURL != "" {
-		o.retrieveConfigFromDiscovery(ctx)
+		if err := o.retrieveConfigFromDiscovery(ctx); err != nil {
+			return nil, err
+		}
 	}
 
 	authURL, err := url.Parse(o.AuthorizationURL)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("Authentication URL is required")
+		return nil, fmt.Errorf("Authentication URL is required: %w", err)
 	}
 
 	tokenURL, _ := url.Parse(o.TokenURL)
@@ -86,7 +141,7 @@ func (o OIDCProviderConfig) CreateProvider(ctx context.Context) foreignProvider
 	case "user_info", "user info", "endpoint":
 		sourceType = usr.USER_INFO
 	default:
-		logger.Fatal().Msg("Unsupported claims_source. Must be id token or endpoint.")
+		return nil, fmt.Errorf("Unsupported claims_source %q. Must be id token or endpoint.", o.ClaimsSource)
 	}
 
 	var flowType usr.AuthFlowType
@@ -106,25 +161,99 @@ func (o OIDCProviderConfig) CreateProvider(ctx context.Context) foreignProvider
 				flowType = usr.HYBRID_USER_INFO
 			}
 		default:
-			logger.Fatal().Msg("Unsupported auth_flow_type. Must be code, implicit or hybrid")
+			return nil, fmt.Errorf("Unsupported auth_flow_type %q. Must be code, implicit or hybrid", o.AuthFlowType)
 	}
 	allScopes := strings.Join(o.Scopes, " ")
 	if !strings.Contains(allScopes, "openid") {
 		allScopes += " openid"
 	}
 
-	return usr.NewOIDCUserProvider(
+	var endSessionURL *url.URL
+	if o.EndSessionURL != "" {
+		if endSessionURL, err = url.Parse(o.EndSessionURL); err != nil {
+			return nil, fmt.Errorf("Could not parse end_session_url: %w", err)
+		}
+	}
+	if o.BackChannelLogout && o.JWKSURL == "" {
+		return nil, fmt.Errorf("backchannel_logout requires a jwks_url to verify logout tokens")
+	}
+	if o.JWKSURL == "" && !o.InsecureSkipIDTokenVerification {
+		return nil, fmt.Errorf("jwks_url is required to verify id tokens. Set it, use a discovery_url that publishes jwks_uri or set insecure_skip_id_token_verification")
+	}
+	if o.JWKSURL != "" && o.Issuer == "" {
+		return nil, fmt.Errorf("issuer is required with a jwks_url. Set it or use a discovery_url that publishes issuer")
+	}
+
+	var mappings []usr.ClaimMapping
+	for _, m := range o.ClaimMapping.Groups {
+		mapping := usr.ClaimMapping{
+			Claim: m.Claim,
+			Value: m.Value,
+			Link:  m.Link,
+		}
+		if m.Match != "" {
+			re, err := regexp.Compile(m.Match)
+			if err != nil {
+				return nil, fmt.Errorf("Could not compile claim mapping match %s: %w", m.Match, err)
+			}
+			mapping.Match = re
+		}
+		if m.Claim == "" || m.Link == "" {
+			return nil, fmt.Errorf("Claim mappings require claim and link: %+v", m)
+		}
+		mappings = append(mappings, mapping)
+	}
+	var passthrough []usr.ClaimPassthrough
+	for _, p := range o.ClaimMapping.Passthrough {
+		if p.Claim == "" {
+			return nil, fmt.Errorf("Passthrough claims require claim")
+		}
+		passthrough = append(passthrough, usr.ClaimPassthrough{
+			Claim:   p.Claim,
+			As:      p.As,
+			Persist: p.Persist,
+		})
+	}
+	provisioning, err := o.Provisioning.rules()
+	if err != nil {
+		return nil, err
+	}
+	if err := usr.CheckCompletionURL(o.CompletionURL, o.AllowedRedirects); err != nil {
+		return nil, err
+	}
+
+	provider := usr.NewOIDCUserProvider(
 		o.Name, allScopes, o.RedirectURI,
 		o.FirstNameClaim, o.LastNameClaim, o.EmailClaim,
-		o.StateSecret, o.ClientID, o.ClientSecret,
+		o.ClientID, o.ClientSecret,
 		o.ExtraArguments,
 		tokenURL, authURL, userInfoURL,
 		MuxFromContext(ctx),
 		flowType, sourceType,
 	)
+
+	provider.AllowedRedirects = o.AllowedRedirects
+	provider.ServerCompletion = o.ServerCompletion
+	provider.CompletionURL = o.CompletionURL
+	provider.PostLogoutRedirectURI = o.PostLogoutRedirectURI
+	provider.BackChannelLogout = o.BackChannelLogout
+	provider.EndSessionURL = endSessionURL
+	provider.ClaimMappings = mappings
+	provider.Passthrough = passthrough
+	provider.Provisioning = provisioning
+
+	if o.JWKSURL != "" {
+		provider.VerifyIDTokens(o.JWKSURL, o.Issuer)
+	} else {
+		logger.Warn().Msg("No jwks_url configured. Id token signatures will not be verified.")
+		provider.Issuer = o.Issuer
+		provider.SkipIDTokenVerification = true
+	}
+
+	return provider, nil
 }
 
-func (o *OIDCProviderConfig) retrieveConfigFromDiscovery(ctx context.Context) {
+func (o *OIDCProviderConfig) retrieveConfigFromDiscovery(ctx context.Context) error {
 	logger := zerolog.Ctx(ctx).With().
 		Str("discovery_url", o.DiscoveryURL).
 		Logger()
@@ -132,31 +261,62 @@ func (o *OIDCProviderConfig) retrieveConfigFromDiscovery(ctx context.Context) {
 	logger.Info().Msg("Retrieving config from discovery url")
 	resp, err := http.Get(o.DiscoveryURL)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("Could not retrieve info from discovery url")
+		return fmt.Errorf("Could not retrieve info from discovery url: %w", err)
 	}
+	defer resp.Body.Close()
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("Could not retrieve info from discovery url")
+		return fmt.Errorf("Could not retrieve info from discovery url: %w", err)
 	}
 	discInfo := make(map[string]interface{})
 	err = json.Unmarshal(bodyBytes, &discInfo)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("Could not retrieve info from discovery url")
+		return fmt.Errorf("Could not retrieve info from discovery url: %w", err)
 	}
 
-	if u, ok := discInfo["authorization_endpoint"]; ok {
-		o.AuthorizationURL = u.(string)
-	}
-	if u, ok := discInfo["token_endpoint"]; ok {
-		o.TokenURL = u.(string)
-	}
-	if u, ok := discInfo["userinfo_endpoint"]; ok {
-		o.UserInfoURL = u.(string)
+	for _, field := range []struct{
+		name   string
+		target *string
+		// Configured values are kept
+		keep   bool
+	}{
+		{ "authorization_endpoint", &o.AuthorizationURL, false },
+		{ "token_endpoint", &o.TokenURL, false },
+		{ "userinfo_endpoint", &o.UserInfoURL, false },
+		{ "jwks_uri", &o.JWKSURL, true },
+		{ "issuer", &o.Issuer, true },
+		{ "end_session_endpoint", &o.EndSessionURL, true },
+	} {
+		value, ok, err := discoveryValue(discInfo, field.name)
+		if err != nil {
+			return err
+		}
+		if ok && (!field.keep || *field.target == "") {
+			*field.target = value
+		}
 	}
 
 	logger.Info().
 		Str("auth_url", o.AuthorizationURL).
 		Str("token_url", o.TokenURL).
 		Str("user_info_url", o.UserInfoURL).
+		Str("jwks_url", o.JWKSURL).
+		Str("issuer", o.Issuer).
+		Str("end_session_url", o.EndSessionURL).
 		Msg("Done dicovering config.")
+	return nil
+}
+
+// discoveryValue returns a field of the discovery document and whether it is present.
+// Fields that are present must be non-empty strings
+func discoveryValue(discInfo map[string]interface{}, name string) (string, bool, error) {
+	value, ok := discInfo[name]
+	if !ok {
+		return "", false, nil
+	}
+	s, ok := value.(string)
+	if !ok || s == "" {
+		return "", false, fmt.Errorf("Discovery document field %s must be a non-empty string, got %v", name, value)
+	}
+	return s, true, nil
 }
@@ -0,0 +1,37 @@
+package cfg
+
+import (
+	"fmt"
+	"regexp"
+	"stoke/internal/usr"
+)
+
+type ProvisioningConfig struct {
+	// Email domains users must have to log in, i.e. example.com. Any domain is allowed when empty (OPTIONAL)
+	AllowedDomains    []string          `json:"allowed_domains"`
+	// Regular expressions that attributes or claims must match to log in, i.e. hd: ^example\.com$ (OPTIONAL)
+	AttributePatterns map[string]string `json:"attribute_patterns"`
+	// Names of the claim groups given to every new user (OPTIONAL)
+	DefaultGroups     []string          `json:"default_groups"`
+	// Let users without any linked groups log in with the groups they already have instead of failing (OPTIONAL)
+	AllowUnlinked     bool              `json:"allow_unlinked"`
+}
+
+func (p ProvisioningConfig) rules() (usr.ProvisioningRules, error) {
+	rules := usr.ProvisioningRules{
+		AllowedDomains: p.AllowedDomains,
+		DefaultGroups:  p.DefaultGroups,
+		AllowUnlinked:  p.AllowUnlinked,
+	}
+	if len(p.AttributePatterns) > 0 {
+		rules.AttributePatterns = make(map[string]*regexp.Regexp, len(p.AttributePatterns))
+	}
+	for name, pattern := range p.AttributePatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return rules, fmt.Errorf("Could not compile provisioning attribute pattern for %s: %w", name, err)
+		}
+		rules.AttributePatterns[name] = re
+	}
+	return rules, nil
+}
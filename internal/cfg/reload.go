@@ -0,0 +1,233 @@
+package cfg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"stoke/internal/ent/schema/policy"
+	"stoke/internal/lifecycle"
+	"stoke/internal/usr"
+
+	"github.com/ghodss/yaml"
+	"github.com/rs/zerolog"
+)
+
+// WatchForReload reloads the configuration from filename when the process receives SIGHUP or,
+// if users.watch_sec is set, when the config file, provider config files or database init files change.
+func (c *Config) WatchForReload(filename string, ctx context.Context) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "cfg.Reload").
+		Str("configFile", filename).
+		Logger()
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	var poll <-chan time.Time
+	if watchSec := c.CurrentUsers().WatchSec; watchSec > 0 {
+		ticker := time.NewTicker(time.Duration(watchSec) * time.Second)
+		poll = ticker.C
+		lifecycle.Go(ctx, func() {
+			<-ctx.Done()
+			ticker.Stop()
+		})
+	}
+
+	lifecycle.Go(ctx, func() {
+		defer signal.Stop(hup)
+
+		lastSeen := c.watchedFilesSignature(filename)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				logger.Info().Msg("Received SIGHUP. Reloading configuration...")
+			case <-poll:
+				current := c.watchedFilesSignature(filename)
+				if current == lastSeen {
+					continue
+				}
+				logger.Info().Msg("Configuration files changed. Reloading configuration...")
+			}
+
+			if err := c.Reload(filename, logger.WithContext(ctx)); err != nil {
+				logger.Error().Err(err).Msg("Could not reload configuration")
+			}
+			lastSeen = c.watchedFilesSignature(filename)
+		}
+	})
+}
+
+// Reload re-reads filename and applies the settings that can change while running:
+// provider definitions, database init files and policy configuration.
+// Changes to any other section are logged and ignored until the next restart.
+func (c *Config) Reload(filename string, ctx context.Context) error {
+	logger := zerolog.Ctx(ctx).With().Str("component", "cfg.Reload").Logger()
+
+	newConf, err := readConfig(filename)
+	if err != nil {
+		return err
+	}
+
+	for _, section := range c.staticChanges(newConf) {
+		logger.Error().
+			Str("setting", section).
+			Msg("Setting cannot be changed while running. Restart stoke to apply this change.")
+	}
+
+	users := newConf.Users
+	if c.userInitFileOverride != "" {
+		users.UserInitFile = c.userInitFileOverride
+	}
+	if users.ProviderConfigDir == "" {
+		users.ProviderConfigDir = "/etc/stoke/providers.d/"
+	}
+
+	// Everything is parsed and validated, including creating the providers, before the database is changed,
+	// so a bad configuration leaves both the running providers and the database as they were
+	if err := users.parseProviders(ctx); err != nil {
+		return err
+	}
+	if err := users.checkClaimNames(c.Tokens); err != nil {
+		return err
+	}
+	mfa, err := users.mfa(c.Tokens.KeyEncryptionSecret)
+	if err != nil {
+		return err
+	}
+
+	providerList := users.newProviderList()
+	providerList.SetMFA(mfa)
+	for _, prov := range users.Providers {
+		created, err := prov.CreateProvider(ctx)
+		if err != nil {
+			// Keep serving the running providers
+			providerList.DiscardForeignProviders()
+			return fmt.Errorf("Could not create provider %s: %w", prov.Name, err)
+		}
+		providerList.AddForeignProvider(prov.Name, created)
+	}
+	providerList.SetProviderChain(users.providerChain(ctx))
+	providerList.SetOfflineLogin(users.offlineLogin(ctx))
+
+	if err := users.initLocalDatabase(ctx); err != nil {
+		logger.Warn().
+			Err(err).
+			Msg("Could not init local database")
+	}
+	if users.CreateStokeClaims {
+		if err := providerList.CheckCreateForStokeClaims(ctx); err != nil {
+			logger.Error().
+				Err(err).
+				Msg("Error while creating stoke claims")
+		}
+	}
+	usr.ProviderFromCtx(ctx).ReplaceForeignProviders(providerList)
+
+	p := users.PolicyConfig
+	policy.ReconfigurePolicies(
+		p.ProtectedUsers,
+		p.ProtectedClaims,
+		p.ProtectedGroups,
+		c.Tokens.UserInfo["username"],
+		p.ReadOnlyMode,
+		p.AllowSuperuserOverride,
+		ctx,
+	)
+
+	c.reloadedUsers.Store(&users)
+
+	logger.Info().
+		Int("providers", len(users.Providers)).
+		Bool("readOnlyMode", p.ReadOnlyMode).
+		Msg("Configuration reloaded")
+	return nil
+}
+
+// staticChanges returns the config sections that differ from the running configuration but can not be reloaded
+func (c *Config) staticChanges(newConf *Config) []string {
+	var changed []string
+
+	// Parsed durations are not set on the new config
+	newTokens := newConf.Tokens
+	newTokens.TokenDuration = c.Tokens.TokenDuration
+	newTokens.KeyDuration = c.Tokens.KeyDuration
+	// Telemetry shutdown functions are only set on the running config
+	newTelemetry := newConf.Telemetry
+	newTelemetry.shutdownFuncs = c.Telemetry.shutdownFuncs
+
+	sections := []struct {
+		name     string
+		old, new any
+	}{
+		{ "server", c.Server, newConf.Server },
+		{ "database", c.Database, newConf.Database },
+		{ "logging", c.Logging, newConf.Logging },
+		{ "tokens", c.Tokens, newTokens },
+		{ "telemetry", c.Telemetry, newTelemetry },
+		{ "cluster", c.Cluster, newConf.Cluster },
+		{ "users.watch_sec", c.CurrentUsers().WatchSec, newConf.Users.WatchSec },
+	}
+	for _, s := range sections {
+		if !reflect.DeepEqual(s.old, s.new) {
+			changed = append(changed, s.name)
+		}
+	}
+	return changed
+}
+
+// watchedFilesSignature summarizes the modification times of every file that affects a reload
+func (c *Config) watchedFilesSignature(filename string) string {
+	users := c.CurrentUsers()
+	files := []string{ filename }
+	if users.UserInitFile != "" {
+		files = append(files, users.UserInitFile)
+	}
+	for _, dir := range []string{ users.ProviderConfigDir, users.UserInitDir } {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if isYAMLFile(e.Name()) {
+				files = append(files, path.Join(dir, e.Name()))
+			}
+		}
+	}
+	sort.Strings(files)
+
+	var sig strings.Builder
+	for _, f := range files {
+		stat, err := os.Stat(f)
+		if err != nil {
+			fmt.Fprintf(&sig, "%s:missing;", f)
+			continue
+		}
+		fmt.Fprintf(&sig, "%s:%d:%d;", f, stat.ModTime().UnixNano(), stat.Size())
+	}
+	return sig.String()
+}
+
+func readConfig(filename string) (*Config, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read config file: %s, %w", filename, err)
+	}
+	conf := &Config{}
+	if err = yaml.Unmarshal(content, conf); err != nil {
+		return nil, fmt.Errorf("Could not read config yaml: %s, %w", filename, err)
+	}
+	return conf, nil
+}
@@ -0,0 +1,126 @@
+package cfg
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"stoke/internal/usr"
+
+	"github.com/rs/zerolog"
+)
+
+type SAMLProviderConfig struct {
+	// Name of this SAML provider. Used in the login, acs and metadata URLs
+	Name              string `json:"name"`
+
+	// URL to download the IdP metadata from
+	IdPMetadataURL    string `json:"idp_metadata_url"`
+	// File to read the IdP metadata from. Used instead of the url when set
+	IdPMetadataFile   string `json:"idp_metadata_file"`
+
+	// Entity ID of stoke as a service provider. Defaults to the metadata url, <base_url>/saml/<name>/metadata
+	EntityID          string `json:"entity_id"`
+	// Public base url of stoke, i.e. https://stoke.example. Used to build the acs and metadata urls
+	BaseURL           string `json:"base_url"`
+	// Name ID format to request from the IdP (OPTIONAL)
+	NameIDFormat      string `json:"name_id_format"`
+
+	// Attribute to use as first name. Matches the attribute Name or FriendlyName
+	FirstNameAttribute string `json:"first_name_attribute"`
+	// Attribute to use as last name. Matches the attribute Name or FriendlyName
+	LastNameAttribute  string `json:"last_name_attribute"`
+	// Attribute to use as email. Defaults to the NameID
+	EmailAttribute     string `json:"email_attribute"`
+	// Attribute to use as username. Defaults to the NameID
+	UsernameAttribute  string `json:"username_attribute"`
+	// Attributes whose values are matched to group link resource specs, i.e. groups
+	GroupAttributes    []string `json:"group_attributes"`
+	// Rules users must satisfy to log in and the groups new users start with (OPTIONAL)
+	Provisioning       ProvisioningConfig `json:"provisioning"`
+
+	// Absolute urls that users may be sent to with the next parameter after authenticating. Relative paths are always allowed (OPTIONAL)
+	AllowedRedirects  []string `json:"allowed_redirects"`
+	// Where to redirect after login when no next url is given. Must be a relative path or an allowed redirect (OPTIONAL)
+	CompletionURL     string   `json:"completion_url"`
+}
+
+func (s SAMLProviderConfig) TypeSpec() string {
+	return "SAML:" + s.Name
+}
+
+func (s SAMLProviderConfig) CreateProvider(ctx context.Context) (foreignProvider, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("provider_name", s.Name).
+		Str("idp_metadata_url", s.IdPMetadataURL).
+		Str("idp_metadata_file", s.IdPMetadataFile).
+		Logger()
+
+	if s.BaseURL == "" {
+		return nil, fmt.Errorf("base_url is required")
+	}
+	routeURL := s.BaseURL + "/saml/" + s.Name
+	entityID := s.EntityID
+	if entityID == "" {
+		entityID = routeURL + "/metadata"
+	}
+
+	metadata, err := s.readIdPMetadata(logger.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	idp, err := usr.ParseSAMLIdPMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse IdP metadata: %w", err)
+	}
+	provisioning, err := s.Provisioning.rules()
+	if err != nil {
+		return nil, err
+	}
+	if err := usr.CheckCompletionURL(s.CompletionURL, s.AllowedRedirects); err != nil {
+		return nil, err
+	}
+
+	provider := usr.NewSAMLUserProvider(
+		s.Name, entityID, routeURL + "/acs",
+		s.FirstNameAttribute, s.LastNameAttribute, s.EmailAttribute, s.UsernameAttribute,
+		s.GroupAttributes,
+		idp,
+		MuxFromContext(ctx),
+	)
+	provider.NameIDFormat = s.NameIDFormat
+	provider.AllowedRedirects = s.AllowedRedirects
+	provider.CompletionURL = s.CompletionURL
+	provider.Provisioning = provisioning
+
+	return provider, nil
+}
+
+func (s SAMLProviderConfig) readIdPMetadata(ctx context.Context) ([]byte, error) {
+	if s.IdPMetadataFile != "" {
+		data, err := os.ReadFile(s.IdPMetadataFile)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read IdP metadata file: %w", err)
+		}
+		return data, nil
+	}
+	if s.IdPMetadataURL == "" {
+		return nil, fmt.Errorf("idp_metadata_url or idp_metadata_file is required")
+	}
+
+	zerolog.Ctx(ctx).Info().Msg("Retrieving IdP metadata")
+	resp, err := http.Get(s.IdPMetadataURL)
+	if err != nil {
+		return nil, fmt.Errorf("Could not retrieve IdP metadata: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Could not retrieve IdP metadata: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Could not retrieve IdP metadata: status %d", resp.StatusCode)
+	}
+	return data, nil
+}
@@ -3,6 +3,7 @@ package cfg
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 type Server struct {
@@ -25,6 +26,20 @@ type Server struct {
 
 	// Disable the admin UI
 	DisableAdmin bool     `json:"disable_admin"`
+
+	// Include foreign provider reachability in /readyz. Unreachable providers degrade readiness without failing it
+	HealthCheckProviders bool `json:"health_check_providers"`
+
+	// Seconds to wait for in-flight requests to finish when shutting down. Defaults to 15
+	ShutdownDrainSec int `json:"shutdown_drain_sec"`
+}
+
+// ShutdownDrain returns how long to wait for in-flight requests during shutdown
+func (s Server) ShutdownDrain() time.Duration {
+	if s.ShutdownDrainSec <= 0 {
+		return 15 * time.Second
+	}
+	return time.Duration(s.ShutdownDrainSec) * time.Second
 }
 
 func (s Server) WithContext(ctx context.Context) context.Context {
@@ -20,6 +20,8 @@ type Tokens struct {
 	NumBits          int    `json:"num_bits"`
 	// Whether or not to save the private keys in the database
 	PersistKeys      bool   `json:"persist_keys"`
+	// Secret used to encrypt private keys saved in the database. Required when cluster key_mode is shared
+	KeyEncryptionSecret string `json:"key_encryption_secret"`
 	// How long to keep signing keys alive
 	KeyDurationStr   string `json:"key_duration"`
 	// How long to issue tokens for
@@ -85,7 +87,7 @@ func (t *Tokens) withContext(ctx context.Context) context.Context {
 			Msg("Unsupported algorithm")
 	}
 
-	if cl := ClusterFromContext(ctx); cl != nil && cl.Enabled {
+	if cl := ClusterFromContext(ctx); cl != nil && cl.Enabled && !cl.SharedKeys() {
 		discoverer := &cluster.StaticDiscoverer{URLs: cl.StaticPeers}
 		basePath := Ctx(ctx).Server.BasePath
 		refreshSec := cl.RefreshSec
@@ -122,13 +124,34 @@ func (t *Tokens) createRSAIssuer(ctx context.Context) key.TokenIssuer {
 }
 
 func createAsymetricIssuer[P key.PrivateKey](t *Tokens, ctx context.Context, pair key.KeyPair[P]) *key.AsymetricTokenIssuer[P] {
-	persistKeys := t.PersistKeys
-	keyIdPrefix := ""
-	if cl := ClusterFromContext(ctx); cl != nil && cl.Enabled {
-		persistKeys = false
-		keyIdPrefix = cl.InstanceID
+	logger := zerolog.Ctx(ctx).With().Str("component", "cfg.Tokens").Logger()
+
+	var cipher *key.KeyCipher
+	if t.KeyEncryptionSecret != "" {
+		var err error
+		if cipher, err = key.NewKeyCipher(t.KeyEncryptionSecret); err != nil {
+			logger.Fatal().Err(err).Msg("Could not create key cipher")
+		}
+	}
+
+	var cache *key.PrivateKeyCache[P]
+	var err error
+	cl := ClusterFromContext(ctx)
+	if cl.SharedKeys() {
+		if cipher == nil {
+			logger.Fatal().Msg("tokens.key_encryption_secret is required when cluster.key_mode is shared")
+		}
+		elector := cluster.NewDBLease("key-management", cl.InstanceID, time.Duration(cl.LeaseSec) * time.Second)
+		cache, err = key.NewSharedPrivateKeyCache(t.TokenDuration, t.KeyDuration, pair, elector, cipher, sharedKeyPoll(cl, t.TokenDuration), ctx)
+	} else {
+		persistKeys := t.PersistKeys
+		keyIdPrefix := ""
+		if cl != nil && cl.Enabled {
+			persistKeys = false
+			keyIdPrefix = cl.InstanceID
+		}
+		cache, err = key.NewEncryptedPrivateKeyCache(t.TokenDuration, t.KeyDuration, persistKeys, pair, ctx, keyIdPrefix, cipher)
 	}
-	cache, err := key.NewPrivateKeyCache(t.TokenDuration, t.KeyDuration, persistKeys, pair, ctx, keyIdPrefix)
 	if err != nil {
 		zerolog.Ctx(ctx).Fatal().
 			Str("component", "cfg.Tokens").
@@ -142,3 +165,14 @@ func createAsymetricIssuer[P key.PrivateKey](t *Tokens, ctx context.Context, pai
 		TokenRefreshCountKey: t.TokenRefreshCountKey,
 	}
 }
+
+// sharedKeyPoll is how often replicas reload shared keys and the leader renews its lease. Replicas must pick up a new key well
+// before it is activated, so the poll interval is capped at a quarter of the token duration. The lease is renewed at least
+// three times per lease so a slow renewal does not hand leadership to another replica.
+func sharedKeyPoll(cl *Cluster, tokenDur time.Duration) time.Duration {
+	return min(
+		time.Duration(cl.RefreshSec) * time.Second,
+		tokenDur / 4,
+		time.Duration(cl.LeaseSec) * time.Second / 3,
+	)
+}
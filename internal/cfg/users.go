@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"regexp"
+	"slices"
 	"stoke/internal/ent"
+	"stoke/internal/key"
 	"stoke/internal/ent/schema/policy"
 	"stoke/internal/usr"
 	"strings"
+	"time"
 
 	"github.com/ghodss/yaml"
 
@@ -29,6 +33,78 @@ type Users struct {
 	PolicyConfig PolicyConfig           `json:"policy_config"`
 	// Configs for providers
 	Providers         []*ProviderConfig `json:"providers"`
+	// Providers tried in order when a login does not name a provider. Only password based providers (ldap, webhook, htpasswd) may be used
+	ProviderChain     []string          `json:"provider_chain"`
+	// Rules that choose the provider for a login that does not name one. The first matching rule wins
+	RealmRules        []RealmRule       `json:"realm_rules"`
+	// Consecutive source errors after which logins are not sent to a provider. Defaults to 5
+	BreakerThreshold  int               `json:"breaker_threshold"`
+	// Seconds logins are not sent to a failing provider before one login probes it again. Defaults to 30
+	BreakerOpenSec    int               `json:"breaker_open_sec"`
+	// Cached credential fallback for password based providers that can not be reached
+	OfflineLogin      OfflineLogin      `json:"offline_login"`
+	// TOTP multi-factor authentication for local users
+	MFA               MFA               `json:"mfa"`
+	// Seconds between checking the config, provider and init files for changes. 0 disables watching; SIGHUP always reloads
+	WatchSec          int               `json:"watch_sec"`
+}
+
+type RealmRule struct {
+	// Matches usernames ending in @EmailDomain, ignoring case
+	EmailDomain     string `json:"email_domain"`
+	// Regular expression matched against the username
+	UsernamePattern string `json:"username_pattern"`
+	// Name of the provider to use
+	Provider        string `json:"provider"`
+}
+
+type OfflineLogin struct {
+	// Password based providers whose users may log in with cached credentials while the provider is unreachable
+	Providers     []string `json:"providers"`
+	// Seconds a cached credential is accepted after the last successful login with the provider. Defaults to 86400
+	CredentialSec int      `json:"credential_sec"`
+	// Seconds tokens issued from cached credentials are valid. Defaults to 300 and never exceeds the token duration
+	TokenSec      int      `json:"token_sec"`
+	// Claim set to "true" in tokens issued from cached credentials. Defaults to "degraded"
+	Claim         string   `json:"claim"`
+}
+
+type MFA struct {
+	// Let local users enroll an authenticator app and require its codes on login
+	Enabled          bool   `json:"enabled"`
+	// Secret used to encrypt the TOTP secrets of users. Defaults to tokens.key_encryption_secret
+	EncryptionSecret string `json:"encryption_secret"`
+	// Issuer shown in authenticator apps. Defaults to "stoke"
+	Issuer           string `json:"issuer"`
+	// Leave stk claims out of tokens of local users until they enable MFA
+	RequireForAdmins bool   `json:"require_for_admins"`
+	// Seconds a login has to answer an MFA challenge. Defaults to 300
+	ChallengeSec     int    `json:"challenge_sec"`
+}
+
+// ClaimName is the claim that marks tokens issued from cached credentials
+func (o OfflineLogin) ClaimName() string {
+	if o.Claim == "" {
+		return "degraded"
+	}
+	return o.Claim
+}
+
+// TokenDuration is the duration of tokens issued from cached credentials
+func (o OfflineLogin) TokenDuration(tokenDur time.Duration) time.Duration {
+	dur := 5 * time.Minute
+	if o.TokenSec > 0 {
+		dur = time.Duration(o.TokenSec) * time.Second
+	}
+	return min(dur, tokenDur)
+}
+
+// Provider types that authenticate a username and password directly and may be tried in a provider chain.
+// Other providers take tokens and must not be sent user passwords.
+var passwordProviderTypes = map[string]bool{
+	"ldap": true,
+	"webhook": true,
+	"htpasswd": true,
 }
 
 type PolicyConfig struct {
@@ -64,7 +140,18 @@ func (u *Users) withContext(ctx context.Context) context.Context {
 			Msg("Error parsing providers")
 	}
 
-	providerList := usr.NewProviderList()
+	if err := u.checkClaimNames(Ctx(ctx).Tokens); err != nil {
+		logger.Fatal().
+			Err(err).
+			Msg("Invalid provider claims")
+	}
+
+	providerList := u.newProviderList()
+	if err := providerList.RegisterMetrics(); err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Could not register provider metrics")
+	}
 
 	if u.CreateStokeClaims {
 		if err := providerList.CheckCreateForStokeClaims(ctx); err != nil {
@@ -75,12 +162,177 @@ func (u *Users) withContext(ctx context.Context) context.Context {
 	}
 
 	for _, prov := range u.Providers {
-		providerList.AddForeignProvider(prov.Name, prov.CreateProvider(ctx))
+		created, err := prov.CreateProvider(ctx)
+		if err != nil {
+			logger.Fatal().
+				Err(err).
+				Str("provider", prov.Name).
+				Msg("Could not create provider")
+		}
+		providerList.AddForeignProvider(prov.Name, created)
+	}
+	providerList.SetProviderChain(u.providerChain(ctx))
+	providerList.SetOfflineLogin(u.offlineLogin(ctx))
+	mfa, err := u.mfa(Ctx(ctx).Tokens.KeyEncryptionSecret)
+	if err != nil {
+		logger.Fatal().
+			Err(err).
+			Msg("Could not configure MFA")
 	}
+	providerList.SetMFA(mfa)
+	providerList.StartDirectorySync(ctx)
 
 	return providerList.WithContext(ctx)
 }
 
+// Claims set by stoke itself or by the JWT standard. Provider claims may not use these names
+var reservedClaimNames = []string{ "stk", "amr", usr.SessionClaim, "iss", "sub", "aud", "exp", "nbf", "iat", "jti" }
+
+// checkClaimNames returns an error if an LDAP attribute claim or OIDC passthrough claim would be issued
+// under a reserved claim name, the offline login claim or a claim of tokens.user_info.
+// Such claims could grant stoke privileges or overwrite claims that stoke sets.
+func (u *Users) checkClaimNames(tokens Tokens) error {
+	reserved := slices.Clone(reservedClaimNames)
+	reserved = append(reserved, u.OfflineLogin.ClaimName())
+	for _, name := range tokens.UserInfo {
+		reserved = append(reserved, name)
+	}
+
+	for _, prov := range u.Providers {
+		var names []string
+		switch pc := prov.providerConfig.(type) {
+		case *LDAPProviderConfig:
+			for _, a := range pc.AttributeClaims {
+				names = append(names, usr.LDAPAttributeClaim{ Attribute: a.Attribute, As: a.As }.ShortName())
+			}
+		case *OIDCProviderConfig:
+			for _, p := range pc.ClaimMapping.Passthrough {
+				names = append(names, usr.ClaimPassthrough{ Claim: p.Claim, As: p.As }.ShortName())
+			}
+		}
+		for _, name := range names {
+			if slices.Contains(reserved, name) {
+				return fmt.Errorf("Provider %s can not issue claim %s. The name is reserved", prov.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+// newProviderList creates a provider list with the configured circuit breaker settings
+func (u *Users) newProviderList() *usr.ProviderList {
+	providerList := usr.NewProviderList()
+	providerList.BreakerThreshold = u.BreakerThreshold
+	providerList.BreakerOpenDuration = time.Duration(u.BreakerOpenSec) * time.Second
+	return providerList
+}
+
+// passwordProvider reports whether name is a configured provider that takes passwords.
+// Unknown providers and providers that only take tokens are logged.
+func (u *Users) passwordProvider(name string, logger zerolog.Logger) bool {
+	for _, prov := range u.Providers {
+		if prov.Name != name {
+			continue
+		}
+		if !passwordProviderTypes[strings.ToLower(prov.ProviderType)] {
+			logger.Error().
+				Str("provider", name).
+				Str("type", prov.ProviderType).
+				Msg("Provider does not take passwords and can not be used in the provider chain, realm rules or offline login")
+			return false
+		}
+		return true
+	}
+	logger.Error().
+		Str("provider", name).
+		Msg("Unknown provider in provider chain, realm rules or offline login")
+	return false
+}
+
+// providerChain returns the configured provider chain and realm rules.
+// Entries naming unknown providers, or providers that do not take passwords, are logged and left out.
+func (u *Users) providerChain(ctx context.Context) ([]string, []usr.RealmRule) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "cfg.Users.providerChain").
+		Logger()
+
+	var chain []string
+	for _, name := range u.ProviderChain {
+		if u.passwordProvider(name, logger) {
+			chain = append(chain, name)
+		}
+	}
+
+	var rules []usr.RealmRule
+	for _, rule := range u.RealmRules {
+		if !u.passwordProvider(rule.Provider, logger) {
+			continue
+		}
+		pattern := rule.UsernamePattern
+		if rule.EmailDomain != "" {
+			pattern = "(?i)@" + regexp.QuoteMeta(strings.TrimPrefix(rule.EmailDomain, "@")) + "$"
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil || pattern == "" {
+			logger.Error().
+				Err(err).
+				Str("provider", rule.Provider).
+				Str("pattern", pattern).
+				Msg("Realm rule needs an email_domain or a valid username_pattern")
+			continue
+		}
+		rules = append(rules, usr.RealmRule{ Pattern: re, Provider: rule.Provider })
+	}
+	return chain, rules
+}
+
+// offlineLogin returns the cached credential fallback settings.
+// Providers that are unknown or do not take passwords are logged and left out.
+func (u *Users) offlineLogin(ctx context.Context) usr.OfflineLogin {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "cfg.Users.offlineLogin").
+		Logger()
+
+	offline := usr.OfflineLogin{
+		CredentialTTL: 24 * time.Hour,
+		Claim: u.OfflineLogin.ClaimName(),
+	}
+	if u.OfflineLogin.CredentialSec > 0 {
+		offline.CredentialTTL = time.Duration(u.OfflineLogin.CredentialSec) * time.Second
+	}
+	for _, name := range u.OfflineLogin.Providers {
+		if u.passwordProvider(name, logger) {
+			offline.Providers = append(offline.Providers, name)
+		}
+	}
+	return offline
+}
+
+// mfa returns the multi-factor authentication settings. tokenSecret is used when no encryption secret is configured.
+func (u *Users) mfa(tokenSecret string) (usr.MFA, error) {
+	if !u.MFA.Enabled {
+		return usr.MFA{}, nil
+	}
+
+	secret := u.MFA.EncryptionSecret
+	if secret == "" {
+		secret = tokenSecret
+	}
+	if secret == "" {
+		return usr.MFA{}, fmt.Errorf("users.mfa.encryption_secret or tokens.key_encryption_secret is required when MFA is enabled")
+	}
+	cipher, err := key.NewKeyCipher(secret)
+	if err != nil {
+		return usr.MFA{}, err
+	}
+	return usr.MFA{
+		Cipher: cipher,
+		Issuer: u.MFA.Issuer,
+		RequireForAdmins: u.MFA.RequireForAdmins,
+		ChallengeTTL: time.Duration(u.MFA.ChallengeSec) * time.Second,
+	}, nil
+}
+
 func (u *Users) parseProviders(ctx context.Context) error {
 	logger := zerolog.Ctx(ctx).With().
 		Str("provider_config_dir", u.ProviderConfigDir).
@@ -191,7 +443,8 @@ type ProviderConfig struct {
 }
 
 type providerConfig interface {
-	CreateProvider(context.Context) foreignProvider
+	// Returns an error when the provider is misconfigured. Nothing is changed when it does
+	CreateProvider(context.Context) (foreignProvider, error)
 	TypeSpec() string
 }
 
@@ -218,6 +471,18 @@ func (pc *ProviderConfig) UnmarshalJSON(b []byte) error {
 	case "oidc", "OIDC":
 		pc.providerConfig = &OIDCProviderConfig{}
 		return json.Unmarshal(b, pc.providerConfig)
+	case "oauth2", "OAUTH2":
+		pc.providerConfig = &OAuth2ProviderConfig{}
+		return json.Unmarshal(b, pc.providerConfig)
+	case "saml", "SAML":
+		pc.providerConfig = &SAMLProviderConfig{}
+		return json.Unmarshal(b, pc.providerConfig)
+	case "webhook", "WEBHOOK":
+		pc.providerConfig = &WebhookProviderConfig{}
+		return json.Unmarshal(b, pc.providerConfig)
+	case "htpasswd", "HTPASSWD":
+		pc.providerConfig = &HtpasswdProviderConfig{}
+		return json.Unmarshal(b, pc.providerConfig)
 	}
 	return fmt.Errorf("Provider type not supported: %s", temp.ProviderType)
 }
@@ -0,0 +1,86 @@
+package cfg
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"stoke/internal/usr"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type WebhookProviderConfig struct {
+	// Name of this webhook provider
+	Name             string `json:"name"`
+	// HTTPS endpoint that credentials are posted to
+	URL              string `json:"url"`
+	// Shared secret used to sign requests with HMAC-SHA256
+	Secret           string `json:"secret"`
+	// Certificate file used to verify the endpoint in addition to the system roots (OPTIONAL)
+	CACert           string `json:"ca_cert"`
+
+	// Seconds to wait for each attempt. Defaults to 5
+	Timeout          int    `json:"timeout"`
+	// Attempts after a failed first attempt. Defaults to 2. Set to -1 to disable retries
+	Retries          int    `json:"retries"`
+	// Milliseconds to wait before the first retry, doubled for each following retry. Defaults to 200
+	RetryBackoffMs   int    `json:"retry_backoff_ms"`
+	// Consecutive failed logins before the webhook is no longer called. Defaults to 5
+	FailureThreshold int    `json:"failure_threshold"`
+	// Seconds the webhook is not called after failure_threshold is reached. Defaults to 30
+	OpenDuration     int    `json:"open_duration"`
+}
+
+func (w WebhookProviderConfig) TypeSpec() string {
+	return "WEBHOOK:" + w.Name
+}
+
+func (w WebhookProviderConfig) CreateProvider(ctx context.Context) (foreignProvider, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "cfg.WebhookProviderConfig.CreateProvider").
+		Str("provider_name", w.Name).
+		Str("url", w.URL).
+		Logger()
+
+	endpoint, err := url.Parse(w.URL)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return nil, fmt.Errorf("An https url is required: %s", w.URL)
+	}
+	if w.Secret == "" {
+		return nil, fmt.Errorf("A secret is required to sign webhook requests")
+	}
+
+	provider := usr.NewWebhookUserProvider(w.Name, endpoint, []byte(w.Secret))
+
+	if w.CACert != "" {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Msg("Could not load system cert pool. Using new empty pool.")
+			certPool = x509.NewCertPool()
+		}
+		publicCerts, err := readPublicCertFile(w.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read webhook ca cert file %s: %w", w.CACert, err)
+		}
+		for _, cert := range publicCerts {
+			certPool.AddCert(cert)
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{ RootCAs: certPool }
+		provider.Client = &http.Client{ Transport: transport }
+	}
+
+	provider.Timeout = time.Duration(w.Timeout) * time.Second
+	provider.Retries = w.Retries
+	provider.RetryBackoff = time.Duration(w.RetryBackoffMs) * time.Millisecond
+	provider.FailureThreshold = w.FailureThreshold
+	provider.OpenDuration = time.Duration(w.OpenDuration) * time.Second
+
+	return provider, nil
+}
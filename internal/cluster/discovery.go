@@ -6,3 +6,8 @@ import "context"
 type Discoverer interface {
 	Peers(ctx context.Context) ([]string, error)
 }
+
+// Deregisterer is implemented by discoverers that announce this replica and must remove it on shutdown.
+type Deregisterer interface {
+	Deregister(ctx context.Context) error
+}
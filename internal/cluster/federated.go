@@ -1,13 +1,41 @@
 package cluster
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"hppr.dev/stoke"
 )
 
+// PeerResult records the outcome of fetching a single peer's JWKS during a merge.
+type PeerResult struct {
+	URL     string
+	// Zero if the fetch failed
+	Fetched time.Time
+	// Empty if the fetch succeeded
+	Error   string
+	KeyIds  []string
+	Expires time.Time
+}
+
+// KeyConflict records a kid that was published by more than one source with different key material.
+// Sources holds "local" and/or peer base URLs in the order they were seen; the first one wins the merge.
+type KeyConflict struct {
+	KeyId   string
+	Sources []string
+}
+
+// MergeReport describes how a merged JWKS was assembled.
+type MergeReport struct {
+	Peers     []PeerResult
+	Conflicts []KeyConflict
+	Expires   time.Time
+}
+
 // MergeJWKS parses localJWKS as a JWKSet, fetches JWKS from each peer at
 // peerURL + "/api/pkeys?local=true" (local-only to avoid recursion), merges all keys deduplicating by KeyId, and returns
 // the combined JWKSet as JSON. Expires is set to the earliest expiry among
@@ -15,6 +43,13 @@ import (
 // Peer fetch failures (non-200 or decode error) cause that peer to be skipped;
 // the merge still succeeds.
 func MergeJWKS(localJWKS []byte, peerURLs []string, httpClient *http.Client) ([]byte, error) {
+	merged, _, err := MergeJWKSWithReport(localJWKS, peerURLs, httpClient)
+	return merged, err
+}
+
+// MergeJWKSWithReport behaves like MergeJWKS and also reports the per-peer fetch results
+// and any kids that were published with different key material by more than one source.
+func MergeJWKSWithReport(localJWKS []byte, peerURLs []string, httpClient *http.Client) ([]byte, *MergeReport, error) {
 	client := httpClient
 	if client == nil {
 		client = http.DefaultClient
@@ -22,59 +57,113 @@ func MergeJWKS(localJWKS []byte, peerURLs []string, httpClient *http.Client) ([]
 
 	var local stoke.JWKSet
 	if err := json.Unmarshal(localJWKS, &local); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	seen := make(map[string]bool)
+	report := &MergeReport{}
+	seen := make(map[string]*stoke.JWK)
+	seenFrom := make(map[string]string)
+	conflictIdx := make(map[string]int)
 	var keys []*stoke.JWK
 	expires := local.Expires
 
-	for _, k := range local.Keys {
-		if k == nil {
-			continue
-		}
-		if seen[k.KeyId] {
-			continue
+	addKeys := func(source string, set []*stoke.JWK) {
+		for _, k := range set {
+			if k == nil {
+				continue
+			}
+			if first, ok := seen[k.KeyId]; ok {
+				if !sameKeyMaterial(first, k) {
+					if i, ok := conflictIdx[k.KeyId]; ok {
+						report.Conflicts[i].Sources = append(report.Conflicts[i].Sources, source)
+					} else {
+						conflictIdx[k.KeyId] = len(report.Conflicts)
+						report.Conflicts = append(report.Conflicts, KeyConflict{KeyId: k.KeyId, Sources: []string{seenFrom[k.KeyId], source}})
+					}
+				}
+				continue
+			}
+			seen[k.KeyId] = k
+			seenFrom[k.KeyId] = source
+			keys = append(keys, k)
 		}
-		seen[k.KeyId] = true
-		keys = append(keys, k)
 	}
 
+	addKeys("local", local.Keys)
+
 	for _, baseURL := range peerURLs {
-		u := strings.TrimSuffix(baseURL, "/") + "/api/pkeys?local=true"
-		resp, err := client.Get(u)
+		peer, err := fetchPeerJWKS(baseURL, client)
+		result := PeerResult{URL: baseURL}
 		if err != nil {
+			result.Error = err.Error()
+			report.Peers = append(report.Peers, result)
 			continue
 		}
-		if resp.StatusCode != http.StatusOK {
-			_ = resp.Body.Close()
-			continue
-		}
-		var peer stoke.JWKSet
-		if err := json.NewDecoder(resp.Body).Decode(&peer); err != nil {
-			_ = resp.Body.Close()
-			continue
+		result.Fetched = time.Now()
+		result.Expires = peer.Expires
+		for _, k := range peer.Keys {
+			if k != nil {
+				result.KeyIds = append(result.KeyIds, k.KeyId)
+			}
 		}
-		_ = resp.Body.Close()
+		report.Peers = append(report.Peers, result)
 
 		if !peer.Expires.IsZero() && (expires.IsZero() || peer.Expires.Before(expires)) {
 			expires = peer.Expires
 		}
-		for _, k := range peer.Keys {
-			if k == nil {
-				continue
-			}
-			if seen[k.KeyId] {
-				continue
-			}
-			seen[k.KeyId] = true
-			keys = append(keys, k)
-		}
+		addKeys(baseURL, peer.Keys)
 	}
 
+	report.Expires = expires
+
 	out := stoke.JWKSet{
 		Expires: expires,
 		Keys:    keys,
 	}
-	return json.Marshal(out)
+	merged, err := json.Marshal(out)
+	return merged, report, err
+}
+
+func fetchPeerJWKS(baseURL string, client *http.Client) (*stoke.JWKSet, error) {
+	u := strings.TrimSuffix(baseURL, "/") + "/api/pkeys?local=true"
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from peer: %s", resp.Status)
+	}
+
+	peer := &stoke.JWKSet{}
+	if err := json.NewDecoder(resp.Body).Decode(peer); err != nil {
+		return nil, err
+	}
+	return peer, nil
+}
+
+// sameKeyMaterial compares two JWKs ignoring the key id and usage
+func sameKeyMaterial(a, b *stoke.JWK) bool {
+	aMat, aErr := keyMaterial(a)
+	bMat, bErr := keyMaterial(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+	return bytes.Equal(aMat, bMat)
+}
+
+func keyMaterial(k *stoke.JWK) ([]byte, error) {
+	raw, err := json.Marshal(k)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "kid")
+	delete(fields, "use")
+	// encoding/json sorts map keys so the output is stable
+	return json.Marshal(fields)
 }
@@ -162,3 +162,92 @@ func TestMergeJWKS_DedupByKeyId(t *testing.T) {
 		t.Errorf("expected p-0 and p-1, got %v", kids)
 	}
 }
+
+func TestMergeJWKSWithReport_DetectsKidConflict(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	localBytes, _ := json.Marshal(stoke.JWKSet{
+		Expires: exp,
+		Keys: []*stoke.JWK{
+			{KeyId: "p-0", KeyType: "EC", Use: "sig", Curve: "P-256", X: "x0", Y: "y0"},
+		},
+	})
+
+	// Same kid and material as local is not a conflict
+	sameBytes, _ := json.Marshal(stoke.JWKSet{
+		Expires: exp,
+		Keys: []*stoke.JWK{
+			{KeyId: "p-0", KeyType: "EC", Use: "sig", Curve: "P-256", X: "x0", Y: "y0"},
+		},
+	})
+	sameSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(sameBytes)
+	}))
+	defer sameSrv.Close()
+
+	otherBytes, _ := json.Marshal(stoke.JWKSet{
+		Expires: exp,
+		Keys: []*stoke.JWK{
+			{KeyId: "p-0", KeyType: "EC", Use: "sig", Curve: "P-256", X: "other", Y: "other"},
+		},
+	})
+	otherSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(otherBytes)
+	}))
+	defer otherSrv.Close()
+
+	_, report, err := MergeJWKSWithReport(localBytes, []string{sameSrv.URL, otherSrv.URL}, nil)
+	if err != nil {
+		t.Fatalf("MergeJWKSWithReport: %v", err)
+	}
+	if len(report.Conflicts) != 1 {
+		t.Fatalf("expected 1 conflict, got %d: %v", len(report.Conflicts), report.Conflicts)
+	}
+	c := report.Conflicts[0]
+	if c.KeyId != "p-0" || len(c.Sources) != 2 || c.Sources[0] != "local" || c.Sources[1] != otherSrv.URL {
+		t.Errorf("unexpected conflict: %+v", c)
+	}
+}
+
+func TestMergeJWKSWithReport_ReportsPeerResults(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	localBytes, _ := json.Marshal(stoke.JWKSet{Expires: exp})
+
+	peerExp := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	peerBytes, _ := json.Marshal(stoke.JWKSet{
+		Expires: peerExp,
+		Keys: []*stoke.JWK{
+			{KeyId: "stoke1-p-0", KeyType: "EC", Use: "sig", Curve: "P-256", X: "x1", Y: "y1"},
+		},
+	})
+	okSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(peerBytes)
+	}))
+	defer okSrv.Close()
+
+	badSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer badSrv.Close()
+
+	_, report, err := MergeJWKSWithReport(localBytes, []string{okSrv.URL, badSrv.URL}, nil)
+	if err != nil {
+		t.Fatalf("MergeJWKSWithReport: %v", err)
+	}
+	if len(report.Peers) != 2 {
+		t.Fatalf("expected 2 peer results, got %d", len(report.Peers))
+	}
+
+	ok := report.Peers[0]
+	if ok.Error != "" || ok.Fetched.IsZero() || !ok.Expires.Equal(peerExp) || len(ok.KeyIds) != 1 || ok.KeyIds[0] != "stoke1-p-0" {
+		t.Errorf("unexpected result for healthy peer: %+v", ok)
+	}
+
+	bad := report.Peers[1]
+	if bad.Error == "" || !bad.Fetched.IsZero() || len(bad.KeyIds) != 0 {
+		t.Errorf("unexpected result for failing peer: %+v", bad)
+	}
+
+	if !report.Expires.Equal(peerExp) {
+		t.Errorf("expires: got %v, want %v", report.Expires, peerExp)
+	}
+}
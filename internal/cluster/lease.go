@@ -0,0 +1,127 @@
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"stoke/internal/ent"
+	"stoke/internal/ent/lease"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Elector decides whether this replica should run cluster wide background work.
+type Elector interface {
+	// TryAcquire acquires or renews leadership. Returns true while this replica is the leader.
+	TryAcquire(ctx context.Context) (bool, error)
+	// Release gives up leadership so another replica may take over without waiting for expiry.
+	Release(ctx context.Context) error
+}
+
+// DBLease elects a leader by holding a named row in the lease table.
+// The holder must renew the lease before Duration passes or another replica may take it.
+type DBLease struct {
+	Name     string
+	Holder   string
+	Duration time.Duration
+
+	// Instance id the holder id starts with. Used to warn about replicas that share it
+	instanceID string
+	warned     bool
+}
+
+// NewDBLease creates a lease elector. The holder id is the instance id, or the hostname if it is empty,
+// with a random suffix, so replicas that share an instance id can not hold the lease at the same time.
+func NewDBLease(name, instanceID string, duration time.Duration) *DBLease {
+	return &DBLease{
+		Name:       name,
+		Holder:     holderID(instanceID),
+		Duration:   duration,
+		instanceID: instanceID,
+	}
+}
+
+// TryAcquire takes the lease if it is free, expired or already held by us, extending it by Duration.
+// The update is a single conditional statement so only one replica can win.
+func (l *DBLease) TryAcquire(ctx context.Context) (bool, error) {
+	client := ent.FromContext(ctx)
+	now := time.Now()
+
+	updated, err := client.Lease.Update().
+		Where(
+			lease.NameEQ(l.Name),
+			lease.Or(
+				lease.HolderEQ(l.Holder),
+				lease.ExpiresLT(now),
+			),
+		).
+		SetHolder(l.Holder).
+		SetExpires(now.Add(l.Duration)).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if updated > 0 {
+		return true, nil
+	}
+
+	err = client.Lease.Create().
+		SetName(l.Name).
+		SetHolder(l.Holder).
+		SetExpires(now.Add(l.Duration)).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// Someone else holds an unexpired lease
+		l.warnSharedInstanceID(ctx)
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// warnSharedInstanceID warns once when the lease is held by another replica with our instance id
+func (l *DBLease) warnSharedInstanceID(ctx context.Context) {
+	if l.instanceID == "" || l.warned {
+		return
+	}
+	current, err := ent.FromContext(ctx).Lease.Query().Where(lease.NameEQ(l.Name)).Only(ctx)
+	if err != nil || !strings.HasPrefix(current.Holder, l.instanceID + "-") {
+		return
+	}
+	l.warned = true
+	zerolog.Ctx(ctx).Warn().
+		Str("component", "cluster.DBLease").
+		Str("lease", l.Name).
+		Str("instanceID", l.instanceID).
+		Str("holder", current.Holder).
+		Msg("Another replica uses the same cluster.instance_id. Give every replica a unique instance_id")
+}
+
+// Release expires the lease if we hold it
+func (l *DBLease) Release(ctx context.Context) error {
+	_, err := ent.FromContext(ctx).Lease.Update().
+		Where(
+			lease.NameEQ(l.Name),
+			lease.HolderEQ(l.Holder),
+		).
+		SetExpires(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// holderID returns the instance id, or the hostname if it is empty, with a random suffix,
+// so restarted replicas do not reuse a holder id
+func holderID(instanceID string) string {
+	if instanceID == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			host = "stoke"
+		}
+		instanceID = host
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return instanceID + "-" + hex.EncodeToString(suffix)
+}
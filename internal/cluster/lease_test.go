@@ -0,0 +1,80 @@
+package cluster
+
+import (
+	"stoke/internal/testutil"
+	"testing"
+	"time"
+)
+
+func TestDBLease_OnlyOneHolder(t *testing.T) {
+	ctx := testutil.NewMockContext(testutil.WithDatabase(t))
+
+	first := NewDBLease("test", "replica-1", time.Minute)
+	second := NewDBLease("test", "replica-2", time.Minute)
+
+	if ok, err := first.TryAcquire(ctx); err != nil || !ok {
+		t.Fatalf("first replica should acquire the free lease: ok=%v err=%v", ok, err)
+	}
+	if ok, err := second.TryAcquire(ctx); err != nil || ok {
+		t.Fatalf("second replica should not acquire a held lease: ok=%v err=%v", ok, err)
+	}
+	if ok, err := first.TryAcquire(ctx); err != nil || !ok {
+		t.Fatalf("holder should be able to renew the lease: ok=%v err=%v", ok, err)
+	}
+}
+
+func TestDBLease_TakeoverAfterExpiry(t *testing.T) {
+	ctx := testutil.NewMockContext(testutil.WithDatabase(t))
+
+	first := NewDBLease("test", "replica-1", 10*time.Millisecond)
+	second := NewDBLease("test", "replica-2", time.Minute)
+
+	if ok, err := first.TryAcquire(ctx); err != nil || !ok {
+		t.Fatalf("first replica should acquire the free lease: ok=%v err=%v", ok, err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if ok, err := second.TryAcquire(ctx); err != nil || !ok {
+		t.Fatalf("second replica should take over an expired lease: ok=%v err=%v", ok, err)
+	}
+	if ok, err := first.TryAcquire(ctx); err != nil || ok {
+		t.Fatalf("previous holder should not reacquire the lease: ok=%v err=%v", ok, err)
+	}
+}
+
+func TestDBLease_Release(t *testing.T) {
+	ctx := testutil.NewMockContext(testutil.WithDatabase(t))
+
+	first := NewDBLease("test", "replica-1", time.Minute)
+	second := NewDBLease("test", "replica-2", time.Minute)
+
+	if ok, err := first.TryAcquire(ctx); err != nil || !ok {
+		t.Fatalf("first replica should acquire the free lease: ok=%v err=%v", ok, err)
+	}
+	if err := first.Release(ctx); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+	if ok, err := second.TryAcquire(ctx); err != nil || !ok {
+		t.Fatalf("second replica should acquire a released lease: ok=%v err=%v", ok, err)
+	}
+}
+
+func TestDBLease_SharedInstanceID(t *testing.T) {
+	ctx := testutil.NewMockContext(testutil.WithDatabase(t))
+
+	first := NewDBLease("test", "stoke-0", time.Minute)
+	second := NewDBLease("test", "stoke-0", time.Minute)
+
+	if first.Holder == second.Holder {
+		t.Fatalf("replicas with the same instance id should have distinct holder ids: %s", first.Holder)
+	}
+	if ok, err := first.TryAcquire(ctx); err != nil || !ok {
+		t.Fatalf("first replica should acquire the free lease: ok=%v err=%v", ok, err)
+	}
+	if ok, err := second.TryAcquire(ctx); err != nil || ok {
+		t.Fatalf("replica with the same instance id should not acquire a held lease: ok=%v err=%v", ok, err)
+	}
+	if !second.warned {
+		t.Errorf("replica with the same instance id should warn about it")
+	}
+}
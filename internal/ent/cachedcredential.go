@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"stoke/internal/ent/cachedcredential"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CachedCredential is the model entity for the CachedCredential schema.
+type CachedCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Verifier holds the value of the "verifier" field.
+	Verifier string `json:"-"`
+	// Salt holds the value of the "salt" field.
+	Salt string `json:"-"`
+	// Expires holds the value of the "expires" field.
+	Expires      time.Time `json:"expires,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CachedCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cachedcredential.FieldID:
+			values[i] = new(sql.NullInt64)
+		case cachedcredential.FieldProvider, cachedcredential.FieldUsername, cachedcredential.FieldVerifier, cachedcredential.FieldSalt:
+			values[i] = new(sql.NullString)
+		case cachedcredential.FieldExpires:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CachedCredential fields.
+func (cc *CachedCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cachedcredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cc.ID = int(value.Int64)
+		case cachedcredential.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				cc.Provider = value.String
+			}
+		case cachedcredential.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				cc.Username = value.String
+			}
+		case cachedcredential.FieldVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verifier", values[i])
+			} else if value.Valid {
+				cc.Verifier = value.String
+			}
+		case cachedcredential.FieldSalt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field salt", values[i])
+			} else if value.Valid {
+				cc.Salt = value.String
+			}
+		case cachedcredential.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				cc.Expires = value.Time
+			}
+		default:
+			cc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CachedCredential.
+// This includes values selected through modifiers, order, etc.
+func (cc *CachedCredential) Value(name string) (ent.Value, error) {
+	return cc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CachedCredential.
+// Note that you need to call CachedCredential.Unwrap() before calling this method if this CachedCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *CachedCredential) Update() *CachedCredentialUpdateOne {
+	return NewCachedCredentialClient(cc.config).UpdateOne(cc)
+}
+
+// Unwrap unwraps the CachedCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *CachedCredential) Unwrap() *CachedCredential {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CachedCredential is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *CachedCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("CachedCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(cc.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(cc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("verifier=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("salt=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires=")
+	builder.WriteString(cc.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CachedCredentials is a parsable slice of CachedCredential.
+type CachedCredentials []*CachedCredential
@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cachedcredential
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the cachedcredential type in the database.
+	Label = "cached_credential"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldVerifier holds the string denoting the verifier field in the database.
+	FieldVerifier = "verifier"
+	// FieldSalt holds the string denoting the salt field in the database.
+	FieldSalt = "salt"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// Table holds the table name of the cachedcredential in the database.
+	Table = "cached_credentials"
+)
+
+// Columns holds all SQL columns for cachedcredential fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldUsername,
+	FieldVerifier,
+	FieldSalt,
+	FieldExpires,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the CachedCredential queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByVerifier orders the results by the verifier field.
+func ByVerifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifier, opts...).ToFunc()
+}
+
+// BySalt orders the results by the salt field.
+func BySalt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalt, opts...).ToFunc()
+}
+
+// ByExpires orders the results by the expires field.
+func ByExpires(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpires, opts...).ToFunc()
+}
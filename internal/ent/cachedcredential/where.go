@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cachedcredential
+
+import (
+	"stoke/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLTE(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldProvider, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldUsername, v))
+}
+
+// Verifier applies equality check predicate on the "verifier" field. It's identical to VerifierEQ.
+func Verifier(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldVerifier, v))
+}
+
+// Salt applies equality check predicate on the "salt" field. It's identical to SaltEQ.
+func Salt(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldSalt, v))
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldExpires, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// VerifierEQ applies the EQ predicate on the "verifier" field.
+func VerifierEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldVerifier, v))
+}
+
+// VerifierNEQ applies the NEQ predicate on the "verifier" field.
+func VerifierNEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNEQ(FieldVerifier, v))
+}
+
+// VerifierIn applies the In predicate on the "verifier" field.
+func VerifierIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldIn(FieldVerifier, vs...))
+}
+
+// VerifierNotIn applies the NotIn predicate on the "verifier" field.
+func VerifierNotIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNotIn(FieldVerifier, vs...))
+}
+
+// VerifierGT applies the GT predicate on the "verifier" field.
+func VerifierGT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGT(FieldVerifier, v))
+}
+
+// VerifierGTE applies the GTE predicate on the "verifier" field.
+func VerifierGTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGTE(FieldVerifier, v))
+}
+
+// VerifierLT applies the LT predicate on the "verifier" field.
+func VerifierLT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLT(FieldVerifier, v))
+}
+
+// VerifierLTE applies the LTE predicate on the "verifier" field.
+func VerifierLTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLTE(FieldVerifier, v))
+}
+
+// VerifierContains applies the Contains predicate on the "verifier" field.
+func VerifierContains(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContains(FieldVerifier, v))
+}
+
+// VerifierHasPrefix applies the HasPrefix predicate on the "verifier" field.
+func VerifierHasPrefix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasPrefix(FieldVerifier, v))
+}
+
+// VerifierHasSuffix applies the HasSuffix predicate on the "verifier" field.
+func VerifierHasSuffix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasSuffix(FieldVerifier, v))
+}
+
+// VerifierEqualFold applies the EqualFold predicate on the "verifier" field.
+func VerifierEqualFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEqualFold(FieldVerifier, v))
+}
+
+// VerifierContainsFold applies the ContainsFold predicate on the "verifier" field.
+func VerifierContainsFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContainsFold(FieldVerifier, v))
+}
+
+// SaltEQ applies the EQ predicate on the "salt" field.
+func SaltEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldSalt, v))
+}
+
+// SaltNEQ applies the NEQ predicate on the "salt" field.
+func SaltNEQ(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNEQ(FieldSalt, v))
+}
+
+// SaltIn applies the In predicate on the "salt" field.
+func SaltIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldIn(FieldSalt, vs...))
+}
+
+// SaltNotIn applies the NotIn predicate on the "salt" field.
+func SaltNotIn(vs ...string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNotIn(FieldSalt, vs...))
+}
+
+// SaltGT applies the GT predicate on the "salt" field.
+func SaltGT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGT(FieldSalt, v))
+}
+
+// SaltGTE applies the GTE predicate on the "salt" field.
+func SaltGTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGTE(FieldSalt, v))
+}
+
+// SaltLT applies the LT predicate on the "salt" field.
+func SaltLT(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLT(FieldSalt, v))
+}
+
+// SaltLTE applies the LTE predicate on the "salt" field.
+func SaltLTE(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLTE(FieldSalt, v))
+}
+
+// SaltContains applies the Contains predicate on the "salt" field.
+func SaltContains(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContains(FieldSalt, v))
+}
+
+// SaltHasPrefix applies the HasPrefix predicate on the "salt" field.
+func SaltHasPrefix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasPrefix(FieldSalt, v))
+}
+
+// SaltHasSuffix applies the HasSuffix predicate on the "salt" field.
+func SaltHasSuffix(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldHasSuffix(FieldSalt, v))
+}
+
+// SaltEqualFold applies the EqualFold predicate on the "salt" field.
+func SaltEqualFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEqualFold(FieldSalt, v))
+}
+
+// SaltContainsFold applies the ContainsFold predicate on the "salt" field.
+func SaltContainsFold(v string) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldContainsFold(FieldSalt, v))
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldEQ(FieldExpires, v))
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNEQ(FieldExpires, v))
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldIn(FieldExpires, vs...))
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldNotIn(FieldExpires, vs...))
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGT(FieldExpires, v))
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldGTE(FieldExpires, v))
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLT(FieldExpires, v))
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.FieldLTE(FieldExpires, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CachedCredential) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CachedCredential) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CachedCredential) predicate.CachedCredential {
+	return predicate.CachedCredential(sql.NotPredicates(p))
+}
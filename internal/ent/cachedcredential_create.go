@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/cachedcredential"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CachedCredentialCreate is the builder for creating a CachedCredential entity.
+type CachedCredentialCreate struct {
+	config
+	mutation *CachedCredentialMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (ccc *CachedCredentialCreate) SetProvider(s string) *CachedCredentialCreate {
+	ccc.mutation.SetProvider(s)
+	return ccc
+}
+
+// SetUsername sets the "username" field.
+func (ccc *CachedCredentialCreate) SetUsername(s string) *CachedCredentialCreate {
+	ccc.mutation.SetUsername(s)
+	return ccc
+}
+
+// SetVerifier sets the "verifier" field.
+func (ccc *CachedCredentialCreate) SetVerifier(s string) *CachedCredentialCreate {
+	ccc.mutation.SetVerifier(s)
+	return ccc
+}
+
+// SetSalt sets the "salt" field.
+func (ccc *CachedCredentialCreate) SetSalt(s string) *CachedCredentialCreate {
+	ccc.mutation.SetSalt(s)
+	return ccc
+}
+
+// SetExpires sets the "expires" field.
+func (ccc *CachedCredentialCreate) SetExpires(t time.Time) *CachedCredentialCreate {
+	ccc.mutation.SetExpires(t)
+	return ccc
+}
+
+// Mutation returns the CachedCredentialMutation object of the builder.
+func (ccc *CachedCredentialCreate) Mutation() *CachedCredentialMutation {
+	return ccc.mutation
+}
+
+// Save creates the CachedCredential in the database.
+func (ccc *CachedCredentialCreate) Save(ctx context.Context) (*CachedCredential, error) {
+	return withHooks(ctx, ccc.sqlSave, ccc.mutation, ccc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *CachedCredentialCreate) SaveX(ctx context.Context) *CachedCredential {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *CachedCredentialCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *CachedCredentialCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *CachedCredentialCreate) check() error {
+	if _, ok := ccc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "CachedCredential.provider"`)}
+	}
+	if _, ok := ccc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "CachedCredential.username"`)}
+	}
+	if _, ok := ccc.mutation.Verifier(); !ok {
+		return &ValidationError{Name: "verifier", err: errors.New(`ent: missing required field "CachedCredential.verifier"`)}
+	}
+	if _, ok := ccc.mutation.Salt(); !ok {
+		return &ValidationError{Name: "salt", err: errors.New(`ent: missing required field "CachedCredential.salt"`)}
+	}
+	if _, ok := ccc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`ent: missing required field "CachedCredential.expires"`)}
+	}
+	return nil
+}
+
+func (ccc *CachedCredentialCreate) sqlSave(ctx context.Context) (*CachedCredential, error) {
+	if err := ccc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ccc.mutation.id = &_node.ID
+	ccc.mutation.done = true
+	return _node, nil
+}
+
+func (ccc *CachedCredentialCreate) createSpec() (*CachedCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CachedCredential{config: ccc.config}
+		_spec = sqlgraph.NewCreateSpec(cachedcredential.Table, sqlgraph.NewFieldSpec(cachedcredential.FieldID, field.TypeInt))
+	)
+	if value, ok := ccc.mutation.Provider(); ok {
+		_spec.SetField(cachedcredential.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := ccc.mutation.Username(); ok {
+		_spec.SetField(cachedcredential.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := ccc.mutation.Verifier(); ok {
+		_spec.SetField(cachedcredential.FieldVerifier, field.TypeString, value)
+		_node.Verifier = value
+	}
+	if value, ok := ccc.mutation.Salt(); ok {
+		_spec.SetField(cachedcredential.FieldSalt, field.TypeString, value)
+		_node.Salt = value
+	}
+	if value, ok := ccc.mutation.Expires(); ok {
+		_spec.SetField(cachedcredential.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// CachedCredentialCreateBulk is the builder for creating many CachedCredential entities in bulk.
+type CachedCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*CachedCredentialCreate
+}
+
+// Save creates the CachedCredential entities in the database.
+func (cccb *CachedCredentialCreateBulk) Save(ctx context.Context) ([]*CachedCredential, error) {
+	if cccb.err != nil {
+		return nil, cccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*CachedCredential, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CachedCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *CachedCredentialCreateBulk) SaveX(ctx context.Context) []*CachedCredential {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *CachedCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *CachedCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"stoke/internal/ent/cachedcredential"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CachedCredentialDelete is the builder for deleting a CachedCredential entity.
+type CachedCredentialDelete struct {
+	config
+	hooks    []Hook
+	mutation *CachedCredentialMutation
+}
+
+// Where appends a list predicates to the CachedCredentialDelete builder.
+func (ccd *CachedCredentialDelete) Where(ps ...predicate.CachedCredential) *CachedCredentialDelete {
+	ccd.mutation.Where(ps...)
+	return ccd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ccd *CachedCredentialDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccd.sqlExec, ccd.mutation, ccd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccd *CachedCredentialDelete) ExecX(ctx context.Context) int {
+	n, err := ccd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ccd *CachedCredentialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cachedcredential.Table, sqlgraph.NewFieldSpec(cachedcredential.FieldID, field.TypeInt))
+	if ps := ccd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ccd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ccd.mutation.done = true
+	return affected, err
+}
+
+// CachedCredentialDeleteOne is the builder for deleting a single CachedCredential entity.
+type CachedCredentialDeleteOne struct {
+	ccd *CachedCredentialDelete
+}
+
+// Where appends a list predicates to the CachedCredentialDelete builder.
+func (ccdo *CachedCredentialDeleteOne) Where(ps ...predicate.CachedCredential) *CachedCredentialDeleteOne {
+	ccdo.ccd.mutation.Where(ps...)
+	return ccdo
+}
+
+// Exec executes the deletion query.
+func (ccdo *CachedCredentialDeleteOne) Exec(ctx context.Context) error {
+	n, err := ccdo.ccd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cachedcredential.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccdo *CachedCredentialDeleteOne) ExecX(ctx context.Context) {
+	if err := ccdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
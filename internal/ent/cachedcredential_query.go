@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"stoke/internal/ent/cachedcredential"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CachedCredentialQuery is the builder for querying CachedCredential entities.
+type CachedCredentialQuery struct {
+	config
+	ctx        *QueryContext
+	order      []cachedcredential.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CachedCredential
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CachedCredentialQuery builder.
+func (ccq *CachedCredentialQuery) Where(ps ...predicate.CachedCredential) *CachedCredentialQuery {
+	ccq.predicates = append(ccq.predicates, ps...)
+	return ccq
+}
+
+// Limit the number of records to be returned by this query.
+func (ccq *CachedCredentialQuery) Limit(limit int) *CachedCredentialQuery {
+	ccq.ctx.Limit = &limit
+	return ccq
+}
+
+// Offset to start from.
+func (ccq *CachedCredentialQuery) Offset(offset int) *CachedCredentialQuery {
+	ccq.ctx.Offset = &offset
+	return ccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccq *CachedCredentialQuery) Unique(unique bool) *CachedCredentialQuery {
+	ccq.ctx.Unique = &unique
+	return ccq
+}
+
+// Order specifies how the records should be ordered.
+func (ccq *CachedCredentialQuery) Order(o ...cachedcredential.OrderOption) *CachedCredentialQuery {
+	ccq.order = append(ccq.order, o...)
+	return ccq
+}
+
+// First returns the first CachedCredential entity from the query.
+// Returns a *NotFoundError when no CachedCredential was found.
+func (ccq *CachedCredentialQuery) First(ctx context.Context) (*CachedCredential, error) {
+	nodes, err := ccq.Limit(1).All(setContextOp(ctx, ccq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{cachedcredential.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) FirstX(ctx context.Context) *CachedCredential {
+	node, err := ccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CachedCredential ID from the query.
+// Returns a *NotFoundError when no CachedCredential ID was found.
+func (ccq *CachedCredentialQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(1).IDs(setContextOp(ctx, ccq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{cachedcredential.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) FirstIDX(ctx context.Context) int {
+	id, err := ccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CachedCredential entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CachedCredential entity is found.
+// Returns a *NotFoundError when no CachedCredential entities are found.
+func (ccq *CachedCredentialQuery) Only(ctx context.Context) (*CachedCredential, error) {
+	nodes, err := ccq.Limit(2).All(setContextOp(ctx, ccq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{cachedcredential.Label}
+	default:
+		return nil, &NotSingularError{cachedcredential.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) OnlyX(ctx context.Context) *CachedCredential {
+	node, err := ccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CachedCredential ID in the query.
+// Returns a *NotSingularError when more than one CachedCredential ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccq *CachedCredentialQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(2).IDs(setContextOp(ctx, ccq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{cachedcredential.Label}
+	default:
+		err = &NotSingularError{cachedcredential.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CachedCredentials.
+func (ccq *CachedCredentialQuery) All(ctx context.Context) ([]*CachedCredential, error) {
+	ctx = setContextOp(ctx, ccq.ctx, "All")
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CachedCredential, *CachedCredentialQuery]()
+	return withInterceptors[[]*CachedCredential](ctx, ccq, qr, ccq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) AllX(ctx context.Context) []*CachedCredential {
+	nodes, err := ccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CachedCredential IDs.
+func (ccq *CachedCredentialQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ccq.ctx.Unique == nil && ccq.path != nil {
+		ccq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ccq.ctx, "IDs")
+	if err = ccq.Select(cachedcredential.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) IDsX(ctx context.Context) []int {
+	ids, err := ccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccq *CachedCredentialQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ccq.ctx, "Count")
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ccq, querierCount[*CachedCredentialQuery](), ccq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) CountX(ctx context.Context) int {
+	count, err := ccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccq *CachedCredentialQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ccq.ctx, "Exist")
+	switch _, err := ccq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccq *CachedCredentialQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CachedCredentialQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccq *CachedCredentialQuery) Clone() *CachedCredentialQuery {
+	if ccq == nil {
+		return nil
+	}
+	return &CachedCredentialQuery{
+		config:     ccq.config,
+		ctx:        ccq.ctx.Clone(),
+		order:      append([]cachedcredential.OrderOption{}, ccq.order...),
+		inters:     append([]Interceptor{}, ccq.inters...),
+		predicates: append([]predicate.CachedCredential{}, ccq.predicates...),
+		// clone intermediate query.
+		sql:  ccq.sql.Clone(),
+		path: ccq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider string `json:"provider,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CachedCredential.Query().
+//		GroupBy(cachedcredential.FieldProvider).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ccq *CachedCredentialQuery) GroupBy(field string, fields ...string) *CachedCredentialGroupBy {
+	ccq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CachedCredentialGroupBy{build: ccq}
+	grbuild.flds = &ccq.ctx.Fields
+	grbuild.label = cachedcredential.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider string `json:"provider,omitempty"`
+//	}
+//
+//	client.CachedCredential.Query().
+//		Select(cachedcredential.FieldProvider).
+//		Scan(ctx, &v)
+func (ccq *CachedCredentialQuery) Select(fields ...string) *CachedCredentialSelect {
+	ccq.ctx.Fields = append(ccq.ctx.Fields, fields...)
+	sbuild := &CachedCredentialSelect{CachedCredentialQuery: ccq}
+	sbuild.label = cachedcredential.Label
+	sbuild.flds, sbuild.scan = &ccq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CachedCredentialSelect configured with the given aggregations.
+func (ccq *CachedCredentialQuery) Aggregate(fns ...AggregateFunc) *CachedCredentialSelect {
+	return ccq.Select().Aggregate(fns...)
+}
+
+func (ccq *CachedCredentialQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ccq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ccq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ccq.ctx.Fields {
+		if !cachedcredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ccq.path != nil {
+		prev, err := ccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccq.sql = prev
+	}
+	return nil
+}
+
+func (ccq *CachedCredentialQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CachedCredential, error) {
+	var (
+		nodes = []*CachedCredential{}
+		_spec = ccq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CachedCredential).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CachedCredential{config: ccq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ccq *CachedCredentialQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccq.querySpec()
+	_spec.Node.Columns = ccq.ctx.Fields
+	if len(ccq.ctx.Fields) > 0 {
+		_spec.Unique = ccq.ctx.Unique != nil && *ccq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ccq.driver, _spec)
+}
+
+func (ccq *CachedCredentialQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(cachedcredential.Table, cachedcredential.Columns, sqlgraph.NewFieldSpec(cachedcredential.FieldID, field.TypeInt))
+	_spec.From = ccq.sql
+	if unique := ccq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ccq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ccq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cachedcredential.FieldID)
+		for i := range fields {
+			if fields[i] != cachedcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccq *CachedCredentialQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccq.driver.Dialect())
+	t1 := builder.Table(cachedcredential.Table)
+	columns := ccq.ctx.Fields
+	if len(columns) == 0 {
+		columns = cachedcredential.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccq.sql != nil {
+		selector = ccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccq.ctx.Unique != nil && *ccq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ccq.predicates {
+		p(selector)
+	}
+	for _, p := range ccq.order {
+		p(selector)
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CachedCredentialGroupBy is the group-by builder for CachedCredential entities.
+type CachedCredentialGroupBy struct {
+	selector
+	build *CachedCredentialQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccgb *CachedCredentialGroupBy) Aggregate(fns ...AggregateFunc) *CachedCredentialGroupBy {
+	ccgb.fns = append(ccgb.fns, fns...)
+	return ccgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccgb *CachedCredentialGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccgb.build.ctx, "GroupBy")
+	if err := ccgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CachedCredentialQuery, *CachedCredentialGroupBy](ctx, ccgb.build, ccgb, ccgb.build.inters, v)
+}
+
+func (ccgb *CachedCredentialGroupBy) sqlScan(ctx context.Context, root *CachedCredentialQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ccgb.fns))
+	for _, fn := range ccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ccgb.flds)+len(ccgb.fns))
+		for _, f := range *ccgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ccgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CachedCredentialSelect is the builder for selecting fields of CachedCredential entities.
+type CachedCredentialSelect struct {
+	*CachedCredentialQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ccs *CachedCredentialSelect) Aggregate(fns ...AggregateFunc) *CachedCredentialSelect {
+	ccs.fns = append(ccs.fns, fns...)
+	return ccs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccs *CachedCredentialSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccs.ctx, "Select")
+	if err := ccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CachedCredentialQuery, *CachedCredentialSelect](ctx, ccs.CachedCredentialQuery, ccs, ccs.inters, v)
+}
+
+func (ccs *CachedCredentialSelect) sqlScan(ctx context.Context, root *CachedCredentialQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ccs.fns))
+	for _, fn := range ccs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ccs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
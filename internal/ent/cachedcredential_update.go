@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/cachedcredential"
+	"stoke/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CachedCredentialUpdate is the builder for updating CachedCredential entities.
+type CachedCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CachedCredentialMutation
+}
+
+// Where appends a list predicates to the CachedCredentialUpdate builder.
+func (ccu *CachedCredentialUpdate) Where(ps ...predicate.CachedCredential) *CachedCredentialUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// SetVerifier sets the "verifier" field.
+func (ccu *CachedCredentialUpdate) SetVerifier(s string) *CachedCredentialUpdate {
+	ccu.mutation.SetVerifier(s)
+	return ccu
+}
+
+// SetNillableVerifier sets the "verifier" field if the given value is not nil.
+func (ccu *CachedCredentialUpdate) SetNillableVerifier(s *string) *CachedCredentialUpdate {
+	if s != nil {
+		ccu.SetVerifier(*s)
+	}
+	return ccu
+}
+
+// SetSalt sets the "salt" field.
+func (ccu *CachedCredentialUpdate) SetSalt(s string) *CachedCredentialUpdate {
+	ccu.mutation.SetSalt(s)
+	return ccu
+}
+
+// SetNillableSalt sets the "salt" field if the given value is not nil.
+func (ccu *CachedCredentialUpdate) SetNillableSalt(s *string) *CachedCredentialUpdate {
+	if s != nil {
+		ccu.SetSalt(*s)
+	}
+	return ccu
+}
+
+// SetExpires sets the "expires" field.
+func (ccu *CachedCredentialUpdate) SetExpires(t time.Time) *CachedCredentialUpdate {
+	ccu.mutation.SetExpires(t)
+	return ccu
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (ccu *CachedCredentialUpdate) SetNillableExpires(t *time.Time) *CachedCredentialUpdate {
+	if t != nil {
+		ccu.SetExpires(*t)
+	}
+	return ccu
+}
+
+// Mutation returns the CachedCredentialMutation object of the builder.
+func (ccu *CachedCredentialUpdate) Mutation() *CachedCredentialMutation {
+	return ccu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *CachedCredentialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccu.sqlSave, ccu.mutation, ccu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *CachedCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *CachedCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *CachedCredentialUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ccu *CachedCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cachedcredential.Table, cachedcredential.Columns, sqlgraph.NewFieldSpec(cachedcredential.FieldID, field.TypeInt))
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccu.mutation.Verifier(); ok {
+		_spec.SetField(cachedcredential.FieldVerifier, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.Salt(); ok {
+		_spec.SetField(cachedcredential.FieldSalt, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.Expires(); ok {
+		_spec.SetField(cachedcredential.FieldExpires, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cachedcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ccu.mutation.done = true
+	return n, nil
+}
+
+// CachedCredentialUpdateOne is the builder for updating a single CachedCredential entity.
+type CachedCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CachedCredentialMutation
+}
+
+// SetVerifier sets the "verifier" field.
+func (ccuo *CachedCredentialUpdateOne) SetVerifier(s string) *CachedCredentialUpdateOne {
+	ccuo.mutation.SetVerifier(s)
+	return ccuo
+}
+
+// SetNillableVerifier sets the "verifier" field if the given value is not nil.
+func (ccuo *CachedCredentialUpdateOne) SetNillableVerifier(s *string) *CachedCredentialUpdateOne {
+	if s != nil {
+		ccuo.SetVerifier(*s)
+	}
+	return ccuo
+}
+
+// SetSalt sets the "salt" field.
+func (ccuo *CachedCredentialUpdateOne) SetSalt(s string) *CachedCredentialUpdateOne {
+	ccuo.mutation.SetSalt(s)
+	return ccuo
+}
+
+// SetNillableSalt sets the "salt" field if the given value is not nil.
+func (ccuo *CachedCredentialUpdateOne) SetNillableSalt(s *string) *CachedCredentialUpdateOne {
+	if s != nil {
+		ccuo.SetSalt(*s)
+	}
+	return ccuo
+}
+
+// SetExpires sets the "expires" field.
+func (ccuo *CachedCredentialUpdateOne) SetExpires(t time.Time) *CachedCredentialUpdateOne {
+	ccuo.mutation.SetExpires(t)
+	return ccuo
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (ccuo *CachedCredentialUpdateOne) SetNillableExpires(t *time.Time) *CachedCredentialUpdateOne {
+	if t != nil {
+		ccuo.SetExpires(*t)
+	}
+	return ccuo
+}
+
+// Mutation returns the CachedCredentialMutation object of the builder.
+func (ccuo *CachedCredentialUpdateOne) Mutation() *CachedCredentialMutation {
+	return ccuo.mutation
+}
+
+// Where appends a list predicates to the CachedCredentialUpdate builder.
+func (ccuo *CachedCredentialUpdateOne) Where(ps ...predicate.CachedCredential) *CachedCredentialUpdateOne {
+	ccuo.mutation.Where(ps...)
+	return ccuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *CachedCredentialUpdateOne) Select(field string, fields ...string) *CachedCredentialUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated CachedCredential entity.
+func (ccuo *CachedCredentialUpdateOne) Save(ctx context.Context) (*CachedCredential, error) {
+	return withHooks(ctx, ccuo.sqlSave, ccuo.mutation, ccuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *CachedCredentialUpdateOne) SaveX(ctx context.Context) *CachedCredential {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *CachedCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *CachedCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ccuo *CachedCredentialUpdateOne) sqlSave(ctx context.Context) (_node *CachedCredential, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cachedcredential.Table, cachedcredential.Columns, sqlgraph.NewFieldSpec(cachedcredential.FieldID, field.TypeInt))
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CachedCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cachedcredential.FieldID)
+		for _, f := range fields {
+			if !cachedcredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cachedcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccuo.mutation.Verifier(); ok {
+		_spec.SetField(cachedcredential.FieldVerifier, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.Salt(); ok {
+		_spec.SetField(cachedcredential.FieldSalt, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.Expires(); ok {
+		_spec.SetField(cachedcredential.FieldExpires, field.TypeTime, value)
+	}
+	_node = &CachedCredential{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cachedcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ccuo.mutation.done = true
+	return _node, nil
+}
@@ -11,11 +11,19 @@ import (
 
 	"stoke/internal/ent/migrate"
 
+	"stoke/internal/ent/cachedcredential"
 	"stoke/internal/ent/claim"
 	"stoke/internal/ent/claimgroup"
 	"stoke/internal/ent/dbinitfile"
 	"stoke/internal/ent/grouplink"
+	"stoke/internal/ent/identity"
+	"stoke/internal/ent/lease"
+	"stoke/internal/ent/logincode"
+	"stoke/internal/ent/logouttoken"
+	"stoke/internal/ent/mfachallenge"
+	"stoke/internal/ent/oidcstate"
 	"stoke/internal/ent/privatekey"
+	"stoke/internal/ent/providersession"
 	"stoke/internal/ent/user"
 
 	"entgo.io/ent"
@@ -29,6 +37,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// CachedCredential is the client for interacting with the CachedCredential builders.
+	CachedCredential *CachedCredentialClient
 	// Claim is the client for interacting with the Claim builders.
 	Claim *ClaimClient
 	// ClaimGroup is the client for interacting with the ClaimGroup builders.
@@ -37,8 +47,22 @@ type Client struct {
 	DBInitFile *DBInitFileClient
 	// GroupLink is the client for interacting with the GroupLink builders.
 	GroupLink *GroupLinkClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// LoginCode is the client for interacting with the LoginCode builders.
+	LoginCode *LoginCodeClient
+	// LogoutToken is the client for interacting with the LogoutToken builders.
+	LogoutToken *LogoutTokenClient
+	// MFAChallenge is the client for interacting with the MFAChallenge builders.
+	MFAChallenge *MFAChallengeClient
+	// OIDCState is the client for interacting with the OIDCState builders.
+	OIDCState *OIDCStateClient
 	// PrivateKey is the client for interacting with the PrivateKey builders.
 	PrivateKey *PrivateKeyClient
+	// ProviderSession is the client for interacting with the ProviderSession builders.
+	ProviderSession *ProviderSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -52,11 +76,19 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.CachedCredential = NewCachedCredentialClient(c.config)
 	c.Claim = NewClaimClient(c.config)
 	c.ClaimGroup = NewClaimGroupClient(c.config)
 	c.DBInitFile = NewDBInitFileClient(c.config)
 	c.GroupLink = NewGroupLinkClient(c.config)
+	c.Identity = NewIdentityClient(c.config)
+	c.Lease = NewLeaseClient(c.config)
+	c.LoginCode = NewLoginCodeClient(c.config)
+	c.LogoutToken = NewLogoutTokenClient(c.config)
+	c.MFAChallenge = NewMFAChallengeClient(c.config)
+	c.OIDCState = NewOIDCStateClient(c.config)
 	c.PrivateKey = NewPrivateKeyClient(c.config)
+	c.ProviderSession = NewProviderSessionClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -148,14 +180,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Claim:      NewClaimClient(cfg),
-		ClaimGroup: NewClaimGroupClient(cfg),
-		DBInitFile: NewDBInitFileClient(cfg),
-		GroupLink:  NewGroupLinkClient(cfg),
-		PrivateKey: NewPrivateKeyClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		CachedCredential: NewCachedCredentialClient(cfg),
+		Claim:            NewClaimClient(cfg),
+		ClaimGroup:       NewClaimGroupClient(cfg),
+		DBInitFile:       NewDBInitFileClient(cfg),
+		GroupLink:        NewGroupLinkClient(cfg),
+		Identity:         NewIdentityClient(cfg),
+		Lease:            NewLeaseClient(cfg),
+		LoginCode:        NewLoginCodeClient(cfg),
+		LogoutToken:      NewLogoutTokenClient(cfg),
+		MFAChallenge:     NewMFAChallengeClient(cfg),
+		OIDCState:        NewOIDCStateClient(cfg),
+		PrivateKey:       NewPrivateKeyClient(cfg),
+		ProviderSession:  NewProviderSessionClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
@@ -173,21 +213,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Claim:      NewClaimClient(cfg),
-		ClaimGroup: NewClaimGroupClient(cfg),
-		DBInitFile: NewDBInitFileClient(cfg),
-		GroupLink:  NewGroupLinkClient(cfg),
-		PrivateKey: NewPrivateKeyClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		CachedCredential: NewCachedCredentialClient(cfg),
+		Claim:            NewClaimClient(cfg),
+		ClaimGroup:       NewClaimGroupClient(cfg),
+		DBInitFile:       NewDBInitFileClient(cfg),
+		GroupLink:        NewGroupLinkClient(cfg),
+		Identity:         NewIdentityClient(cfg),
+		Lease:            NewLeaseClient(cfg),
+		LoginCode:        NewLoginCodeClient(cfg),
+		LogoutToken:      NewLogoutTokenClient(cfg),
+		MFAChallenge:     NewMFAChallengeClient(cfg),
+		OIDCState:        NewOIDCStateClient(cfg),
+		PrivateKey:       NewPrivateKeyClient(cfg),
+		ProviderSession:  NewProviderSessionClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Claim.
+//		CachedCredential.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -210,7 +258,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Claim, c.ClaimGroup, c.DBInitFile, c.GroupLink, c.PrivateKey, c.User,
+		c.CachedCredential, c.Claim, c.ClaimGroup, c.DBInitFile, c.GroupLink,
+		c.Identity, c.Lease, c.LoginCode, c.LogoutToken, c.MFAChallenge, c.OIDCState,
+		c.PrivateKey, c.ProviderSession, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -220,7 +270,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Claim, c.ClaimGroup, c.DBInitFile, c.GroupLink, c.PrivateKey, c.User,
+		c.CachedCredential, c.Claim, c.ClaimGroup, c.DBInitFile, c.GroupLink,
+		c.Identity, c.Lease, c.LoginCode, c.LogoutToken, c.MFAChallenge, c.OIDCState,
+		c.PrivateKey, c.ProviderSession, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -229,6 +281,8 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *CachedCredentialMutation:
+		return c.CachedCredential.mutate(ctx, m)
 	case *ClaimMutation:
 		return c.Claim.mutate(ctx, m)
 	case *ClaimGroupMutation:
@@ -237,8 +291,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.DBInitFile.mutate(ctx, m)
 	case *GroupLinkMutation:
 		return c.GroupLink.mutate(ctx, m)
+	case *IdentityMutation:
+		return c.Identity.mutate(ctx, m)
+	case *LeaseMutation:
+		return c.Lease.mutate(ctx, m)
+	case *LoginCodeMutation:
+		return c.LoginCode.mutate(ctx, m)
+	case *LogoutTokenMutation:
+		return c.LogoutToken.mutate(ctx, m)
+	case *MFAChallengeMutation:
+		return c.MFAChallenge.mutate(ctx, m)
+	case *OIDCStateMutation:
+		return c.OIDCState.mutate(ctx, m)
 	case *PrivateKeyMutation:
 		return c.PrivateKey.mutate(ctx, m)
+	case *ProviderSessionMutation:
+		return c.ProviderSession.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -246,6 +314,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// CachedCredentialClient is a client for the CachedCredential schema.
+type CachedCredentialClient struct {
+	config
+}
+
+// NewCachedCredentialClient returns a client for the CachedCredential from the given config.
+func NewCachedCredentialClient(c config) *CachedCredentialClient {
+	return &CachedCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cachedcredential.Hooks(f(g(h())))`.
+func (c *CachedCredentialClient) Use(hooks ...Hook) {
+	c.hooks.CachedCredential = append(c.hooks.CachedCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cachedcredential.Intercept(f(g(h())))`.
+func (c *CachedCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CachedCredential = append(c.inters.CachedCredential, interceptors...)
+}
+
+// Create returns a builder for creating a CachedCredential entity.
+func (c *CachedCredentialClient) Create() *CachedCredentialCreate {
+	mutation := newCachedCredentialMutation(c.config, OpCreate)
+	return &CachedCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CachedCredential entities.
+func (c *CachedCredentialClient) CreateBulk(builders ...*CachedCredentialCreate) *CachedCredentialCreateBulk {
+	return &CachedCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CachedCredentialClient) MapCreateBulk(slice any, setFunc func(*CachedCredentialCreate, int)) *CachedCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CachedCredentialCreateBulk{err: fmt.Errorf("calling to CachedCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CachedCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CachedCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CachedCredential.
+func (c *CachedCredentialClient) Update() *CachedCredentialUpdate {
+	mutation := newCachedCredentialMutation(c.config, OpUpdate)
+	return &CachedCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CachedCredentialClient) UpdateOne(cc *CachedCredential) *CachedCredentialUpdateOne {
+	mutation := newCachedCredentialMutation(c.config, OpUpdateOne, withCachedCredential(cc))
+	return &CachedCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CachedCredentialClient) UpdateOneID(id int) *CachedCredentialUpdateOne {
+	mutation := newCachedCredentialMutation(c.config, OpUpdateOne, withCachedCredentialID(id))
+	return &CachedCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CachedCredential.
+func (c *CachedCredentialClient) Delete() *CachedCredentialDelete {
+	mutation := newCachedCredentialMutation(c.config, OpDelete)
+	return &CachedCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CachedCredentialClient) DeleteOne(cc *CachedCredential) *CachedCredentialDeleteOne {
+	return c.DeleteOneID(cc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CachedCredentialClient) DeleteOneID(id int) *CachedCredentialDeleteOne {
+	builder := c.Delete().Where(cachedcredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CachedCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for CachedCredential.
+func (c *CachedCredentialClient) Query() *CachedCredentialQuery {
+	return &CachedCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCachedCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CachedCredential entity by its id.
+func (c *CachedCredentialClient) Get(ctx context.Context, id int) (*CachedCredential, error) {
+	return c.Query().Where(cachedcredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CachedCredentialClient) GetX(ctx context.Context, id int) *CachedCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CachedCredentialClient) Hooks() []Hook {
+	return c.hooks.CachedCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *CachedCredentialClient) Interceptors() []Interceptor {
+	return c.inters.CachedCredential
+}
+
+func (c *CachedCredentialClient) mutate(ctx context.Context, m *CachedCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CachedCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CachedCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CachedCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CachedCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CachedCredential mutation op: %q", m.Op())
+	}
+}
+
 // ClaimClient is a client for the Claim schema.
 type ClaimClient struct {
 	config
@@ -860,107 +1061,107 @@ func (c *GroupLinkClient) mutate(ctx context.Context, m *GroupLinkMutation) (Val
 	}
 }
 
-// PrivateKeyClient is a client for the PrivateKey schema.
-type PrivateKeyClient struct {
+// IdentityClient is a client for the Identity schema.
+type IdentityClient struct {
 	config
 }
 
-// NewPrivateKeyClient returns a client for the PrivateKey from the given config.
-func NewPrivateKeyClient(c config) *PrivateKeyClient {
-	return &PrivateKeyClient{config: c}
+// NewIdentityClient returns a client for the Identity from the given config.
+func NewIdentityClient(c config) *IdentityClient {
+	return &IdentityClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `privatekey.Hooks(f(g(h())))`.
-func (c *PrivateKeyClient) Use(hooks ...Hook) {
-	c.hooks.PrivateKey = append(c.hooks.PrivateKey, hooks...)
+// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
+func (c *IdentityClient) Use(hooks ...Hook) {
+	c.hooks.Identity = append(c.hooks.Identity, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `privatekey.Intercept(f(g(h())))`.
-func (c *PrivateKeyClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PrivateKey = append(c.inters.PrivateKey, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `identity.Intercept(f(g(h())))`.
+func (c *IdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Identity = append(c.inters.Identity, interceptors...)
 }
 
-// Create returns a builder for creating a PrivateKey entity.
-func (c *PrivateKeyClient) Create() *PrivateKeyCreate {
-	mutation := newPrivateKeyMutation(c.config, OpCreate)
-	return &PrivateKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Identity entity.
+func (c *IdentityClient) Create() *IdentityCreate {
+	mutation := newIdentityMutation(c.config, OpCreate)
+	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PrivateKey entities.
-func (c *PrivateKeyClient) CreateBulk(builders ...*PrivateKeyCreate) *PrivateKeyCreateBulk {
-	return &PrivateKeyCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Identity entities.
+func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
+	return &IdentityCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PrivateKeyClient) MapCreateBulk(slice any, setFunc func(*PrivateKeyCreate, int)) *PrivateKeyCreateBulk {
+func (c *IdentityClient) MapCreateBulk(slice any, setFunc func(*IdentityCreate, int)) *IdentityCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PrivateKeyCreateBulk{err: fmt.Errorf("calling to PrivateKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IdentityCreateBulk{err: fmt.Errorf("calling to IdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PrivateKeyCreate, rv.Len())
+	builders := make([]*IdentityCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PrivateKeyCreateBulk{config: c.config, builders: builders}
+	return &IdentityCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PrivateKey.
-func (c *PrivateKeyClient) Update() *PrivateKeyUpdate {
-	mutation := newPrivateKeyMutation(c.config, OpUpdate)
-	return &PrivateKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Identity.
+func (c *IdentityClient) Update() *IdentityUpdate {
+	mutation := newIdentityMutation(c.config, OpUpdate)
+	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PrivateKeyClient) UpdateOne(pk *PrivateKey) *PrivateKeyUpdateOne {
-	mutation := newPrivateKeyMutation(c.config, OpUpdateOne, withPrivateKey(pk))
-	return &PrivateKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityClient) UpdateOne(i *Identity) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(i))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PrivateKeyClient) UpdateOneID(id int) *PrivateKeyUpdateOne {
-	mutation := newPrivateKeyMutation(c.config, OpUpdateOne, withPrivateKeyID(id))
-	return &PrivateKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityClient) UpdateOneID(id int) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PrivateKey.
-func (c *PrivateKeyClient) Delete() *PrivateKeyDelete {
-	mutation := newPrivateKeyMutation(c.config, OpDelete)
-	return &PrivateKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Identity.
+func (c *IdentityClient) Delete() *IdentityDelete {
+	mutation := newIdentityMutation(c.config, OpDelete)
+	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PrivateKeyClient) DeleteOne(pk *PrivateKey) *PrivateKeyDeleteOne {
-	return c.DeleteOneID(pk.ID)
+func (c *IdentityClient) DeleteOne(i *Identity) *IdentityDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PrivateKeyClient) DeleteOneID(id int) *PrivateKeyDeleteOne {
-	builder := c.Delete().Where(privatekey.ID(id))
+func (c *IdentityClient) DeleteOneID(id int) *IdentityDeleteOne {
+	builder := c.Delete().Where(identity.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PrivateKeyDeleteOne{builder}
+	return &IdentityDeleteOne{builder}
 }
 
-// Query returns a query builder for PrivateKey.
-func (c *PrivateKeyClient) Query() *PrivateKeyQuery {
-	return &PrivateKeyQuery{
+// Query returns a query builder for Identity.
+func (c *IdentityClient) Query() *IdentityQuery {
+	return &IdentityQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePrivateKey},
+		ctx:    &QueryContext{Type: TypeIdentity},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PrivateKey entity by its id.
-func (c *PrivateKeyClient) Get(ctx context.Context, id int) (*PrivateKey, error) {
-	return c.Query().Where(privatekey.ID(id)).Only(ctx)
+// Get returns a Identity entity by its id.
+func (c *IdentityClient) Get(ctx context.Context, id int) (*Identity, error) {
+	return c.Query().Where(identity.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PrivateKeyClient) GetX(ctx context.Context, id int) *PrivateKey {
+func (c *IdentityClient) GetX(ctx context.Context, id int) *Identity {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -968,132 +1169,148 @@ func (c *PrivateKeyClient) GetX(ctx context.Context, id int) *PrivateKey {
 	return obj
 }
 
+// QueryUser queries the user edge of a Identity.
+func (c *IdentityClient) QueryUser(i *Identity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, identity.UserTable, identity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *PrivateKeyClient) Hooks() []Hook {
-	return c.hooks.PrivateKey
+func (c *IdentityClient) Hooks() []Hook {
+	return c.hooks.Identity
 }
 
 // Interceptors returns the client interceptors.
-func (c *PrivateKeyClient) Interceptors() []Interceptor {
-	return c.inters.PrivateKey
+func (c *IdentityClient) Interceptors() []Interceptor {
+	return c.inters.Identity
 }
 
-func (c *PrivateKeyClient) mutate(ctx context.Context, m *PrivateKeyMutation) (Value, error) {
+func (c *IdentityClient) mutate(ctx context.Context, m *IdentityMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PrivateKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PrivateKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PrivateKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PrivateKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PrivateKey mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Identity mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// LeaseClient is a client for the Lease schema.
+type LeaseClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewLeaseClient returns a client for the Lease from the given config.
+func NewLeaseClient(c config) *LeaseClient {
+	return &LeaseClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `lease.Hooks(f(g(h())))`.
+func (c *LeaseClient) Use(hooks ...Hook) {
+	c.hooks.Lease = append(c.hooks.Lease, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `lease.Intercept(f(g(h())))`.
+func (c *LeaseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Lease = append(c.inters.Lease, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Lease entity.
+func (c *LeaseClient) Create() *LeaseCreate {
+	mutation := newLeaseMutation(c.config, OpCreate)
+	return &LeaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Lease entities.
+func (c *LeaseClient) CreateBulk(builders ...*LeaseCreate) *LeaseCreateBulk {
+	return &LeaseCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *LeaseClient) MapCreateBulk(slice any, setFunc func(*LeaseCreate, int)) *LeaseCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LeaseCreateBulk{err: fmt.Errorf("calling to LeaseClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*LeaseCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &LeaseCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Lease.
+func (c *LeaseClient) Update() *LeaseUpdate {
+	mutation := newLeaseMutation(c.config, OpUpdate)
+	return &LeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LeaseClient) UpdateOne(l *Lease) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLease(l))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LeaseClient) UpdateOneID(id int) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLeaseID(id))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Lease.
+func (c *LeaseClient) Delete() *LeaseDelete {
+	mutation := newLeaseMutation(c.config, OpDelete)
+	return &LeaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
-	return c.DeleteOneID(u.ID)
+func (c *LeaseClient) DeleteOne(l *Lease) *LeaseDeleteOne {
+	return c.DeleteOneID(l.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *LeaseClient) DeleteOneID(id int) *LeaseDeleteOne {
+	builder := c.Delete().Where(lease.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &LeaseDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for Lease.
+func (c *LeaseClient) Query() *LeaseQuery {
+	return &LeaseQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeLease},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a Lease entity by its id.
+func (c *LeaseClient) Get(ctx context.Context, id int) (*Lease, error) {
+	return c.Query().Where(lease.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *LeaseClient) GetX(ctx context.Context, id int) *Lease {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1101,15 +1318,962 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
-// QueryClaimGroups queries the claim_groups edge of a User.
-func (c *UserClient) QueryClaimGroups(u *User) *ClaimGroupQuery {
-	query := (&ClaimGroupClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(claimgroup.Table, claimgroup.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, user.ClaimGroupsTable, user.ClaimGroupsPrimaryKey...),
+// Hooks returns the client hooks.
+func (c *LeaseClient) Hooks() []Hook {
+	return c.hooks.Lease
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaseClient) Interceptors() []Interceptor {
+	return c.inters.Lease
+}
+
+func (c *LeaseClient) mutate(ctx context.Context, m *LeaseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Lease mutation op: %q", m.Op())
+	}
+}
+
+// LoginCodeClient is a client for the LoginCode schema.
+type LoginCodeClient struct {
+	config
+}
+
+// NewLoginCodeClient returns a client for the LoginCode from the given config.
+func NewLoginCodeClient(c config) *LoginCodeClient {
+	return &LoginCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `logincode.Hooks(f(g(h())))`.
+func (c *LoginCodeClient) Use(hooks ...Hook) {
+	c.hooks.LoginCode = append(c.hooks.LoginCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `logincode.Intercept(f(g(h())))`.
+func (c *LoginCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginCode = append(c.inters.LoginCode, interceptors...)
+}
+
+// Create returns a builder for creating a LoginCode entity.
+func (c *LoginCodeClient) Create() *LoginCodeCreate {
+	mutation := newLoginCodeMutation(c.config, OpCreate)
+	return &LoginCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginCode entities.
+func (c *LoginCodeClient) CreateBulk(builders ...*LoginCodeCreate) *LoginCodeCreateBulk {
+	return &LoginCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginCodeClient) MapCreateBulk(slice any, setFunc func(*LoginCodeCreate, int)) *LoginCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginCodeCreateBulk{err: fmt.Errorf("calling to LoginCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginCode.
+func (c *LoginCodeClient) Update() *LoginCodeUpdate {
+	mutation := newLoginCodeMutation(c.config, OpUpdate)
+	return &LoginCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginCodeClient) UpdateOne(lc *LoginCode) *LoginCodeUpdateOne {
+	mutation := newLoginCodeMutation(c.config, OpUpdateOne, withLoginCode(lc))
+	return &LoginCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginCodeClient) UpdateOneID(id int) *LoginCodeUpdateOne {
+	mutation := newLoginCodeMutation(c.config, OpUpdateOne, withLoginCodeID(id))
+	return &LoginCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginCode.
+func (c *LoginCodeClient) Delete() *LoginCodeDelete {
+	mutation := newLoginCodeMutation(c.config, OpDelete)
+	return &LoginCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginCodeClient) DeleteOne(lc *LoginCode) *LoginCodeDeleteOne {
+	return c.DeleteOneID(lc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginCodeClient) DeleteOneID(id int) *LoginCodeDeleteOne {
+	builder := c.Delete().Where(logincode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginCode.
+func (c *LoginCodeClient) Query() *LoginCodeQuery {
+	return &LoginCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginCode entity by its id.
+func (c *LoginCodeClient) Get(ctx context.Context, id int) (*LoginCode, error) {
+	return c.Query().Where(logincode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginCodeClient) GetX(ctx context.Context, id int) *LoginCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LoginCodeClient) Hooks() []Hook {
+	return c.hooks.LoginCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoginCodeClient) Interceptors() []Interceptor {
+	return c.inters.LoginCode
+}
+
+func (c *LoginCodeClient) mutate(ctx context.Context, m *LoginCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoginCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoginCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginCode mutation op: %q", m.Op())
+	}
+}
+
+// LogoutTokenClient is a client for the LogoutToken schema.
+type LogoutTokenClient struct {
+	config
+}
+
+// NewLogoutTokenClient returns a client for the LogoutToken from the given config.
+func NewLogoutTokenClient(c config) *LogoutTokenClient {
+	return &LogoutTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `logouttoken.Hooks(f(g(h())))`.
+func (c *LogoutTokenClient) Use(hooks ...Hook) {
+	c.hooks.LogoutToken = append(c.hooks.LogoutToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `logouttoken.Intercept(f(g(h())))`.
+func (c *LogoutTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LogoutToken = append(c.inters.LogoutToken, interceptors...)
+}
+
+// Create returns a builder for creating a LogoutToken entity.
+func (c *LogoutTokenClient) Create() *LogoutTokenCreate {
+	mutation := newLogoutTokenMutation(c.config, OpCreate)
+	return &LogoutTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LogoutToken entities.
+func (c *LogoutTokenClient) CreateBulk(builders ...*LogoutTokenCreate) *LogoutTokenCreateBulk {
+	return &LogoutTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LogoutTokenClient) MapCreateBulk(slice any, setFunc func(*LogoutTokenCreate, int)) *LogoutTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LogoutTokenCreateBulk{err: fmt.Errorf("calling to LogoutTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LogoutTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LogoutTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LogoutToken.
+func (c *LogoutTokenClient) Update() *LogoutTokenUpdate {
+	mutation := newLogoutTokenMutation(c.config, OpUpdate)
+	return &LogoutTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LogoutTokenClient) UpdateOne(lt *LogoutToken) *LogoutTokenUpdateOne {
+	mutation := newLogoutTokenMutation(c.config, OpUpdateOne, withLogoutToken(lt))
+	return &LogoutTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LogoutTokenClient) UpdateOneID(id int) *LogoutTokenUpdateOne {
+	mutation := newLogoutTokenMutation(c.config, OpUpdateOne, withLogoutTokenID(id))
+	return &LogoutTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LogoutToken.
+func (c *LogoutTokenClient) Delete() *LogoutTokenDelete {
+	mutation := newLogoutTokenMutation(c.config, OpDelete)
+	return &LogoutTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LogoutTokenClient) DeleteOne(lt *LogoutToken) *LogoutTokenDeleteOne {
+	return c.DeleteOneID(lt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LogoutTokenClient) DeleteOneID(id int) *LogoutTokenDeleteOne {
+	builder := c.Delete().Where(logouttoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LogoutTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for LogoutToken.
+func (c *LogoutTokenClient) Query() *LogoutTokenQuery {
+	return &LogoutTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLogoutToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LogoutToken entity by its id.
+func (c *LogoutTokenClient) Get(ctx context.Context, id int) (*LogoutToken, error) {
+	return c.Query().Where(logouttoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LogoutTokenClient) GetX(ctx context.Context, id int) *LogoutToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LogoutTokenClient) Hooks() []Hook {
+	return c.hooks.LogoutToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *LogoutTokenClient) Interceptors() []Interceptor {
+	return c.inters.LogoutToken
+}
+
+func (c *LogoutTokenClient) mutate(ctx context.Context, m *LogoutTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LogoutTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LogoutTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LogoutTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LogoutTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LogoutToken mutation op: %q", m.Op())
+	}
+}
+
+// MFAChallengeClient is a client for the MFAChallenge schema.
+type MFAChallengeClient struct {
+	config
+}
+
+// NewMFAChallengeClient returns a client for the MFAChallenge from the given config.
+func NewMFAChallengeClient(c config) *MFAChallengeClient {
+	return &MFAChallengeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mfachallenge.Hooks(f(g(h())))`.
+func (c *MFAChallengeClient) Use(hooks ...Hook) {
+	c.hooks.MFAChallenge = append(c.hooks.MFAChallenge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mfachallenge.Intercept(f(g(h())))`.
+func (c *MFAChallengeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MFAChallenge = append(c.inters.MFAChallenge, interceptors...)
+}
+
+// Create returns a builder for creating a MFAChallenge entity.
+func (c *MFAChallengeClient) Create() *MFAChallengeCreate {
+	mutation := newMFAChallengeMutation(c.config, OpCreate)
+	return &MFAChallengeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MFAChallenge entities.
+func (c *MFAChallengeClient) CreateBulk(builders ...*MFAChallengeCreate) *MFAChallengeCreateBulk {
+	return &MFAChallengeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MFAChallengeClient) MapCreateBulk(slice any, setFunc func(*MFAChallengeCreate, int)) *MFAChallengeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MFAChallengeCreateBulk{err: fmt.Errorf("calling to MFAChallengeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MFAChallengeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MFAChallengeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MFAChallenge.
+func (c *MFAChallengeClient) Update() *MFAChallengeUpdate {
+	mutation := newMFAChallengeMutation(c.config, OpUpdate)
+	return &MFAChallengeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MFAChallengeClient) UpdateOne(mc *MFAChallenge) *MFAChallengeUpdateOne {
+	mutation := newMFAChallengeMutation(c.config, OpUpdateOne, withMFAChallenge(mc))
+	return &MFAChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MFAChallengeClient) UpdateOneID(id int) *MFAChallengeUpdateOne {
+	mutation := newMFAChallengeMutation(c.config, OpUpdateOne, withMFAChallengeID(id))
+	return &MFAChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MFAChallenge.
+func (c *MFAChallengeClient) Delete() *MFAChallengeDelete {
+	mutation := newMFAChallengeMutation(c.config, OpDelete)
+	return &MFAChallengeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MFAChallengeClient) DeleteOne(mc *MFAChallenge) *MFAChallengeDeleteOne {
+	return c.DeleteOneID(mc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MFAChallengeClient) DeleteOneID(id int) *MFAChallengeDeleteOne {
+	builder := c.Delete().Where(mfachallenge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MFAChallengeDeleteOne{builder}
+}
+
+// Query returns a query builder for MFAChallenge.
+func (c *MFAChallengeClient) Query() *MFAChallengeQuery {
+	return &MFAChallengeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMFAChallenge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MFAChallenge entity by its id.
+func (c *MFAChallengeClient) Get(ctx context.Context, id int) (*MFAChallenge, error) {
+	return c.Query().Where(mfachallenge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MFAChallengeClient) GetX(ctx context.Context, id int) *MFAChallenge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MFAChallengeClient) Hooks() []Hook {
+	return c.hooks.MFAChallenge
+}
+
+// Interceptors returns the client interceptors.
+func (c *MFAChallengeClient) Interceptors() []Interceptor {
+	return c.inters.MFAChallenge
+}
+
+func (c *MFAChallengeClient) mutate(ctx context.Context, m *MFAChallengeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MFAChallengeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MFAChallengeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MFAChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MFAChallengeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MFAChallenge mutation op: %q", m.Op())
+	}
+}
+
+// OIDCStateClient is a client for the OIDCState schema.
+type OIDCStateClient struct {
+	config
+}
+
+// NewOIDCStateClient returns a client for the OIDCState from the given config.
+func NewOIDCStateClient(c config) *OIDCStateClient {
+	return &OIDCStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oidcstate.Hooks(f(g(h())))`.
+func (c *OIDCStateClient) Use(hooks ...Hook) {
+	c.hooks.OIDCState = append(c.hooks.OIDCState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oidcstate.Intercept(f(g(h())))`.
+func (c *OIDCStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OIDCState = append(c.inters.OIDCState, interceptors...)
+}
+
+// Create returns a builder for creating a OIDCState entity.
+func (c *OIDCStateClient) Create() *OIDCStateCreate {
+	mutation := newOIDCStateMutation(c.config, OpCreate)
+	return &OIDCStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OIDCState entities.
+func (c *OIDCStateClient) CreateBulk(builders ...*OIDCStateCreate) *OIDCStateCreateBulk {
+	return &OIDCStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OIDCStateClient) MapCreateBulk(slice any, setFunc func(*OIDCStateCreate, int)) *OIDCStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OIDCStateCreateBulk{err: fmt.Errorf("calling to OIDCStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OIDCStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OIDCStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OIDCState.
+func (c *OIDCStateClient) Update() *OIDCStateUpdate {
+	mutation := newOIDCStateMutation(c.config, OpUpdate)
+	return &OIDCStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OIDCStateClient) UpdateOne(os *OIDCState) *OIDCStateUpdateOne {
+	mutation := newOIDCStateMutation(c.config, OpUpdateOne, withOIDCState(os))
+	return &OIDCStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OIDCStateClient) UpdateOneID(id int) *OIDCStateUpdateOne {
+	mutation := newOIDCStateMutation(c.config, OpUpdateOne, withOIDCStateID(id))
+	return &OIDCStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OIDCState.
+func (c *OIDCStateClient) Delete() *OIDCStateDelete {
+	mutation := newOIDCStateMutation(c.config, OpDelete)
+	return &OIDCStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OIDCStateClient) DeleteOne(os *OIDCState) *OIDCStateDeleteOne {
+	return c.DeleteOneID(os.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OIDCStateClient) DeleteOneID(id int) *OIDCStateDeleteOne {
+	builder := c.Delete().Where(oidcstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OIDCStateDeleteOne{builder}
+}
+
+// Query returns a query builder for OIDCState.
+func (c *OIDCStateClient) Query() *OIDCStateQuery {
+	return &OIDCStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOIDCState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OIDCState entity by its id.
+func (c *OIDCStateClient) Get(ctx context.Context, id int) (*OIDCState, error) {
+	return c.Query().Where(oidcstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OIDCStateClient) GetX(ctx context.Context, id int) *OIDCState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OIDCStateClient) Hooks() []Hook {
+	return c.hooks.OIDCState
+}
+
+// Interceptors returns the client interceptors.
+func (c *OIDCStateClient) Interceptors() []Interceptor {
+	return c.inters.OIDCState
+}
+
+func (c *OIDCStateClient) mutate(ctx context.Context, m *OIDCStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OIDCStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OIDCStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OIDCStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OIDCStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OIDCState mutation op: %q", m.Op())
+	}
+}
+
+// PrivateKeyClient is a client for the PrivateKey schema.
+type PrivateKeyClient struct {
+	config
+}
+
+// NewPrivateKeyClient returns a client for the PrivateKey from the given config.
+func NewPrivateKeyClient(c config) *PrivateKeyClient {
+	return &PrivateKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `privatekey.Hooks(f(g(h())))`.
+func (c *PrivateKeyClient) Use(hooks ...Hook) {
+	c.hooks.PrivateKey = append(c.hooks.PrivateKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `privatekey.Intercept(f(g(h())))`.
+func (c *PrivateKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PrivateKey = append(c.inters.PrivateKey, interceptors...)
+}
+
+// Create returns a builder for creating a PrivateKey entity.
+func (c *PrivateKeyClient) Create() *PrivateKeyCreate {
+	mutation := newPrivateKeyMutation(c.config, OpCreate)
+	return &PrivateKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PrivateKey entities.
+func (c *PrivateKeyClient) CreateBulk(builders ...*PrivateKeyCreate) *PrivateKeyCreateBulk {
+	return &PrivateKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrivateKeyClient) MapCreateBulk(slice any, setFunc func(*PrivateKeyCreate, int)) *PrivateKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrivateKeyCreateBulk{err: fmt.Errorf("calling to PrivateKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrivateKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrivateKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PrivateKey.
+func (c *PrivateKeyClient) Update() *PrivateKeyUpdate {
+	mutation := newPrivateKeyMutation(c.config, OpUpdate)
+	return &PrivateKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrivateKeyClient) UpdateOne(pk *PrivateKey) *PrivateKeyUpdateOne {
+	mutation := newPrivateKeyMutation(c.config, OpUpdateOne, withPrivateKey(pk))
+	return &PrivateKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrivateKeyClient) UpdateOneID(id int) *PrivateKeyUpdateOne {
+	mutation := newPrivateKeyMutation(c.config, OpUpdateOne, withPrivateKeyID(id))
+	return &PrivateKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PrivateKey.
+func (c *PrivateKeyClient) Delete() *PrivateKeyDelete {
+	mutation := newPrivateKeyMutation(c.config, OpDelete)
+	return &PrivateKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PrivateKeyClient) DeleteOne(pk *PrivateKey) *PrivateKeyDeleteOne {
+	return c.DeleteOneID(pk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PrivateKeyClient) DeleteOneID(id int) *PrivateKeyDeleteOne {
+	builder := c.Delete().Where(privatekey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PrivateKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for PrivateKey.
+func (c *PrivateKeyClient) Query() *PrivateKeyQuery {
+	return &PrivateKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrivateKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PrivateKey entity by its id.
+func (c *PrivateKeyClient) Get(ctx context.Context, id int) (*PrivateKey, error) {
+	return c.Query().Where(privatekey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PrivateKeyClient) GetX(ctx context.Context, id int) *PrivateKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PrivateKeyClient) Hooks() []Hook {
+	return c.hooks.PrivateKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *PrivateKeyClient) Interceptors() []Interceptor {
+	return c.inters.PrivateKey
+}
+
+func (c *PrivateKeyClient) mutate(ctx context.Context, m *PrivateKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PrivateKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PrivateKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PrivateKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PrivateKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PrivateKey mutation op: %q", m.Op())
+	}
+}
+
+// ProviderSessionClient is a client for the ProviderSession schema.
+type ProviderSessionClient struct {
+	config
+}
+
+// NewProviderSessionClient returns a client for the ProviderSession from the given config.
+func NewProviderSessionClient(c config) *ProviderSessionClient {
+	return &ProviderSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providersession.Hooks(f(g(h())))`.
+func (c *ProviderSessionClient) Use(hooks ...Hook) {
+	c.hooks.ProviderSession = append(c.hooks.ProviderSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providersession.Intercept(f(g(h())))`.
+func (c *ProviderSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderSession = append(c.inters.ProviderSession, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderSession entity.
+func (c *ProviderSessionClient) Create() *ProviderSessionCreate {
+	mutation := newProviderSessionMutation(c.config, OpCreate)
+	return &ProviderSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderSession entities.
+func (c *ProviderSessionClient) CreateBulk(builders ...*ProviderSessionCreate) *ProviderSessionCreateBulk {
+	return &ProviderSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderSessionClient) MapCreateBulk(slice any, setFunc func(*ProviderSessionCreate, int)) *ProviderSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderSessionCreateBulk{err: fmt.Errorf("calling to ProviderSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderSession.
+func (c *ProviderSessionClient) Update() *ProviderSessionUpdate {
+	mutation := newProviderSessionMutation(c.config, OpUpdate)
+	return &ProviderSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderSessionClient) UpdateOne(ps *ProviderSession) *ProviderSessionUpdateOne {
+	mutation := newProviderSessionMutation(c.config, OpUpdateOne, withProviderSession(ps))
+	return &ProviderSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderSessionClient) UpdateOneID(id int) *ProviderSessionUpdateOne {
+	mutation := newProviderSessionMutation(c.config, OpUpdateOne, withProviderSessionID(id))
+	return &ProviderSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderSession.
+func (c *ProviderSessionClient) Delete() *ProviderSessionDelete {
+	mutation := newProviderSessionMutation(c.config, OpDelete)
+	return &ProviderSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderSessionClient) DeleteOne(ps *ProviderSession) *ProviderSessionDeleteOne {
+	return c.DeleteOneID(ps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderSessionClient) DeleteOneID(id int) *ProviderSessionDeleteOne {
+	builder := c.Delete().Where(providersession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderSession.
+func (c *ProviderSessionClient) Query() *ProviderSessionQuery {
+	return &ProviderSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderSession entity by its id.
+func (c *ProviderSessionClient) Get(ctx context.Context, id int) (*ProviderSession, error) {
+	return c.Query().Where(providersession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderSessionClient) GetX(ctx context.Context, id int) *ProviderSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderSessionClient) Hooks() []Hook {
+	return c.hooks.ProviderSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderSessionClient) Interceptors() []Interceptor {
+	return c.inters.ProviderSession
+}
+
+func (c *ProviderSessionClient) mutate(ctx context.Context, m *ProviderSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderSession mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClaimGroups queries the claim_groups edge of a User.
+func (c *UserClient) QueryClaimGroups(u *User) *ClaimGroupQuery {
+	query := (&ClaimGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(claimgroup.Table, claimgroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.ClaimGroupsTable, user.ClaimGroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *IdentityQuery {
+	query := (&IdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
 		)
 		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
@@ -1146,9 +2310,13 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Claim, ClaimGroup, DBInitFile, GroupLink, PrivateKey, User []ent.Hook
+		CachedCredential, Claim, ClaimGroup, DBInitFile, GroupLink, Identity, Lease,
+		LoginCode, LogoutToken, MFAChallenge, OIDCState, PrivateKey, ProviderSession,
+		User []ent.Hook
 	}
 	inters struct {
-		Claim, ClaimGroup, DBInitFile, GroupLink, PrivateKey, User []ent.Interceptor
+		CachedCredential, Claim, ClaimGroup, DBInitFile, GroupLink, Identity, Lease,
+		LoginCode, LogoutToken, MFAChallenge, OIDCState, PrivateKey, ProviderSession,
+		User []ent.Interceptor
 	}
 )
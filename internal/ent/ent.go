@@ -7,11 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"stoke/internal/ent/cachedcredential"
 	"stoke/internal/ent/claim"
 	"stoke/internal/ent/claimgroup"
 	"stoke/internal/ent/dbinitfile"
 	"stoke/internal/ent/grouplink"
+	"stoke/internal/ent/identity"
+	"stoke/internal/ent/lease"
+	"stoke/internal/ent/logincode"
+	"stoke/internal/ent/logouttoken"
+	"stoke/internal/ent/mfachallenge"
+	"stoke/internal/ent/oidcstate"
 	"stoke/internal/ent/privatekey"
+	"stoke/internal/ent/providersession"
 	"stoke/internal/ent/user"
 	"sync"
 
@@ -78,12 +86,20 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			claim.Table:      claim.ValidColumn,
-			claimgroup.Table: claimgroup.ValidColumn,
-			dbinitfile.Table: dbinitfile.ValidColumn,
-			grouplink.Table:  grouplink.ValidColumn,
-			privatekey.Table: privatekey.ValidColumn,
-			user.Table:       user.ValidColumn,
+			cachedcredential.Table: cachedcredential.ValidColumn,
+			claim.Table:            claim.ValidColumn,
+			claimgroup.Table:       claimgroup.ValidColumn,
+			dbinitfile.Table:       dbinitfile.ValidColumn,
+			grouplink.Table:        grouplink.ValidColumn,
+			identity.Table:         identity.ValidColumn,
+			lease.Table:            lease.ValidColumn,
+			logincode.Table:        logincode.ValidColumn,
+			logouttoken.Table:      logouttoken.ValidColumn,
+			mfachallenge.Table:     mfachallenge.ValidColumn,
+			oidcstate.Table:        oidcstate.ValidColumn,
+			privatekey.Table:       privatekey.ValidColumn,
+			providersession.Table:  providersession.ValidColumn,
+			user.Table:             user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -6,4 +6,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = "{\"Schema\":\"stoke/internal/ent/schema\",\"Package\":\"stoke/internal/ent\",\"Schemas\":[{\"name\":\"Claim\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"claim_groups\",\"type\":\"ClaimGroup\"}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"short_name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"value\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}}],\"indexes\":[{\"unique\":true,\"fields\":[\"short_name\",\"value\"]}],\"policy\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}]},{\"name\":\"ClaimGroup\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"users\",\"type\":\"User\"},{\"name\":\"group_links\",\"type\":\"GroupLink\",\"annotations\":{\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"claims\",\"type\":\"Claim\",\"ref_name\":\"claim_groups\",\"inverse\":true}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"policy\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}]},{\"name\":\"DBInitFile\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"filename\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"md5\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"GroupLink\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"claim_group\",\"type\":\"ClaimGroup\",\"ref_name\":\"group_links\",\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"type\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"resource_spec\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}]},{\"name\":\"PrivateKey\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"text\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"claim_groups\",\"type\":\"ClaimGroup\",\"ref_name\":\"users\",\"inverse\":true}],\"fields\":[{\"name\":\"fname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"lname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"source\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"username\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"salt\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"policy\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"Features\":[\"privacy\",\"schema/snapshot\"]}"
+const Schema = "{\"Schema\":\"stoke/internal/ent/schema\",\"Package\":\"stoke/internal/ent\",\"Schemas\":[{\"name\":\"CachedCredential\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"provider\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"username\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"verifier\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"salt\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}}],\"indexes\":[{\"unique\":true,\"fields\":[\"provider\",\"username\"]}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"Claim\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"claim_groups\",\"type\":\"ClaimGroup\"}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"short_name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"value\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}}],\"indexes\":[{\"unique\":true,\"fields\":[\"short_name\",\"value\"]}],\"policy\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}]},{\"name\":\"ClaimGroup\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"users\",\"type\":\"User\"},{\"name\":\"group_links\",\"type\":\"GroupLink\",\"annotations\":{\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"claims\",\"type\":\"Claim\",\"ref_name\":\"claim_groups\",\"inverse\":true}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"policy\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}]},{\"name\":\"DBInitFile\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"filename\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"md5\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"GroupLink\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"claim_group\",\"type\":\"ClaimGroup\",\"ref_name\":\"group_links\",\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"type\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"resource_spec\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}]},{\"name\":\"Identity\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"ref_name\":\"identities\",\"unique\":true,\"inverse\":true,\"required\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"provider_type\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"provider_name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"subject\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"created\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}}],\"indexes\":[{\"unique\":true,\"fields\":[\"provider_name\",\"subject\"]}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"Lease\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"holder\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"LoginCode\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"code\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"username\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"token\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"refresh\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"LogoutToken\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"provider\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"jti\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}}],\"indexes\":[{\"unique\":true,\"fields\":[\"provider\",\"jti\"]}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"MFAChallenge\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"challenge\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"username\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"claims\",\"type\":{\"Type\":3,\"Ident\":\"map[string]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"map[string]string\",\"Kind\":21,\"PkgPath\":\"\",\"Methods\":{}}},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"attempts\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":0,\"default_kind\":2,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"OIDCState\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"state\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"provider\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"nonce\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"code_verifier\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"next_url\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"xfer\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"access_token\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"PrivateKey\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"text\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"ProviderSession\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"session\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"provider\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"subject\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"sid\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"revoked\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"created\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"expires\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0}}],\"indexes\":[{\"fields\":[\"provider\",\"subject\"]},{\"fields\":[\"provider\",\"sid\"]},{\"fields\":[\"expires\"]}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"claim_groups\",\"type\":\"ClaimGroup\",\"ref_name\":\"users\",\"inverse\":true},{\"name\":\"identities\",\"type\":\"Identity\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}}],\"fields\":[{\"name\":\"fname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"lname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"source\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"username\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"salt\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"mfa_secret\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"mfa_enabled\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"mfa_recovery_codes\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"mfa_last_step\",\"type\":{\"Type\":13,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"mfa_failures\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":0,\"default_kind\":2,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"mfa_locked_until\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":13,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"policy\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}],\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"Features\":[\"privacy\",\"schema/snapshot\"]}"
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"stoke/internal/ent/lease"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Lease is the model entity for the Lease schema.
+type Lease struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Holder holds the value of the "holder" field.
+	Holder string `json:"holder,omitempty"`
+	// Expires holds the value of the "expires" field.
+	Expires      time.Time `json:"expires,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Lease) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lease.FieldID:
+			values[i] = new(sql.NullInt64)
+		case lease.FieldName, lease.FieldHolder:
+			values[i] = new(sql.NullString)
+		case lease.FieldExpires:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Lease fields.
+func (l *Lease) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lease.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			l.ID = int(value.Int64)
+		case lease.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				l.Name = value.String
+			}
+		case lease.FieldHolder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder", values[i])
+			} else if value.Valid {
+				l.Holder = value.String
+			}
+		case lease.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				l.Expires = value.Time
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Lease.
+// This includes values selected through modifiers, order, etc.
+func (l *Lease) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Lease.
+// Note that you need to call Lease.Unwrap() before calling this method if this Lease
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Lease) Update() *LeaseUpdateOne {
+	return NewLeaseClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Lease entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Lease) Unwrap() *Lease {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Lease is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Lease) String() string {
+	var builder strings.Builder
+	builder.WriteString("Lease(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("name=")
+	builder.WriteString(l.Name)
+	builder.WriteString(", ")
+	builder.WriteString("holder=")
+	builder.WriteString(l.Holder)
+	builder.WriteString(", ")
+	builder.WriteString("expires=")
+	builder.WriteString(l.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Leases is a parsable slice of Lease.
+type Leases []*Lease
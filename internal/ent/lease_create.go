@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/lease"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseCreate is the builder for creating a Lease entity.
+type LeaseCreate struct {
+	config
+	mutation *LeaseMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (lc *LeaseCreate) SetName(s string) *LeaseCreate {
+	lc.mutation.SetName(s)
+	return lc
+}
+
+// SetHolder sets the "holder" field.
+func (lc *LeaseCreate) SetHolder(s string) *LeaseCreate {
+	lc.mutation.SetHolder(s)
+	return lc
+}
+
+// SetExpires sets the "expires" field.
+func (lc *LeaseCreate) SetExpires(t time.Time) *LeaseCreate {
+	lc.mutation.SetExpires(t)
+	return lc
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (lc *LeaseCreate) Mutation() *LeaseMutation {
+	return lc.mutation
+}
+
+// Save creates the Lease in the database.
+func (lc *LeaseCreate) Save(ctx context.Context) (*Lease, error) {
+	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LeaseCreate) SaveX(ctx context.Context) *Lease {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *LeaseCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *LeaseCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LeaseCreate) check() error {
+	if _, ok := lc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Lease.name"`)}
+	}
+	if _, ok := lc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New(`ent: missing required field "Lease.holder"`)}
+	}
+	if _, ok := lc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`ent: missing required field "Lease.expires"`)}
+	}
+	return nil
+}
+
+func (lc *LeaseCreate) sqlSave(ctx context.Context) (*Lease, error) {
+	if err := lc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lc.mutation.id = &_node.ID
+	lc.mutation.done = true
+	return _node, nil
+}
+
+func (lc *LeaseCreate) createSpec() (*Lease, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Lease{config: lc.config}
+		_spec = sqlgraph.NewCreateSpec(lease.Table, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt))
+	)
+	if value, ok := lc.mutation.Name(); ok {
+		_spec.SetField(lease.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := lc.mutation.Holder(); ok {
+		_spec.SetField(lease.FieldHolder, field.TypeString, value)
+		_node.Holder = value
+	}
+	if value, ok := lc.mutation.Expires(); ok {
+		_spec.SetField(lease.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// LeaseCreateBulk is the builder for creating many Lease entities in bulk.
+type LeaseCreateBulk struct {
+	config
+	err      error
+	builders []*LeaseCreate
+}
+
+// Save creates the Lease entities in the database.
+func (lcb *LeaseCreateBulk) Save(ctx context.Context) ([]*Lease, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Lease, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LeaseCreateBulk) SaveX(ctx context.Context) []*Lease {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *LeaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *LeaseCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/lease"
+	"stoke/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseUpdate is the builder for updating Lease entities.
+type LeaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeaseMutation
+}
+
+// Where appends a list predicates to the LeaseUpdate builder.
+func (lu *LeaseUpdate) Where(ps ...predicate.Lease) *LeaseUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetHolder sets the "holder" field.
+func (lu *LeaseUpdate) SetHolder(s string) *LeaseUpdate {
+	lu.mutation.SetHolder(s)
+	return lu
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableHolder(s *string) *LeaseUpdate {
+	if s != nil {
+		lu.SetHolder(*s)
+	}
+	return lu
+}
+
+// SetExpires sets the "expires" field.
+func (lu *LeaseUpdate) SetExpires(t time.Time) *LeaseUpdate {
+	lu.mutation.SetExpires(t)
+	return lu
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableExpires(t *time.Time) *LeaseUpdate {
+	if t != nil {
+		lu.SetExpires(*t)
+	}
+	return lu
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (lu *LeaseUpdate) Mutation() *LeaseMutation {
+	return lu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LeaseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LeaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LeaseUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LeaseUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lu *LeaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(lease.Table, lease.Columns, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Holder(); ok {
+		_spec.SetField(lease.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Expires(); ok {
+		_spec.SetField(lease.FieldExpires, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LeaseUpdateOne is the builder for updating a single Lease entity.
+type LeaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LeaseMutation
+}
+
+// SetHolder sets the "holder" field.
+func (luo *LeaseUpdateOne) SetHolder(s string) *LeaseUpdateOne {
+	luo.mutation.SetHolder(s)
+	return luo
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableHolder(s *string) *LeaseUpdateOne {
+	if s != nil {
+		luo.SetHolder(*s)
+	}
+	return luo
+}
+
+// SetExpires sets the "expires" field.
+func (luo *LeaseUpdateOne) SetExpires(t time.Time) *LeaseUpdateOne {
+	luo.mutation.SetExpires(t)
+	return luo
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableExpires(t *time.Time) *LeaseUpdateOne {
+	if t != nil {
+		luo.SetExpires(*t)
+	}
+	return luo
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (luo *LeaseUpdateOne) Mutation() *LeaseMutation {
+	return luo.mutation
+}
+
+// Where appends a list predicates to the LeaseUpdate builder.
+func (luo *LeaseUpdateOne) Where(ps ...predicate.Lease) *LeaseUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LeaseUpdateOne) Select(field string, fields ...string) *LeaseUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Lease entity.
+func (luo *LeaseUpdateOne) Save(ctx context.Context) (*Lease, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LeaseUpdateOne) SaveX(ctx context.Context) *Lease {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LeaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LeaseUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (luo *LeaseUpdateOne) sqlSave(ctx context.Context) (_node *Lease, err error) {
+	_spec := sqlgraph.NewUpdateSpec(lease.Table, lease.Columns, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Lease.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lease.FieldID)
+		for _, f := range fields {
+			if !lease.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Holder(); ok {
+		_spec.SetField(lease.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Expires(); ok {
+		_spec.SetField(lease.FieldExpires, field.TypeTime, value)
+	}
+	_node = &Lease{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
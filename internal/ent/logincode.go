@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"stoke/internal/ent/logincode"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LoginCode is the model entity for the LoginCode schema.
+type LoginCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"-"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// Refresh holds the value of the "refresh" field.
+	Refresh string `json:"-"`
+	// Expires holds the value of the "expires" field.
+	Expires      time.Time `json:"expires,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case logincode.FieldID:
+			values[i] = new(sql.NullInt64)
+		case logincode.FieldCode, logincode.FieldUsername, logincode.FieldToken, logincode.FieldRefresh:
+			values[i] = new(sql.NullString)
+		case logincode.FieldExpires:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginCode fields.
+func (lc *LoginCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case logincode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lc.ID = int(value.Int64)
+		case logincode.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				lc.Code = value.String
+			}
+		case logincode.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				lc.Username = value.String
+			}
+		case logincode.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				lc.Token = value.String
+			}
+		case logincode.FieldRefresh:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh", values[i])
+			} else if value.Valid {
+				lc.Refresh = value.String
+			}
+		case logincode.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				lc.Expires = value.Time
+			}
+		default:
+			lc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginCode.
+// This includes values selected through modifiers, order, etc.
+func (lc *LoginCode) Value(name string) (ent.Value, error) {
+	return lc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginCode.
+// Note that you need to call LoginCode.Unwrap() before calling this method if this LoginCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lc *LoginCode) Update() *LoginCodeUpdateOne {
+	return NewLoginCodeClient(lc.config).UpdateOne(lc)
+}
+
+// Unwrap unwraps the LoginCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lc *LoginCode) Unwrap() *LoginCode {
+	_tx, ok := lc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginCode is not a transactional entity")
+	}
+	lc.config.driver = _tx.drv
+	return lc
+}
+
+// String implements the fmt.Stringer.
+func (lc *LoginCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lc.ID))
+	builder.WriteString("code=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(lc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("refresh=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires=")
+	builder.WriteString(lc.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginCodes is a parsable slice of LoginCode.
+type LoginCodes []*LoginCode
@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package logincode
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the logincode type in the database.
+	Label = "login_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldRefresh holds the string denoting the refresh field in the database.
+	FieldRefresh = "refresh"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// Table holds the table name of the logincode in the database.
+	Table = "login_codes"
+)
+
+// Columns holds all SQL columns for logincode fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldUsername,
+	FieldToken,
+	FieldRefresh,
+	FieldExpires,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the LoginCode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByRefresh orders the results by the refresh field.
+func ByRefresh(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefresh, opts...).ToFunc()
+}
+
+// ByExpires orders the results by the expires field.
+func ByExpires(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpires, opts...).ToFunc()
+}
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package logincode
+
+import (
+	"stoke/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldCode, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldUsername, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldToken, v))
+}
+
+// Refresh applies equality check predicate on the "refresh" field. It's identical to RefreshEQ.
+func Refresh(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldRefresh, v))
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldExpires, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContainsFold(FieldToken, v))
+}
+
+// RefreshEQ applies the EQ predicate on the "refresh" field.
+func RefreshEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldRefresh, v))
+}
+
+// RefreshNEQ applies the NEQ predicate on the "refresh" field.
+func RefreshNEQ(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNEQ(FieldRefresh, v))
+}
+
+// RefreshIn applies the In predicate on the "refresh" field.
+func RefreshIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldIn(FieldRefresh, vs...))
+}
+
+// RefreshNotIn applies the NotIn predicate on the "refresh" field.
+func RefreshNotIn(vs ...string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNotIn(FieldRefresh, vs...))
+}
+
+// RefreshGT applies the GT predicate on the "refresh" field.
+func RefreshGT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGT(FieldRefresh, v))
+}
+
+// RefreshGTE applies the GTE predicate on the "refresh" field.
+func RefreshGTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGTE(FieldRefresh, v))
+}
+
+// RefreshLT applies the LT predicate on the "refresh" field.
+func RefreshLT(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLT(FieldRefresh, v))
+}
+
+// RefreshLTE applies the LTE predicate on the "refresh" field.
+func RefreshLTE(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLTE(FieldRefresh, v))
+}
+
+// RefreshContains applies the Contains predicate on the "refresh" field.
+func RefreshContains(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContains(FieldRefresh, v))
+}
+
+// RefreshHasPrefix applies the HasPrefix predicate on the "refresh" field.
+func RefreshHasPrefix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasPrefix(FieldRefresh, v))
+}
+
+// RefreshHasSuffix applies the HasSuffix predicate on the "refresh" field.
+func RefreshHasSuffix(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldHasSuffix(FieldRefresh, v))
+}
+
+// RefreshEqualFold applies the EqualFold predicate on the "refresh" field.
+func RefreshEqualFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEqualFold(FieldRefresh, v))
+}
+
+// RefreshContainsFold applies the ContainsFold predicate on the "refresh" field.
+func RefreshContainsFold(v string) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldContainsFold(FieldRefresh, v))
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldEQ(FieldExpires, v))
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNEQ(FieldExpires, v))
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldIn(FieldExpires, vs...))
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldNotIn(FieldExpires, vs...))
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGT(FieldExpires, v))
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldGTE(FieldExpires, v))
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLT(FieldExpires, v))
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.LoginCode {
+	return predicate.LoginCode(sql.FieldLTE(FieldExpires, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoginCode) predicate.LoginCode {
+	return predicate.LoginCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoginCode) predicate.LoginCode {
+	return predicate.LoginCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoginCode) predicate.LoginCode {
+	return predicate.LoginCode(sql.NotPredicates(p))
+}
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/logincode"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginCodeCreate is the builder for creating a LoginCode entity.
+type LoginCodeCreate struct {
+	config
+	mutation *LoginCodeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (lcc *LoginCodeCreate) SetCode(s string) *LoginCodeCreate {
+	lcc.mutation.SetCode(s)
+	return lcc
+}
+
+// SetUsername sets the "username" field.
+func (lcc *LoginCodeCreate) SetUsername(s string) *LoginCodeCreate {
+	lcc.mutation.SetUsername(s)
+	return lcc
+}
+
+// SetToken sets the "token" field.
+func (lcc *LoginCodeCreate) SetToken(s string) *LoginCodeCreate {
+	lcc.mutation.SetToken(s)
+	return lcc
+}
+
+// SetRefresh sets the "refresh" field.
+func (lcc *LoginCodeCreate) SetRefresh(s string) *LoginCodeCreate {
+	lcc.mutation.SetRefresh(s)
+	return lcc
+}
+
+// SetExpires sets the "expires" field.
+func (lcc *LoginCodeCreate) SetExpires(t time.Time) *LoginCodeCreate {
+	lcc.mutation.SetExpires(t)
+	return lcc
+}
+
+// Mutation returns the LoginCodeMutation object of the builder.
+func (lcc *LoginCodeCreate) Mutation() *LoginCodeMutation {
+	return lcc.mutation
+}
+
+// Save creates the LoginCode in the database.
+func (lcc *LoginCodeCreate) Save(ctx context.Context) (*LoginCode, error) {
+	return withHooks(ctx, lcc.sqlSave, lcc.mutation, lcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lcc *LoginCodeCreate) SaveX(ctx context.Context) *LoginCode {
+	v, err := lcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcc *LoginCodeCreate) Exec(ctx context.Context) error {
+	_, err := lcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcc *LoginCodeCreate) ExecX(ctx context.Context) {
+	if err := lcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lcc *LoginCodeCreate) check() error {
+	if _, ok := lcc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "LoginCode.code"`)}
+	}
+	if _, ok := lcc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "LoginCode.username"`)}
+	}
+	if _, ok := lcc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "LoginCode.token"`)}
+	}
+	if _, ok := lcc.mutation.Refresh(); !ok {
+		return &ValidationError{Name: "refresh", err: errors.New(`ent: missing required field "LoginCode.refresh"`)}
+	}
+	if _, ok := lcc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`ent: missing required field "LoginCode.expires"`)}
+	}
+	return nil
+}
+
+func (lcc *LoginCodeCreate) sqlSave(ctx context.Context) (*LoginCode, error) {
+	if err := lcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lcc.mutation.id = &_node.ID
+	lcc.mutation.done = true
+	return _node, nil
+}
+
+func (lcc *LoginCodeCreate) createSpec() (*LoginCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginCode{config: lcc.config}
+		_spec = sqlgraph.NewCreateSpec(logincode.Table, sqlgraph.NewFieldSpec(logincode.FieldID, field.TypeInt))
+	)
+	if value, ok := lcc.mutation.Code(); ok {
+		_spec.SetField(logincode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := lcc.mutation.Username(); ok {
+		_spec.SetField(logincode.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := lcc.mutation.Token(); ok {
+		_spec.SetField(logincode.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := lcc.mutation.Refresh(); ok {
+		_spec.SetField(logincode.FieldRefresh, field.TypeString, value)
+		_node.Refresh = value
+	}
+	if value, ok := lcc.mutation.Expires(); ok {
+		_spec.SetField(logincode.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// LoginCodeCreateBulk is the builder for creating many LoginCode entities in bulk.
+type LoginCodeCreateBulk struct {
+	config
+	err      error
+	builders []*LoginCodeCreate
+}
+
+// Save creates the LoginCode entities in the database.
+func (lccb *LoginCodeCreateBulk) Save(ctx context.Context) ([]*LoginCode, error) {
+	if lccb.err != nil {
+		return nil, lccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lccb.builders))
+	nodes := make([]*LoginCode, len(lccb.builders))
+	mutators := make([]Mutator, len(lccb.builders))
+	for i := range lccb.builders {
+		func(i int, root context.Context) {
+			builder := lccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lccb *LoginCodeCreateBulk) SaveX(ctx context.Context) []*LoginCode {
+	v, err := lccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lccb *LoginCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := lccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lccb *LoginCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := lccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
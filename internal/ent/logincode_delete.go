@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"stoke/internal/ent/logincode"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginCodeDelete is the builder for deleting a LoginCode entity.
+type LoginCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginCodeMutation
+}
+
+// Where appends a list predicates to the LoginCodeDelete builder.
+func (lcd *LoginCodeDelete) Where(ps ...predicate.LoginCode) *LoginCodeDelete {
+	lcd.mutation.Where(ps...)
+	return lcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lcd *LoginCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lcd.sqlExec, lcd.mutation, lcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcd *LoginCodeDelete) ExecX(ctx context.Context) int {
+	n, err := lcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lcd *LoginCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(logincode.Table, sqlgraph.NewFieldSpec(logincode.FieldID, field.TypeInt))
+	if ps := lcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lcd.mutation.done = true
+	return affected, err
+}
+
+// LoginCodeDeleteOne is the builder for deleting a single LoginCode entity.
+type LoginCodeDeleteOne struct {
+	lcd *LoginCodeDelete
+}
+
+// Where appends a list predicates to the LoginCodeDelete builder.
+func (lcdo *LoginCodeDeleteOne) Where(ps ...predicate.LoginCode) *LoginCodeDeleteOne {
+	lcdo.lcd.mutation.Where(ps...)
+	return lcdo
+}
+
+// Exec executes the deletion query.
+func (lcdo *LoginCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := lcdo.lcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{logincode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcdo *LoginCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := lcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
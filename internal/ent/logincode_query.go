@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"stoke/internal/ent/logincode"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginCodeQuery is the builder for querying LoginCode entities.
+type LoginCodeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []logincode.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoginCode
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginCodeQuery builder.
+func (lcq *LoginCodeQuery) Where(ps ...predicate.LoginCode) *LoginCodeQuery {
+	lcq.predicates = append(lcq.predicates, ps...)
+	return lcq
+}
+
+// Limit the number of records to be returned by this query.
+func (lcq *LoginCodeQuery) Limit(limit int) *LoginCodeQuery {
+	lcq.ctx.Limit = &limit
+	return lcq
+}
+
+// Offset to start from.
+func (lcq *LoginCodeQuery) Offset(offset int) *LoginCodeQuery {
+	lcq.ctx.Offset = &offset
+	return lcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lcq *LoginCodeQuery) Unique(unique bool) *LoginCodeQuery {
+	lcq.ctx.Unique = &unique
+	return lcq
+}
+
+// Order specifies how the records should be ordered.
+func (lcq *LoginCodeQuery) Order(o ...logincode.OrderOption) *LoginCodeQuery {
+	lcq.order = append(lcq.order, o...)
+	return lcq
+}
+
+// First returns the first LoginCode entity from the query.
+// Returns a *NotFoundError when no LoginCode was found.
+func (lcq *LoginCodeQuery) First(ctx context.Context) (*LoginCode, error) {
+	nodes, err := lcq.Limit(1).All(setContextOp(ctx, lcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{logincode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lcq *LoginCodeQuery) FirstX(ctx context.Context) *LoginCode {
+	node, err := lcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginCode ID from the query.
+// Returns a *NotFoundError when no LoginCode ID was found.
+func (lcq *LoginCodeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lcq.Limit(1).IDs(setContextOp(ctx, lcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{logincode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lcq *LoginCodeQuery) FirstIDX(ctx context.Context) int {
+	id, err := lcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginCode entity is found.
+// Returns a *NotFoundError when no LoginCode entities are found.
+func (lcq *LoginCodeQuery) Only(ctx context.Context) (*LoginCode, error) {
+	nodes, err := lcq.Limit(2).All(setContextOp(ctx, lcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{logincode.Label}
+	default:
+		return nil, &NotSingularError{logincode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lcq *LoginCodeQuery) OnlyX(ctx context.Context) *LoginCode {
+	node, err := lcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginCode ID in the query.
+// Returns a *NotSingularError when more than one LoginCode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lcq *LoginCodeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lcq.Limit(2).IDs(setContextOp(ctx, lcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{logincode.Label}
+	default:
+		err = &NotSingularError{logincode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lcq *LoginCodeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginCodes.
+func (lcq *LoginCodeQuery) All(ctx context.Context) ([]*LoginCode, error) {
+	ctx = setContextOp(ctx, lcq.ctx, "All")
+	if err := lcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoginCode, *LoginCodeQuery]()
+	return withInterceptors[[]*LoginCode](ctx, lcq, qr, lcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lcq *LoginCodeQuery) AllX(ctx context.Context) []*LoginCode {
+	nodes, err := lcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginCode IDs.
+func (lcq *LoginCodeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if lcq.ctx.Unique == nil && lcq.path != nil {
+		lcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lcq.ctx, "IDs")
+	if err = lcq.Select(logincode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lcq *LoginCodeQuery) IDsX(ctx context.Context) []int {
+	ids, err := lcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lcq *LoginCodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lcq.ctx, "Count")
+	if err := lcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lcq, querierCount[*LoginCodeQuery](), lcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lcq *LoginCodeQuery) CountX(ctx context.Context) int {
+	count, err := lcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lcq *LoginCodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lcq.ctx, "Exist")
+	switch _, err := lcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lcq *LoginCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := lcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lcq *LoginCodeQuery) Clone() *LoginCodeQuery {
+	if lcq == nil {
+		return nil
+	}
+	return &LoginCodeQuery{
+		config:     lcq.config,
+		ctx:        lcq.ctx.Clone(),
+		order:      append([]logincode.OrderOption{}, lcq.order...),
+		inters:     append([]Interceptor{}, lcq.inters...),
+		predicates: append([]predicate.LoginCode{}, lcq.predicates...),
+		// clone intermediate query.
+		sql:  lcq.sql.Clone(),
+		path: lcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginCode.Query().
+//		GroupBy(logincode.FieldCode).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lcq *LoginCodeQuery) GroupBy(field string, fields ...string) *LoginCodeGroupBy {
+	lcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoginCodeGroupBy{build: lcq}
+	grbuild.flds = &lcq.ctx.Fields
+	grbuild.label = logincode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//	}
+//
+//	client.LoginCode.Query().
+//		Select(logincode.FieldCode).
+//		Scan(ctx, &v)
+func (lcq *LoginCodeQuery) Select(fields ...string) *LoginCodeSelect {
+	lcq.ctx.Fields = append(lcq.ctx.Fields, fields...)
+	sbuild := &LoginCodeSelect{LoginCodeQuery: lcq}
+	sbuild.label = logincode.Label
+	sbuild.flds, sbuild.scan = &lcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoginCodeSelect configured with the given aggregations.
+func (lcq *LoginCodeQuery) Aggregate(fns ...AggregateFunc) *LoginCodeSelect {
+	return lcq.Select().Aggregate(fns...)
+}
+
+func (lcq *LoginCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lcq.ctx.Fields {
+		if !logincode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lcq.path != nil {
+		prev, err := lcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lcq.sql = prev
+	}
+	return nil
+}
+
+func (lcq *LoginCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoginCode, error) {
+	var (
+		nodes = []*LoginCode{}
+		_spec = lcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoginCode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoginCode{config: lcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lcq *LoginCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lcq.querySpec()
+	_spec.Node.Columns = lcq.ctx.Fields
+	if len(lcq.ctx.Fields) > 0 {
+		_spec.Unique = lcq.ctx.Unique != nil && *lcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lcq.driver, _spec)
+}
+
+func (lcq *LoginCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(logincode.Table, logincode.Columns, sqlgraph.NewFieldSpec(logincode.FieldID, field.TypeInt))
+	_spec.From = lcq.sql
+	if unique := lcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, logincode.FieldID)
+		for i := range fields {
+			if fields[i] != logincode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lcq *LoginCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lcq.driver.Dialect())
+	t1 := builder.Table(logincode.Table)
+	columns := lcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = logincode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lcq.sql != nil {
+		selector = lcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lcq.ctx.Unique != nil && *lcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lcq.predicates {
+		p(selector)
+	}
+	for _, p := range lcq.order {
+		p(selector)
+	}
+	if offset := lcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoginCodeGroupBy is the group-by builder for LoginCode entities.
+type LoginCodeGroupBy struct {
+	selector
+	build *LoginCodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lcgb *LoginCodeGroupBy) Aggregate(fns ...AggregateFunc) *LoginCodeGroupBy {
+	lcgb.fns = append(lcgb.fns, fns...)
+	return lcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lcgb *LoginCodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lcgb.build.ctx, "GroupBy")
+	if err := lcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginCodeQuery, *LoginCodeGroupBy](ctx, lcgb.build, lcgb, lcgb.build.inters, v)
+}
+
+func (lcgb *LoginCodeGroupBy) sqlScan(ctx context.Context, root *LoginCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lcgb.fns))
+	for _, fn := range lcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lcgb.flds)+len(lcgb.fns))
+		for _, f := range *lcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoginCodeSelect is the builder for selecting fields of LoginCode entities.
+type LoginCodeSelect struct {
+	*LoginCodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lcs *LoginCodeSelect) Aggregate(fns ...AggregateFunc) *LoginCodeSelect {
+	lcs.fns = append(lcs.fns, fns...)
+	return lcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lcs *LoginCodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lcs.ctx, "Select")
+	if err := lcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginCodeQuery, *LoginCodeSelect](ctx, lcs.LoginCodeQuery, lcs, lcs.inters, v)
+}
+
+func (lcs *LoginCodeSelect) sqlScan(ctx context.Context, root *LoginCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lcs.fns))
+	for _, fn := range lcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
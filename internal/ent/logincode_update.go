@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/logincode"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginCodeUpdate is the builder for updating LoginCode entities.
+type LoginCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginCodeMutation
+}
+
+// Where appends a list predicates to the LoginCodeUpdate builder.
+func (lcu *LoginCodeUpdate) Where(ps ...predicate.LoginCode) *LoginCodeUpdate {
+	lcu.mutation.Where(ps...)
+	return lcu
+}
+
+// Mutation returns the LoginCodeMutation object of the builder.
+func (lcu *LoginCodeUpdate) Mutation() *LoginCodeMutation {
+	return lcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lcu *LoginCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lcu.sqlSave, lcu.mutation, lcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcu *LoginCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := lcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lcu *LoginCodeUpdate) Exec(ctx context.Context) error {
+	_, err := lcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcu *LoginCodeUpdate) ExecX(ctx context.Context) {
+	if err := lcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lcu *LoginCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(logincode.Table, logincode.Columns, sqlgraph.NewFieldSpec(logincode.FieldID, field.TypeInt))
+	if ps := lcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logincode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lcu.mutation.done = true
+	return n, nil
+}
+
+// LoginCodeUpdateOne is the builder for updating a single LoginCode entity.
+type LoginCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginCodeMutation
+}
+
+// Mutation returns the LoginCodeMutation object of the builder.
+func (lcuo *LoginCodeUpdateOne) Mutation() *LoginCodeMutation {
+	return lcuo.mutation
+}
+
+// Where appends a list predicates to the LoginCodeUpdate builder.
+func (lcuo *LoginCodeUpdateOne) Where(ps ...predicate.LoginCode) *LoginCodeUpdateOne {
+	lcuo.mutation.Where(ps...)
+	return lcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lcuo *LoginCodeUpdateOne) Select(field string, fields ...string) *LoginCodeUpdateOne {
+	lcuo.fields = append([]string{field}, fields...)
+	return lcuo
+}
+
+// Save executes the query and returns the updated LoginCode entity.
+func (lcuo *LoginCodeUpdateOne) Save(ctx context.Context) (*LoginCode, error) {
+	return withHooks(ctx, lcuo.sqlSave, lcuo.mutation, lcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcuo *LoginCodeUpdateOne) SaveX(ctx context.Context) *LoginCode {
+	node, err := lcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lcuo *LoginCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := lcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcuo *LoginCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := lcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lcuo *LoginCodeUpdateOne) sqlSave(ctx context.Context) (_node *LoginCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(logincode.Table, logincode.Columns, sqlgraph.NewFieldSpec(logincode.FieldID, field.TypeInt))
+	id, ok := lcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, logincode.FieldID)
+		for _, f := range fields {
+			if !logincode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != logincode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &LoginCode{config: lcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logincode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"stoke/internal/ent/logouttoken"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LogoutToken is the model entity for the LogoutToken schema.
+type LogoutToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Jti holds the value of the "jti" field.
+	Jti string `json:"jti,omitempty"`
+	// Expires holds the value of the "expires" field.
+	Expires      time.Time `json:"expires,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LogoutToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case logouttoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case logouttoken.FieldProvider, logouttoken.FieldJti:
+			values[i] = new(sql.NullString)
+		case logouttoken.FieldExpires:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LogoutToken fields.
+func (lt *LogoutToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case logouttoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lt.ID = int(value.Int64)
+		case logouttoken.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				lt.Provider = value.String
+			}
+		case logouttoken.FieldJti:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jti", values[i])
+			} else if value.Valid {
+				lt.Jti = value.String
+			}
+		case logouttoken.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				lt.Expires = value.Time
+			}
+		default:
+			lt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LogoutToken.
+// This includes values selected through modifiers, order, etc.
+func (lt *LogoutToken) Value(name string) (ent.Value, error) {
+	return lt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LogoutToken.
+// Note that you need to call LogoutToken.Unwrap() before calling this method if this LogoutToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lt *LogoutToken) Update() *LogoutTokenUpdateOne {
+	return NewLogoutTokenClient(lt.config).UpdateOne(lt)
+}
+
+// Unwrap unwraps the LogoutToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lt *LogoutToken) Unwrap() *LogoutToken {
+	_tx, ok := lt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LogoutToken is not a transactional entity")
+	}
+	lt.config.driver = _tx.drv
+	return lt
+}
+
+// String implements the fmt.Stringer.
+func (lt *LogoutToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("LogoutToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lt.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(lt.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("jti=")
+	builder.WriteString(lt.Jti)
+	builder.WriteString(", ")
+	builder.WriteString("expires=")
+	builder.WriteString(lt.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LogoutTokens is a parsable slice of LogoutToken.
+type LogoutTokens []*LogoutToken
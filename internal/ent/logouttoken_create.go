@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/logouttoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LogoutTokenCreate is the builder for creating a LogoutToken entity.
+type LogoutTokenCreate struct {
+	config
+	mutation *LogoutTokenMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (ltc *LogoutTokenCreate) SetProvider(s string) *LogoutTokenCreate {
+	ltc.mutation.SetProvider(s)
+	return ltc
+}
+
+// SetJti sets the "jti" field.
+func (ltc *LogoutTokenCreate) SetJti(s string) *LogoutTokenCreate {
+	ltc.mutation.SetJti(s)
+	return ltc
+}
+
+// SetExpires sets the "expires" field.
+func (ltc *LogoutTokenCreate) SetExpires(t time.Time) *LogoutTokenCreate {
+	ltc.mutation.SetExpires(t)
+	return ltc
+}
+
+// Mutation returns the LogoutTokenMutation object of the builder.
+func (ltc *LogoutTokenCreate) Mutation() *LogoutTokenMutation {
+	return ltc.mutation
+}
+
+// Save creates the LogoutToken in the database.
+func (ltc *LogoutTokenCreate) Save(ctx context.Context) (*LogoutToken, error) {
+	return withHooks(ctx, ltc.sqlSave, ltc.mutation, ltc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ltc *LogoutTokenCreate) SaveX(ctx context.Context) *LogoutToken {
+	v, err := ltc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltc *LogoutTokenCreate) Exec(ctx context.Context) error {
+	_, err := ltc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltc *LogoutTokenCreate) ExecX(ctx context.Context) {
+	if err := ltc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltc *LogoutTokenCreate) check() error {
+	if _, ok := ltc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "LogoutToken.provider"`)}
+	}
+	if _, ok := ltc.mutation.Jti(); !ok {
+		return &ValidationError{Name: "jti", err: errors.New(`ent: missing required field "LogoutToken.jti"`)}
+	}
+	if _, ok := ltc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`ent: missing required field "LogoutToken.expires"`)}
+	}
+	return nil
+}
+
+func (ltc *LogoutTokenCreate) sqlSave(ctx context.Context) (*LogoutToken, error) {
+	if err := ltc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ltc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ltc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ltc.mutation.id = &_node.ID
+	ltc.mutation.done = true
+	return _node, nil
+}
+
+func (ltc *LogoutTokenCreate) createSpec() (*LogoutToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LogoutToken{config: ltc.config}
+		_spec = sqlgraph.NewCreateSpec(logouttoken.Table, sqlgraph.NewFieldSpec(logouttoken.FieldID, field.TypeInt))
+	)
+	if value, ok := ltc.mutation.Provider(); ok {
+		_spec.SetField(logouttoken.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := ltc.mutation.Jti(); ok {
+		_spec.SetField(logouttoken.FieldJti, field.TypeString, value)
+		_node.Jti = value
+	}
+	if value, ok := ltc.mutation.Expires(); ok {
+		_spec.SetField(logouttoken.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// LogoutTokenCreateBulk is the builder for creating many LogoutToken entities in bulk.
+type LogoutTokenCreateBulk struct {
+	config
+	err      error
+	builders []*LogoutTokenCreate
+}
+
+// Save creates the LogoutToken entities in the database.
+func (ltcb *LogoutTokenCreateBulk) Save(ctx context.Context) ([]*LogoutToken, error) {
+	if ltcb.err != nil {
+		return nil, ltcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ltcb.builders))
+	nodes := make([]*LogoutToken, len(ltcb.builders))
+	mutators := make([]Mutator, len(ltcb.builders))
+	for i := range ltcb.builders {
+		func(i int, root context.Context) {
+			builder := ltcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LogoutTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ltcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ltcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ltcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltcb *LogoutTokenCreateBulk) SaveX(ctx context.Context) []*LogoutToken {
+	v, err := ltcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltcb *LogoutTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := ltcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltcb *LogoutTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := ltcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
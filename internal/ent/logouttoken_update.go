@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/logouttoken"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LogoutTokenUpdate is the builder for updating LogoutToken entities.
+type LogoutTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LogoutTokenMutation
+}
+
+// Where appends a list predicates to the LogoutTokenUpdate builder.
+func (ltu *LogoutTokenUpdate) Where(ps ...predicate.LogoutToken) *LogoutTokenUpdate {
+	ltu.mutation.Where(ps...)
+	return ltu
+}
+
+// Mutation returns the LogoutTokenMutation object of the builder.
+func (ltu *LogoutTokenUpdate) Mutation() *LogoutTokenMutation {
+	return ltu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ltu *LogoutTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ltu.sqlSave, ltu.mutation, ltu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltu *LogoutTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := ltu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ltu *LogoutTokenUpdate) Exec(ctx context.Context) error {
+	_, err := ltu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltu *LogoutTokenUpdate) ExecX(ctx context.Context) {
+	if err := ltu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ltu *LogoutTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(logouttoken.Table, logouttoken.Columns, sqlgraph.NewFieldSpec(logouttoken.FieldID, field.TypeInt))
+	if ps := ltu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ltu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logouttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ltu.mutation.done = true
+	return n, nil
+}
+
+// LogoutTokenUpdateOne is the builder for updating a single LogoutToken entity.
+type LogoutTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LogoutTokenMutation
+}
+
+// Mutation returns the LogoutTokenMutation object of the builder.
+func (ltuo *LogoutTokenUpdateOne) Mutation() *LogoutTokenMutation {
+	return ltuo.mutation
+}
+
+// Where appends a list predicates to the LogoutTokenUpdate builder.
+func (ltuo *LogoutTokenUpdateOne) Where(ps ...predicate.LogoutToken) *LogoutTokenUpdateOne {
+	ltuo.mutation.Where(ps...)
+	return ltuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ltuo *LogoutTokenUpdateOne) Select(field string, fields ...string) *LogoutTokenUpdateOne {
+	ltuo.fields = append([]string{field}, fields...)
+	return ltuo
+}
+
+// Save executes the query and returns the updated LogoutToken entity.
+func (ltuo *LogoutTokenUpdateOne) Save(ctx context.Context) (*LogoutToken, error) {
+	return withHooks(ctx, ltuo.sqlSave, ltuo.mutation, ltuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltuo *LogoutTokenUpdateOne) SaveX(ctx context.Context) *LogoutToken {
+	node, err := ltuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ltuo *LogoutTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := ltuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltuo *LogoutTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := ltuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ltuo *LogoutTokenUpdateOne) sqlSave(ctx context.Context) (_node *LogoutToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(logouttoken.Table, logouttoken.Columns, sqlgraph.NewFieldSpec(logouttoken.FieldID, field.TypeInt))
+	id, ok := ltuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LogoutToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ltuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, logouttoken.FieldID)
+		for _, f := range fields {
+			if !logouttoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != logouttoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ltuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &LogoutToken{config: ltuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ltuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logouttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ltuo.mutation.done = true
+	return _node, nil
+}
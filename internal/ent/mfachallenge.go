@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"stoke/internal/ent/mfachallenge"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MFAChallenge is the model entity for the MFAChallenge schema.
+type MFAChallenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Challenge holds the value of the "challenge" field.
+	Challenge string `json:"-"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Claims holds the value of the "claims" field.
+	Claims map[string]string `json:"-"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// Expires holds the value of the "expires" field.
+	Expires      time.Time `json:"expires,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MFAChallenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mfachallenge.FieldClaims:
+			values[i] = new([]byte)
+		case mfachallenge.FieldID, mfachallenge.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case mfachallenge.FieldChallenge, mfachallenge.FieldUsername:
+			values[i] = new(sql.NullString)
+		case mfachallenge.FieldExpires:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MFAChallenge fields.
+func (mc *MFAChallenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mfachallenge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mc.ID = int(value.Int64)
+		case mfachallenge.FieldChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge", values[i])
+			} else if value.Valid {
+				mc.Challenge = value.String
+			}
+		case mfachallenge.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				mc.Username = value.String
+			}
+		case mfachallenge.FieldClaims:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claims", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mc.Claims); err != nil {
+					return fmt.Errorf("unmarshal field claims: %w", err)
+				}
+			}
+		case mfachallenge.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				mc.Attempts = int(value.Int64)
+			}
+		case mfachallenge.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				mc.Expires = value.Time
+			}
+		default:
+			mc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MFAChallenge.
+// This includes values selected through modifiers, order, etc.
+func (mc *MFAChallenge) Value(name string) (ent.Value, error) {
+	return mc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MFAChallenge.
+// Note that you need to call MFAChallenge.Unwrap() before calling this method if this MFAChallenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mc *MFAChallenge) Update() *MFAChallengeUpdateOne {
+	return NewMFAChallengeClient(mc.config).UpdateOne(mc)
+}
+
+// Unwrap unwraps the MFAChallenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mc *MFAChallenge) Unwrap() *MFAChallenge {
+	_tx, ok := mc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MFAChallenge is not a transactional entity")
+	}
+	mc.config.driver = _tx.drv
+	return mc
+}
+
+// String implements the fmt.Stringer.
+func (mc *MFAChallenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("MFAChallenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mc.ID))
+	builder.WriteString("challenge=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(mc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("claims=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", mc.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires=")
+	builder.WriteString(mc.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MFAChallenges is a parsable slice of MFAChallenge.
+type MFAChallenges []*MFAChallenge
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/mfachallenge"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MFAChallengeCreate is the builder for creating a MFAChallenge entity.
+type MFAChallengeCreate struct {
+	config
+	mutation *MFAChallengeMutation
+	hooks    []Hook
+}
+
+// SetChallenge sets the "challenge" field.
+func (mcc *MFAChallengeCreate) SetChallenge(s string) *MFAChallengeCreate {
+	mcc.mutation.SetChallenge(s)
+	return mcc
+}
+
+// SetUsername sets the "username" field.
+func (mcc *MFAChallengeCreate) SetUsername(s string) *MFAChallengeCreate {
+	mcc.mutation.SetUsername(s)
+	return mcc
+}
+
+// SetClaims sets the "claims" field.
+func (mcc *MFAChallengeCreate) SetClaims(m map[string]string) *MFAChallengeCreate {
+	mcc.mutation.SetClaims(m)
+	return mcc
+}
+
+// SetAttempts sets the "attempts" field.
+func (mcc *MFAChallengeCreate) SetAttempts(i int) *MFAChallengeCreate {
+	mcc.mutation.SetAttempts(i)
+	return mcc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mcc *MFAChallengeCreate) SetNillableAttempts(i *int) *MFAChallengeCreate {
+	if i != nil {
+		mcc.SetAttempts(*i)
+	}
+	return mcc
+}
+
+// SetExpires sets the "expires" field.
+func (mcc *MFAChallengeCreate) SetExpires(t time.Time) *MFAChallengeCreate {
+	mcc.mutation.SetExpires(t)
+	return mcc
+}
+
+// Mutation returns the MFAChallengeMutation object of the builder.
+func (mcc *MFAChallengeCreate) Mutation() *MFAChallengeMutation {
+	return mcc.mutation
+}
+
+// Save creates the MFAChallenge in the database.
+func (mcc *MFAChallengeCreate) Save(ctx context.Context) (*MFAChallenge, error) {
+	mcc.defaults()
+	return withHooks(ctx, mcc.sqlSave, mcc.mutation, mcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mcc *MFAChallengeCreate) SaveX(ctx context.Context) *MFAChallenge {
+	v, err := mcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcc *MFAChallengeCreate) Exec(ctx context.Context) error {
+	_, err := mcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcc *MFAChallengeCreate) ExecX(ctx context.Context) {
+	if err := mcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcc *MFAChallengeCreate) defaults() {
+	if _, ok := mcc.mutation.Attempts(); !ok {
+		v := mfachallenge.DefaultAttempts
+		mcc.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcc *MFAChallengeCreate) check() error {
+	if _, ok := mcc.mutation.Challenge(); !ok {
+		return &ValidationError{Name: "challenge", err: errors.New(`ent: missing required field "MFAChallenge.challenge"`)}
+	}
+	if _, ok := mcc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "MFAChallenge.username"`)}
+	}
+	if _, ok := mcc.mutation.Claims(); !ok {
+		return &ValidationError{Name: "claims", err: errors.New(`ent: missing required field "MFAChallenge.claims"`)}
+	}
+	if _, ok := mcc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "MFAChallenge.attempts"`)}
+	}
+	if _, ok := mcc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`ent: missing required field "MFAChallenge.expires"`)}
+	}
+	return nil
+}
+
+func (mcc *MFAChallengeCreate) sqlSave(ctx context.Context) (*MFAChallenge, error) {
+	if err := mcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mcc.mutation.id = &_node.ID
+	mcc.mutation.done = true
+	return _node, nil
+}
+
+func (mcc *MFAChallengeCreate) createSpec() (*MFAChallenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MFAChallenge{config: mcc.config}
+		_spec = sqlgraph.NewCreateSpec(mfachallenge.Table, sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeInt))
+	)
+	if value, ok := mcc.mutation.Challenge(); ok {
+		_spec.SetField(mfachallenge.FieldChallenge, field.TypeString, value)
+		_node.Challenge = value
+	}
+	if value, ok := mcc.mutation.Username(); ok {
+		_spec.SetField(mfachallenge.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := mcc.mutation.Claims(); ok {
+		_spec.SetField(mfachallenge.FieldClaims, field.TypeJSON, value)
+		_node.Claims = value
+	}
+	if value, ok := mcc.mutation.Attempts(); ok {
+		_spec.SetField(mfachallenge.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := mcc.mutation.Expires(); ok {
+		_spec.SetField(mfachallenge.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// MFAChallengeCreateBulk is the builder for creating many MFAChallenge entities in bulk.
+type MFAChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*MFAChallengeCreate
+}
+
+// Save creates the MFAChallenge entities in the database.
+func (mccb *MFAChallengeCreateBulk) Save(ctx context.Context) ([]*MFAChallenge, error) {
+	if mccb.err != nil {
+		return nil, mccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mccb.builders))
+	nodes := make([]*MFAChallenge, len(mccb.builders))
+	mutators := make([]Mutator, len(mccb.builders))
+	for i := range mccb.builders {
+		func(i int, root context.Context) {
+			builder := mccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MFAChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mccb *MFAChallengeCreateBulk) SaveX(ctx context.Context) []*MFAChallenge {
+	v, err := mccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mccb *MFAChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := mccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mccb *MFAChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := mccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
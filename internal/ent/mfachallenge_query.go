@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"stoke/internal/ent/mfachallenge"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MFAChallengeQuery is the builder for querying MFAChallenge entities.
+type MFAChallengeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []mfachallenge.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MFAChallenge
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MFAChallengeQuery builder.
+func (mcq *MFAChallengeQuery) Where(ps ...predicate.MFAChallenge) *MFAChallengeQuery {
+	mcq.predicates = append(mcq.predicates, ps...)
+	return mcq
+}
+
+// Limit the number of records to be returned by this query.
+func (mcq *MFAChallengeQuery) Limit(limit int) *MFAChallengeQuery {
+	mcq.ctx.Limit = &limit
+	return mcq
+}
+
+// Offset to start from.
+func (mcq *MFAChallengeQuery) Offset(offset int) *MFAChallengeQuery {
+	mcq.ctx.Offset = &offset
+	return mcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mcq *MFAChallengeQuery) Unique(unique bool) *MFAChallengeQuery {
+	mcq.ctx.Unique = &unique
+	return mcq
+}
+
+// Order specifies how the records should be ordered.
+func (mcq *MFAChallengeQuery) Order(o ...mfachallenge.OrderOption) *MFAChallengeQuery {
+	mcq.order = append(mcq.order, o...)
+	return mcq
+}
+
+// First returns the first MFAChallenge entity from the query.
+// Returns a *NotFoundError when no MFAChallenge was found.
+func (mcq *MFAChallengeQuery) First(ctx context.Context) (*MFAChallenge, error) {
+	nodes, err := mcq.Limit(1).All(setContextOp(ctx, mcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mfachallenge.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) FirstX(ctx context.Context) *MFAChallenge {
+	node, err := mcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MFAChallenge ID from the query.
+// Returns a *NotFoundError when no MFAChallenge ID was found.
+func (mcq *MFAChallengeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(1).IDs(setContextOp(ctx, mcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mfachallenge.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) FirstIDX(ctx context.Context) int {
+	id, err := mcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MFAChallenge entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MFAChallenge entity is found.
+// Returns a *NotFoundError when no MFAChallenge entities are found.
+func (mcq *MFAChallengeQuery) Only(ctx context.Context) (*MFAChallenge, error) {
+	nodes, err := mcq.Limit(2).All(setContextOp(ctx, mcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mfachallenge.Label}
+	default:
+		return nil, &NotSingularError{mfachallenge.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) OnlyX(ctx context.Context) *MFAChallenge {
+	node, err := mcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MFAChallenge ID in the query.
+// Returns a *NotSingularError when more than one MFAChallenge ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mcq *MFAChallengeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(2).IDs(setContextOp(ctx, mcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mfachallenge.Label}
+	default:
+		err = &NotSingularError{mfachallenge.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MFAChallenges.
+func (mcq *MFAChallengeQuery) All(ctx context.Context) ([]*MFAChallenge, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "All")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MFAChallenge, *MFAChallengeQuery]()
+	return withInterceptors[[]*MFAChallenge](ctx, mcq, qr, mcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) AllX(ctx context.Context) []*MFAChallenge {
+	nodes, err := mcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MFAChallenge IDs.
+func (mcq *MFAChallengeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mcq.ctx.Unique == nil && mcq.path != nil {
+		mcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mcq.ctx, "IDs")
+	if err = mcq.Select(mfachallenge.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) IDsX(ctx context.Context) []int {
+	ids, err := mcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mcq *MFAChallengeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Count")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mcq, querierCount[*MFAChallengeQuery](), mcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) CountX(ctx context.Context) int {
+	count, err := mcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mcq *MFAChallengeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Exist")
+	switch _, err := mcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mcq *MFAChallengeQuery) ExistX(ctx context.Context) bool {
+	exist, err := mcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MFAChallengeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mcq *MFAChallengeQuery) Clone() *MFAChallengeQuery {
+	if mcq == nil {
+		return nil
+	}
+	return &MFAChallengeQuery{
+		config:     mcq.config,
+		ctx:        mcq.ctx.Clone(),
+		order:      append([]mfachallenge.OrderOption{}, mcq.order...),
+		inters:     append([]Interceptor{}, mcq.inters...),
+		predicates: append([]predicate.MFAChallenge{}, mcq.predicates...),
+		// clone intermediate query.
+		sql:  mcq.sql.Clone(),
+		path: mcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Challenge string `json:"challenge,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MFAChallenge.Query().
+//		GroupBy(mfachallenge.FieldChallenge).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mcq *MFAChallengeQuery) GroupBy(field string, fields ...string) *MFAChallengeGroupBy {
+	mcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MFAChallengeGroupBy{build: mcq}
+	grbuild.flds = &mcq.ctx.Fields
+	grbuild.label = mfachallenge.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Challenge string `json:"challenge,omitempty"`
+//	}
+//
+//	client.MFAChallenge.Query().
+//		Select(mfachallenge.FieldChallenge).
+//		Scan(ctx, &v)
+func (mcq *MFAChallengeQuery) Select(fields ...string) *MFAChallengeSelect {
+	mcq.ctx.Fields = append(mcq.ctx.Fields, fields...)
+	sbuild := &MFAChallengeSelect{MFAChallengeQuery: mcq}
+	sbuild.label = mfachallenge.Label
+	sbuild.flds, sbuild.scan = &mcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MFAChallengeSelect configured with the given aggregations.
+func (mcq *MFAChallengeQuery) Aggregate(fns ...AggregateFunc) *MFAChallengeSelect {
+	return mcq.Select().Aggregate(fns...)
+}
+
+func (mcq *MFAChallengeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mcq.ctx.Fields {
+		if !mfachallenge.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mcq.path != nil {
+		prev, err := mcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mcq.sql = prev
+	}
+	return nil
+}
+
+func (mcq *MFAChallengeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MFAChallenge, error) {
+	var (
+		nodes = []*MFAChallenge{}
+		_spec = mcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MFAChallenge).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MFAChallenge{config: mcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mcq *MFAChallengeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mcq.querySpec()
+	_spec.Node.Columns = mcq.ctx.Fields
+	if len(mcq.ctx.Fields) > 0 {
+		_spec.Unique = mcq.ctx.Unique != nil && *mcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mcq.driver, _spec)
+}
+
+func (mcq *MFAChallengeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mfachallenge.Table, mfachallenge.Columns, sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeInt))
+	_spec.From = mcq.sql
+	if unique := mcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mfachallenge.FieldID)
+		for i := range fields {
+			if fields[i] != mfachallenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mcq *MFAChallengeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mcq.driver.Dialect())
+	t1 := builder.Table(mfachallenge.Table)
+	columns := mcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mfachallenge.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mcq.sql != nil {
+		selector = mcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mcq.ctx.Unique != nil && *mcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mcq.predicates {
+		p(selector)
+	}
+	for _, p := range mcq.order {
+		p(selector)
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MFAChallengeGroupBy is the group-by builder for MFAChallenge entities.
+type MFAChallengeGroupBy struct {
+	selector
+	build *MFAChallengeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mcgb *MFAChallengeGroupBy) Aggregate(fns ...AggregateFunc) *MFAChallengeGroupBy {
+	mcgb.fns = append(mcgb.fns, fns...)
+	return mcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcgb *MFAChallengeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcgb.build.ctx, "GroupBy")
+	if err := mcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MFAChallengeQuery, *MFAChallengeGroupBy](ctx, mcgb.build, mcgb, mcgb.build.inters, v)
+}
+
+func (mcgb *MFAChallengeGroupBy) sqlScan(ctx context.Context, root *MFAChallengeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mcgb.fns))
+	for _, fn := range mcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mcgb.flds)+len(mcgb.fns))
+		for _, f := range *mcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MFAChallengeSelect is the builder for selecting fields of MFAChallenge entities.
+type MFAChallengeSelect struct {
+	*MFAChallengeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mcs *MFAChallengeSelect) Aggregate(fns ...AggregateFunc) *MFAChallengeSelect {
+	mcs.fns = append(mcs.fns, fns...)
+	return mcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcs *MFAChallengeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcs.ctx, "Select")
+	if err := mcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MFAChallengeQuery, *MFAChallengeSelect](ctx, mcs.MFAChallengeQuery, mcs, mcs.inters, v)
+}
+
+func (mcs *MFAChallengeSelect) sqlScan(ctx context.Context, root *MFAChallengeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mcs.fns))
+	for _, fn := range mcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/mfachallenge"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MFAChallengeUpdate is the builder for updating MFAChallenge entities.
+type MFAChallengeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MFAChallengeMutation
+}
+
+// Where appends a list predicates to the MFAChallengeUpdate builder.
+func (mcu *MFAChallengeUpdate) Where(ps ...predicate.MFAChallenge) *MFAChallengeUpdate {
+	mcu.mutation.Where(ps...)
+	return mcu
+}
+
+// SetAttempts sets the "attempts" field.
+func (mcu *MFAChallengeUpdate) SetAttempts(i int) *MFAChallengeUpdate {
+	mcu.mutation.ResetAttempts()
+	mcu.mutation.SetAttempts(i)
+	return mcu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mcu *MFAChallengeUpdate) SetNillableAttempts(i *int) *MFAChallengeUpdate {
+	if i != nil {
+		mcu.SetAttempts(*i)
+	}
+	return mcu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (mcu *MFAChallengeUpdate) AddAttempts(i int) *MFAChallengeUpdate {
+	mcu.mutation.AddAttempts(i)
+	return mcu
+}
+
+// Mutation returns the MFAChallengeMutation object of the builder.
+func (mcu *MFAChallengeUpdate) Mutation() *MFAChallengeMutation {
+	return mcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mcu *MFAChallengeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcu.sqlSave, mcu.mutation, mcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcu *MFAChallengeUpdate) SaveX(ctx context.Context) int {
+	affected, err := mcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mcu *MFAChallengeUpdate) Exec(ctx context.Context) error {
+	_, err := mcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcu *MFAChallengeUpdate) ExecX(ctx context.Context) {
+	if err := mcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mcu *MFAChallengeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(mfachallenge.Table, mfachallenge.Columns, sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeInt))
+	if ps := mcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcu.mutation.Attempts(); ok {
+		_spec.SetField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedAttempts(); ok {
+		_spec.AddField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfachallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mcu.mutation.done = true
+	return n, nil
+}
+
+// MFAChallengeUpdateOne is the builder for updating a single MFAChallenge entity.
+type MFAChallengeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MFAChallengeMutation
+}
+
+// SetAttempts sets the "attempts" field.
+func (mcuo *MFAChallengeUpdateOne) SetAttempts(i int) *MFAChallengeUpdateOne {
+	mcuo.mutation.ResetAttempts()
+	mcuo.mutation.SetAttempts(i)
+	return mcuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mcuo *MFAChallengeUpdateOne) SetNillableAttempts(i *int) *MFAChallengeUpdateOne {
+	if i != nil {
+		mcuo.SetAttempts(*i)
+	}
+	return mcuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (mcuo *MFAChallengeUpdateOne) AddAttempts(i int) *MFAChallengeUpdateOne {
+	mcuo.mutation.AddAttempts(i)
+	return mcuo
+}
+
+// Mutation returns the MFAChallengeMutation object of the builder.
+func (mcuo *MFAChallengeUpdateOne) Mutation() *MFAChallengeMutation {
+	return mcuo.mutation
+}
+
+// Where appends a list predicates to the MFAChallengeUpdate builder.
+func (mcuo *MFAChallengeUpdateOne) Where(ps ...predicate.MFAChallenge) *MFAChallengeUpdateOne {
+	mcuo.mutation.Where(ps...)
+	return mcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mcuo *MFAChallengeUpdateOne) Select(field string, fields ...string) *MFAChallengeUpdateOne {
+	mcuo.fields = append([]string{field}, fields...)
+	return mcuo
+}
+
+// Save executes the query and returns the updated MFAChallenge entity.
+func (mcuo *MFAChallengeUpdateOne) Save(ctx context.Context) (*MFAChallenge, error) {
+	return withHooks(ctx, mcuo.sqlSave, mcuo.mutation, mcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcuo *MFAChallengeUpdateOne) SaveX(ctx context.Context) *MFAChallenge {
+	node, err := mcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mcuo *MFAChallengeUpdateOne) Exec(ctx context.Context) error {
+	_, err := mcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcuo *MFAChallengeUpdateOne) ExecX(ctx context.Context) {
+	if err := mcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mcuo *MFAChallengeUpdateOne) sqlSave(ctx context.Context) (_node *MFAChallenge, err error) {
+	_spec := sqlgraph.NewUpdateSpec(mfachallenge.Table, mfachallenge.Columns, sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeInt))
+	id, ok := mcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MFAChallenge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mfachallenge.FieldID)
+		for _, f := range fields {
+			if !mfachallenge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mfachallenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcuo.mutation.Attempts(); ok {
+		_spec.SetField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	_node = &MFAChallenge{config: mcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfachallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mcuo.mutation.done = true
+	return _node, nil
+}
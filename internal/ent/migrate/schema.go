@@ -8,6 +8,28 @@ import (
 )
 
 var (
+	// CachedCredentialsColumns holds the columns for the "cached_credentials" table.
+	CachedCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString},
+		{Name: "verifier", Type: field.TypeString},
+		{Name: "salt", Type: field.TypeString},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// CachedCredentialsTable holds the schema information for the "cached_credentials" table.
+	CachedCredentialsTable = &schema.Table{
+		Name:       "cached_credentials",
+		Columns:    CachedCredentialsColumns,
+		PrimaryKey: []*schema.Column{CachedCredentialsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cachedcredential_provider_username",
+				Unique:  true,
+				Columns: []*schema.Column{CachedCredentialsColumns[1], CachedCredentialsColumns[2]},
+			},
+		},
+	}
 	// ClaimsColumns holds the columns for the "claims" table.
 	ClaimsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -74,6 +96,117 @@ var (
 			},
 		},
 	}
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider_type", Type: field.TypeString},
+		{Name: "provider_name", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "user_identities", Type: field.TypeInt},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_provider_name_subject",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[2], IdentitiesColumns[3]},
+			},
+		},
+	}
+	// LeasesColumns holds the columns for the "leases" table.
+	LeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// LeasesTable holds the schema information for the "leases" table.
+	LeasesTable = &schema.Table{
+		Name:       "leases",
+		Columns:    LeasesColumns,
+		PrimaryKey: []*schema.Column{LeasesColumns[0]},
+	}
+	// LoginCodesColumns holds the columns for the "login_codes" table.
+	LoginCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "username", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString},
+		{Name: "refresh", Type: field.TypeString},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// LoginCodesTable holds the schema information for the "login_codes" table.
+	LoginCodesTable = &schema.Table{
+		Name:       "login_codes",
+		Columns:    LoginCodesColumns,
+		PrimaryKey: []*schema.Column{LoginCodesColumns[0]},
+	}
+	// LogoutTokensColumns holds the columns for the "logout_tokens" table.
+	LogoutTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "jti", Type: field.TypeString},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// LogoutTokensTable holds the schema information for the "logout_tokens" table.
+	LogoutTokensTable = &schema.Table{
+		Name:       "logout_tokens",
+		Columns:    LogoutTokensColumns,
+		PrimaryKey: []*schema.Column{LogoutTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "logouttoken_provider_jti",
+				Unique:  true,
+				Columns: []*schema.Column{LogoutTokensColumns[1], LogoutTokensColumns[2]},
+			},
+		},
+	}
+	// MfaChallengesColumns holds the columns for the "mfa_challenges" table.
+	MfaChallengesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "challenge", Type: field.TypeString, Unique: true},
+		{Name: "username", Type: field.TypeString},
+		{Name: "claims", Type: field.TypeJSON},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// MfaChallengesTable holds the schema information for the "mfa_challenges" table.
+	MfaChallengesTable = &schema.Table{
+		Name:       "mfa_challenges",
+		Columns:    MfaChallengesColumns,
+		PrimaryKey: []*schema.Column{MfaChallengesColumns[0]},
+	}
+	// OidcStatesColumns holds the columns for the "oidc_states" table.
+	OidcStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "state", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "nonce", Type: field.TypeString},
+		{Name: "code_verifier", Type: field.TypeString},
+		{Name: "next_url", Type: field.TypeString, Default: ""},
+		{Name: "xfer", Type: field.TypeString, Default: ""},
+		{Name: "access_token", Type: field.TypeString, Default: ""},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// OidcStatesTable holds the schema information for the "oidc_states" table.
+	OidcStatesTable = &schema.Table{
+		Name:       "oidc_states",
+		Columns:    OidcStatesColumns,
+		PrimaryKey: []*schema.Column{OidcStatesColumns[0]},
+	}
 	// PrivateKeysColumns holds the columns for the "private_keys" table.
 	PrivateKeysColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -86,6 +219,40 @@ var (
 		Columns:    PrivateKeysColumns,
 		PrimaryKey: []*schema.Column{PrivateKeysColumns[0]},
 	}
+	// ProviderSessionsColumns holds the columns for the "provider_sessions" table.
+	ProviderSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "session", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "sid", Type: field.TypeString, Default: ""},
+		{Name: "revoked", Type: field.TypeBool, Default: false},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "expires", Type: field.TypeTime, Nullable: true},
+	}
+	// ProviderSessionsTable holds the schema information for the "provider_sessions" table.
+	ProviderSessionsTable = &schema.Table{
+		Name:       "provider_sessions",
+		Columns:    ProviderSessionsColumns,
+		PrimaryKey: []*schema.Column{ProviderSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "providersession_provider_subject",
+				Unique:  false,
+				Columns: []*schema.Column{ProviderSessionsColumns[2], ProviderSessionsColumns[3]},
+			},
+			{
+				Name:    "providersession_provider_sid",
+				Unique:  false,
+				Columns: []*schema.Column{ProviderSessionsColumns[2], ProviderSessionsColumns[4]},
+			},
+			{
+				Name:    "providersession_expires",
+				Unique:  false,
+				Columns: []*schema.Column{ProviderSessionsColumns[7]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -96,6 +263,12 @@ var (
 		{Name: "username", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "salt", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_secret", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_enabled", Type: field.TypeBool, Default: false},
+		{Name: "mfa_recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "mfa_last_step", Type: field.TypeInt64, Nullable: true},
+		{Name: "mfa_failures", Type: field.TypeInt, Default: 0},
+		{Name: "mfa_locked_until", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -156,11 +329,19 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CachedCredentialsTable,
 		ClaimsTable,
 		ClaimGroupsTable,
 		DbInitFilesTable,
 		GroupLinksTable,
+		IdentitiesTable,
+		LeasesTable,
+		LoginCodesTable,
+		LogoutTokensTable,
+		MfaChallengesTable,
+		OidcStatesTable,
 		PrivateKeysTable,
+		ProviderSessionsTable,
 		UsersTable,
 		ClaimClaimGroupsTable,
 		ClaimGroupUsersTable,
@@ -169,6 +350,7 @@ var (
 
 func init() {
 	GroupLinksTable.ForeignKeys[0].RefTable = ClaimGroupsTable
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	ClaimClaimGroupsTable.ForeignKeys[0].RefTable = ClaimsTable
 	ClaimClaimGroupsTable.ForeignKeys[1].RefTable = ClaimGroupsTable
 	ClaimGroupUsersTable.ForeignKeys[0].RefTable = ClaimGroupsTable
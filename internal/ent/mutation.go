@@ -6,12 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"stoke/internal/ent/cachedcredential"
 	"stoke/internal/ent/claim"
 	"stoke/internal/ent/claimgroup"
 	"stoke/internal/ent/dbinitfile"
 	"stoke/internal/ent/grouplink"
+	"stoke/internal/ent/identity"
+	"stoke/internal/ent/lease"
+	"stoke/internal/ent/logincode"
+	"stoke/internal/ent/logouttoken"
+	"stoke/internal/ent/mfachallenge"
+	"stoke/internal/ent/oidcstate"
 	"stoke/internal/ent/predicate"
 	"stoke/internal/ent/privatekey"
+	"stoke/internal/ent/providersession"
 	"stoke/internal/ent/user"
 	"sync"
 	"time"
@@ -29,44 +37,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeClaim      = "Claim"
-	TypeClaimGroup = "ClaimGroup"
-	TypeDBInitFile = "DBInitFile"
-	TypeGroupLink  = "GroupLink"
-	TypePrivateKey = "PrivateKey"
-	TypeUser       = "User"
+	TypeCachedCredential = "CachedCredential"
+	TypeClaim            = "Claim"
+	TypeClaimGroup       = "ClaimGroup"
+	TypeDBInitFile       = "DBInitFile"
+	TypeGroupLink        = "GroupLink"
+	TypeIdentity         = "Identity"
+	TypeLease            = "Lease"
+	TypeLoginCode        = "LoginCode"
+	TypeLogoutToken      = "LogoutToken"
+	TypeMFAChallenge     = "MFAChallenge"
+	TypeOIDCState        = "OIDCState"
+	TypePrivateKey       = "PrivateKey"
+	TypeProviderSession  = "ProviderSession"
+	TypeUser             = "User"
 )
 
-// ClaimMutation represents an operation that mutates the Claim nodes in the graph.
-type ClaimMutation struct {
+// CachedCredentialMutation represents an operation that mutates the CachedCredential nodes in the graph.
+type CachedCredentialMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	name                *string
-	short_name          *string
-	value               *string
-	description         *string
-	clearedFields       map[string]struct{}
-	claim_groups        map[int]struct{}
-	removedclaim_groups map[int]struct{}
-	clearedclaim_groups bool
-	done                bool
-	oldValue            func(context.Context) (*Claim, error)
-	predicates          []predicate.Claim
+	op            Op
+	typ           string
+	id            *int
+	provider      *string
+	username      *string
+	verifier      *string
+	salt          *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CachedCredential, error)
+	predicates    []predicate.CachedCredential
 }
 
-var _ ent.Mutation = (*ClaimMutation)(nil)
+var _ ent.Mutation = (*CachedCredentialMutation)(nil)
 
-// claimOption allows management of the mutation configuration using functional options.
-type claimOption func(*ClaimMutation)
+// cachedcredentialOption allows management of the mutation configuration using functional options.
+type cachedcredentialOption func(*CachedCredentialMutation)
 
-// newClaimMutation creates new mutation for the Claim entity.
-func newClaimMutation(c config, op Op, opts ...claimOption) *ClaimMutation {
-	m := &ClaimMutation{
+// newCachedCredentialMutation creates new mutation for the CachedCredential entity.
+func newCachedCredentialMutation(c config, op Op, opts ...cachedcredentialOption) *CachedCredentialMutation {
+	m := &CachedCredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeClaim,
+		typ:           TypeCachedCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -75,20 +89,20 @@ func newClaimMutation(c config, op Op, opts ...claimOption) *ClaimMutation {
 	return m
 }
 
-// withClaimID sets the ID field of the mutation.
-func withClaimID(id int) claimOption {
-	return func(m *ClaimMutation) {
+// withCachedCredentialID sets the ID field of the mutation.
+func withCachedCredentialID(id int) cachedcredentialOption {
+	return func(m *CachedCredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Claim
+			value *CachedCredential
 		)
-		m.oldValue = func(ctx context.Context) (*Claim, error) {
+		m.oldValue = func(ctx context.Context) (*CachedCredential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Claim.Get(ctx, id)
+					value, err = m.Client().CachedCredential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -97,10 +111,10 @@ func withClaimID(id int) claimOption {
 	}
 }
 
-// withClaim sets the old Claim of the mutation.
-func withClaim(node *Claim) claimOption {
-	return func(m *ClaimMutation) {
-		m.oldValue = func(context.Context) (*Claim, error) {
+// withCachedCredential sets the old CachedCredential of the mutation.
+func withCachedCredential(node *CachedCredential) cachedcredentialOption {
+	return func(m *CachedCredentialMutation) {
+		m.oldValue = func(context.Context) (*CachedCredential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -109,7 +123,7 @@ func withClaim(node *Claim) claimOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ClaimMutation) Client() *Client {
+func (m CachedCredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -117,7 +131,7 @@ func (m ClaimMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ClaimMutation) Tx() (*Tx, error) {
+func (m CachedCredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -128,7 +142,7 @@ func (m ClaimMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ClaimMutation) ID() (id int, exists bool) {
+func (m *CachedCredentialMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -139,7 +153,7 @@ func (m *ClaimMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ClaimMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CachedCredentialMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -148,219 +162,201 @@ func (m *ClaimMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Claim.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CachedCredential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ClaimMutation) SetName(s string) {
-	m.name = &s
+// SetProvider sets the "provider" field.
+func (m *CachedCredentialMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ClaimMutation) Name() (r string, exists bool) {
-	v := m.name
+// Provider returns the value of the "provider" field in the mutation.
+func (m *CachedCredentialMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Claim entity.
-// If the Claim object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the CachedCredential entity.
+// If the CachedCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClaimMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CachedCredentialMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Provider, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ClaimMutation) ResetName() {
-	m.name = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *CachedCredentialMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetShortName sets the "short_name" field.
-func (m *ClaimMutation) SetShortName(s string) {
-	m.short_name = &s
+// SetUsername sets the "username" field.
+func (m *CachedCredentialMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// ShortName returns the value of the "short_name" field in the mutation.
-func (m *ClaimMutation) ShortName() (r string, exists bool) {
-	v := m.short_name
+// Username returns the value of the "username" field in the mutation.
+func (m *CachedCredentialMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShortName returns the old "short_name" field's value of the Claim entity.
-// If the Claim object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the CachedCredential entity.
+// If the CachedCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClaimMutation) OldShortName(ctx context.Context) (v string, err error) {
+func (m *CachedCredentialMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShortName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShortName requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShortName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.ShortName, nil
+	return oldValue.Username, nil
 }
 
-// ResetShortName resets all changes to the "short_name" field.
-func (m *ClaimMutation) ResetShortName() {
-	m.short_name = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *CachedCredentialMutation) ResetUsername() {
+	m.username = nil
 }
 
-// SetValue sets the "value" field.
-func (m *ClaimMutation) SetValue(s string) {
-	m.value = &s
+// SetVerifier sets the "verifier" field.
+func (m *CachedCredentialMutation) SetVerifier(s string) {
+	m.verifier = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ClaimMutation) Value() (r string, exists bool) {
-	v := m.value
+// Verifier returns the value of the "verifier" field in the mutation.
+func (m *CachedCredentialMutation) Verifier() (r string, exists bool) {
+	v := m.verifier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Claim entity.
-// If the Claim object wasn't provided to the builder, the object is fetched from the database.
+// OldVerifier returns the old "verifier" field's value of the CachedCredential entity.
+// If the CachedCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClaimMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *CachedCredentialMutation) OldVerifier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldVerifier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldVerifier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerifier: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Verifier, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *ClaimMutation) ResetValue() {
-	m.value = nil
+// ResetVerifier resets all changes to the "verifier" field.
+func (m *CachedCredentialMutation) ResetVerifier() {
+	m.verifier = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ClaimMutation) SetDescription(s string) {
-	m.description = &s
+// SetSalt sets the "salt" field.
+func (m *CachedCredentialMutation) SetSalt(s string) {
+	m.salt = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ClaimMutation) Description() (r string, exists bool) {
-	v := m.description
+// Salt returns the value of the "salt" field in the mutation.
+func (m *CachedCredentialMutation) Salt() (r string, exists bool) {
+	v := m.salt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Claim entity.
-// If the Claim object wasn't provided to the builder, the object is fetched from the database.
+// OldSalt returns the old "salt" field's value of the CachedCredential entity.
+// If the CachedCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClaimMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *CachedCredentialMutation) OldSalt(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSalt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ClaimMutation) ResetDescription() {
-	m.description = nil
-}
-
-// AddClaimGroupIDs adds the "claim_groups" edge to the ClaimGroup entity by ids.
-func (m *ClaimMutation) AddClaimGroupIDs(ids ...int) {
-	if m.claim_groups == nil {
-		m.claim_groups = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.claim_groups[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
 	}
+	return oldValue.Salt, nil
 }
 
-// ClearClaimGroups clears the "claim_groups" edge to the ClaimGroup entity.
-func (m *ClaimMutation) ClearClaimGroups() {
-	m.clearedclaim_groups = true
+// ResetSalt resets all changes to the "salt" field.
+func (m *CachedCredentialMutation) ResetSalt() {
+	m.salt = nil
 }
 
-// ClaimGroupsCleared reports if the "claim_groups" edge to the ClaimGroup entity was cleared.
-func (m *ClaimMutation) ClaimGroupsCleared() bool {
-	return m.clearedclaim_groups
+// SetExpires sets the "expires" field.
+func (m *CachedCredentialMutation) SetExpires(t time.Time) {
+	m.expires = &t
 }
 
-// RemoveClaimGroupIDs removes the "claim_groups" edge to the ClaimGroup entity by IDs.
-func (m *ClaimMutation) RemoveClaimGroupIDs(ids ...int) {
-	if m.removedclaim_groups == nil {
-		m.removedclaim_groups = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.claim_groups, ids[i])
-		m.removedclaim_groups[ids[i]] = struct{}{}
+// Expires returns the value of the "expires" field in the mutation.
+func (m *CachedCredentialMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedClaimGroups returns the removed IDs of the "claim_groups" edge to the ClaimGroup entity.
-func (m *ClaimMutation) RemovedClaimGroupsIDs() (ids []int) {
-	for id := range m.removedclaim_groups {
-		ids = append(ids, id)
+// OldExpires returns the old "expires" field's value of the CachedCredential entity.
+// If the CachedCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CachedCredentialMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ClaimGroupsIDs returns the "claim_groups" edge IDs in the mutation.
-func (m *ClaimMutation) ClaimGroupsIDs() (ids []int) {
-	for id := range m.claim_groups {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
 }
 
-// ResetClaimGroups resets all changes to the "claim_groups" edge.
-func (m *ClaimMutation) ResetClaimGroups() {
-	m.claim_groups = nil
-	m.clearedclaim_groups = false
-	m.removedclaim_groups = nil
+// ResetExpires resets all changes to the "expires" field.
+func (m *CachedCredentialMutation) ResetExpires() {
+	m.expires = nil
 }
 
-// Where appends a list predicates to the ClaimMutation builder.
-func (m *ClaimMutation) Where(ps ...predicate.Claim) {
+// Where appends a list predicates to the CachedCredentialMutation builder.
+func (m *CachedCredentialMutation) Where(ps ...predicate.CachedCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ClaimMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CachedCredentialMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ClaimMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Claim, len(ps))
+func (m *CachedCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CachedCredential, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -368,36 +364,39 @@ func (m *ClaimMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ClaimMutation) Op() Op {
+func (m *CachedCredentialMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ClaimMutation) SetOp(op Op) {
+func (m *CachedCredentialMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Claim).
-func (m *ClaimMutation) Type() string {
+// Type returns the node type of this mutation (CachedCredential).
+func (m *CachedCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ClaimMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, claim.FieldName)
+func (m *CachedCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.provider != nil {
+		fields = append(fields, cachedcredential.FieldProvider)
 	}
-	if m.short_name != nil {
-		fields = append(fields, claim.FieldShortName)
+	if m.username != nil {
+		fields = append(fields, cachedcredential.FieldUsername)
 	}
-	if m.value != nil {
-		fields = append(fields, claim.FieldValue)
+	if m.verifier != nil {
+		fields = append(fields, cachedcredential.FieldVerifier)
 	}
-	if m.description != nil {
-		fields = append(fields, claim.FieldDescription)
+	if m.salt != nil {
+		fields = append(fields, cachedcredential.FieldSalt)
+	}
+	if m.expires != nil {
+		fields = append(fields, cachedcredential.FieldExpires)
 	}
 	return fields
 }
@@ -405,16 +404,18 @@ func (m *ClaimMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ClaimMutation) Field(name string) (ent.Value, bool) {
+func (m *CachedCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case claim.FieldName:
-		return m.Name()
-	case claim.FieldShortName:
-		return m.ShortName()
-	case claim.FieldValue:
-		return m.Value()
-	case claim.FieldDescription:
-		return m.Description()
+	case cachedcredential.FieldProvider:
+		return m.Provider()
+	case cachedcredential.FieldUsername:
+		return m.Username()
+	case cachedcredential.FieldVerifier:
+		return m.Verifier()
+	case cachedcredential.FieldSalt:
+		return m.Salt()
+	case cachedcredential.FieldExpires:
+		return m.Expires()
 	}
 	return nil, false
 }
@@ -422,236 +423,208 @@ func (m *ClaimMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ClaimMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CachedCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case claim.FieldName:
-		return m.OldName(ctx)
-	case claim.FieldShortName:
-		return m.OldShortName(ctx)
-	case claim.FieldValue:
-		return m.OldValue(ctx)
-	case claim.FieldDescription:
-		return m.OldDescription(ctx)
+	case cachedcredential.FieldProvider:
+		return m.OldProvider(ctx)
+	case cachedcredential.FieldUsername:
+		return m.OldUsername(ctx)
+	case cachedcredential.FieldVerifier:
+		return m.OldVerifier(ctx)
+	case cachedcredential.FieldSalt:
+		return m.OldSalt(ctx)
+	case cachedcredential.FieldExpires:
+		return m.OldExpires(ctx)
 	}
-	return nil, fmt.Errorf("unknown Claim field %s", name)
+	return nil, fmt.Errorf("unknown CachedCredential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ClaimMutation) SetField(name string, value ent.Value) error {
+func (m *CachedCredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case claim.FieldName:
+	case cachedcredential.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetProvider(v)
 		return nil
-	case claim.FieldShortName:
+	case cachedcredential.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShortName(v)
+		m.SetUsername(v)
 		return nil
-	case claim.FieldValue:
+	case cachedcredential.FieldVerifier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetVerifier(v)
 		return nil
-	case claim.FieldDescription:
+	case cachedcredential.FieldSalt:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetSalt(v)
+		return nil
+	case cachedcredential.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Claim field %s", name)
+	return fmt.Errorf("unknown CachedCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ClaimMutation) AddedFields() []string {
+func (m *CachedCredentialMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ClaimMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CachedCredentialMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ClaimMutation) AddField(name string, value ent.Value) error {
+func (m *CachedCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Claim numeric field %s", name)
+	return fmt.Errorf("unknown CachedCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ClaimMutation) ClearedFields() []string {
+func (m *CachedCredentialMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ClaimMutation) FieldCleared(name string) bool {
+func (m *CachedCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ClaimMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Claim nullable field %s", name)
+func (m *CachedCredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CachedCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ClaimMutation) ResetField(name string) error {
+func (m *CachedCredentialMutation) ResetField(name string) error {
 	switch name {
-	case claim.FieldName:
-		m.ResetName()
+	case cachedcredential.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case claim.FieldShortName:
-		m.ResetShortName()
+	case cachedcredential.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case claim.FieldValue:
-		m.ResetValue()
+	case cachedcredential.FieldVerifier:
+		m.ResetVerifier()
 		return nil
-	case claim.FieldDescription:
-		m.ResetDescription()
+	case cachedcredential.FieldSalt:
+		m.ResetSalt()
+		return nil
+	case cachedcredential.FieldExpires:
+		m.ResetExpires()
 		return nil
 	}
-	return fmt.Errorf("unknown Claim field %s", name)
+	return fmt.Errorf("unknown CachedCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ClaimMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.claim_groups != nil {
-		edges = append(edges, claim.EdgeClaimGroups)
-	}
+func (m *CachedCredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ClaimMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case claim.EdgeClaimGroups:
-		ids := make([]ent.Value, 0, len(m.claim_groups))
-		for id := range m.claim_groups {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CachedCredentialMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ClaimMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedclaim_groups != nil {
-		edges = append(edges, claim.EdgeClaimGroups)
-	}
+func (m *CachedCredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ClaimMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case claim.EdgeClaimGroups:
-		ids := make([]ent.Value, 0, len(m.removedclaim_groups))
-		for id := range m.removedclaim_groups {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CachedCredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ClaimMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedclaim_groups {
-		edges = append(edges, claim.EdgeClaimGroups)
-	}
+func (m *CachedCredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ClaimMutation) EdgeCleared(name string) bool {
-	switch name {
-	case claim.EdgeClaimGroups:
-		return m.clearedclaim_groups
-	}
+func (m *CachedCredentialMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ClaimMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Claim unique edge %s", name)
+func (m *CachedCredentialMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CachedCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ClaimMutation) ResetEdge(name string) error {
-	switch name {
-	case claim.EdgeClaimGroups:
-		m.ResetClaimGroups()
-		return nil
-	}
-	return fmt.Errorf("unknown Claim edge %s", name)
+func (m *CachedCredentialMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CachedCredential edge %s", name)
 }
 
-// ClaimGroupMutation represents an operation that mutates the ClaimGroup nodes in the graph.
-type ClaimGroupMutation struct {
+// ClaimMutation represents an operation that mutates the Claim nodes in the graph.
+type ClaimMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	description        *string
-	clearedFields      map[string]struct{}
-	users              map[int]struct{}
-	removedusers       map[int]struct{}
-	clearedusers       bool
-	group_links        map[int]struct{}
-	removedgroup_links map[int]struct{}
-	clearedgroup_links bool
-	claims             map[int]struct{}
-	removedclaims      map[int]struct{}
-	clearedclaims      bool
-	done               bool
-	oldValue           func(context.Context) (*ClaimGroup, error)
-	predicates         []predicate.ClaimGroup
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	short_name          *string
+	value               *string
+	description         *string
+	clearedFields       map[string]struct{}
+	claim_groups        map[int]struct{}
+	removedclaim_groups map[int]struct{}
+	clearedclaim_groups bool
+	done                bool
+	oldValue            func(context.Context) (*Claim, error)
+	predicates          []predicate.Claim
 }
 
-var _ ent.Mutation = (*ClaimGroupMutation)(nil)
+var _ ent.Mutation = (*ClaimMutation)(nil)
 
-// claimgroupOption allows management of the mutation configuration using functional options.
-type claimgroupOption func(*ClaimGroupMutation)
+// claimOption allows management of the mutation configuration using functional options.
+type claimOption func(*ClaimMutation)
 
-// newClaimGroupMutation creates new mutation for the ClaimGroup entity.
-func newClaimGroupMutation(c config, op Op, opts ...claimgroupOption) *ClaimGroupMutation {
-	m := &ClaimGroupMutation{
+// newClaimMutation creates new mutation for the Claim entity.
+func newClaimMutation(c config, op Op, opts ...claimOption) *ClaimMutation {
+	m := &ClaimMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeClaimGroup,
+		typ:           TypeClaim,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -660,20 +633,20 @@ func newClaimGroupMutation(c config, op Op, opts ...claimgroupOption) *ClaimGrou
 	return m
 }
 
-// withClaimGroupID sets the ID field of the mutation.
-func withClaimGroupID(id int) claimgroupOption {
-	return func(m *ClaimGroupMutation) {
+// withClaimID sets the ID field of the mutation.
+func withClaimID(id int) claimOption {
+	return func(m *ClaimMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ClaimGroup
+			value *Claim
 		)
-		m.oldValue = func(ctx context.Context) (*ClaimGroup, error) {
+		m.oldValue = func(ctx context.Context) (*Claim, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ClaimGroup.Get(ctx, id)
+					value, err = m.Client().Claim.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -682,10 +655,10 @@ func withClaimGroupID(id int) claimgroupOption {
 	}
 }
 
-// withClaimGroup sets the old ClaimGroup of the mutation.
-func withClaimGroup(node *ClaimGroup) claimgroupOption {
-	return func(m *ClaimGroupMutation) {
-		m.oldValue = func(context.Context) (*ClaimGroup, error) {
+// withClaim sets the old Claim of the mutation.
+func withClaim(node *Claim) claimOption {
+	return func(m *ClaimMutation) {
+		m.oldValue = func(context.Context) (*Claim, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -694,7 +667,7 @@ func withClaimGroup(node *ClaimGroup) claimgroupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ClaimGroupMutation) Client() *Client {
+func (m ClaimMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -702,7 +675,7 @@ func (m ClaimGroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ClaimGroupMutation) Tx() (*Tx, error) {
+func (m ClaimMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -713,7 +686,7 @@ func (m ClaimGroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ClaimGroupMutation) ID() (id int, exists bool) {
+func (m *ClaimMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -724,7 +697,7 @@ func (m *ClaimGroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ClaimGroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ClaimMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -733,19 +706,19 @@ func (m *ClaimGroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ClaimGroup.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Claim.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *ClaimGroupMutation) SetName(s string) {
+func (m *ClaimMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ClaimGroupMutation) Name() (r string, exists bool) {
+func (m *ClaimMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -753,10 +726,10 @@ func (m *ClaimGroupMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ClaimGroup entity.
-// If the ClaimGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Claim entity.
+// If the Claim object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClaimGroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ClaimMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -771,217 +744,181 @@ func (m *ClaimGroupMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ClaimGroupMutation) ResetName() {
+func (m *ClaimMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ClaimGroupMutation) SetDescription(s string) {
-	m.description = &s
+// SetShortName sets the "short_name" field.
+func (m *ClaimMutation) SetShortName(s string) {
+	m.short_name = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ClaimGroupMutation) Description() (r string, exists bool) {
-	v := m.description
+// ShortName returns the value of the "short_name" field in the mutation.
+func (m *ClaimMutation) ShortName() (r string, exists bool) {
+	v := m.short_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ClaimGroup entity.
-// If the ClaimGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldShortName returns the old "short_name" field's value of the Claim entity.
+// If the Claim object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClaimGroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ClaimMutation) OldShortName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldShortName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldShortName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldShortName: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ClaimGroupMutation) ResetDescription() {
-	m.description = nil
+	return oldValue.ShortName, nil
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *ClaimGroupMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
+// ResetShortName resets all changes to the "short_name" field.
+func (m *ClaimMutation) ResetShortName() {
+	m.short_name = nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *ClaimGroupMutation) ClearUsers() {
-	m.clearedusers = true
+// SetValue sets the "value" field.
+func (m *ClaimMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *ClaimGroupMutation) UsersCleared() bool {
-	return m.clearedusers
+// Value returns the value of the "value" field in the mutation.
+func (m *ClaimMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *ClaimGroupMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
+// OldValue returns the old "value" field's value of the Claim entity.
+// If the Claim object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
+	return oldValue.Value, nil
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *ClaimGroupMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
-	}
-	return
+// ResetValue resets all changes to the "value" field.
+func (m *ClaimMutation) ResetValue() {
+	m.value = nil
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *ClaimGroupMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *ClaimGroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// SetDescription sets the "description" field.
+func (m *ClaimMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// AddGroupLinkIDs adds the "group_links" edge to the GroupLink entity by ids.
-func (m *ClaimGroupMutation) AddGroupLinkIDs(ids ...int) {
-	if m.group_links == nil {
-		m.group_links = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.group_links[ids[i]] = struct{}{}
+// Description returns the value of the "description" field in the mutation.
+func (m *ClaimMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearGroupLinks clears the "group_links" edge to the GroupLink entity.
-func (m *ClaimGroupMutation) ClearGroupLinks() {
-	m.clearedgroup_links = true
-}
-
-// GroupLinksCleared reports if the "group_links" edge to the GroupLink entity was cleared.
-func (m *ClaimGroupMutation) GroupLinksCleared() bool {
-	return m.clearedgroup_links
-}
-
-// RemoveGroupLinkIDs removes the "group_links" edge to the GroupLink entity by IDs.
-func (m *ClaimGroupMutation) RemoveGroupLinkIDs(ids ...int) {
-	if m.removedgroup_links == nil {
-		m.removedgroup_links = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.group_links, ids[i])
-		m.removedgroup_links[ids[i]] = struct{}{}
+// OldDescription returns the old "description" field's value of the Claim entity.
+// If the Claim object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedGroupLinks returns the removed IDs of the "group_links" edge to the GroupLink entity.
-func (m *ClaimGroupMutation) RemovedGroupLinksIDs() (ids []int) {
-	for id := range m.removedgroup_links {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
-	return
-}
-
-// GroupLinksIDs returns the "group_links" edge IDs in the mutation.
-func (m *ClaimGroupMutation) GroupLinksIDs() (ids []int) {
-	for id := range m.group_links {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return
+	return oldValue.Description, nil
 }
 
-// ResetGroupLinks resets all changes to the "group_links" edge.
-func (m *ClaimGroupMutation) ResetGroupLinks() {
-	m.group_links = nil
-	m.clearedgroup_links = false
-	m.removedgroup_links = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *ClaimMutation) ResetDescription() {
+	m.description = nil
 }
 
-// AddClaimIDs adds the "claims" edge to the Claim entity by ids.
-func (m *ClaimGroupMutation) AddClaimIDs(ids ...int) {
-	if m.claims == nil {
-		m.claims = make(map[int]struct{})
+// AddClaimGroupIDs adds the "claim_groups" edge to the ClaimGroup entity by ids.
+func (m *ClaimMutation) AddClaimGroupIDs(ids ...int) {
+	if m.claim_groups == nil {
+		m.claim_groups = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.claims[ids[i]] = struct{}{}
+		m.claim_groups[ids[i]] = struct{}{}
 	}
 }
 
-// ClearClaims clears the "claims" edge to the Claim entity.
-func (m *ClaimGroupMutation) ClearClaims() {
-	m.clearedclaims = true
+// ClearClaimGroups clears the "claim_groups" edge to the ClaimGroup entity.
+func (m *ClaimMutation) ClearClaimGroups() {
+	m.clearedclaim_groups = true
 }
 
-// ClaimsCleared reports if the "claims" edge to the Claim entity was cleared.
-func (m *ClaimGroupMutation) ClaimsCleared() bool {
-	return m.clearedclaims
+// ClaimGroupsCleared reports if the "claim_groups" edge to the ClaimGroup entity was cleared.
+func (m *ClaimMutation) ClaimGroupsCleared() bool {
+	return m.clearedclaim_groups
 }
 
-// RemoveClaimIDs removes the "claims" edge to the Claim entity by IDs.
-func (m *ClaimGroupMutation) RemoveClaimIDs(ids ...int) {
-	if m.removedclaims == nil {
-		m.removedclaims = make(map[int]struct{})
+// RemoveClaimGroupIDs removes the "claim_groups" edge to the ClaimGroup entity by IDs.
+func (m *ClaimMutation) RemoveClaimGroupIDs(ids ...int) {
+	if m.removedclaim_groups == nil {
+		m.removedclaim_groups = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.claims, ids[i])
-		m.removedclaims[ids[i]] = struct{}{}
+		delete(m.claim_groups, ids[i])
+		m.removedclaim_groups[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedClaims returns the removed IDs of the "claims" edge to the Claim entity.
-func (m *ClaimGroupMutation) RemovedClaimsIDs() (ids []int) {
-	for id := range m.removedclaims {
+// RemovedClaimGroups returns the removed IDs of the "claim_groups" edge to the ClaimGroup entity.
+func (m *ClaimMutation) RemovedClaimGroupsIDs() (ids []int) {
+	for id := range m.removedclaim_groups {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ClaimsIDs returns the "claims" edge IDs in the mutation.
-func (m *ClaimGroupMutation) ClaimsIDs() (ids []int) {
-	for id := range m.claims {
+// ClaimGroupsIDs returns the "claim_groups" edge IDs in the mutation.
+func (m *ClaimMutation) ClaimGroupsIDs() (ids []int) {
+	for id := range m.claim_groups {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetClaims resets all changes to the "claims" edge.
-func (m *ClaimGroupMutation) ResetClaims() {
-	m.claims = nil
-	m.clearedclaims = false
-	m.removedclaims = nil
+// ResetClaimGroups resets all changes to the "claim_groups" edge.
+func (m *ClaimMutation) ResetClaimGroups() {
+	m.claim_groups = nil
+	m.clearedclaim_groups = false
+	m.removedclaim_groups = nil
 }
 
-// Where appends a list predicates to the ClaimGroupMutation builder.
-func (m *ClaimGroupMutation) Where(ps ...predicate.ClaimGroup) {
+// Where appends a list predicates to the ClaimMutation builder.
+func (m *ClaimMutation) Where(ps ...predicate.Claim) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ClaimGroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ClaimMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ClaimGroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ClaimGroup, len(ps))
+func (m *ClaimMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Claim, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -989,30 +926,36 @@ func (m *ClaimGroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ClaimGroupMutation) Op() Op {
+func (m *ClaimMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ClaimGroupMutation) SetOp(op Op) {
+func (m *ClaimMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ClaimGroup).
-func (m *ClaimGroupMutation) Type() string {
+// Type returns the node type of this mutation (Claim).
+func (m *ClaimMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ClaimGroupMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+func (m *ClaimMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.name != nil {
-		fields = append(fields, claimgroup.FieldName)
+		fields = append(fields, claim.FieldName)
+	}
+	if m.short_name != nil {
+		fields = append(fields, claim.FieldShortName)
+	}
+	if m.value != nil {
+		fields = append(fields, claim.FieldValue)
 	}
 	if m.description != nil {
-		fields = append(fields, claimgroup.FieldDescription)
+		fields = append(fields, claim.FieldDescription)
 	}
 	return fields
 }
@@ -1020,11 +963,15 @@ func (m *ClaimGroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ClaimGroupMutation) Field(name string) (ent.Value, bool) {
+func (m *ClaimMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case claimgroup.FieldName:
+	case claim.FieldName:
 		return m.Name()
-	case claimgroup.FieldDescription:
+	case claim.FieldShortName:
+		return m.ShortName()
+	case claim.FieldValue:
+		return m.Value()
+	case claim.FieldDescription:
 		return m.Description()
 	}
 	return nil, false
@@ -1033,29 +980,47 @@ func (m *ClaimGroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ClaimGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ClaimMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case claimgroup.FieldName:
+	case claim.FieldName:
 		return m.OldName(ctx)
-	case claimgroup.FieldDescription:
+	case claim.FieldShortName:
+		return m.OldShortName(ctx)
+	case claim.FieldValue:
+		return m.OldValue(ctx)
+	case claim.FieldDescription:
 		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown ClaimGroup field %s", name)
+	return nil, fmt.Errorf("unknown Claim field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ClaimGroupMutation) SetField(name string, value ent.Value) error {
+func (m *ClaimMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case claimgroup.FieldName:
+	case claim.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case claimgroup.FieldDescription:
+	case claim.FieldShortName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortName(v)
+		return nil
+	case claim.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case claim.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1063,98 +1028,86 @@ func (m *ClaimGroupMutation) SetField(name string, value ent.Value) error {
 		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ClaimGroup field %s", name)
+	return fmt.Errorf("unknown Claim field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ClaimGroupMutation) AddedFields() []string {
+func (m *ClaimMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ClaimGroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ClaimMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ClaimGroupMutation) AddField(name string, value ent.Value) error {
+func (m *ClaimMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ClaimGroup numeric field %s", name)
+	return fmt.Errorf("unknown Claim numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ClaimGroupMutation) ClearedFields() []string {
+func (m *ClaimMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ClaimGroupMutation) FieldCleared(name string) bool {
+func (m *ClaimMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ClaimGroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ClaimGroup nullable field %s", name)
+func (m *ClaimMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Claim nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ClaimGroupMutation) ResetField(name string) error {
+func (m *ClaimMutation) ResetField(name string) error {
 	switch name {
-	case claimgroup.FieldName:
+	case claim.FieldName:
 		m.ResetName()
 		return nil
-	case claimgroup.FieldDescription:
+	case claim.FieldShortName:
+		m.ResetShortName()
+		return nil
+	case claim.FieldValue:
+		m.ResetValue()
+		return nil
+	case claim.FieldDescription:
 		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown ClaimGroup field %s", name)
+	return fmt.Errorf("unknown Claim field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ClaimGroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.users != nil {
-		edges = append(edges, claimgroup.EdgeUsers)
-	}
-	if m.group_links != nil {
-		edges = append(edges, claimgroup.EdgeGroupLinks)
-	}
-	if m.claims != nil {
-		edges = append(edges, claimgroup.EdgeClaims)
+func (m *ClaimMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.claim_groups != nil {
+		edges = append(edges, claim.EdgeClaimGroups)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ClaimGroupMutation) AddedIDs(name string) []ent.Value {
+func (m *ClaimMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case claimgroup.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case claimgroup.EdgeGroupLinks:
-		ids := make([]ent.Value, 0, len(m.group_links))
-		for id := range m.group_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case claimgroup.EdgeClaims:
-		ids := make([]ent.Value, 0, len(m.claims))
-		for id := range m.claims {
+	case claim.EdgeClaimGroups:
+		ids := make([]ent.Value, 0, len(m.claim_groups))
+		for id := range m.claim_groups {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1163,39 +1116,21 @@ func (m *ClaimGroupMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ClaimGroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedusers != nil {
-		edges = append(edges, claimgroup.EdgeUsers)
-	}
-	if m.removedgroup_links != nil {
-		edges = append(edges, claimgroup.EdgeGroupLinks)
-	}
-	if m.removedclaims != nil {
-		edges = append(edges, claimgroup.EdgeClaims)
+func (m *ClaimMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedclaim_groups != nil {
+		edges = append(edges, claim.EdgeClaimGroups)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ClaimGroupMutation) RemovedIDs(name string) []ent.Value {
+func (m *ClaimMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case claimgroup.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case claimgroup.EdgeGroupLinks:
-		ids := make([]ent.Value, 0, len(m.removedgroup_links))
-		for id := range m.removedgroup_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case claimgroup.EdgeClaims:
-		ids := make([]ent.Value, 0, len(m.removedclaims))
-		for id := range m.removedclaims {
+	case claim.EdgeClaimGroups:
+		ids := make([]ent.Value, 0, len(m.removedclaim_groups))
+		for id := range m.removedclaim_groups {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1204,84 +1139,77 @@ func (m *ClaimGroupMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ClaimGroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedusers {
-		edges = append(edges, claimgroup.EdgeUsers)
-	}
-	if m.clearedgroup_links {
-		edges = append(edges, claimgroup.EdgeGroupLinks)
-	}
-	if m.clearedclaims {
-		edges = append(edges, claimgroup.EdgeClaims)
+func (m *ClaimMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedclaim_groups {
+		edges = append(edges, claim.EdgeClaimGroups)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ClaimGroupMutation) EdgeCleared(name string) bool {
+func (m *ClaimMutation) EdgeCleared(name string) bool {
 	switch name {
-	case claimgroup.EdgeUsers:
-		return m.clearedusers
-	case claimgroup.EdgeGroupLinks:
-		return m.clearedgroup_links
-	case claimgroup.EdgeClaims:
-		return m.clearedclaims
+	case claim.EdgeClaimGroups:
+		return m.clearedclaim_groups
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ClaimGroupMutation) ClearEdge(name string) error {
+func (m *ClaimMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ClaimGroup unique edge %s", name)
+	return fmt.Errorf("unknown Claim unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ClaimGroupMutation) ResetEdge(name string) error {
+func (m *ClaimMutation) ResetEdge(name string) error {
 	switch name {
-	case claimgroup.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case claimgroup.EdgeGroupLinks:
-		m.ResetGroupLinks()
-		return nil
-	case claimgroup.EdgeClaims:
-		m.ResetClaims()
+	case claim.EdgeClaimGroups:
+		m.ResetClaimGroups()
 		return nil
 	}
-	return fmt.Errorf("unknown ClaimGroup edge %s", name)
+	return fmt.Errorf("unknown Claim edge %s", name)
 }
 
-// DBInitFileMutation represents an operation that mutates the DBInitFile nodes in the graph.
-type DBInitFileMutation struct {
+// ClaimGroupMutation represents an operation that mutates the ClaimGroup nodes in the graph.
+type ClaimGroupMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	filename      *string
-	md5           *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*DBInitFile, error)
-	predicates    []predicate.DBInitFile
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	description        *string
+	clearedFields      map[string]struct{}
+	users              map[int]struct{}
+	removedusers       map[int]struct{}
+	clearedusers       bool
+	group_links        map[int]struct{}
+	removedgroup_links map[int]struct{}
+	clearedgroup_links bool
+	claims             map[int]struct{}
+	removedclaims      map[int]struct{}
+	clearedclaims      bool
+	done               bool
+	oldValue           func(context.Context) (*ClaimGroup, error)
+	predicates         []predicate.ClaimGroup
 }
 
-var _ ent.Mutation = (*DBInitFileMutation)(nil)
+var _ ent.Mutation = (*ClaimGroupMutation)(nil)
 
-// dbinitfileOption allows management of the mutation configuration using functional options.
-type dbinitfileOption func(*DBInitFileMutation)
+// claimgroupOption allows management of the mutation configuration using functional options.
+type claimgroupOption func(*ClaimGroupMutation)
 
-// newDBInitFileMutation creates new mutation for the DBInitFile entity.
-func newDBInitFileMutation(c config, op Op, opts ...dbinitfileOption) *DBInitFileMutation {
-	m := &DBInitFileMutation{
+// newClaimGroupMutation creates new mutation for the ClaimGroup entity.
+func newClaimGroupMutation(c config, op Op, opts ...claimgroupOption) *ClaimGroupMutation {
+	m := &ClaimGroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDBInitFile,
+		typ:           TypeClaimGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1290,20 +1218,20 @@ func newDBInitFileMutation(c config, op Op, opts ...dbinitfileOption) *DBInitFil
 	return m
 }
 
-// withDBInitFileID sets the ID field of the mutation.
-func withDBInitFileID(id int) dbinitfileOption {
-	return func(m *DBInitFileMutation) {
+// withClaimGroupID sets the ID field of the mutation.
+func withClaimGroupID(id int) claimgroupOption {
+	return func(m *ClaimGroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DBInitFile
+			value *ClaimGroup
 		)
-		m.oldValue = func(ctx context.Context) (*DBInitFile, error) {
+		m.oldValue = func(ctx context.Context) (*ClaimGroup, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DBInitFile.Get(ctx, id)
+					value, err = m.Client().ClaimGroup.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1312,10 +1240,10 @@ func withDBInitFileID(id int) dbinitfileOption {
 	}
 }
 
-// withDBInitFile sets the old DBInitFile of the mutation.
-func withDBInitFile(node *DBInitFile) dbinitfileOption {
-	return func(m *DBInitFileMutation) {
-		m.oldValue = func(context.Context) (*DBInitFile, error) {
+// withClaimGroup sets the old ClaimGroup of the mutation.
+func withClaimGroup(node *ClaimGroup) claimgroupOption {
+	return func(m *ClaimGroupMutation) {
+		m.oldValue = func(context.Context) (*ClaimGroup, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1324,7 +1252,7 @@ func withDBInitFile(node *DBInitFile) dbinitfileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DBInitFileMutation) Client() *Client {
+func (m ClaimGroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1332,7 +1260,7 @@ func (m DBInitFileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DBInitFileMutation) Tx() (*Tx, error) {
+func (m ClaimGroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1343,7 +1271,7 @@ func (m DBInitFileMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DBInitFileMutation) ID() (id int, exists bool) {
+func (m *ClaimGroupMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1354,7 +1282,7 @@ func (m *DBInitFileMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DBInitFileMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ClaimGroupMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1363,124 +1291,286 @@ func (m *DBInitFileMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DBInitFile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ClaimGroup.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFilename sets the "filename" field.
-func (m *DBInitFileMutation) SetFilename(s string) {
-	m.filename = &s
+// SetName sets the "name" field.
+func (m *ClaimGroupMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *DBInitFileMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// Name returns the value of the "name" field in the mutation.
+func (m *ClaimGroupMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the DBInitFile entity.
-// If the DBInitFile object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ClaimGroup entity.
+// If the ClaimGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DBInitFileMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *ClaimGroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.Name, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *DBInitFileMutation) ResetFilename() {
-	m.filename = nil
+// ResetName resets all changes to the "name" field.
+func (m *ClaimGroupMutation) ResetName() {
+	m.name = nil
 }
 
-// SetMd5 sets the "md5" field.
-func (m *DBInitFileMutation) SetMd5(s string) {
-	m.md5 = &s
+// SetDescription sets the "description" field.
+func (m *ClaimGroupMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Md5 returns the value of the "md5" field in the mutation.
-func (m *DBInitFileMutation) Md5() (r string, exists bool) {
-	v := m.md5
+// Description returns the value of the "description" field in the mutation.
+func (m *ClaimGroupMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMd5 returns the old "md5" field's value of the DBInitFile entity.
-// If the DBInitFile object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the ClaimGroup entity.
+// If the ClaimGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DBInitFileMutation) OldMd5(ctx context.Context) (v string, err error) {
+func (m *ClaimGroupMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMd5 is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMd5 requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMd5: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Md5, nil
+	return oldValue.Description, nil
 }
 
-// ResetMd5 resets all changes to the "md5" field.
-func (m *DBInitFileMutation) ResetMd5() {
-	m.md5 = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *ClaimGroupMutation) ResetDescription() {
+	m.description = nil
 }
 
-// Where appends a list predicates to the DBInitFileMutation builder.
-func (m *DBInitFileMutation) Where(ps ...predicate.DBInitFile) {
-	m.predicates = append(m.predicates, ps...)
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *ClaimGroupMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the DBInitFileMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DBInitFileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DBInitFile, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearUsers clears the "users" edge to the User entity.
+func (m *ClaimGroupMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// Op returns the operation name.
-func (m *DBInitFileMutation) Op() Op {
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *ClaimGroupMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *ClaimGroupMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *ClaimGroupMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *ClaimGroupMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *ClaimGroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddGroupLinkIDs adds the "group_links" edge to the GroupLink entity by ids.
+func (m *ClaimGroupMutation) AddGroupLinkIDs(ids ...int) {
+	if m.group_links == nil {
+		m.group_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.group_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroupLinks clears the "group_links" edge to the GroupLink entity.
+func (m *ClaimGroupMutation) ClearGroupLinks() {
+	m.clearedgroup_links = true
+}
+
+// GroupLinksCleared reports if the "group_links" edge to the GroupLink entity was cleared.
+func (m *ClaimGroupMutation) GroupLinksCleared() bool {
+	return m.clearedgroup_links
+}
+
+// RemoveGroupLinkIDs removes the "group_links" edge to the GroupLink entity by IDs.
+func (m *ClaimGroupMutation) RemoveGroupLinkIDs(ids ...int) {
+	if m.removedgroup_links == nil {
+		m.removedgroup_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.group_links, ids[i])
+		m.removedgroup_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroupLinks returns the removed IDs of the "group_links" edge to the GroupLink entity.
+func (m *ClaimGroupMutation) RemovedGroupLinksIDs() (ids []int) {
+	for id := range m.removedgroup_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupLinksIDs returns the "group_links" edge IDs in the mutation.
+func (m *ClaimGroupMutation) GroupLinksIDs() (ids []int) {
+	for id := range m.group_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroupLinks resets all changes to the "group_links" edge.
+func (m *ClaimGroupMutation) ResetGroupLinks() {
+	m.group_links = nil
+	m.clearedgroup_links = false
+	m.removedgroup_links = nil
+}
+
+// AddClaimIDs adds the "claims" edge to the Claim entity by ids.
+func (m *ClaimGroupMutation) AddClaimIDs(ids ...int) {
+	if m.claims == nil {
+		m.claims = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.claims[ids[i]] = struct{}{}
+	}
+}
+
+// ClearClaims clears the "claims" edge to the Claim entity.
+func (m *ClaimGroupMutation) ClearClaims() {
+	m.clearedclaims = true
+}
+
+// ClaimsCleared reports if the "claims" edge to the Claim entity was cleared.
+func (m *ClaimGroupMutation) ClaimsCleared() bool {
+	return m.clearedclaims
+}
+
+// RemoveClaimIDs removes the "claims" edge to the Claim entity by IDs.
+func (m *ClaimGroupMutation) RemoveClaimIDs(ids ...int) {
+	if m.removedclaims == nil {
+		m.removedclaims = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.claims, ids[i])
+		m.removedclaims[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedClaims returns the removed IDs of the "claims" edge to the Claim entity.
+func (m *ClaimGroupMutation) RemovedClaimsIDs() (ids []int) {
+	for id := range m.removedclaims {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ClaimsIDs returns the "claims" edge IDs in the mutation.
+func (m *ClaimGroupMutation) ClaimsIDs() (ids []int) {
+	for id := range m.claims {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetClaims resets all changes to the "claims" edge.
+func (m *ClaimGroupMutation) ResetClaims() {
+	m.claims = nil
+	m.clearedclaims = false
+	m.removedclaims = nil
+}
+
+// Where appends a list predicates to the ClaimGroupMutation builder.
+func (m *ClaimGroupMutation) Where(ps ...predicate.ClaimGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClaimGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClaimGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ClaimGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClaimGroupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DBInitFileMutation) SetOp(op Op) {
+func (m *ClaimGroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DBInitFile).
-func (m *DBInitFileMutation) Type() string {
+// Type returns the node type of this mutation (ClaimGroup).
+func (m *ClaimGroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DBInitFileMutation) Fields() []string {
+func (m *ClaimGroupMutation) Fields() []string {
 	fields := make([]string, 0, 2)
-	if m.filename != nil {
-		fields = append(fields, dbinitfile.FieldFilename)
+	if m.name != nil {
+		fields = append(fields, claimgroup.FieldName)
 	}
-	if m.md5 != nil {
-		fields = append(fields, dbinitfile.FieldMd5)
+	if m.description != nil {
+		fields = append(fields, claimgroup.FieldDescription)
 	}
 	return fields
 }
@@ -1488,12 +1578,12 @@ func (m *DBInitFileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DBInitFileMutation) Field(name string) (ent.Value, bool) {
+func (m *ClaimGroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dbinitfile.FieldFilename:
-		return m.Filename()
-	case dbinitfile.FieldMd5:
-		return m.Md5()
+	case claimgroup.FieldName:
+		return m.Name()
+	case claimgroup.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -1501,169 +1591,255 @@ func (m *DBInitFileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DBInitFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ClaimGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dbinitfile.FieldFilename:
-		return m.OldFilename(ctx)
-	case dbinitfile.FieldMd5:
-		return m.OldMd5(ctx)
+	case claimgroup.FieldName:
+		return m.OldName(ctx)
+	case claimgroup.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown DBInitFile field %s", name)
+	return nil, fmt.Errorf("unknown ClaimGroup field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DBInitFileMutation) SetField(name string, value ent.Value) error {
+func (m *ClaimGroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dbinitfile.FieldFilename:
+	case claimgroup.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilename(v)
+		m.SetName(v)
 		return nil
-	case dbinitfile.FieldMd5:
+	case claimgroup.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMd5(v)
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DBInitFile field %s", name)
+	return fmt.Errorf("unknown ClaimGroup field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DBInitFileMutation) AddedFields() []string {
+func (m *ClaimGroupMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DBInitFileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ClaimGroupMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DBInitFileMutation) AddField(name string, value ent.Value) error {
+func (m *ClaimGroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DBInitFile numeric field %s", name)
+	return fmt.Errorf("unknown ClaimGroup numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DBInitFileMutation) ClearedFields() []string {
+func (m *ClaimGroupMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DBInitFileMutation) FieldCleared(name string) bool {
+func (m *ClaimGroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DBInitFileMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DBInitFile nullable field %s", name)
+func (m *ClaimGroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ClaimGroup nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DBInitFileMutation) ResetField(name string) error {
+func (m *ClaimGroupMutation) ResetField(name string) error {
 	switch name {
-	case dbinitfile.FieldFilename:
-		m.ResetFilename()
+	case claimgroup.FieldName:
+		m.ResetName()
 		return nil
-	case dbinitfile.FieldMd5:
-		m.ResetMd5()
+	case claimgroup.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown DBInitFile field %s", name)
+	return fmt.Errorf("unknown ClaimGroup field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DBInitFileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ClaimGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.users != nil {
+		edges = append(edges, claimgroup.EdgeUsers)
+	}
+	if m.group_links != nil {
+		edges = append(edges, claimgroup.EdgeGroupLinks)
+	}
+	if m.claims != nil {
+		edges = append(edges, claimgroup.EdgeClaims)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DBInitFileMutation) AddedIDs(name string) []ent.Value {
+func (m *ClaimGroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case claimgroup.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case claimgroup.EdgeGroupLinks:
+		ids := make([]ent.Value, 0, len(m.group_links))
+		for id := range m.group_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case claimgroup.EdgeClaims:
+		ids := make([]ent.Value, 0, len(m.claims))
+		for id := range m.claims {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DBInitFileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ClaimGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedusers != nil {
+		edges = append(edges, claimgroup.EdgeUsers)
+	}
+	if m.removedgroup_links != nil {
+		edges = append(edges, claimgroup.EdgeGroupLinks)
+	}
+	if m.removedclaims != nil {
+		edges = append(edges, claimgroup.EdgeClaims)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DBInitFileMutation) RemovedIDs(name string) []ent.Value {
+func (m *ClaimGroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case claimgroup.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case claimgroup.EdgeGroupLinks:
+		ids := make([]ent.Value, 0, len(m.removedgroup_links))
+		for id := range m.removedgroup_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case claimgroup.EdgeClaims:
+		ids := make([]ent.Value, 0, len(m.removedclaims))
+		for id := range m.removedclaims {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DBInitFileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ClaimGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedusers {
+		edges = append(edges, claimgroup.EdgeUsers)
+	}
+	if m.clearedgroup_links {
+		edges = append(edges, claimgroup.EdgeGroupLinks)
+	}
+	if m.clearedclaims {
+		edges = append(edges, claimgroup.EdgeClaims)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DBInitFileMutation) EdgeCleared(name string) bool {
+func (m *ClaimGroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case claimgroup.EdgeUsers:
+		return m.clearedusers
+	case claimgroup.EdgeGroupLinks:
+		return m.clearedgroup_links
+	case claimgroup.EdgeClaims:
+		return m.clearedclaims
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DBInitFileMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DBInitFile unique edge %s", name)
+func (m *ClaimGroupMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ClaimGroup unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DBInitFileMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DBInitFile edge %s", name)
+func (m *ClaimGroupMutation) ResetEdge(name string) error {
+	switch name {
+	case claimgroup.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case claimgroup.EdgeGroupLinks:
+		m.ResetGroupLinks()
+		return nil
+	case claimgroup.EdgeClaims:
+		m.ResetClaims()
+		return nil
+	}
+	return fmt.Errorf("unknown ClaimGroup edge %s", name)
 }
 
-// GroupLinkMutation represents an operation that mutates the GroupLink nodes in the graph.
-type GroupLinkMutation struct {
+// DBInitFileMutation represents an operation that mutates the DBInitFile nodes in the graph.
+type DBInitFileMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	_type              *string
-	resource_spec      *string
-	clearedFields      map[string]struct{}
-	claim_group        *int
-	clearedclaim_group bool
-	done               bool
-	oldValue           func(context.Context) (*GroupLink, error)
-	predicates         []predicate.GroupLink
+	op            Op
+	typ           string
+	id            *int
+	filename      *string
+	md5           *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DBInitFile, error)
+	predicates    []predicate.DBInitFile
 }
 
-var _ ent.Mutation = (*GroupLinkMutation)(nil)
+var _ ent.Mutation = (*DBInitFileMutation)(nil)
 
-// grouplinkOption allows management of the mutation configuration using functional options.
-type grouplinkOption func(*GroupLinkMutation)
+// dbinitfileOption allows management of the mutation configuration using functional options.
+type dbinitfileOption func(*DBInitFileMutation)
 
-// newGroupLinkMutation creates new mutation for the GroupLink entity.
-func newGroupLinkMutation(c config, op Op, opts ...grouplinkOption) *GroupLinkMutation {
-	m := &GroupLinkMutation{
+// newDBInitFileMutation creates new mutation for the DBInitFile entity.
+func newDBInitFileMutation(c config, op Op, opts ...dbinitfileOption) *DBInitFileMutation {
+	m := &DBInitFileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroupLink,
+		typ:           TypeDBInitFile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1672,20 +1848,20 @@ func newGroupLinkMutation(c config, op Op, opts ...grouplinkOption) *GroupLinkMu
 	return m
 }
 
-// withGroupLinkID sets the ID field of the mutation.
-func withGroupLinkID(id int) grouplinkOption {
-	return func(m *GroupLinkMutation) {
+// withDBInitFileID sets the ID field of the mutation.
+func withDBInitFileID(id int) dbinitfileOption {
+	return func(m *DBInitFileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GroupLink
+			value *DBInitFile
 		)
-		m.oldValue = func(ctx context.Context) (*GroupLink, error) {
+		m.oldValue = func(ctx context.Context) (*DBInitFile, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GroupLink.Get(ctx, id)
+					value, err = m.Client().DBInitFile.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1694,10 +1870,10 @@ func withGroupLinkID(id int) grouplinkOption {
 	}
 }
 
-// withGroupLink sets the old GroupLink of the mutation.
-func withGroupLink(node *GroupLink) grouplinkOption {
-	return func(m *GroupLinkMutation) {
-		m.oldValue = func(context.Context) (*GroupLink, error) {
+// withDBInitFile sets the old DBInitFile of the mutation.
+func withDBInitFile(node *DBInitFile) dbinitfileOption {
+	return func(m *DBInitFileMutation) {
+		m.oldValue = func(context.Context) (*DBInitFile, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1706,7 +1882,7 @@ func withGroupLink(node *GroupLink) grouplinkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupLinkMutation) Client() *Client {
+func (m DBInitFileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1714,7 +1890,7 @@ func (m GroupLinkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupLinkMutation) Tx() (*Tx, error) {
+func (m DBInitFileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1725,7 +1901,7 @@ func (m GroupLinkMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupLinkMutation) ID() (id int, exists bool) {
+func (m *DBInitFileMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1736,7 +1912,7 @@ func (m *GroupLinkMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupLinkMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DBInitFileMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1745,163 +1921,124 @@ func (m *GroupLinkMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GroupLink.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DBInitFile.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *GroupLinkMutation) SetType(s string) {
-	m._type = &s
+// SetFilename sets the "filename" field.
+func (m *DBInitFileMutation) SetFilename(s string) {
+	m.filename = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *GroupLinkMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Filename returns the value of the "filename" field in the mutation.
+func (m *DBInitFileMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the GroupLink entity.
-// If the GroupLink object wasn't provided to the builder, the object is fetched from the database.
+// OldFilename returns the old "filename" field's value of the DBInitFile entity.
+// If the DBInitFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupLinkMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *DBInitFileMutation) OldFilename(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldFilename requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Filename, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *GroupLinkMutation) ResetType() {
-	m._type = nil
+// ResetFilename resets all changes to the "filename" field.
+func (m *DBInitFileMutation) ResetFilename() {
+	m.filename = nil
 }
 
-// SetResourceSpec sets the "resource_spec" field.
-func (m *GroupLinkMutation) SetResourceSpec(s string) {
-	m.resource_spec = &s
+// SetMd5 sets the "md5" field.
+func (m *DBInitFileMutation) SetMd5(s string) {
+	m.md5 = &s
 }
 
-// ResourceSpec returns the value of the "resource_spec" field in the mutation.
-func (m *GroupLinkMutation) ResourceSpec() (r string, exists bool) {
-	v := m.resource_spec
+// Md5 returns the value of the "md5" field in the mutation.
+func (m *DBInitFileMutation) Md5() (r string, exists bool) {
+	v := m.md5
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceSpec returns the old "resource_spec" field's value of the GroupLink entity.
-// If the GroupLink object wasn't provided to the builder, the object is fetched from the database.
+// OldMd5 returns the old "md5" field's value of the DBInitFile entity.
+// If the DBInitFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupLinkMutation) OldResourceSpec(ctx context.Context) (v string, err error) {
+func (m *DBInitFileMutation) OldMd5(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceSpec is only allowed on UpdateOne operations")
+		return v, errors.New("OldMd5 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceSpec requires an ID field in the mutation")
+		return v, errors.New("OldMd5 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceSpec: %w", err)
+		return v, fmt.Errorf("querying old value for OldMd5: %w", err)
 	}
-	return oldValue.ResourceSpec, nil
+	return oldValue.Md5, nil
 }
 
-// ResetResourceSpec resets all changes to the "resource_spec" field.
-func (m *GroupLinkMutation) ResetResourceSpec() {
-	m.resource_spec = nil
+// ResetMd5 resets all changes to the "md5" field.
+func (m *DBInitFileMutation) ResetMd5() {
+	m.md5 = nil
 }
 
-// SetClaimGroupID sets the "claim_group" edge to the ClaimGroup entity by id.
-func (m *GroupLinkMutation) SetClaimGroupID(id int) {
-	m.claim_group = &id
+// Where appends a list predicates to the DBInitFileMutation builder.
+func (m *DBInitFileMutation) Where(ps ...predicate.DBInitFile) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearClaimGroup clears the "claim_group" edge to the ClaimGroup entity.
-func (m *GroupLinkMutation) ClearClaimGroup() {
-	m.clearedclaim_group = true
+// WhereP appends storage-level predicates to the DBInitFileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DBInitFileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DBInitFile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ClaimGroupCleared reports if the "claim_group" edge to the ClaimGroup entity was cleared.
-func (m *GroupLinkMutation) ClaimGroupCleared() bool {
-	return m.clearedclaim_group
-}
-
-// ClaimGroupID returns the "claim_group" edge ID in the mutation.
-func (m *GroupLinkMutation) ClaimGroupID() (id int, exists bool) {
-	if m.claim_group != nil {
-		return *m.claim_group, true
-	}
-	return
-}
-
-// ClaimGroupIDs returns the "claim_group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ClaimGroupID instead. It exists only for internal usage by the builders.
-func (m *GroupLinkMutation) ClaimGroupIDs() (ids []int) {
-	if id := m.claim_group; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetClaimGroup resets all changes to the "claim_group" edge.
-func (m *GroupLinkMutation) ResetClaimGroup() {
-	m.claim_group = nil
-	m.clearedclaim_group = false
-}
-
-// Where appends a list predicates to the GroupLinkMutation builder.
-func (m *GroupLinkMutation) Where(ps ...predicate.GroupLink) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the GroupLinkMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupLinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GroupLink, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *GroupLinkMutation) Op() Op {
-	return m.op
+// Op returns the operation name.
+func (m *DBInitFileMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupLinkMutation) SetOp(op Op) {
+func (m *DBInitFileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GroupLink).
-func (m *GroupLinkMutation) Type() string {
+// Type returns the node type of this mutation (DBInitFile).
+func (m *DBInitFileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupLinkMutation) Fields() []string {
+func (m *DBInitFileMutation) Fields() []string {
 	fields := make([]string, 0, 2)
-	if m._type != nil {
-		fields = append(fields, grouplink.FieldType)
+	if m.filename != nil {
+		fields = append(fields, dbinitfile.FieldFilename)
 	}
-	if m.resource_spec != nil {
-		fields = append(fields, grouplink.FieldResourceSpec)
+	if m.md5 != nil {
+		fields = append(fields, dbinitfile.FieldMd5)
 	}
 	return fields
 }
@@ -1909,12 +2046,12 @@ func (m *GroupLinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupLinkMutation) Field(name string) (ent.Value, bool) {
+func (m *DBInitFileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case grouplink.FieldType:
-		return m.GetType()
-	case grouplink.FieldResourceSpec:
-		return m.ResourceSpec()
+	case dbinitfile.FieldFilename:
+		return m.Filename()
+	case dbinitfile.FieldMd5:
+		return m.Md5()
 	}
 	return nil, false
 }
@@ -1922,193 +2059,169 @@ func (m *GroupLinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DBInitFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case grouplink.FieldType:
-		return m.OldType(ctx)
-	case grouplink.FieldResourceSpec:
-		return m.OldResourceSpec(ctx)
+	case dbinitfile.FieldFilename:
+		return m.OldFilename(ctx)
+	case dbinitfile.FieldMd5:
+		return m.OldMd5(ctx)
 	}
-	return nil, fmt.Errorf("unknown GroupLink field %s", name)
+	return nil, fmt.Errorf("unknown DBInitFile field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupLinkMutation) SetField(name string, value ent.Value) error {
+func (m *DBInitFileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case grouplink.FieldType:
+	case dbinitfile.FieldFilename:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetFilename(v)
 		return nil
-	case grouplink.FieldResourceSpec:
+	case dbinitfile.FieldMd5:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceSpec(v)
+		m.SetMd5(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GroupLink field %s", name)
+	return fmt.Errorf("unknown DBInitFile field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupLinkMutation) AddedFields() []string {
+func (m *DBInitFileMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupLinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DBInitFileMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupLinkMutation) AddField(name string, value ent.Value) error {
+func (m *DBInitFileMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown GroupLink numeric field %s", name)
+	return fmt.Errorf("unknown DBInitFile numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupLinkMutation) ClearedFields() []string {
+func (m *DBInitFileMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupLinkMutation) FieldCleared(name string) bool {
+func (m *DBInitFileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupLinkMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown GroupLink nullable field %s", name)
+func (m *DBInitFileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DBInitFile nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupLinkMutation) ResetField(name string) error {
+func (m *DBInitFileMutation) ResetField(name string) error {
 	switch name {
-	case grouplink.FieldType:
-		m.ResetType()
+	case dbinitfile.FieldFilename:
+		m.ResetFilename()
 		return nil
-	case grouplink.FieldResourceSpec:
-		m.ResetResourceSpec()
+	case dbinitfile.FieldMd5:
+		m.ResetMd5()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupLink field %s", name)
+	return fmt.Errorf("unknown DBInitFile field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupLinkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.claim_group != nil {
-		edges = append(edges, grouplink.EdgeClaimGroup)
-	}
+func (m *DBInitFileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupLinkMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case grouplink.EdgeClaimGroup:
-		if id := m.claim_group; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *DBInitFileMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupLinkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DBInitFileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupLinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *DBInitFileMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupLinkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedclaim_group {
-		edges = append(edges, grouplink.EdgeClaimGroup)
-	}
+func (m *DBInitFileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupLinkMutation) EdgeCleared(name string) bool {
-	switch name {
-	case grouplink.EdgeClaimGroup:
-		return m.clearedclaim_group
-	}
+func (m *DBInitFileMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupLinkMutation) ClearEdge(name string) error {
-	switch name {
-	case grouplink.EdgeClaimGroup:
-		m.ClearClaimGroup()
-		return nil
-	}
-	return fmt.Errorf("unknown GroupLink unique edge %s", name)
+func (m *DBInitFileMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DBInitFile unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupLinkMutation) ResetEdge(name string) error {
-	switch name {
-	case grouplink.EdgeClaimGroup:
-		m.ResetClaimGroup()
-		return nil
-	}
-	return fmt.Errorf("unknown GroupLink edge %s", name)
+func (m *DBInitFileMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DBInitFile edge %s", name)
 }
 
-// PrivateKeyMutation represents an operation that mutates the PrivateKey nodes in the graph.
-type PrivateKeyMutation struct {
+// GroupLinkMutation represents an operation that mutates the GroupLink nodes in the graph.
+type GroupLinkMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	text          *string
-	expires       *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*PrivateKey, error)
-	predicates    []predicate.PrivateKey
+	op                 Op
+	typ                string
+	id                 *int
+	_type              *string
+	resource_spec      *string
+	clearedFields      map[string]struct{}
+	claim_group        *int
+	clearedclaim_group bool
+	done               bool
+	oldValue           func(context.Context) (*GroupLink, error)
+	predicates         []predicate.GroupLink
 }
 
-var _ ent.Mutation = (*PrivateKeyMutation)(nil)
+var _ ent.Mutation = (*GroupLinkMutation)(nil)
 
-// privatekeyOption allows management of the mutation configuration using functional options.
-type privatekeyOption func(*PrivateKeyMutation)
+// grouplinkOption allows management of the mutation configuration using functional options.
+type grouplinkOption func(*GroupLinkMutation)
 
-// newPrivateKeyMutation creates new mutation for the PrivateKey entity.
-func newPrivateKeyMutation(c config, op Op, opts ...privatekeyOption) *PrivateKeyMutation {
-	m := &PrivateKeyMutation{
+// newGroupLinkMutation creates new mutation for the GroupLink entity.
+func newGroupLinkMutation(c config, op Op, opts ...grouplinkOption) *GroupLinkMutation {
+	m := &GroupLinkMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePrivateKey,
+		typ:           TypeGroupLink,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2117,20 +2230,20 @@ func newPrivateKeyMutation(c config, op Op, opts ...privatekeyOption) *PrivateKe
 	return m
 }
 
-// withPrivateKeyID sets the ID field of the mutation.
-func withPrivateKeyID(id int) privatekeyOption {
-	return func(m *PrivateKeyMutation) {
+// withGroupLinkID sets the ID field of the mutation.
+func withGroupLinkID(id int) grouplinkOption {
+	return func(m *GroupLinkMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PrivateKey
+			value *GroupLink
 		)
-		m.oldValue = func(ctx context.Context) (*PrivateKey, error) {
+		m.oldValue = func(ctx context.Context) (*GroupLink, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PrivateKey.Get(ctx, id)
+					value, err = m.Client().GroupLink.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2139,10 +2252,10 @@ func withPrivateKeyID(id int) privatekeyOption {
 	}
 }
 
-// withPrivateKey sets the old PrivateKey of the mutation.
-func withPrivateKey(node *PrivateKey) privatekeyOption {
-	return func(m *PrivateKeyMutation) {
-		m.oldValue = func(context.Context) (*PrivateKey, error) {
+// withGroupLink sets the old GroupLink of the mutation.
+func withGroupLink(node *GroupLink) grouplinkOption {
+	return func(m *GroupLinkMutation) {
+		m.oldValue = func(context.Context) (*GroupLink, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2151,7 +2264,7 @@ func withPrivateKey(node *PrivateKey) privatekeyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PrivateKeyMutation) Client() *Client {
+func (m GroupLinkMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2159,7 +2272,7 @@ func (m PrivateKeyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PrivateKeyMutation) Tx() (*Tx, error) {
+func (m GroupLinkMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2170,7 +2283,7 @@ func (m PrivateKeyMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PrivateKeyMutation) ID() (id int, exists bool) {
+func (m *GroupLinkMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2181,7 +2294,7 @@ func (m *PrivateKeyMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PrivateKeyMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GroupLinkMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2190,93 +2303,132 @@ func (m *PrivateKeyMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PrivateKey.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GroupLink.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetText sets the "text" field.
-func (m *PrivateKeyMutation) SetText(s string) {
-	m.text = &s
+// SetType sets the "type" field.
+func (m *GroupLinkMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *PrivateKeyMutation) Text() (r string, exists bool) {
-	v := m.text
+// GetType returns the value of the "type" field in the mutation.
+func (m *GroupLinkMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the PrivateKey entity.
-// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the GroupLink entity.
+// If the GroupLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateKeyMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *GroupLinkMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.Type, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *PrivateKeyMutation) ResetText() {
-	m.text = nil
+// ResetType resets all changes to the "type" field.
+func (m *GroupLinkMutation) ResetType() {
+	m._type = nil
 }
 
-// SetExpires sets the "expires" field.
-func (m *PrivateKeyMutation) SetExpires(t time.Time) {
-	m.expires = &t
+// SetResourceSpec sets the "resource_spec" field.
+func (m *GroupLinkMutation) SetResourceSpec(s string) {
+	m.resource_spec = &s
 }
 
-// Expires returns the value of the "expires" field in the mutation.
-func (m *PrivateKeyMutation) Expires() (r time.Time, exists bool) {
-	v := m.expires
+// ResourceSpec returns the value of the "resource_spec" field in the mutation.
+func (m *GroupLinkMutation) ResourceSpec() (r string, exists bool) {
+	v := m.resource_spec
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpires returns the old "expires" field's value of the PrivateKey entity.
-// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceSpec returns the old "resource_spec" field's value of the GroupLink entity.
+// If the GroupLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateKeyMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+func (m *GroupLinkMutation) OldResourceSpec(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceSpec is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpires requires an ID field in the mutation")
+		return v, errors.New("OldResourceSpec requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceSpec: %w", err)
 	}
-	return oldValue.Expires, nil
+	return oldValue.ResourceSpec, nil
 }
 
-// ResetExpires resets all changes to the "expires" field.
-func (m *PrivateKeyMutation) ResetExpires() {
-	m.expires = nil
+// ResetResourceSpec resets all changes to the "resource_spec" field.
+func (m *GroupLinkMutation) ResetResourceSpec() {
+	m.resource_spec = nil
 }
 
-// Where appends a list predicates to the PrivateKeyMutation builder.
-func (m *PrivateKeyMutation) Where(ps ...predicate.PrivateKey) {
+// SetClaimGroupID sets the "claim_group" edge to the ClaimGroup entity by id.
+func (m *GroupLinkMutation) SetClaimGroupID(id int) {
+	m.claim_group = &id
+}
+
+// ClearClaimGroup clears the "claim_group" edge to the ClaimGroup entity.
+func (m *GroupLinkMutation) ClearClaimGroup() {
+	m.clearedclaim_group = true
+}
+
+// ClaimGroupCleared reports if the "claim_group" edge to the ClaimGroup entity was cleared.
+func (m *GroupLinkMutation) ClaimGroupCleared() bool {
+	return m.clearedclaim_group
+}
+
+// ClaimGroupID returns the "claim_group" edge ID in the mutation.
+func (m *GroupLinkMutation) ClaimGroupID() (id int, exists bool) {
+	if m.claim_group != nil {
+		return *m.claim_group, true
+	}
+	return
+}
+
+// ClaimGroupIDs returns the "claim_group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ClaimGroupID instead. It exists only for internal usage by the builders.
+func (m *GroupLinkMutation) ClaimGroupIDs() (ids []int) {
+	if id := m.claim_group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetClaimGroup resets all changes to the "claim_group" edge.
+func (m *GroupLinkMutation) ResetClaimGroup() {
+	m.claim_group = nil
+	m.clearedclaim_group = false
+}
+
+// Where appends a list predicates to the GroupLinkMutation builder.
+func (m *GroupLinkMutation) Where(ps ...predicate.GroupLink) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PrivateKeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the GroupLinkMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PrivateKeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PrivateKey, len(ps))
+func (m *GroupLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupLink, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2284,30 +2436,30 @@ func (m *PrivateKeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PrivateKeyMutation) Op() Op {
+func (m *GroupLinkMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PrivateKeyMutation) SetOp(op Op) {
+func (m *GroupLinkMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PrivateKey).
-func (m *PrivateKeyMutation) Type() string {
+// Type returns the node type of this mutation (GroupLink).
+func (m *GroupLinkMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PrivateKeyMutation) Fields() []string {
+func (m *GroupLinkMutation) Fields() []string {
 	fields := make([]string, 0, 2)
-	if m.text != nil {
-		fields = append(fields, privatekey.FieldText)
+	if m._type != nil {
+		fields = append(fields, grouplink.FieldType)
 	}
-	if m.expires != nil {
-		fields = append(fields, privatekey.FieldExpires)
+	if m.resource_spec != nil {
+		fields = append(fields, grouplink.FieldResourceSpec)
 	}
 	return fields
 }
@@ -2315,12 +2467,12 @@ func (m *PrivateKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PrivateKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *GroupLinkMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case privatekey.FieldText:
-		return m.Text()
-	case privatekey.FieldExpires:
-		return m.Expires()
+	case grouplink.FieldType:
+		return m.GetType()
+	case grouplink.FieldResourceSpec:
+		return m.ResourceSpec()
 	}
 	return nil, false
 }
@@ -2328,176 +2480,197 @@ func (m *PrivateKeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PrivateKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case privatekey.FieldText:
-		return m.OldText(ctx)
-	case privatekey.FieldExpires:
-		return m.OldExpires(ctx)
+	case grouplink.FieldType:
+		return m.OldType(ctx)
+	case grouplink.FieldResourceSpec:
+		return m.OldResourceSpec(ctx)
 	}
-	return nil, fmt.Errorf("unknown PrivateKey field %s", name)
+	return nil, fmt.Errorf("unknown GroupLink field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrivateKeyMutation) SetField(name string, value ent.Value) error {
+func (m *GroupLinkMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case privatekey.FieldText:
+	case grouplink.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetType(v)
 		return nil
-	case privatekey.FieldExpires:
-		v, ok := value.(time.Time)
+	case grouplink.FieldResourceSpec:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpires(v)
+		m.SetResourceSpec(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateKey field %s", name)
+	return fmt.Errorf("unknown GroupLink field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PrivateKeyMutation) AddedFields() []string {
+func (m *GroupLinkMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PrivateKeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GroupLinkMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrivateKeyMutation) AddField(name string, value ent.Value) error {
+func (m *GroupLinkMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PrivateKey numeric field %s", name)
+	return fmt.Errorf("unknown GroupLink numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PrivateKeyMutation) ClearedFields() []string {
+func (m *GroupLinkMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PrivateKeyMutation) FieldCleared(name string) bool {
+func (m *GroupLinkMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PrivateKeyMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PrivateKey nullable field %s", name)
+func (m *GroupLinkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GroupLink nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PrivateKeyMutation) ResetField(name string) error {
+func (m *GroupLinkMutation) ResetField(name string) error {
 	switch name {
-	case privatekey.FieldText:
-		m.ResetText()
+	case grouplink.FieldType:
+		m.ResetType()
 		return nil
-	case privatekey.FieldExpires:
-		m.ResetExpires()
+	case grouplink.FieldResourceSpec:
+		m.ResetResourceSpec()
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateKey field %s", name)
+	return fmt.Errorf("unknown GroupLink field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PrivateKeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *GroupLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.claim_group != nil {
+		edges = append(edges, grouplink.EdgeClaimGroup)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PrivateKeyMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case grouplink.EdgeClaimGroup:
+		if id := m.claim_group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PrivateKeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *GroupLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PrivateKeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *GroupLinkMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PrivateKeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *GroupLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedclaim_group {
+		edges = append(edges, grouplink.EdgeClaimGroup)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PrivateKeyMutation) EdgeCleared(name string) bool {
+func (m *GroupLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case grouplink.EdgeClaimGroup:
+		return m.clearedclaim_group
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PrivateKeyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PrivateKey unique edge %s", name)
+func (m *GroupLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case grouplink.EdgeClaimGroup:
+		m.ClearClaimGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupLink unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PrivateKeyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PrivateKey edge %s", name)
+func (m *GroupLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case grouplink.EdgeClaimGroup:
+		m.ResetClaimGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupLink edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// IdentityMutation represents an operation that mutates the Identity nodes in the graph.
+type IdentityMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	fname               *string
-	lname               *string
-	source              *string
-	email               *string
-	username            *string
-	password            *string
-	salt                *string
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	claim_groups        map[int]struct{}
-	removedclaim_groups map[int]struct{}
-	clearedclaim_groups bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
+	op            Op
+	typ           string
+	id            *int
+	provider_type *string
+	provider_name *string
+	subject       *string
+	created       *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Identity, error)
+	predicates    []predicate.Identity
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*IdentityMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// identityOption allows management of the mutation configuration using functional options.
+type identityOption func(*IdentityMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newIdentityMutation creates new mutation for the Identity entity.
+func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutation {
+	m := &IdentityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeIdentity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2506,20 +2679,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withIdentityID sets the ID field of the mutation.
+func withIdentityID(id int) identityOption {
+	return func(m *IdentityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Identity
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Identity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Identity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2528,10 +2701,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withIdentity sets the old Identity of the mutation.
+func withIdentity(node *Identity) identityOption {
+	return func(m *IdentityMutation) {
+		m.oldValue = func(context.Context) (*Identity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2540,7 +2713,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m IdentityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2548,7 +2721,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m IdentityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2559,7 +2732,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *IdentityMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2570,7 +2743,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *IdentityMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2579,288 +2752,4929 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Identity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFname sets the "fname" field.
-func (m *UserMutation) SetFname(s string) {
-	m.fname = &s
+// SetProviderType sets the "provider_type" field.
+func (m *IdentityMutation) SetProviderType(s string) {
+	m.provider_type = &s
+}
+
+// ProviderType returns the value of the "provider_type" field in the mutation.
+func (m *IdentityMutation) ProviderType() (r string, exists bool) {
+	v := m.provider_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderType returns the old "provider_type" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldProviderType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+	}
+	return oldValue.ProviderType, nil
+}
+
+// ResetProviderType resets all changes to the "provider_type" field.
+func (m *IdentityMutation) ResetProviderType() {
+	m.provider_type = nil
+}
+
+// SetProviderName sets the "provider_name" field.
+func (m *IdentityMutation) SetProviderName(s string) {
+	m.provider_name = &s
+}
+
+// ProviderName returns the value of the "provider_name" field in the mutation.
+func (m *IdentityMutation) ProviderName() (r string, exists bool) {
+	v := m.provider_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderName returns the old "provider_name" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldProviderName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderName: %w", err)
+	}
+	return oldValue.ProviderName, nil
+}
+
+// ResetProviderName resets all changes to the "provider_name" field.
+func (m *IdentityMutation) ResetProviderName() {
+	m.provider_name = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *IdentityMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *IdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *IdentityMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *IdentityMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *IdentityMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *IdentityMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *IdentityMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *IdentityMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *IdentityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *IdentityMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *IdentityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *IdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the IdentityMutation builder.
+func (m *IdentityMutation) Where(ps ...predicate.Identity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdentityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Identity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdentityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdentityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Identity).
+func (m *IdentityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdentityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.provider_type != nil {
+		fields = append(fields, identity.FieldProviderType)
+	}
+	if m.provider_name != nil {
+		fields = append(fields, identity.FieldProviderName)
+	}
+	if m.subject != nil {
+		fields = append(fields, identity.FieldSubject)
+	}
+	if m.created != nil {
+		fields = append(fields, identity.FieldCreated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case identity.FieldProviderType:
+		return m.ProviderType()
+	case identity.FieldProviderName:
+		return m.ProviderName()
+	case identity.FieldSubject:
+		return m.Subject()
+	case identity.FieldCreated:
+		return m.Created()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case identity.FieldProviderType:
+		return m.OldProviderType(ctx)
+	case identity.FieldProviderName:
+		return m.OldProviderName(ctx)
+	case identity.FieldSubject:
+		return m.OldSubject(ctx)
+	case identity.FieldCreated:
+		return m.OldCreated(ctx)
+	}
+	return nil, fmt.Errorf("unknown Identity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case identity.FieldProviderType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderType(v)
+		return nil
+	case identity.FieldProviderName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderName(v)
+		return nil
+	case identity.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case identity.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdentityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Identity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdentityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdentityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdentityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Identity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdentityMutation) ResetField(name string) error {
+	switch name {
+	case identity.FieldProviderType:
+		m.ResetProviderType()
+		return nil
+	case identity.FieldProviderName:
+		m.ResetProviderName()
+		return nil
+	case identity.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case identity.FieldCreated:
+		m.ResetCreated()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, identity.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdentityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case identity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdentityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, identity.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdentityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case identity.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdentityMutation) ClearEdge(name string) error {
+	switch name {
+	case identity.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdentityMutation) ResetEdge(name string) error {
+	switch name {
+	case identity.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity edge %s", name)
+}
+
+// LeaseMutation represents an operation that mutates the Lease nodes in the graph.
+type LeaseMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	holder        *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Lease, error)
+	predicates    []predicate.Lease
+}
+
+var _ ent.Mutation = (*LeaseMutation)(nil)
+
+// leaseOption allows management of the mutation configuration using functional options.
+type leaseOption func(*LeaseMutation)
+
+// newLeaseMutation creates new mutation for the Lease entity.
+func newLeaseMutation(c config, op Op, opts ...leaseOption) *LeaseMutation {
+	m := &LeaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLease,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeaseID sets the ID field of the mutation.
+func withLeaseID(id int) leaseOption {
+	return func(m *LeaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Lease
+		)
+		m.oldValue = func(ctx context.Context) (*Lease, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Lease.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLease sets the old Lease of the mutation.
+func withLease(node *Lease) leaseOption {
+	return func(m *LeaseMutation) {
+		m.oldValue = func(context.Context) (*Lease, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LeaseMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LeaseMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Lease.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *LeaseMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LeaseMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LeaseMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHolder sets the "holder" field.
+func (m *LeaseMutation) SetHolder(s string) {
+	m.holder = &s
+}
+
+// Holder returns the value of the "holder" field in the mutation.
+func (m *LeaseMutation) Holder() (r string, exists bool) {
+	v := m.holder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolder returns the old "holder" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldHolder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
+	}
+	return oldValue.Holder, nil
+}
+
+// ResetHolder resets all changes to the "holder" field.
+func (m *LeaseMutation) ResetHolder() {
+	m.holder = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *LeaseMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *LeaseMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *LeaseMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// Where appends a list predicates to the LeaseMutation builder.
+func (m *LeaseMutation) Where(ps ...predicate.Lease) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LeaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LeaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Lease, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LeaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LeaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Lease).
+func (m *LeaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeaseMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, lease.FieldName)
+	}
+	if m.holder != nil {
+		fields = append(fields, lease.FieldHolder)
+	}
+	if m.expires != nil {
+		fields = append(fields, lease.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lease.FieldName:
+		return m.Name()
+	case lease.FieldHolder:
+		return m.Holder()
+	case lease.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lease.FieldName:
+		return m.OldName(ctx)
+	case lease.FieldHolder:
+		return m.OldHolder(ctx)
+	case lease.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown Lease field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lease.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case lease.FieldHolder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolder(v)
+		return nil
+	case lease.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeaseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeaseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Lease numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeaseMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeaseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Lease nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeaseMutation) ResetField(name string) error {
+	switch name {
+	case lease.FieldName:
+		m.ResetName()
+		return nil
+	case lease.FieldHolder:
+		m.ResetHolder()
+		return nil
+	case lease.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeaseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeaseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Lease unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Lease edge %s", name)
+}
+
+// LoginCodeMutation represents an operation that mutates the LoginCode nodes in the graph.
+type LoginCodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	code          *string
+	username      *string
+	token         *string
+	refresh       *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LoginCode, error)
+	predicates    []predicate.LoginCode
+}
+
+var _ ent.Mutation = (*LoginCodeMutation)(nil)
+
+// logincodeOption allows management of the mutation configuration using functional options.
+type logincodeOption func(*LoginCodeMutation)
+
+// newLoginCodeMutation creates new mutation for the LoginCode entity.
+func newLoginCodeMutation(c config, op Op, opts ...logincodeOption) *LoginCodeMutation {
+	m := &LoginCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoginCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoginCodeID sets the ID field of the mutation.
+func withLoginCodeID(id int) logincodeOption {
+	return func(m *LoginCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoginCode
+		)
+		m.oldValue = func(ctx context.Context) (*LoginCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoginCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoginCode sets the old LoginCode of the mutation.
+func withLoginCode(node *LoginCode) logincodeOption {
+	return func(m *LoginCodeMutation) {
+		m.oldValue = func(context.Context) (*LoginCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginCodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginCodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *LoginCodeMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *LoginCodeMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the LoginCode entity.
+// If the LoginCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginCodeMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *LoginCodeMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *LoginCodeMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *LoginCodeMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the LoginCode entity.
+// If the LoginCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginCodeMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *LoginCodeMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetToken sets the "token" field.
+func (m *LoginCodeMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *LoginCodeMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the LoginCode entity.
+// If the LoginCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginCodeMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *LoginCodeMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetRefresh sets the "refresh" field.
+func (m *LoginCodeMutation) SetRefresh(s string) {
+	m.refresh = &s
+}
+
+// Refresh returns the value of the "refresh" field in the mutation.
+func (m *LoginCodeMutation) Refresh() (r string, exists bool) {
+	v := m.refresh
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefresh returns the old "refresh" field's value of the LoginCode entity.
+// If the LoginCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginCodeMutation) OldRefresh(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefresh is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefresh requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefresh: %w", err)
+	}
+	return oldValue.Refresh, nil
+}
+
+// ResetRefresh resets all changes to the "refresh" field.
+func (m *LoginCodeMutation) ResetRefresh() {
+	m.refresh = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *LoginCodeMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *LoginCodeMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the LoginCode entity.
+// If the LoginCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginCodeMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *LoginCodeMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// Where appends a list predicates to the LoginCodeMutation builder.
+func (m *LoginCodeMutation) Where(ps ...predicate.LoginCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoginCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoginCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoginCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoginCode).
+func (m *LoginCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginCodeMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.code != nil {
+		fields = append(fields, logincode.FieldCode)
+	}
+	if m.username != nil {
+		fields = append(fields, logincode.FieldUsername)
+	}
+	if m.token != nil {
+		fields = append(fields, logincode.FieldToken)
+	}
+	if m.refresh != nil {
+		fields = append(fields, logincode.FieldRefresh)
+	}
+	if m.expires != nil {
+		fields = append(fields, logincode.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case logincode.FieldCode:
+		return m.Code()
+	case logincode.FieldUsername:
+		return m.Username()
+	case logincode.FieldToken:
+		return m.Token()
+	case logincode.FieldRefresh:
+		return m.Refresh()
+	case logincode.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case logincode.FieldCode:
+		return m.OldCode(ctx)
+	case logincode.FieldUsername:
+		return m.OldUsername(ctx)
+	case logincode.FieldToken:
+		return m.OldToken(ctx)
+	case logincode.FieldRefresh:
+		return m.OldRefresh(ctx)
+	case logincode.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case logincode.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case logincode.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case logincode.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case logincode.FieldRefresh:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefresh(v)
+		return nil
+	case logincode.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginCodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginCodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LoginCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginCodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginCodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LoginCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginCodeMutation) ResetField(name string) error {
+	switch name {
+	case logincode.FieldCode:
+		m.ResetCode()
+		return nil
+	case logincode.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case logincode.FieldToken:
+		m.ResetToken()
+		return nil
+	case logincode.FieldRefresh:
+		m.ResetRefresh()
+		return nil
+	case logincode.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginCodeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginCodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginCodeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginCodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LoginCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginCodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LoginCode edge %s", name)
+}
+
+// LogoutTokenMutation represents an operation that mutates the LogoutToken nodes in the graph.
+type LogoutTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	provider      *string
+	jti           *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LogoutToken, error)
+	predicates    []predicate.LogoutToken
+}
+
+var _ ent.Mutation = (*LogoutTokenMutation)(nil)
+
+// logouttokenOption allows management of the mutation configuration using functional options.
+type logouttokenOption func(*LogoutTokenMutation)
+
+// newLogoutTokenMutation creates new mutation for the LogoutToken entity.
+func newLogoutTokenMutation(c config, op Op, opts ...logouttokenOption) *LogoutTokenMutation {
+	m := &LogoutTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLogoutToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLogoutTokenID sets the ID field of the mutation.
+func withLogoutTokenID(id int) logouttokenOption {
+	return func(m *LogoutTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LogoutToken
+		)
+		m.oldValue = func(ctx context.Context) (*LogoutToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LogoutToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLogoutToken sets the old LogoutToken of the mutation.
+func withLogoutToken(node *LogoutToken) logouttokenOption {
+	return func(m *LogoutTokenMutation) {
+		m.oldValue = func(context.Context) (*LogoutToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LogoutTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LogoutTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LogoutTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LogoutTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LogoutToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvider sets the "provider" field.
+func (m *LogoutTokenMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *LogoutTokenMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the LogoutToken entity.
+// If the LogoutToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutTokenMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *LogoutTokenMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetJti sets the "jti" field.
+func (m *LogoutTokenMutation) SetJti(s string) {
+	m.jti = &s
+}
+
+// Jti returns the value of the "jti" field in the mutation.
+func (m *LogoutTokenMutation) Jti() (r string, exists bool) {
+	v := m.jti
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJti returns the old "jti" field's value of the LogoutToken entity.
+// If the LogoutToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutTokenMutation) OldJti(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJti is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJti requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJti: %w", err)
+	}
+	return oldValue.Jti, nil
+}
+
+// ResetJti resets all changes to the "jti" field.
+func (m *LogoutTokenMutation) ResetJti() {
+	m.jti = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *LogoutTokenMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *LogoutTokenMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the LogoutToken entity.
+// If the LogoutToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutTokenMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *LogoutTokenMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// Where appends a list predicates to the LogoutTokenMutation builder.
+func (m *LogoutTokenMutation) Where(ps ...predicate.LogoutToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LogoutTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LogoutTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LogoutToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LogoutTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LogoutTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LogoutToken).
+func (m *LogoutTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LogoutTokenMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.provider != nil {
+		fields = append(fields, logouttoken.FieldProvider)
+	}
+	if m.jti != nil {
+		fields = append(fields, logouttoken.FieldJti)
+	}
+	if m.expires != nil {
+		fields = append(fields, logouttoken.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LogoutTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case logouttoken.FieldProvider:
+		return m.Provider()
+	case logouttoken.FieldJti:
+		return m.Jti()
+	case logouttoken.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LogoutTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case logouttoken.FieldProvider:
+		return m.OldProvider(ctx)
+	case logouttoken.FieldJti:
+		return m.OldJti(ctx)
+	case logouttoken.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown LogoutToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LogoutTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case logouttoken.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case logouttoken.FieldJti:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJti(v)
+		return nil
+	case logouttoken.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LogoutToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LogoutTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LogoutTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LogoutTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LogoutToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LogoutTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LogoutTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LogoutTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LogoutToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LogoutTokenMutation) ResetField(name string) error {
+	switch name {
+	case logouttoken.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case logouttoken.FieldJti:
+		m.ResetJti()
+		return nil
+	case logouttoken.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown LogoutToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LogoutTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LogoutTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LogoutTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LogoutTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LogoutTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LogoutTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LogoutTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LogoutToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LogoutTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LogoutToken edge %s", name)
+}
+
+// MFAChallengeMutation represents an operation that mutates the MFAChallenge nodes in the graph.
+type MFAChallengeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	challenge     *string
+	username      *string
+	claims        *map[string]string
+	attempts      *int
+	addattempts   *int
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MFAChallenge, error)
+	predicates    []predicate.MFAChallenge
+}
+
+var _ ent.Mutation = (*MFAChallengeMutation)(nil)
+
+// mfachallengeOption allows management of the mutation configuration using functional options.
+type mfachallengeOption func(*MFAChallengeMutation)
+
+// newMFAChallengeMutation creates new mutation for the MFAChallenge entity.
+func newMFAChallengeMutation(c config, op Op, opts ...mfachallengeOption) *MFAChallengeMutation {
+	m := &MFAChallengeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMFAChallenge,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMFAChallengeID sets the ID field of the mutation.
+func withMFAChallengeID(id int) mfachallengeOption {
+	return func(m *MFAChallengeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MFAChallenge
+		)
+		m.oldValue = func(ctx context.Context) (*MFAChallenge, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MFAChallenge.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMFAChallenge sets the old MFAChallenge of the mutation.
+func withMFAChallenge(node *MFAChallenge) mfachallengeOption {
+	return func(m *MFAChallengeMutation) {
+		m.oldValue = func(context.Context) (*MFAChallenge, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MFAChallengeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MFAChallengeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MFAChallengeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MFAChallengeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MFAChallenge.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChallenge sets the "challenge" field.
+func (m *MFAChallengeMutation) SetChallenge(s string) {
+	m.challenge = &s
+}
+
+// Challenge returns the value of the "challenge" field in the mutation.
+func (m *MFAChallengeMutation) Challenge() (r string, exists bool) {
+	v := m.challenge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChallenge returns the old "challenge" field's value of the MFAChallenge entity.
+// If the MFAChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MFAChallengeMutation) OldChallenge(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChallenge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChallenge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChallenge: %w", err)
+	}
+	return oldValue.Challenge, nil
+}
+
+// ResetChallenge resets all changes to the "challenge" field.
+func (m *MFAChallengeMutation) ResetChallenge() {
+	m.challenge = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *MFAChallengeMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *MFAChallengeMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the MFAChallenge entity.
+// If the MFAChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MFAChallengeMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *MFAChallengeMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetClaims sets the "claims" field.
+func (m *MFAChallengeMutation) SetClaims(value map[string]string) {
+	m.claims = &value
+}
+
+// Claims returns the value of the "claims" field in the mutation.
+func (m *MFAChallengeMutation) Claims() (r map[string]string, exists bool) {
+	v := m.claims
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaims returns the old "claims" field's value of the MFAChallenge entity.
+// If the MFAChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MFAChallengeMutation) OldClaims(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaims is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaims requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaims: %w", err)
+	}
+	return oldValue.Claims, nil
+}
+
+// ResetClaims resets all changes to the "claims" field.
+func (m *MFAChallengeMutation) ResetClaims() {
+	m.claims = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *MFAChallengeMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *MFAChallengeMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the MFAChallenge entity.
+// If the MFAChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MFAChallengeMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *MFAChallengeMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *MFAChallengeMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *MFAChallengeMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *MFAChallengeMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *MFAChallengeMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the MFAChallenge entity.
+// If the MFAChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MFAChallengeMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *MFAChallengeMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// Where appends a list predicates to the MFAChallengeMutation builder.
+func (m *MFAChallengeMutation) Where(ps ...predicate.MFAChallenge) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MFAChallengeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MFAChallengeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MFAChallenge, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MFAChallengeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MFAChallengeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MFAChallenge).
+func (m *MFAChallengeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MFAChallengeMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.challenge != nil {
+		fields = append(fields, mfachallenge.FieldChallenge)
+	}
+	if m.username != nil {
+		fields = append(fields, mfachallenge.FieldUsername)
+	}
+	if m.claims != nil {
+		fields = append(fields, mfachallenge.FieldClaims)
+	}
+	if m.attempts != nil {
+		fields = append(fields, mfachallenge.FieldAttempts)
+	}
+	if m.expires != nil {
+		fields = append(fields, mfachallenge.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MFAChallengeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mfachallenge.FieldChallenge:
+		return m.Challenge()
+	case mfachallenge.FieldUsername:
+		return m.Username()
+	case mfachallenge.FieldClaims:
+		return m.Claims()
+	case mfachallenge.FieldAttempts:
+		return m.Attempts()
+	case mfachallenge.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MFAChallengeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mfachallenge.FieldChallenge:
+		return m.OldChallenge(ctx)
+	case mfachallenge.FieldUsername:
+		return m.OldUsername(ctx)
+	case mfachallenge.FieldClaims:
+		return m.OldClaims(ctx)
+	case mfachallenge.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case mfachallenge.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown MFAChallenge field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MFAChallengeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mfachallenge.FieldChallenge:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChallenge(v)
+		return nil
+	case mfachallenge.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case mfachallenge.FieldClaims:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaims(v)
+		return nil
+	case mfachallenge.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case mfachallenge.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MFAChallenge field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MFAChallengeMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, mfachallenge.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MFAChallengeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mfachallenge.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MFAChallengeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mfachallenge.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MFAChallenge numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MFAChallengeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MFAChallengeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MFAChallengeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MFAChallenge nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MFAChallengeMutation) ResetField(name string) error {
+	switch name {
+	case mfachallenge.FieldChallenge:
+		m.ResetChallenge()
+		return nil
+	case mfachallenge.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case mfachallenge.FieldClaims:
+		m.ResetClaims()
+		return nil
+	case mfachallenge.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case mfachallenge.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown MFAChallenge field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MFAChallengeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MFAChallengeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MFAChallengeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MFAChallengeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MFAChallengeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MFAChallengeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MFAChallengeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MFAChallenge unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MFAChallengeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MFAChallenge edge %s", name)
+}
+
+// OIDCStateMutation represents an operation that mutates the OIDCState nodes in the graph.
+type OIDCStateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	state         *string
+	provider      *string
+	nonce         *string
+	code_verifier *string
+	next_url      *string
+	xfer          *string
+	access_token  *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*OIDCState, error)
+	predicates    []predicate.OIDCState
+}
+
+var _ ent.Mutation = (*OIDCStateMutation)(nil)
+
+// oidcstateOption allows management of the mutation configuration using functional options.
+type oidcstateOption func(*OIDCStateMutation)
+
+// newOIDCStateMutation creates new mutation for the OIDCState entity.
+func newOIDCStateMutation(c config, op Op, opts ...oidcstateOption) *OIDCStateMutation {
+	m := &OIDCStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOIDCState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOIDCStateID sets the ID field of the mutation.
+func withOIDCStateID(id int) oidcstateOption {
+	return func(m *OIDCStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OIDCState
+		)
+		m.oldValue = func(ctx context.Context) (*OIDCState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OIDCState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOIDCState sets the old OIDCState of the mutation.
+func withOIDCState(node *OIDCState) oidcstateOption {
+	return func(m *OIDCStateMutation) {
+		m.oldValue = func(context.Context) (*OIDCState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OIDCStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OIDCStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OIDCStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OIDCStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OIDCState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetState sets the "state" field.
+func (m *OIDCStateMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *OIDCStateMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *OIDCStateMutation) ResetState() {
+	m.state = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *OIDCStateMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *OIDCStateMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *OIDCStateMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetNonce sets the "nonce" field.
+func (m *OIDCStateMutation) SetNonce(s string) {
+	m.nonce = &s
+}
+
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *OIDCStateMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNonce returns the old "nonce" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldNonce(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+	}
+	return oldValue.Nonce, nil
+}
+
+// ResetNonce resets all changes to the "nonce" field.
+func (m *OIDCStateMutation) ResetNonce() {
+	m.nonce = nil
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (m *OIDCStateMutation) SetCodeVerifier(s string) {
+	m.code_verifier = &s
+}
+
+// CodeVerifier returns the value of the "code_verifier" field in the mutation.
+func (m *OIDCStateMutation) CodeVerifier() (r string, exists bool) {
+	v := m.code_verifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeVerifier returns the old "code_verifier" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldCodeVerifier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeVerifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeVerifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeVerifier: %w", err)
+	}
+	return oldValue.CodeVerifier, nil
+}
+
+// ResetCodeVerifier resets all changes to the "code_verifier" field.
+func (m *OIDCStateMutation) ResetCodeVerifier() {
+	m.code_verifier = nil
+}
+
+// SetNextURL sets the "next_url" field.
+func (m *OIDCStateMutation) SetNextURL(s string) {
+	m.next_url = &s
+}
+
+// NextURL returns the value of the "next_url" field in the mutation.
+func (m *OIDCStateMutation) NextURL() (r string, exists bool) {
+	v := m.next_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextURL returns the old "next_url" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldNextURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextURL: %w", err)
+	}
+	return oldValue.NextURL, nil
+}
+
+// ResetNextURL resets all changes to the "next_url" field.
+func (m *OIDCStateMutation) ResetNextURL() {
+	m.next_url = nil
+}
+
+// SetXfer sets the "xfer" field.
+func (m *OIDCStateMutation) SetXfer(s string) {
+	m.xfer = &s
+}
+
+// Xfer returns the value of the "xfer" field in the mutation.
+func (m *OIDCStateMutation) Xfer() (r string, exists bool) {
+	v := m.xfer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldXfer returns the old "xfer" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldXfer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldXfer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldXfer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldXfer: %w", err)
+	}
+	return oldValue.Xfer, nil
+}
+
+// ResetXfer resets all changes to the "xfer" field.
+func (m *OIDCStateMutation) ResetXfer() {
+	m.xfer = nil
+}
+
+// SetAccessToken sets the "access_token" field.
+func (m *OIDCStateMutation) SetAccessToken(s string) {
+	m.access_token = &s
+}
+
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *OIDCStateMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessToken returns the old "access_token" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+	}
+	return oldValue.AccessToken, nil
+}
+
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *OIDCStateMutation) ResetAccessToken() {
+	m.access_token = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *OIDCStateMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *OIDCStateMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the OIDCState entity.
+// If the OIDCState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OIDCStateMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *OIDCStateMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// Where appends a list predicates to the OIDCStateMutation builder.
+func (m *OIDCStateMutation) Where(ps ...predicate.OIDCState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OIDCStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OIDCStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OIDCState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OIDCStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OIDCStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OIDCState).
+func (m *OIDCStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OIDCStateMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.state != nil {
+		fields = append(fields, oidcstate.FieldState)
+	}
+	if m.provider != nil {
+		fields = append(fields, oidcstate.FieldProvider)
+	}
+	if m.nonce != nil {
+		fields = append(fields, oidcstate.FieldNonce)
+	}
+	if m.code_verifier != nil {
+		fields = append(fields, oidcstate.FieldCodeVerifier)
+	}
+	if m.next_url != nil {
+		fields = append(fields, oidcstate.FieldNextURL)
+	}
+	if m.xfer != nil {
+		fields = append(fields, oidcstate.FieldXfer)
+	}
+	if m.access_token != nil {
+		fields = append(fields, oidcstate.FieldAccessToken)
+	}
+	if m.expires != nil {
+		fields = append(fields, oidcstate.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OIDCStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case oidcstate.FieldState:
+		return m.State()
+	case oidcstate.FieldProvider:
+		return m.Provider()
+	case oidcstate.FieldNonce:
+		return m.Nonce()
+	case oidcstate.FieldCodeVerifier:
+		return m.CodeVerifier()
+	case oidcstate.FieldNextURL:
+		return m.NextURL()
+	case oidcstate.FieldXfer:
+		return m.Xfer()
+	case oidcstate.FieldAccessToken:
+		return m.AccessToken()
+	case oidcstate.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OIDCStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case oidcstate.FieldState:
+		return m.OldState(ctx)
+	case oidcstate.FieldProvider:
+		return m.OldProvider(ctx)
+	case oidcstate.FieldNonce:
+		return m.OldNonce(ctx)
+	case oidcstate.FieldCodeVerifier:
+		return m.OldCodeVerifier(ctx)
+	case oidcstate.FieldNextURL:
+		return m.OldNextURL(ctx)
+	case oidcstate.FieldXfer:
+		return m.OldXfer(ctx)
+	case oidcstate.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case oidcstate.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown OIDCState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OIDCStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case oidcstate.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case oidcstate.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case oidcstate.FieldNonce:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNonce(v)
+		return nil
+	case oidcstate.FieldCodeVerifier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeVerifier(v)
+		return nil
+	case oidcstate.FieldNextURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextURL(v)
+		return nil
+	case oidcstate.FieldXfer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetXfer(v)
+		return nil
+	case oidcstate.FieldAccessToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessToken(v)
+		return nil
+	case oidcstate.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OIDCState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OIDCStateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OIDCStateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OIDCStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OIDCState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OIDCStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OIDCStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OIDCStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OIDCState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OIDCStateMutation) ResetField(name string) error {
+	switch name {
+	case oidcstate.FieldState:
+		m.ResetState()
+		return nil
+	case oidcstate.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case oidcstate.FieldNonce:
+		m.ResetNonce()
+		return nil
+	case oidcstate.FieldCodeVerifier:
+		m.ResetCodeVerifier()
+		return nil
+	case oidcstate.FieldNextURL:
+		m.ResetNextURL()
+		return nil
+	case oidcstate.FieldXfer:
+		m.ResetXfer()
+		return nil
+	case oidcstate.FieldAccessToken:
+		m.ResetAccessToken()
+		return nil
+	case oidcstate.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown OIDCState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OIDCStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OIDCStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OIDCStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OIDCStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OIDCStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OIDCStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OIDCStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OIDCState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OIDCStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OIDCState edge %s", name)
+}
+
+// PrivateKeyMutation represents an operation that mutates the PrivateKey nodes in the graph.
+type PrivateKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	text          *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PrivateKey, error)
+	predicates    []predicate.PrivateKey
+}
+
+var _ ent.Mutation = (*PrivateKeyMutation)(nil)
+
+// privatekeyOption allows management of the mutation configuration using functional options.
+type privatekeyOption func(*PrivateKeyMutation)
+
+// newPrivateKeyMutation creates new mutation for the PrivateKey entity.
+func newPrivateKeyMutation(c config, op Op, opts ...privatekeyOption) *PrivateKeyMutation {
+	m := &PrivateKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrivateKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrivateKeyID sets the ID field of the mutation.
+func withPrivateKeyID(id int) privatekeyOption {
+	return func(m *PrivateKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrivateKey
+		)
+		m.oldValue = func(ctx context.Context) (*PrivateKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrivateKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrivateKey sets the old PrivateKey of the mutation.
+func withPrivateKey(node *PrivateKey) privatekeyOption {
+	return func(m *PrivateKeyMutation) {
+		m.oldValue = func(context.Context) (*PrivateKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrivateKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrivateKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrivateKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrivateKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrivateKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetText sets the "text" field.
+func (m *PrivateKeyMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *PrivateKeyMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the PrivateKey entity.
+// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateKeyMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *PrivateKeyMutation) ResetText() {
+	m.text = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *PrivateKeyMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *PrivateKeyMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the PrivateKey entity.
+// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateKeyMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *PrivateKeyMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// Where appends a list predicates to the PrivateKeyMutation builder.
+func (m *PrivateKeyMutation) Where(ps ...predicate.PrivateKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrivateKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrivateKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PrivateKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrivateKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrivateKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PrivateKey).
+func (m *PrivateKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrivateKeyMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.text != nil {
+		fields = append(fields, privatekey.FieldText)
+	}
+	if m.expires != nil {
+		fields = append(fields, privatekey.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrivateKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case privatekey.FieldText:
+		return m.Text()
+	case privatekey.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrivateKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case privatekey.FieldText:
+		return m.OldText(ctx)
+	case privatekey.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown PrivateKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivateKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case privatekey.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case privatekey.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrivateKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrivateKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivateKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PrivateKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrivateKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrivateKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrivateKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PrivateKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrivateKeyMutation) ResetField(name string) error {
+	switch name {
+	case privatekey.FieldText:
+		m.ResetText()
+		return nil
+	case privatekey.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrivateKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrivateKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrivateKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrivateKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrivateKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrivateKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrivateKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PrivateKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrivateKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PrivateKey edge %s", name)
+}
+
+// ProviderSessionMutation represents an operation that mutates the ProviderSession nodes in the graph.
+type ProviderSessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	session       *string
+	provider      *string
+	subject       *string
+	sid           *string
+	revoked       *bool
+	created       *time.Time
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ProviderSession, error)
+	predicates    []predicate.ProviderSession
+}
+
+var _ ent.Mutation = (*ProviderSessionMutation)(nil)
+
+// providersessionOption allows management of the mutation configuration using functional options.
+type providersessionOption func(*ProviderSessionMutation)
+
+// newProviderSessionMutation creates new mutation for the ProviderSession entity.
+func newProviderSessionMutation(c config, op Op, opts ...providersessionOption) *ProviderSessionMutation {
+	m := &ProviderSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProviderSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProviderSessionID sets the ID field of the mutation.
+func withProviderSessionID(id int) providersessionOption {
+	return func(m *ProviderSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProviderSession
+		)
+		m.oldValue = func(ctx context.Context) (*ProviderSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProviderSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProviderSession sets the old ProviderSession of the mutation.
+func withProviderSession(node *ProviderSession) providersessionOption {
+	return func(m *ProviderSessionMutation) {
+		m.oldValue = func(context.Context) (*ProviderSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProviderSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProviderSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProviderSessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProviderSessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProviderSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSession sets the "session" field.
+func (m *ProviderSessionMutation) SetSession(s string) {
+	m.session = &s
+}
+
+// Session returns the value of the "session" field in the mutation.
+func (m *ProviderSessionMutation) Session() (r string, exists bool) {
+	v := m.session
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSession returns the old "session" field's value of the ProviderSession entity.
+// If the ProviderSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderSessionMutation) OldSession(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSession is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSession requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSession: %w", err)
+	}
+	return oldValue.Session, nil
+}
+
+// ResetSession resets all changes to the "session" field.
+func (m *ProviderSessionMutation) ResetSession() {
+	m.session = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *ProviderSessionMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ProviderSessionMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the ProviderSession entity.
+// If the ProviderSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderSessionMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *ProviderSessionMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *ProviderSessionMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *ProviderSessionMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the ProviderSession entity.
+// If the ProviderSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderSessionMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *ProviderSessionMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetSid sets the "sid" field.
+func (m *ProviderSessionMutation) SetSid(s string) {
+	m.sid = &s
+}
+
+// Sid returns the value of the "sid" field in the mutation.
+func (m *ProviderSessionMutation) Sid() (r string, exists bool) {
+	v := m.sid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSid returns the old "sid" field's value of the ProviderSession entity.
+// If the ProviderSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderSessionMutation) OldSid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSid: %w", err)
+	}
+	return oldValue.Sid, nil
+}
+
+// ResetSid resets all changes to the "sid" field.
+func (m *ProviderSessionMutation) ResetSid() {
+	m.sid = nil
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *ProviderSessionMutation) SetRevoked(b bool) {
+	m.revoked = &b
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *ProviderSessionMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevoked returns the old "revoked" field's value of the ProviderSession entity.
+// If the ProviderSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderSessionMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *ProviderSessionMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *ProviderSessionMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *ProviderSessionMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the ProviderSession entity.
+// If the ProviderSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderSessionMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *ProviderSessionMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *ProviderSessionMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *ProviderSessionMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the ProviderSession entity.
+// If the ProviderSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderSessionMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ClearExpires clears the value of the "expires" field.
+func (m *ProviderSessionMutation) ClearExpires() {
+	m.expires = nil
+	m.clearedFields[providersession.FieldExpires] = struct{}{}
+}
+
+// ExpiresCleared returns if the "expires" field was cleared in this mutation.
+func (m *ProviderSessionMutation) ExpiresCleared() bool {
+	_, ok := m.clearedFields[providersession.FieldExpires]
+	return ok
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *ProviderSessionMutation) ResetExpires() {
+	m.expires = nil
+	delete(m.clearedFields, providersession.FieldExpires)
+}
+
+// Where appends a list predicates to the ProviderSessionMutation builder.
+func (m *ProviderSessionMutation) Where(ps ...predicate.ProviderSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProviderSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProviderSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProviderSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProviderSessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProviderSessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProviderSession).
+func (m *ProviderSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProviderSessionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.session != nil {
+		fields = append(fields, providersession.FieldSession)
+	}
+	if m.provider != nil {
+		fields = append(fields, providersession.FieldProvider)
+	}
+	if m.subject != nil {
+		fields = append(fields, providersession.FieldSubject)
+	}
+	if m.sid != nil {
+		fields = append(fields, providersession.FieldSid)
+	}
+	if m.revoked != nil {
+		fields = append(fields, providersession.FieldRevoked)
+	}
+	if m.created != nil {
+		fields = append(fields, providersession.FieldCreated)
+	}
+	if m.expires != nil {
+		fields = append(fields, providersession.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProviderSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case providersession.FieldSession:
+		return m.Session()
+	case providersession.FieldProvider:
+		return m.Provider()
+	case providersession.FieldSubject:
+		return m.Subject()
+	case providersession.FieldSid:
+		return m.Sid()
+	case providersession.FieldRevoked:
+		return m.Revoked()
+	case providersession.FieldCreated:
+		return m.Created()
+	case providersession.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProviderSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case providersession.FieldSession:
+		return m.OldSession(ctx)
+	case providersession.FieldProvider:
+		return m.OldProvider(ctx)
+	case providersession.FieldSubject:
+		return m.OldSubject(ctx)
+	case providersession.FieldSid:
+		return m.OldSid(ctx)
+	case providersession.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case providersession.FieldCreated:
+		return m.OldCreated(ctx)
+	case providersession.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProviderSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProviderSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case providersession.FieldSession:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSession(v)
+		return nil
+	case providersession.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case providersession.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case providersession.FieldSid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSid(v)
+		return nil
+	case providersession.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	case providersession.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case providersession.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProviderSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProviderSessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProviderSessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProviderSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProviderSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProviderSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(providersession.FieldExpires) {
+		fields = append(fields, providersession.FieldExpires)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProviderSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProviderSessionMutation) ClearField(name string) error {
+	switch name {
+	case providersession.FieldExpires:
+		m.ClearExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown ProviderSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProviderSessionMutation) ResetField(name string) error {
+	switch name {
+	case providersession.FieldSession:
+		m.ResetSession()
+		return nil
+	case providersession.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case providersession.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case providersession.FieldSid:
+		m.ResetSid()
+		return nil
+	case providersession.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	case providersession.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case providersession.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown ProviderSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProviderSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProviderSessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProviderSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProviderSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProviderSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProviderSessionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProviderSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProviderSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProviderSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProviderSession edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	fname                    *string
+	lname                    *string
+	source                   *string
+	email                    *string
+	username                 *string
+	password                 *string
+	salt                     *string
+	mfa_secret               *string
+	mfa_enabled              *bool
+	mfa_recovery_codes       *[]string
+	appendmfa_recovery_codes []string
+	mfa_last_step            *int64
+	addmfa_last_step         *int64
+	mfa_failures             *int
+	addmfa_failures          *int
+	mfa_locked_until         *time.Time
+	created_at               *time.Time
+	clearedFields            map[string]struct{}
+	claim_groups             map[int]struct{}
+	removedclaim_groups      map[int]struct{}
+	clearedclaim_groups      bool
+	identities               map[int]struct{}
+	removedidentities        map[int]struct{}
+	clearedidentities        bool
+	done                     bool
+	oldValue                 func(context.Context) (*User, error)
+	predicates               []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFname sets the "fname" field.
+func (m *UserMutation) SetFname(s string) {
+	m.fname = &s
+}
+
+// Fname returns the value of the "fname" field in the mutation.
+func (m *UserMutation) Fname() (r string, exists bool) {
+	v := m.fname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFname returns the old "fname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFname: %w", err)
+	}
+	return oldValue.Fname, nil
+}
+
+// ResetFname resets all changes to the "fname" field.
+func (m *UserMutation) ResetFname() {
+	m.fname = nil
+}
+
+// SetLname sets the "lname" field.
+func (m *UserMutation) SetLname(s string) {
+	m.lname = &s
+}
+
+// Lname returns the value of the "lname" field in the mutation.
+func (m *UserMutation) Lname() (r string, exists bool) {
+	v := m.lname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLname returns the old "lname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLname: %w", err)
+	}
+	return oldValue.Lname, nil
+}
+
+// ResetLname resets all changes to the "lname" field.
+func (m *UserMutation) ResetLname() {
+	m.lname = nil
+}
+
+// SetSource sets the "source" field.
+func (m *UserMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *UserMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *UserMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *UserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[user.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *UserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[user.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, user.FieldPassword)
+}
+
+// SetSalt sets the "salt" field.
+func (m *UserMutation) SetSalt(s string) {
+	m.salt = &s
+}
+
+// Salt returns the value of the "salt" field in the mutation.
+func (m *UserMutation) Salt() (r string, exists bool) {
+	v := m.salt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSalt returns the old "salt" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSalt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSalt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
+	}
+	return oldValue.Salt, nil
+}
+
+// ClearSalt clears the value of the "salt" field.
+func (m *UserMutation) ClearSalt() {
+	m.salt = nil
+	m.clearedFields[user.FieldSalt] = struct{}{}
+}
+
+// SaltCleared returns if the "salt" field was cleared in this mutation.
+func (m *UserMutation) SaltCleared() bool {
+	_, ok := m.clearedFields[user.FieldSalt]
+	return ok
+}
+
+// ResetSalt resets all changes to the "salt" field.
+func (m *UserMutation) ResetSalt() {
+	m.salt = nil
+	delete(m.clearedFields, user.FieldSalt)
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (m *UserMutation) SetMfaSecret(s string) {
+	m.mfa_secret = &s
 }
 
-// Fname returns the value of the "fname" field in the mutation.
-func (m *UserMutation) Fname() (r string, exists bool) {
-	v := m.fname
+// MfaSecret returns the value of the "mfa_secret" field in the mutation.
+func (m *UserMutation) MfaSecret() (r string, exists bool) {
+	v := m.mfa_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFname returns the old "fname" field's value of the User entity.
+// OldMfaSecret returns the old "mfa_secret" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFname(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldMfaSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFname is only allowed on UpdateOne operations")
+		return v, errors.New("OldMfaSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFname requires an ID field in the mutation")
+		return v, errors.New("OldMfaSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFname: %w", err)
+		return v, fmt.Errorf("querying old value for OldMfaSecret: %w", err)
 	}
-	return oldValue.Fname, nil
+	return oldValue.MfaSecret, nil
 }
 
-// ResetFname resets all changes to the "fname" field.
-func (m *UserMutation) ResetFname() {
-	m.fname = nil
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (m *UserMutation) ClearMfaSecret() {
+	m.mfa_secret = nil
+	m.clearedFields[user.FieldMfaSecret] = struct{}{}
 }
 
-// SetLname sets the "lname" field.
-func (m *UserMutation) SetLname(s string) {
-	m.lname = &s
+// MfaSecretCleared returns if the "mfa_secret" field was cleared in this mutation.
+func (m *UserMutation) MfaSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldMfaSecret]
+	return ok
 }
 
-// Lname returns the value of the "lname" field in the mutation.
-func (m *UserMutation) Lname() (r string, exists bool) {
-	v := m.lname
+// ResetMfaSecret resets all changes to the "mfa_secret" field.
+func (m *UserMutation) ResetMfaSecret() {
+	m.mfa_secret = nil
+	delete(m.clearedFields, user.FieldMfaSecret)
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (m *UserMutation) SetMfaEnabled(b bool) {
+	m.mfa_enabled = &b
+}
+
+// MfaEnabled returns the value of the "mfa_enabled" field in the mutation.
+func (m *UserMutation) MfaEnabled() (r bool, exists bool) {
+	v := m.mfa_enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLname returns the old "lname" field's value of the User entity.
+// OldMfaEnabled returns the old "mfa_enabled" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLname(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldMfaEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLname is only allowed on UpdateOne operations")
+		return v, errors.New("OldMfaEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLname requires an ID field in the mutation")
+		return v, errors.New("OldMfaEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLname: %w", err)
+		return v, fmt.Errorf("querying old value for OldMfaEnabled: %w", err)
 	}
-	return oldValue.Lname, nil
+	return oldValue.MfaEnabled, nil
 }
 
-// ResetLname resets all changes to the "lname" field.
-func (m *UserMutation) ResetLname() {
-	m.lname = nil
+// ResetMfaEnabled resets all changes to the "mfa_enabled" field.
+func (m *UserMutation) ResetMfaEnabled() {
+	m.mfa_enabled = nil
 }
 
-// SetSource sets the "source" field.
-func (m *UserMutation) SetSource(s string) {
-	m.source = &s
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (m *UserMutation) SetMfaRecoveryCodes(s []string) {
+	m.mfa_recovery_codes = &s
+	m.appendmfa_recovery_codes = nil
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *UserMutation) Source() (r string, exists bool) {
-	v := m.source
+// MfaRecoveryCodes returns the value of the "mfa_recovery_codes" field in the mutation.
+func (m *UserMutation) MfaRecoveryCodes() (r []string, exists bool) {
+	v := m.mfa_recovery_codes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the User entity.
+// OldMfaRecoveryCodes returns the old "mfa_recovery_codes" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldMfaRecoveryCodes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldMfaRecoveryCodes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldMfaRecoveryCodes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldMfaRecoveryCodes: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.MfaRecoveryCodes, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *UserMutation) ResetSource() {
-	m.source = nil
+// AppendMfaRecoveryCodes adds s to the "mfa_recovery_codes" field.
+func (m *UserMutation) AppendMfaRecoveryCodes(s []string) {
+	m.appendmfa_recovery_codes = append(m.appendmfa_recovery_codes, s...)
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// AppendedMfaRecoveryCodes returns the list of values that were appended to the "mfa_recovery_codes" field in this mutation.
+func (m *UserMutation) AppendedMfaRecoveryCodes() ([]string, bool) {
+	if len(m.appendmfa_recovery_codes) == 0 {
+		return nil, false
+	}
+	return m.appendmfa_recovery_codes, true
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (m *UserMutation) ClearMfaRecoveryCodes() {
+	m.mfa_recovery_codes = nil
+	m.appendmfa_recovery_codes = nil
+	m.clearedFields[user.FieldMfaRecoveryCodes] = struct{}{}
+}
+
+// MfaRecoveryCodesCleared returns if the "mfa_recovery_codes" field was cleared in this mutation.
+func (m *UserMutation) MfaRecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[user.FieldMfaRecoveryCodes]
+	return ok
+}
+
+// ResetMfaRecoveryCodes resets all changes to the "mfa_recovery_codes" field.
+func (m *UserMutation) ResetMfaRecoveryCodes() {
+	m.mfa_recovery_codes = nil
+	m.appendmfa_recovery_codes = nil
+	delete(m.clearedFields, user.FieldMfaRecoveryCodes)
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (m *UserMutation) SetMfaLastStep(i int64) {
+	m.mfa_last_step = &i
+	m.addmfa_last_step = nil
+}
+
+// MfaLastStep returns the value of the "mfa_last_step" field in the mutation.
+func (m *UserMutation) MfaLastStep() (r int64, exists bool) {
+	v := m.mfa_last_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
+// OldMfaLastStep returns the old "mfa_last_step" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldMfaLastStep(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldMfaLastStep is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldMfaLastStep requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldMfaLastStep: %w", err)
 	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+	return oldValue.MfaLastStep, nil
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// AddMfaLastStep adds i to the "mfa_last_step" field.
+func (m *UserMutation) AddMfaLastStep(i int64) {
+	if m.addmfa_last_step != nil {
+		*m.addmfa_last_step += i
+	} else {
+		m.addmfa_last_step = &i
+	}
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// AddedMfaLastStep returns the value that was added to the "mfa_last_step" field in this mutation.
+func (m *UserMutation) AddedMfaLastStep() (r int64, exists bool) {
+	v := m.addmfa_last_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
-	}
-	return oldValue.Username, nil
+// ClearMfaLastStep clears the value of the "mfa_last_step" field.
+func (m *UserMutation) ClearMfaLastStep() {
+	m.mfa_last_step = nil
+	m.addmfa_last_step = nil
+	m.clearedFields[user.FieldMfaLastStep] = struct{}{}
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// MfaLastStepCleared returns if the "mfa_last_step" field was cleared in this mutation.
+func (m *UserMutation) MfaLastStepCleared() bool {
+	_, ok := m.clearedFields[user.FieldMfaLastStep]
+	return ok
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// ResetMfaLastStep resets all changes to the "mfa_last_step" field.
+func (m *UserMutation) ResetMfaLastStep() {
+	m.mfa_last_step = nil
+	m.addmfa_last_step = nil
+	delete(m.clearedFields, user.FieldMfaLastStep)
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// SetMfaFailures sets the "mfa_failures" field.
+func (m *UserMutation) SetMfaFailures(i int) {
+	m.mfa_failures = &i
+	m.addmfa_failures = nil
+}
+
+// MfaFailures returns the value of the "mfa_failures" field in the mutation.
+func (m *UserMutation) MfaFailures() (r int, exists bool) {
+	v := m.mfa_failures
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
+// OldMfaFailures returns the old "mfa_failures" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldMfaFailures(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldMfaFailures is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldMfaFailures requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldMfaFailures: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.MfaFailures, nil
 }
 
-// ClearPassword clears the value of the "password" field.
-func (m *UserMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[user.FieldPassword] = struct{}{}
+// AddMfaFailures adds i to the "mfa_failures" field.
+func (m *UserMutation) AddMfaFailures(i int) {
+	if m.addmfa_failures != nil {
+		*m.addmfa_failures += i
+	} else {
+		m.addmfa_failures = &i
+	}
 }
 
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *UserMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[user.FieldPassword]
-	return ok
+// AddedMfaFailures returns the value that was added to the "mfa_failures" field in this mutation.
+func (m *UserMutation) AddedMfaFailures() (r int, exists bool) {
+	v := m.addmfa_failures
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, user.FieldPassword)
+// ResetMfaFailures resets all changes to the "mfa_failures" field.
+func (m *UserMutation) ResetMfaFailures() {
+	m.mfa_failures = nil
+	m.addmfa_failures = nil
 }
 
-// SetSalt sets the "salt" field.
-func (m *UserMutation) SetSalt(s string) {
-	m.salt = &s
+// SetMfaLockedUntil sets the "mfa_locked_until" field.
+func (m *UserMutation) SetMfaLockedUntil(t time.Time) {
+	m.mfa_locked_until = &t
 }
 
-// Salt returns the value of the "salt" field in the mutation.
-func (m *UserMutation) Salt() (r string, exists bool) {
-	v := m.salt
+// MfaLockedUntil returns the value of the "mfa_locked_until" field in the mutation.
+func (m *UserMutation) MfaLockedUntil() (r time.Time, exists bool) {
+	v := m.mfa_locked_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSalt returns the old "salt" field's value of the User entity.
+// OldMfaLockedUntil returns the old "mfa_locked_until" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSalt(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldMfaLockedUntil(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMfaLockedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSalt requires an ID field in the mutation")
+		return v, errors.New("OldMfaLockedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMfaLockedUntil: %w", err)
 	}
-	return oldValue.Salt, nil
+	return oldValue.MfaLockedUntil, nil
 }
 
-// ClearSalt clears the value of the "salt" field.
-func (m *UserMutation) ClearSalt() {
-	m.salt = nil
-	m.clearedFields[user.FieldSalt] = struct{}{}
+// ClearMfaLockedUntil clears the value of the "mfa_locked_until" field.
+func (m *UserMutation) ClearMfaLockedUntil() {
+	m.mfa_locked_until = nil
+	m.clearedFields[user.FieldMfaLockedUntil] = struct{}{}
 }
 
-// SaltCleared returns if the "salt" field was cleared in this mutation.
-func (m *UserMutation) SaltCleared() bool {
-	_, ok := m.clearedFields[user.FieldSalt]
+// MfaLockedUntilCleared returns if the "mfa_locked_until" field was cleared in this mutation.
+func (m *UserMutation) MfaLockedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldMfaLockedUntil]
 	return ok
 }
 
-// ResetSalt resets all changes to the "salt" field.
-func (m *UserMutation) ResetSalt() {
-	m.salt = nil
-	delete(m.clearedFields, user.FieldSalt)
+// ResetMfaLockedUntil resets all changes to the "mfa_locked_until" field.
+func (m *UserMutation) ResetMfaLockedUntil() {
+	m.mfa_locked_until = nil
+	delete(m.clearedFields, user.FieldMfaLockedUntil)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -2953,6 +7767,60 @@ func (m *UserMutation) ResetClaimGroups() {
 	m.removedclaim_groups = nil
 }
 
+// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...int) {
+	if m.identities == nil {
+		m.identities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdentities clears the "identities" edge to the Identity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
+}
+
+// IdentitiesCleared reports if the "identities" edge to the Identity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...int) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []int) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []int) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -2987,7 +7855,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 14)
 	if m.fname != nil {
 		fields = append(fields, user.FieldFname)
 	}
@@ -3009,6 +7877,24 @@ func (m *UserMutation) Fields() []string {
 	if m.salt != nil {
 		fields = append(fields, user.FieldSalt)
 	}
+	if m.mfa_secret != nil {
+		fields = append(fields, user.FieldMfaSecret)
+	}
+	if m.mfa_enabled != nil {
+		fields = append(fields, user.FieldMfaEnabled)
+	}
+	if m.mfa_recovery_codes != nil {
+		fields = append(fields, user.FieldMfaRecoveryCodes)
+	}
+	if m.mfa_last_step != nil {
+		fields = append(fields, user.FieldMfaLastStep)
+	}
+	if m.mfa_failures != nil {
+		fields = append(fields, user.FieldMfaFailures)
+	}
+	if m.mfa_locked_until != nil {
+		fields = append(fields, user.FieldMfaLockedUntil)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -3034,6 +7920,18 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Password()
 	case user.FieldSalt:
 		return m.Salt()
+	case user.FieldMfaSecret:
+		return m.MfaSecret()
+	case user.FieldMfaEnabled:
+		return m.MfaEnabled()
+	case user.FieldMfaRecoveryCodes:
+		return m.MfaRecoveryCodes()
+	case user.FieldMfaLastStep:
+		return m.MfaLastStep()
+	case user.FieldMfaFailures:
+		return m.MfaFailures()
+	case user.FieldMfaLockedUntil:
+		return m.MfaLockedUntil()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -3059,6 +7957,18 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldPassword(ctx)
 	case user.FieldSalt:
 		return m.OldSalt(ctx)
+	case user.FieldMfaSecret:
+		return m.OldMfaSecret(ctx)
+	case user.FieldMfaEnabled:
+		return m.OldMfaEnabled(ctx)
+	case user.FieldMfaRecoveryCodes:
+		return m.OldMfaRecoveryCodes(ctx)
+	case user.FieldMfaLastStep:
+		return m.OldMfaLastStep(ctx)
+	case user.FieldMfaFailures:
+		return m.OldMfaFailures(ctx)
+	case user.FieldMfaLockedUntil:
+		return m.OldMfaLockedUntil(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -3119,6 +8029,48 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSalt(v)
 		return nil
+	case user.FieldMfaSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaSecret(v)
+		return nil
+	case user.FieldMfaEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaEnabled(v)
+		return nil
+	case user.FieldMfaRecoveryCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaRecoveryCodes(v)
+		return nil
+	case user.FieldMfaLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaLastStep(v)
+		return nil
+	case user.FieldMfaFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaFailures(v)
+		return nil
+	case user.FieldMfaLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaLockedUntil(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -3133,13 +8085,26 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addmfa_last_step != nil {
+		fields = append(fields, user.FieldMfaLastStep)
+	}
+	if m.addmfa_failures != nil {
+		fields = append(fields, user.FieldMfaFailures)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldMfaLastStep:
+		return m.AddedMfaLastStep()
+	case user.FieldMfaFailures:
+		return m.AddedMfaFailures()
+	}
 	return nil, false
 }
 
@@ -3148,6 +8113,20 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldMfaLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMfaLastStep(v)
+		return nil
+	case user.FieldMfaFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMfaFailures(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -3162,6 +8141,18 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldSalt) {
 		fields = append(fields, user.FieldSalt)
 	}
+	if m.FieldCleared(user.FieldMfaSecret) {
+		fields = append(fields, user.FieldMfaSecret)
+	}
+	if m.FieldCleared(user.FieldMfaRecoveryCodes) {
+		fields = append(fields, user.FieldMfaRecoveryCodes)
+	}
+	if m.FieldCleared(user.FieldMfaLastStep) {
+		fields = append(fields, user.FieldMfaLastStep)
+	}
+	if m.FieldCleared(user.FieldMfaLockedUntil) {
+		fields = append(fields, user.FieldMfaLockedUntil)
+	}
 	return fields
 }
 
@@ -3182,6 +8173,18 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldSalt:
 		m.ClearSalt()
 		return nil
+	case user.FieldMfaSecret:
+		m.ClearMfaSecret()
+		return nil
+	case user.FieldMfaRecoveryCodes:
+		m.ClearMfaRecoveryCodes()
+		return nil
+	case user.FieldMfaLastStep:
+		m.ClearMfaLastStep()
+		return nil
+	case user.FieldMfaLockedUntil:
+		m.ClearMfaLockedUntil()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -3211,6 +8214,24 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldSalt:
 		m.ResetSalt()
 		return nil
+	case user.FieldMfaSecret:
+		m.ResetMfaSecret()
+		return nil
+	case user.FieldMfaEnabled:
+		m.ResetMfaEnabled()
+		return nil
+	case user.FieldMfaRecoveryCodes:
+		m.ResetMfaRecoveryCodes()
+		return nil
+	case user.FieldMfaLastStep:
+		m.ResetMfaLastStep()
+		return nil
+	case user.FieldMfaFailures:
+		m.ResetMfaFailures()
+		return nil
+	case user.FieldMfaLockedUntil:
+		m.ResetMfaLockedUntil()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -3220,10 +8241,13 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.claim_groups != nil {
 		edges = append(edges, user.EdgeClaimGroups)
 	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
 	return edges
 }
 
@@ -3237,16 +8261,25 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.removedclaim_groups != nil {
 		edges = append(edges, user.EdgeClaimGroups)
 	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
 	return edges
 }
 
@@ -3260,16 +8293,25 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.clearedclaim_groups {
 		edges = append(edges, user.EdgeClaimGroups)
 	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
 	return edges
 }
 
@@ -3279,6 +8321,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
 	case user.EdgeClaimGroups:
 		return m.clearedclaim_groups
+	case user.EdgeIdentities:
+		return m.clearedidentities
 	}
 	return false
 }
@@ -3298,6 +8342,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeClaimGroups:
 		m.ResetClaimGroups()
 		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -24,6 +24,13 @@ import (
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// ActivateMFA invokes activateMFA operation.
+	//
+	// Enables MFA for the signed in user when code was made with the enrolled secret. Recovery codes are
+	// only returned once.
+	//
+	// POST /mfa/activate
+	ActivateMFA(ctx context.Context, request *ActivateMFAReq) (ActivateMFARes, error)
 	// AvailableProviders invokes available_providers operation.
 	//
 	// Get available providers.
@@ -36,6 +43,13 @@ type Invoker interface {
 	//
 	// GET /capabilities
 	Capabilities(ctx context.Context) (*CapabilitiesOK, error)
+	// ClusterStatus invokes cluster_status operation.
+	//
+	// Reports discovered peers, the result of the last public key fetch from each peer, the merged key
+	// cache expiry and any key ids published by more than one peer with different key material.
+	//
+	// GET /cluster
+	ClusterStatus(ctx context.Context) (*ClusterStatusOK, error)
 	// CreateClaim invokes createClaim operation.
 	//
 	// Creates a new Claim and persists it to storage.
@@ -84,6 +98,33 @@ type Invoker interface {
 	//
 	// DELETE /admin/users/{id}
 	DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error)
+	// DisableMFA invokes disableMFA operation.
+	//
+	// Disable MFA.
+	//
+	// POST /mfa/disable
+	DisableMFA(ctx context.Context, request *DisableMFAReq) (DisableMFARes, error)
+	// EnrollMFA invokes enrollMFA operation.
+	//
+	// Creates a new TOTP secret for the signed in user. MFA is enabled once a code made with the secret
+	// is sent to /mfa/activate.
+	//
+	// POST /mfa/enroll
+	EnrollMFA(ctx context.Context) (EnrollMFARes, error)
+	// LinkIdentity invokes linkIdentity operation.
+	//
+	// Links the account with subject at provider to the user without logging in to the provider. The
+	// account must not be linked to another user.
+	//
+	// POST /admin/identities
+	LinkIdentity(ctx context.Context, request *LinkIdentityReq) (LinkIdentityRes, error)
+	// LinkMyIdentity invokes linkMyIdentity operation.
+	//
+	// The user proves the account is theirs by logging in to the provider. Token based providers take
+	// the provider token as the password.
+	//
+	// POST /identities
+	LinkMyIdentity(ctx context.Context, request *LinkMyIdentityReq) (LinkMyIdentityRes, error)
 	// ListClaim invokes listClaim operation.
 	//
 	// List Claims.
@@ -126,6 +167,18 @@ type Invoker interface {
 	//
 	// GET /admin/group-links
 	ListGroupLink(ctx context.Context, params ListGroupLinkParams) (ListGroupLinkRes, error)
+	// ListIdentities invokes listIdentities operation.
+	//
+	// List linked identities.
+	//
+	// GET /admin/identities
+	ListIdentities(ctx context.Context, params ListIdentitiesParams) (*ListIdentitiesOK, error)
+	// ListMyIdentities invokes listMyIdentities operation.
+	//
+	// List the signed in user's identities.
+	//
+	// GET /identities
+	ListMyIdentities(ctx context.Context) (ListMyIdentitiesRes, error)
 	// ListPrivateKey invokes listPrivateKey operation.
 	//
 	// List PrivateKeys.
@@ -150,12 +203,33 @@ type Invoker interface {
 	//
 	// POST /login
 	Login(ctx context.Context, request *LoginReq) (LoginRes, error)
+	// LoginExchange invokes loginExchange operation.
+	//
+	// Redeem a login code issued after a server-side provider login.
+	//
+	// POST /login/exchange
+	LoginExchange(ctx context.Context, request *LoginExchangeReq) (LoginExchangeRes, error)
+	// LoginMFA invokes loginMFA operation.
+	//
+	// Answer an MFA challenge to receive a token.
+	//
+	// POST /login/mfa
+	LoginMFA(ctx context.Context, request *LoginMFAReq) (LoginMFARes, error)
 	// Pkeys invokes pkeys operation.
 	//
-	// Get current valid public keys.
+	// Returns JWKS (merged from all peers when clustered). Optional query: local=true or local=1 to
+	// return only this node's keys (used by peers to avoid recursion).
 	//
 	// GET /pkeys
 	Pkeys(ctx context.Context) (*PkeysOK, error)
+	// ProviderHealth invokes provider_health operation.
+	//
+	// Reports the circuit breaker state of each foreign provider, the number of consecutive logins that
+	// failed because the provider could not be reached and the times of the last successful and failed
+	// logins. Providers are degraded while logins are failing or the circuit breaker is not closed.
+	//
+	// GET /provider_health
+	ProviderHealth(ctx context.Context) (*ProviderHealthOK, error)
 	// ReadClaim invokes readClaim operation.
 	//
 	// Finds the Claim with the requested ID and returns it.
@@ -198,12 +272,30 @@ type Invoker interface {
 	//
 	// POST /refresh
 	Refresh(ctx context.Context, request *RefreshReq) (RefreshRes, error)
+	// ResetMFA invokes resetMFA operation.
+	//
+	// Used when a user lost their authenticator and recovery codes.
+	//
+	// DELETE /admin/mfa/{username}
+	ResetMFA(ctx context.Context, params ResetMFAParams) (ResetMFARes, error)
 	// Totals invokes totals operation.
 	//
 	// Get entity count totals.
 	//
 	// GET /admin/totals
 	Totals(ctx context.Context) (*TotalsOK, error)
+	// UnlinkIdentity invokes unlinkIdentity operation.
+	//
+	// The only identity of a user without a local password can not be unlinked.
+	//
+	// DELETE /admin/identities/{id}
+	UnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) (UnlinkIdentityRes, error)
+	// UnlinkMyIdentity invokes unlinkMyIdentity operation.
+	//
+	// The only identity of a user without a local password can not be unlinked.
+	//
+	// DELETE /identities/{id}
+	UnlinkMyIdentity(ctx context.Context, params UnlinkMyIdentityParams) (UnlinkMyIdentityRes, error)
 	// UpdateClaim invokes updateClaim operation.
 	//
 	// Updates a Claim and persists changes to storage.
@@ -286,6 +378,115 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
+// ActivateMFA invokes activateMFA operation.
+//
+// Enables MFA for the signed in user when code was made with the enrolled secret. Recovery codes are
+// only returned once.
+//
+// POST /mfa/activate
+func (c *Client) ActivateMFA(ctx context.Context, request *ActivateMFAReq) (ActivateMFARes, error) {
+	res, err := c.sendActivateMFA(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendActivateMFA(ctx context.Context, request *ActivateMFAReq) (res ActivateMFARes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("activateMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/mfa/activate"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ActivateMFA",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/mfa/activate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeActivateMFARequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ActivateMFA", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeActivateMFAResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // AvailableProviders invokes available_providers operation.
 //
 // Get available providers.
@@ -463,6 +664,112 @@ func (c *Client) sendCapabilities(ctx context.Context) (res *CapabilitiesOK, err
 	return result, nil
 }
 
+// ClusterStatus invokes cluster_status operation.
+//
+// Reports discovered peers, the result of the last public key fetch from each peer, the merged key
+// cache expiry and any key ids published by more than one peer with different key material.
+//
+// GET /cluster
+func (c *Client) ClusterStatus(ctx context.Context) (*ClusterStatusOK, error) {
+	res, err := c.sendClusterStatus(ctx)
+	return res, err
+}
+
+func (c *Client) sendClusterStatus(ctx context.Context) (res *ClusterStatusOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("cluster_status"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/cluster"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ClusterStatus",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/cluster"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ClusterStatus", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeClusterStatusResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // CreateClaim invokes createClaim operation.
 //
 // Creates a new Claim and persists it to storage.
@@ -1387,21 +1694,21 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	return result, nil
 }
 
-// ListClaim invokes listClaim operation.
+// DisableMFA invokes disableMFA operation.
 //
-// List Claims.
+// Disable MFA.
 //
-// GET /admin/claims
-func (c *Client) ListClaim(ctx context.Context, params ListClaimParams) (ListClaimRes, error) {
-	res, err := c.sendListClaim(ctx, params)
+// POST /mfa/disable
+func (c *Client) DisableMFA(ctx context.Context, request *DisableMFAReq) (DisableMFARes, error) {
+	res, err := c.sendDisableMFA(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListClaim(ctx context.Context, params ListClaimParams) (res ListClaimRes, err error) {
+func (c *Client) sendDisableMFA(ctx context.Context, request *DisableMFAReq) (res DisableMFARes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaim"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claims"),
+		otelogen.OperationID("disableMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/mfa/disable"),
 	}
 
 	// Run stopwatch.
@@ -1416,7 +1723,7 @@ func (c *Client) sendListClaim(ctx context.Context, params ListClaimParams) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaim",
+	ctx, span := c.cfg.Tracer.Start(ctx, "DisableMFA",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1434,66 +1741,31 @@ func (c *Client) sendListClaim(ctx context.Context, params ListClaimParams) (res
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/admin/claims"
+	pathParts[0] = "/mfa/disable"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDisableMFARequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
 	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "itemsPerPage" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "itemsPerPage",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ItemsPerPage.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListClaim", r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"Token\"")
-			}
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "DisableMFA", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
 		}
 
 		if ok := func() bool {
@@ -1522,7 +1794,7 @@ func (c *Client) sendListClaim(ctx context.Context, params ListClaimParams) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListClaimResponse(resp)
+	result, err := decodeDisableMFAResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1530,21 +1802,22 @@ func (c *Client) sendListClaim(ctx context.Context, params ListClaimParams) (res
 	return result, nil
 }
 
-// ListClaimClaimGroups invokes listClaimClaimGroups operation.
+// EnrollMFA invokes enrollMFA operation.
 //
-// List attached ClaimGroups.
+// Creates a new TOTP secret for the signed in user. MFA is enabled once a code made with the secret
+// is sent to /mfa/activate.
 //
-// GET /admin/claims/{id}/claim-groups
-func (c *Client) ListClaimClaimGroups(ctx context.Context, params ListClaimClaimGroupsParams) (ListClaimClaimGroupsRes, error) {
-	res, err := c.sendListClaimClaimGroups(ctx, params)
+// POST /mfa/enroll
+func (c *Client) EnrollMFA(ctx context.Context) (EnrollMFARes, error) {
+	res, err := c.sendEnrollMFA(ctx)
 	return res, err
 }
 
-func (c *Client) sendListClaimClaimGroups(ctx context.Context, params ListClaimClaimGroupsParams) (res ListClaimClaimGroupsRes, err error) {
+func (c *Client) sendEnrollMFA(ctx context.Context) (res EnrollMFARes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimClaimGroups"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claims/{id}/claim-groups"),
+		otelogen.OperationID("enrollMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/mfa/enroll"),
 	}
 
 	// Run stopwatch.
@@ -1559,7 +1832,7 @@ func (c *Client) sendListClaimClaimGroups(ctx context.Context, params ListClaimC
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimClaimGroups",
+	ctx, span := c.cfg.Tracer.Start(ctx, "EnrollMFA",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1576,69 +1849,12 @@ func (c *Client) sendListClaimClaimGroups(ctx context.Context, params ListClaimC
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/admin/claims/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/claim-groups"
+	var pathParts [1]string
+	pathParts[0] = "/mfa/enroll"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "itemsPerPage" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "itemsPerPage",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ItemsPerPage.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1648,7 +1864,7 @@ func (c *Client) sendListClaimClaimGroups(ctx context.Context, params ListClaimC
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListClaimClaimGroups", r); {
+			switch err := c.securityToken(ctx, "EnrollMFA", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1684,7 +1900,7 @@ func (c *Client) sendListClaimClaimGroups(ctx context.Context, params ListClaimC
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListClaimClaimGroupsResponse(resp)
+	result, err := decodeEnrollMFAResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1692,21 +1908,22 @@ func (c *Client) sendListClaimClaimGroups(ctx context.Context, params ListClaimC
 	return result, nil
 }
 
-// ListClaimGroup invokes listClaimGroup operation.
+// LinkIdentity invokes linkIdentity operation.
 //
-// List ClaimGroups.
+// Links the account with subject at provider to the user without logging in to the provider. The
+// account must not be linked to another user.
 //
-// GET /admin/claim-groups
-func (c *Client) ListClaimGroup(ctx context.Context, params ListClaimGroupParams) (ListClaimGroupRes, error) {
-	res, err := c.sendListClaimGroup(ctx, params)
+// POST /admin/identities
+func (c *Client) LinkIdentity(ctx context.Context, request *LinkIdentityReq) (LinkIdentityRes, error) {
+	res, err := c.sendLinkIdentity(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListClaimGroup(ctx context.Context, params ListClaimGroupParams) (res ListClaimGroupRes, err error) {
+func (c *Client) sendLinkIdentity(ctx context.Context, request *LinkIdentityReq) (res LinkIdentityRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroup"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups"),
+		otelogen.OperationID("linkIdentity"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/admin/identities"),
 	}
 
 	// Run stopwatch.
@@ -1721,7 +1938,7 @@ func (c *Client) sendListClaimGroup(ctx context.Context, params ListClaimGroupPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroup",
+	ctx, span := c.cfg.Tracer.Start(ctx, "LinkIdentity",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1739,59 +1956,24 @@ func (c *Client) sendListClaimGroup(ctx context.Context, params ListClaimGroupPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/admin/claim-groups"
+	pathParts[0] = "/admin/identities"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "itemsPerPage" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "itemsPerPage",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ItemsPerPage.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeLinkIdentityRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListClaimGroup", r); {
+			switch err := c.securityToken(ctx, "LinkIdentity", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1827,7 +2009,7 @@ func (c *Client) sendListClaimGroup(ctx context.Context, params ListClaimGroupPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListClaimGroupResponse(resp)
+	result, err := decodeLinkIdentityResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1835,21 +2017,22 @@ func (c *Client) sendListClaimGroup(ctx context.Context, params ListClaimGroupPa
 	return result, nil
 }
 
-// ListClaimGroupClaims invokes listClaimGroupClaims operation.
+// LinkMyIdentity invokes linkMyIdentity operation.
 //
-// List attached Claims.
+// The user proves the account is theirs by logging in to the provider. Token based providers take
+// the provider token as the password.
 //
-// GET /admin/claim-groups/{id}/claims
-func (c *Client) ListClaimGroupClaims(ctx context.Context, params ListClaimGroupClaimsParams) (ListClaimGroupClaimsRes, error) {
-	res, err := c.sendListClaimGroupClaims(ctx, params)
+// POST /identities
+func (c *Client) LinkMyIdentity(ctx context.Context, request *LinkMyIdentityReq) (LinkMyIdentityRes, error) {
+	res, err := c.sendLinkMyIdentity(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListClaimGroupClaims(ctx context.Context, params ListClaimGroupClaimsParams) (res ListClaimGroupClaimsRes, err error) {
+func (c *Client) sendLinkMyIdentity(ctx context.Context, request *LinkMyIdentityReq) (res LinkMyIdentityRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroupClaims"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/claims"),
+		otelogen.OperationID("linkMyIdentity"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/identities"),
 	}
 
 	// Run stopwatch.
@@ -1864,7 +2047,7 @@ func (c *Client) sendListClaimGroupClaims(ctx context.Context, params ListClaimG
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroupClaims",
+	ctx, span := c.cfg.Tracer.Start(ctx, "LinkMyIdentity",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1881,79 +2064,25 @@ func (c *Client) sendListClaimGroupClaims(ctx context.Context, params ListClaimG
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/admin/claim-groups/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/claims"
+	var pathParts [1]string
+	pathParts[0] = "/identities"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "itemsPerPage" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "itemsPerPage",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ItemsPerPage.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeLinkMyIdentityRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListClaimGroupClaims", r); {
+			switch err := c.securityToken(ctx, "LinkMyIdentity", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1989,7 +2118,7 @@ func (c *Client) sendListClaimGroupClaims(ctx context.Context, params ListClaimG
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListClaimGroupClaimsResponse(resp)
+	result, err := decodeLinkMyIdentityResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1997,21 +2126,21 @@ func (c *Client) sendListClaimGroupClaims(ctx context.Context, params ListClaimG
 	return result, nil
 }
 
-// ListClaimGroupGroupLinks invokes listClaimGroupGroupLinks operation.
+// ListClaim invokes listClaim operation.
 //
-// List attached GroupLinks.
+// List Claims.
 //
-// GET /admin/claim-groups/{id}/group-links
-func (c *Client) ListClaimGroupGroupLinks(ctx context.Context, params ListClaimGroupGroupLinksParams) (ListClaimGroupGroupLinksRes, error) {
-	res, err := c.sendListClaimGroupGroupLinks(ctx, params)
+// GET /admin/claims
+func (c *Client) ListClaim(ctx context.Context, params ListClaimParams) (ListClaimRes, error) {
+	res, err := c.sendListClaim(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListClaimGroupGroupLinks(ctx context.Context, params ListClaimGroupGroupLinksParams) (res ListClaimGroupGroupLinksRes, err error) {
+func (c *Client) sendListClaim(ctx context.Context, params ListClaimParams) (res ListClaimRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroupGroupLinks"),
+		otelogen.OperationID("listClaim"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/group-links"),
+		semconv.HTTPRouteKey.String("/admin/claims"),
 	}
 
 	// Run stopwatch.
@@ -2026,7 +2155,7 @@ func (c *Client) sendListClaimGroupGroupLinks(ctx context.Context, params ListCl
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroupGroupLinks",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaim",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2043,27 +2172,8 @@ func (c *Client) sendListClaimGroupGroupLinks(ctx context.Context, params ListCl
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/admin/claim-groups/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/group-links"
+	var pathParts [1]string
+	pathParts[0] = "/admin/claims"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -2115,7 +2225,7 @@ func (c *Client) sendListClaimGroupGroupLinks(ctx context.Context, params ListCl
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListClaimGroupGroupLinks", r); {
+			switch err := c.securityToken(ctx, "ListClaim", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2151,7 +2261,7 @@ func (c *Client) sendListClaimGroupGroupLinks(ctx context.Context, params ListCl
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListClaimGroupGroupLinksResponse(resp)
+	result, err := decodeListClaimResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2159,21 +2269,21 @@ func (c *Client) sendListClaimGroupGroupLinks(ctx context.Context, params ListCl
 	return result, nil
 }
 
-// ListClaimGroupUsers invokes listClaimGroupUsers operation.
+// ListClaimClaimGroups invokes listClaimClaimGroups operation.
 //
-// List attached Users.
+// List attached ClaimGroups.
 //
-// GET /admin/claim-groups/{id}/users
-func (c *Client) ListClaimGroupUsers(ctx context.Context, params ListClaimGroupUsersParams) (ListClaimGroupUsersRes, error) {
-	res, err := c.sendListClaimGroupUsers(ctx, params)
+// GET /admin/claims/{id}/claim-groups
+func (c *Client) ListClaimClaimGroups(ctx context.Context, params ListClaimClaimGroupsParams) (ListClaimClaimGroupsRes, error) {
+	res, err := c.sendListClaimClaimGroups(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGroupUsersParams) (res ListClaimGroupUsersRes, err error) {
+func (c *Client) sendListClaimClaimGroups(ctx context.Context, params ListClaimClaimGroupsParams) (res ListClaimClaimGroupsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroupUsers"),
+		otelogen.OperationID("listClaimClaimGroups"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/users"),
+		semconv.HTTPRouteKey.String("/admin/claims/{id}/claim-groups"),
 	}
 
 	// Run stopwatch.
@@ -2188,7 +2298,7 @@ func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGr
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroupUsers",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimClaimGroups",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2206,7 +2316,7 @@ func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGr
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/admin/claim-groups/"
+	pathParts[0] = "/admin/claims/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -2225,7 +2335,7 @@ func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGr
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/users"
+	pathParts[2] = "/claim-groups"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -2277,7 +2387,7 @@ func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGr
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListClaimGroupUsers", r); {
+			switch err := c.securityToken(ctx, "ListClaimClaimGroups", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2313,7 +2423,7 @@ func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGr
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListClaimGroupUsersResponse(resp)
+	result, err := decodeListClaimClaimGroupsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2321,21 +2431,21 @@ func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGr
 	return result, nil
 }
 
-// ListGroupLink invokes listGroupLink operation.
+// ListClaimGroup invokes listClaimGroup operation.
 //
-// List GroupLinks.
+// List ClaimGroups.
 //
-// GET /admin/group-links
-func (c *Client) ListGroupLink(ctx context.Context, params ListGroupLinkParams) (ListGroupLinkRes, error) {
-	res, err := c.sendListGroupLink(ctx, params)
+// GET /admin/claim-groups
+func (c *Client) ListClaimGroup(ctx context.Context, params ListClaimGroupParams) (ListClaimGroupRes, error) {
+	res, err := c.sendListClaimGroup(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListGroupLink(ctx context.Context, params ListGroupLinkParams) (res ListGroupLinkRes, err error) {
+func (c *Client) sendListClaimGroup(ctx context.Context, params ListClaimGroupParams) (res ListClaimGroupRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listGroupLink"),
+		otelogen.OperationID("listClaimGroup"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/group-links"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups"),
 	}
 
 	// Run stopwatch.
@@ -2350,7 +2460,7 @@ func (c *Client) sendListGroupLink(ctx context.Context, params ListGroupLinkPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListGroupLink",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroup",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2368,7 +2478,7 @@ func (c *Client) sendListGroupLink(ctx context.Context, params ListGroupLinkPara
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/admin/group-links"
+	pathParts[0] = "/admin/claim-groups"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -2420,7 +2530,7 @@ func (c *Client) sendListGroupLink(ctx context.Context, params ListGroupLinkPara
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListGroupLink", r); {
+			switch err := c.securityToken(ctx, "ListClaimGroup", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2456,7 +2566,7 @@ func (c *Client) sendListGroupLink(ctx context.Context, params ListGroupLinkPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListGroupLinkResponse(resp)
+	result, err := decodeListClaimGroupResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2464,21 +2574,21 @@ func (c *Client) sendListGroupLink(ctx context.Context, params ListGroupLinkPara
 	return result, nil
 }
 
-// ListPrivateKey invokes listPrivateKey operation.
+// ListClaimGroupClaims invokes listClaimGroupClaims operation.
 //
-// List PrivateKeys.
+// List attached Claims.
 //
-// GET /admin/private-keys
-func (c *Client) ListPrivateKey(ctx context.Context, params ListPrivateKeyParams) (ListPrivateKeyRes, error) {
-	res, err := c.sendListPrivateKey(ctx, params)
+// GET /admin/claim-groups/{id}/claims
+func (c *Client) ListClaimGroupClaims(ctx context.Context, params ListClaimGroupClaimsParams) (ListClaimGroupClaimsRes, error) {
+	res, err := c.sendListClaimGroupClaims(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListPrivateKey(ctx context.Context, params ListPrivateKeyParams) (res ListPrivateKeyRes, err error) {
+func (c *Client) sendListClaimGroupClaims(ctx context.Context, params ListClaimGroupClaimsParams) (res ListClaimGroupClaimsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listPrivateKey"),
+		otelogen.OperationID("listClaimGroupClaims"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/private-keys"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/claims"),
 	}
 
 	// Run stopwatch.
@@ -2493,7 +2603,7 @@ func (c *Client) sendListPrivateKey(ctx context.Context, params ListPrivateKeyPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListPrivateKey",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroupClaims",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2510,8 +2620,27 @@ func (c *Client) sendListPrivateKey(ctx context.Context, params ListPrivateKeyPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/admin/private-keys"
+	var pathParts [3]string
+	pathParts[0] = "/admin/claim-groups/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/claims"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -2563,7 +2692,7 @@ func (c *Client) sendListPrivateKey(ctx context.Context, params ListPrivateKeyPa
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListPrivateKey", r); {
+			switch err := c.securityToken(ctx, "ListClaimGroupClaims", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2599,7 +2728,7 @@ func (c *Client) sendListPrivateKey(ctx context.Context, params ListPrivateKeyPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListPrivateKeyResponse(resp)
+	result, err := decodeListClaimGroupClaimsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2607,21 +2736,21 @@ func (c *Client) sendListPrivateKey(ctx context.Context, params ListPrivateKeyPa
 	return result, nil
 }
 
-// ListUser invokes listUser operation.
+// ListClaimGroupGroupLinks invokes listClaimGroupGroupLinks operation.
 //
-// List Users.
+// List attached GroupLinks.
 //
-// GET /admin/users
-func (c *Client) ListUser(ctx context.Context, params ListUserParams) (ListUserRes, error) {
-	res, err := c.sendListUser(ctx, params)
+// GET /admin/claim-groups/{id}/group-links
+func (c *Client) ListClaimGroupGroupLinks(ctx context.Context, params ListClaimGroupGroupLinksParams) (ListClaimGroupGroupLinksRes, error) {
+	res, err := c.sendListClaimGroupGroupLinks(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListUser(ctx context.Context, params ListUserParams) (res ListUserRes, err error) {
+func (c *Client) sendListClaimGroupGroupLinks(ctx context.Context, params ListClaimGroupGroupLinksParams) (res ListClaimGroupGroupLinksRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUser"),
+		otelogen.OperationID("listClaimGroupGroupLinks"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/users"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/group-links"),
 	}
 
 	// Run stopwatch.
@@ -2636,7 +2765,7 @@ func (c *Client) sendListUser(ctx context.Context, params ListUserParams) (res L
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListUser",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroupGroupLinks",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2653,8 +2782,27 @@ func (c *Client) sendListUser(ctx context.Context, params ListUserParams) (res L
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/admin/users"
+	var pathParts [3]string
+	pathParts[0] = "/admin/claim-groups/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/group-links"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -2706,7 +2854,7 @@ func (c *Client) sendListUser(ctx context.Context, params ListUserParams) (res L
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListUser", r); {
+			switch err := c.securityToken(ctx, "ListClaimGroupGroupLinks", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2742,7 +2890,7 @@ func (c *Client) sendListUser(ctx context.Context, params ListUserParams) (res L
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListUserResponse(resp)
+	result, err := decodeListClaimGroupGroupLinksResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2750,21 +2898,21 @@ func (c *Client) sendListUser(ctx context.Context, params ListUserParams) (res L
 	return result, nil
 }
 
-// ListUserClaimGroups invokes listUserClaimGroups operation.
+// ListClaimGroupUsers invokes listClaimGroupUsers operation.
 //
-// List attached ClaimGroups.
+// List attached Users.
 //
-// GET /admin/users/{id}/claim-groups
-func (c *Client) ListUserClaimGroups(ctx context.Context, params ListUserClaimGroupsParams) (ListUserClaimGroupsRes, error) {
-	res, err := c.sendListUserClaimGroups(ctx, params)
+// GET /admin/claim-groups/{id}/users
+func (c *Client) ListClaimGroupUsers(ctx context.Context, params ListClaimGroupUsersParams) (ListClaimGroupUsersRes, error) {
+	res, err := c.sendListClaimGroupUsers(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserClaimGroupsParams) (res ListUserClaimGroupsRes, err error) {
+func (c *Client) sendListClaimGroupUsers(ctx context.Context, params ListClaimGroupUsersParams) (res ListClaimGroupUsersRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUserClaimGroups"),
+		otelogen.OperationID("listClaimGroupUsers"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/users/{id}/claim-groups"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/users"),
 	}
 
 	// Run stopwatch.
@@ -2779,7 +2927,7 @@ func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserCla
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ListUserClaimGroups",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListClaimGroupUsers",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2797,7 +2945,7 @@ func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserCla
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/admin/users/"
+	pathParts[0] = "/admin/claim-groups/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -2816,7 +2964,7 @@ func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserCla
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/claim-groups"
+	pathParts[2] = "/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -2868,7 +3016,7 @@ func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserCla
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ListUserClaimGroups", r); {
+			switch err := c.securityToken(ctx, "ListClaimGroupUsers", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2904,7 +3052,7 @@ func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserCla
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListUserClaimGroupsResponse(resp)
+	result, err := decodeListClaimGroupUsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2912,21 +3060,21 @@ func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserCla
 	return result, nil
 }
 
-// Login invokes login operation.
+// ListGroupLink invokes listGroupLink operation.
 //
-// Request a token.
+// List GroupLinks.
 //
-// POST /login
-func (c *Client) Login(ctx context.Context, request *LoginReq) (LoginRes, error) {
-	res, err := c.sendLogin(ctx, request)
+// GET /admin/group-links
+func (c *Client) ListGroupLink(ctx context.Context, params ListGroupLinkParams) (ListGroupLinkRes, error) {
+	res, err := c.sendListGroupLink(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendLogin(ctx context.Context, request *LoginReq) (res LoginRes, err error) {
+func (c *Client) sendListGroupLink(ctx context.Context, params ListGroupLinkParams) (res ListGroupLinkRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("login"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/login"),
+		otelogen.OperationID("listGroupLink"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/group-links"),
 	}
 
 	// Run stopwatch.
@@ -2941,7 +3089,7 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginReq) (res LoginRes
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "Login",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListGroupLink",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2959,27 +3107,95 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginReq) (res LoginRes
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/login"
+	pathParts[0] = "/admin/group-links"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeLoginRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
-	}
-	defer resp.Body.Close()
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "itemsPerPage" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "itemsPerPage",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ItemsPerPage.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ListGroupLink", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLoginResponse(resp)
+	result, err := decodeListGroupLinkResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2987,21 +3203,21 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginReq) (res LoginRes
 	return result, nil
 }
 
-// Pkeys invokes pkeys operation.
+// ListIdentities invokes listIdentities operation.
 //
-// Get current valid public keys.
+// List linked identities.
 //
-// GET /pkeys
-func (c *Client) Pkeys(ctx context.Context) (*PkeysOK, error) {
-	res, err := c.sendPkeys(ctx)
+// GET /admin/identities
+func (c *Client) ListIdentities(ctx context.Context, params ListIdentitiesParams) (*ListIdentitiesOK, error) {
+	res, err := c.sendListIdentities(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPkeys(ctx context.Context) (res *PkeysOK, err error) {
+func (c *Client) sendListIdentities(ctx context.Context, params ListIdentitiesParams) (res *ListIdentitiesOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pkeys"),
+		otelogen.OperationID("listIdentities"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/pkeys"),
+		semconv.HTTPRouteKey.String("/admin/identities"),
 	}
 
 	// Run stopwatch.
@@ -3016,7 +3232,7 @@ func (c *Client) sendPkeys(ctx context.Context) (res *PkeysOK, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "Pkeys",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListIdentities",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3034,7 +3250,133 @@ func (c *Client) sendPkeys(ctx context.Context) (res *PkeysOK, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/pkeys"
+	pathParts[0] = "/admin/identities"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "username" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Username.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ListIdentities", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListIdentitiesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListMyIdentities invokes listMyIdentities operation.
+//
+// List the signed in user's identities.
+//
+// GET /identities
+func (c *Client) ListMyIdentities(ctx context.Context) (ListMyIdentitiesRes, error) {
+	res, err := c.sendListMyIdentities(ctx)
+	return res, err
+}
+
+func (c *Client) sendListMyIdentities(ctx context.Context) (res ListMyIdentitiesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listMyIdentities"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/identities"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListMyIdentities",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/identities"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3043,6 +3385,39 @@ func (c *Client) sendPkeys(ctx context.Context) (res *PkeysOK, err error) {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ListMyIdentities", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -3051,7 +3426,7 @@ func (c *Client) sendPkeys(ctx context.Context) (res *PkeysOK, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePkeysResponse(resp)
+	result, err := decodeListMyIdentitiesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3059,21 +3434,1243 @@ func (c *Client) sendPkeys(ctx context.Context) (res *PkeysOK, err error) {
 	return result, nil
 }
 
-// ReadClaim invokes readClaim operation.
+// ListPrivateKey invokes listPrivateKey operation.
 //
-// Finds the Claim with the requested ID and returns it.
+// List PrivateKeys.
 //
-// GET /admin/claims/{id}
-func (c *Client) ReadClaim(ctx context.Context, params ReadClaimParams) (ReadClaimRes, error) {
-	res, err := c.sendReadClaim(ctx, params)
+// GET /admin/private-keys
+func (c *Client) ListPrivateKey(ctx context.Context, params ListPrivateKeyParams) (ListPrivateKeyRes, error) {
+	res, err := c.sendListPrivateKey(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListPrivateKey(ctx context.Context, params ListPrivateKeyParams) (res ListPrivateKeyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listPrivateKey"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/private-keys"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListPrivateKey",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/admin/private-keys"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "itemsPerPage" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "itemsPerPage",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ItemsPerPage.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ListPrivateKey", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListPrivateKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListUser invokes listUser operation.
+//
+// List Users.
+//
+// GET /admin/users
+func (c *Client) ListUser(ctx context.Context, params ListUserParams) (ListUserRes, error) {
+	res, err := c.sendListUser(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListUser(ctx context.Context, params ListUserParams) (res ListUserRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listUser"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/users"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListUser",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/admin/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "itemsPerPage" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "itemsPerPage",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ItemsPerPage.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ListUser", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListUserClaimGroups invokes listUserClaimGroups operation.
+//
+// List attached ClaimGroups.
+//
+// GET /admin/users/{id}/claim-groups
+func (c *Client) ListUserClaimGroups(ctx context.Context, params ListUserClaimGroupsParams) (ListUserClaimGroupsRes, error) {
+	res, err := c.sendListUserClaimGroups(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListUserClaimGroups(ctx context.Context, params ListUserClaimGroupsParams) (res ListUserClaimGroupsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listUserClaimGroups"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/users/{id}/claim-groups"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListUserClaimGroups",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/admin/users/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/claim-groups"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "itemsPerPage" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "itemsPerPage",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ItemsPerPage.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ListUserClaimGroups", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListUserClaimGroupsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Login invokes login operation.
+//
+// Request a token.
+//
+// POST /login
+func (c *Client) Login(ctx context.Context, request *LoginReq) (LoginRes, error) {
+	res, err := c.sendLogin(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLogin(ctx context.Context, request *LoginReq) (res LoginRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("login"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "Login",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLoginRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LoginExchange invokes loginExchange operation.
+//
+// Redeem a login code issued after a server-side provider login.
+//
+// POST /login/exchange
+func (c *Client) LoginExchange(ctx context.Context, request *LoginExchangeReq) (LoginExchangeRes, error) {
+	res, err := c.sendLoginExchange(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLoginExchange(ctx context.Context, request *LoginExchangeReq) (res LoginExchangeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("loginExchange"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login/exchange"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "LoginExchange",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/login/exchange"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLoginExchangeRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLoginExchangeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LoginMFA invokes loginMFA operation.
+//
+// Answer an MFA challenge to receive a token.
+//
+// POST /login/mfa
+func (c *Client) LoginMFA(ctx context.Context, request *LoginMFAReq) (LoginMFARes, error) {
+	res, err := c.sendLoginMFA(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLoginMFA(ctx context.Context, request *LoginMFAReq) (res LoginMFARes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("loginMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login/mfa"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "LoginMFA",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/login/mfa"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLoginMFARequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLoginMFAResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Pkeys invokes pkeys operation.
+//
+// Returns JWKS (merged from all peers when clustered). Optional query: local=true or local=1 to
+// return only this node's keys (used by peers to avoid recursion).
+//
+// GET /pkeys
+func (c *Client) Pkeys(ctx context.Context) (*PkeysOK, error) {
+	res, err := c.sendPkeys(ctx)
+	return res, err
+}
+
+func (c *Client) sendPkeys(ctx context.Context) (res *PkeysOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pkeys"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/pkeys"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "Pkeys",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/pkeys"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePkeysResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ProviderHealth invokes provider_health operation.
+//
+// Reports the circuit breaker state of each foreign provider, the number of consecutive logins that
+// failed because the provider could not be reached and the times of the last successful and failed
+// logins. Providers are degraded while logins are failing or the circuit breaker is not closed.
+//
+// GET /provider_health
+func (c *Client) ProviderHealth(ctx context.Context) (*ProviderHealthOK, error) {
+	res, err := c.sendProviderHealth(ctx)
+	return res, err
+}
+
+func (c *Client) sendProviderHealth(ctx context.Context) (res *ProviderHealthOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("provider_health"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/provider_health"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ProviderHealth",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/provider_health"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ProviderHealth", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeProviderHealthResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReadClaim invokes readClaim operation.
+//
+// Finds the Claim with the requested ID and returns it.
+//
+// GET /admin/claims/{id}
+func (c *Client) ReadClaim(ctx context.Context, params ReadClaimParams) (ReadClaimRes, error) {
+	res, err := c.sendReadClaim(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res ReadClaimRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("readClaim"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/claims/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ReadClaim",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/admin/claims/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ReadClaim", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeReadClaimResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReadClaimGroup invokes readClaimGroup operation.
+//
+// Finds the ClaimGroup with the requested ID and returns it.
+//
+// GET /admin/claim-groups/{id}
+func (c *Client) ReadClaimGroup(ctx context.Context, params ReadClaimGroupParams) (ReadClaimGroupRes, error) {
+	res, err := c.sendReadClaimGroup(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendReadClaimGroup(ctx context.Context, params ReadClaimGroupParams) (res ReadClaimGroupRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("readClaimGroup"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ReadClaimGroup",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/admin/claim-groups/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ReadClaimGroup", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeReadClaimGroupResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReadGroupLink invokes readGroupLink operation.
+//
+// Finds the GroupLink with the requested ID and returns it.
+//
+// GET /admin/group-links/{id}
+func (c *Client) ReadGroupLink(ctx context.Context, params ReadGroupLinkParams) (ReadGroupLinkRes, error) {
+	res, err := c.sendReadGroupLink(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendReadGroupLink(ctx context.Context, params ReadGroupLinkParams) (res ReadGroupLinkRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("readGroupLink"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/group-links/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ReadGroupLink",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/admin/group-links/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Token"
+			switch err := c.securityToken(ctx, "ReadGroupLink", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Token\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeReadGroupLinkResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReadGroupLinkClaimGroup invokes readGroupLinkClaimGroup operation.
+//
+// Find the attached ClaimGroup of the GroupLink with the given ID.
+//
+// GET /admin/group-links/{id}/claim-group
+func (c *Client) ReadGroupLinkClaimGroup(ctx context.Context, params ReadGroupLinkClaimGroupParams) (ReadGroupLinkClaimGroupRes, error) {
+	res, err := c.sendReadGroupLinkClaimGroup(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res ReadClaimRes, err error) {
+func (c *Client) sendReadGroupLinkClaimGroup(ctx context.Context, params ReadGroupLinkClaimGroupParams) (res ReadGroupLinkClaimGroupRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readClaim"),
+		otelogen.OperationID("readGroupLinkClaimGroup"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claims/{id}"),
+		semconv.HTTPRouteKey.String("/admin/group-links/{id}/claim-group"),
 	}
 
 	// Run stopwatch.
@@ -3088,7 +4685,7 @@ func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ReadClaim",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ReadGroupLinkClaimGroup",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3105,8 +4702,8 @@ func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/admin/claims/"
+	var pathParts [3]string
+	pathParts[0] = "/admin/group-links/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3125,6 +4722,7 @@ func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/claim-group"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3138,7 +4736,7 @@ func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ReadClaim", r); {
+			switch err := c.securityToken(ctx, "ReadGroupLinkClaimGroup", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3174,7 +4772,7 @@ func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReadClaimResponse(resp)
+	result, err := decodeReadGroupLinkClaimGroupResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3182,21 +4780,21 @@ func (c *Client) sendReadClaim(ctx context.Context, params ReadClaimParams) (res
 	return result, nil
 }
 
-// ReadClaimGroup invokes readClaimGroup operation.
+// ReadPrivateKey invokes readPrivateKey operation.
 //
-// Finds the ClaimGroup with the requested ID and returns it.
+// Finds the PrivateKey with the requested ID and returns it.
 //
-// GET /admin/claim-groups/{id}
-func (c *Client) ReadClaimGroup(ctx context.Context, params ReadClaimGroupParams) (ReadClaimGroupRes, error) {
-	res, err := c.sendReadClaimGroup(ctx, params)
+// GET /admin/private-keys/{id}
+func (c *Client) ReadPrivateKey(ctx context.Context, params ReadPrivateKeyParams) (ReadPrivateKeyRes, error) {
+	res, err := c.sendReadPrivateKey(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendReadClaimGroup(ctx context.Context, params ReadClaimGroupParams) (res ReadClaimGroupRes, err error) {
+func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyParams) (res ReadPrivateKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readClaimGroup"),
+		otelogen.OperationID("readPrivateKey"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}"),
+		semconv.HTTPRouteKey.String("/admin/private-keys/{id}"),
 	}
 
 	// Run stopwatch.
@@ -3211,7 +4809,7 @@ func (c *Client) sendReadClaimGroup(ctx context.Context, params ReadClaimGroupPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ReadClaimGroup",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ReadPrivateKey",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3229,7 +4827,7 @@ func (c *Client) sendReadClaimGroup(ctx context.Context, params ReadClaimGroupPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/admin/claim-groups/"
+	pathParts[0] = "/admin/private-keys/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3261,7 +4859,7 @@ func (c *Client) sendReadClaimGroup(ctx context.Context, params ReadClaimGroupPa
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ReadClaimGroup", r); {
+			switch err := c.securityToken(ctx, "ReadPrivateKey", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3297,7 +4895,7 @@ func (c *Client) sendReadClaimGroup(ctx context.Context, params ReadClaimGroupPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReadClaimGroupResponse(resp)
+	result, err := decodeReadPrivateKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3305,21 +4903,21 @@ func (c *Client) sendReadClaimGroup(ctx context.Context, params ReadClaimGroupPa
 	return result, nil
 }
 
-// ReadGroupLink invokes readGroupLink operation.
+// ReadUser invokes readUser operation.
 //
-// Finds the GroupLink with the requested ID and returns it.
+// Finds the User with the requested ID and returns it.
 //
-// GET /admin/group-links/{id}
-func (c *Client) ReadGroupLink(ctx context.Context, params ReadGroupLinkParams) (ReadGroupLinkRes, error) {
-	res, err := c.sendReadGroupLink(ctx, params)
+// GET /admin/users/{id}
+func (c *Client) ReadUser(ctx context.Context, params ReadUserParams) (ReadUserRes, error) {
+	res, err := c.sendReadUser(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendReadGroupLink(ctx context.Context, params ReadGroupLinkParams) (res ReadGroupLinkRes, err error) {
+func (c *Client) sendReadUser(ctx context.Context, params ReadUserParams) (res ReadUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readGroupLink"),
+		otelogen.OperationID("readUser"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/group-links/{id}"),
+		semconv.HTTPRouteKey.String("/admin/users/{id}"),
 	}
 
 	// Run stopwatch.
@@ -3334,7 +4932,7 @@ func (c *Client) sendReadGroupLink(ctx context.Context, params ReadGroupLinkPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ReadGroupLink",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ReadUser",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3352,7 +4950,7 @@ func (c *Client) sendReadGroupLink(ctx context.Context, params ReadGroupLinkPara
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/admin/group-links/"
+	pathParts[0] = "/admin/users/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3384,7 +4982,7 @@ func (c *Client) sendReadGroupLink(ctx context.Context, params ReadGroupLinkPara
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ReadGroupLink", r); {
+			switch err := c.securityToken(ctx, "ReadUser", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3420,7 +5018,7 @@ func (c *Client) sendReadGroupLink(ctx context.Context, params ReadGroupLinkPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReadGroupLinkResponse(resp)
+	result, err := decodeReadUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3428,21 +5026,21 @@ func (c *Client) sendReadGroupLink(ctx context.Context, params ReadGroupLinkPara
 	return result, nil
 }
 
-// ReadGroupLinkClaimGroup invokes readGroupLinkClaimGroup operation.
+// Refresh invokes refresh operation.
 //
-// Find the attached ClaimGroup of the GroupLink with the given ID.
+// Request a refreshed token.
 //
-// GET /admin/group-links/{id}/claim-group
-func (c *Client) ReadGroupLinkClaimGroup(ctx context.Context, params ReadGroupLinkClaimGroupParams) (ReadGroupLinkClaimGroupRes, error) {
-	res, err := c.sendReadGroupLinkClaimGroup(ctx, params)
+// POST /refresh
+func (c *Client) Refresh(ctx context.Context, request *RefreshReq) (RefreshRes, error) {
+	res, err := c.sendRefresh(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendReadGroupLinkClaimGroup(ctx context.Context, params ReadGroupLinkClaimGroupParams) (res ReadGroupLinkClaimGroupRes, err error) {
+func (c *Client) sendRefresh(ctx context.Context, request *RefreshReq) (res RefreshRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readGroupLinkClaimGroup"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/group-links/{id}/claim-group"),
+		otelogen.OperationID("refresh"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/refresh"),
 	}
 
 	// Run stopwatch.
@@ -3457,7 +5055,7 @@ func (c *Client) sendReadGroupLinkClaimGroup(ctx context.Context, params ReadGro
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ReadGroupLinkClaimGroup",
+	ctx, span := c.cfg.Tracer.Start(ctx, "Refresh",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3474,41 +5072,25 @@ func (c *Client) sendReadGroupLinkClaimGroup(ctx context.Context, params ReadGro
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/admin/group-links/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/claim-group"
+	var pathParts [1]string
+	pathParts[0] = "/refresh"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeRefreshRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ReadGroupLinkClaimGroup", r); {
+			switch err := c.securityToken(ctx, "Refresh", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3544,7 +5126,7 @@ func (c *Client) sendReadGroupLinkClaimGroup(ctx context.Context, params ReadGro
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReadGroupLinkClaimGroupResponse(resp)
+	result, err := decodeRefreshResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3552,21 +5134,21 @@ func (c *Client) sendReadGroupLinkClaimGroup(ctx context.Context, params ReadGro
 	return result, nil
 }
 
-// ReadPrivateKey invokes readPrivateKey operation.
+// ResetMFA invokes resetMFA operation.
 //
-// Finds the PrivateKey with the requested ID and returns it.
+// Used when a user lost their authenticator and recovery codes.
 //
-// GET /admin/private-keys/{id}
-func (c *Client) ReadPrivateKey(ctx context.Context, params ReadPrivateKeyParams) (ReadPrivateKeyRes, error) {
-	res, err := c.sendReadPrivateKey(ctx, params)
+// DELETE /admin/mfa/{username}
+func (c *Client) ResetMFA(ctx context.Context, params ResetMFAParams) (ResetMFARes, error) {
+	res, err := c.sendResetMFA(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyParams) (res ReadPrivateKeyRes, err error) {
+func (c *Client) sendResetMFA(ctx context.Context, params ResetMFAParams) (res ResetMFARes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readPrivateKey"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/private-keys/{id}"),
+		otelogen.OperationID("resetMFA"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/admin/mfa/{username}"),
 	}
 
 	// Run stopwatch.
@@ -3581,7 +5163,7 @@ func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ReadPrivateKey",
+	ctx, span := c.cfg.Tracer.Start(ctx, "ResetMFA",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3599,16 +5181,16 @@ func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/admin/private-keys/"
+	pathParts[0] = "/admin/mfa/"
 	{
-		// Encode "id" parameter.
+		// Encode "username" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "username",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.StringToString(params.Username))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3621,7 +5203,7 @@ func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyPa
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3631,7 +5213,7 @@ func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyPa
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ReadPrivateKey", r); {
+			switch err := c.securityToken(ctx, "ResetMFA", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3667,7 +5249,7 @@ func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReadPrivateKeyResponse(resp)
+	result, err := decodeResetMFAResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3675,21 +5257,21 @@ func (c *Client) sendReadPrivateKey(ctx context.Context, params ReadPrivateKeyPa
 	return result, nil
 }
 
-// ReadUser invokes readUser operation.
+// Totals invokes totals operation.
 //
-// Finds the User with the requested ID and returns it.
+// Get entity count totals.
 //
-// GET /admin/users/{id}
-func (c *Client) ReadUser(ctx context.Context, params ReadUserParams) (ReadUserRes, error) {
-	res, err := c.sendReadUser(ctx, params)
+// GET /admin/totals
+func (c *Client) Totals(ctx context.Context) (*TotalsOK, error) {
+	res, err := c.sendTotals(ctx)
 	return res, err
 }
 
-func (c *Client) sendReadUser(ctx context.Context, params ReadUserParams) (res ReadUserRes, err error) {
+func (c *Client) sendTotals(ctx context.Context) (res *TotalsOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readUser"),
+		otelogen.OperationID("totals"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/users/{id}"),
+		semconv.HTTPRouteKey.String("/admin/totals"),
 	}
 
 	// Run stopwatch.
@@ -3704,7 +5286,7 @@ func (c *Client) sendReadUser(ctx context.Context, params ReadUserParams) (res R
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "ReadUser",
+	ctx, span := c.cfg.Tracer.Start(ctx, "Totals",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3721,26 +5303,8 @@ func (c *Client) sendReadUser(ctx context.Context, params ReadUserParams) (res R
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/admin/users/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/admin/totals"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3754,7 +5318,7 @@ func (c *Client) sendReadUser(ctx context.Context, params ReadUserParams) (res R
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "ReadUser", r); {
+			switch err := c.securityToken(ctx, "Totals", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3790,7 +5354,7 @@ func (c *Client) sendReadUser(ctx context.Context, params ReadUserParams) (res R
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReadUserResponse(resp)
+	result, err := decodeTotalsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3798,21 +5362,21 @@ func (c *Client) sendReadUser(ctx context.Context, params ReadUserParams) (res R
 	return result, nil
 }
 
-// Refresh invokes refresh operation.
+// UnlinkIdentity invokes unlinkIdentity operation.
 //
-// Request a refreshed token.
+// The only identity of a user without a local password can not be unlinked.
 //
-// POST /refresh
-func (c *Client) Refresh(ctx context.Context, request *RefreshReq) (RefreshRes, error) {
-	res, err := c.sendRefresh(ctx, request)
+// DELETE /admin/identities/{id}
+func (c *Client) UnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) (UnlinkIdentityRes, error) {
+	res, err := c.sendUnlinkIdentity(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRefresh(ctx context.Context, request *RefreshReq) (res RefreshRes, err error) {
+func (c *Client) sendUnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) (res UnlinkIdentityRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("refresh"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/refresh"),
+		otelogen.OperationID("unlinkIdentity"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/admin/identities/{id}"),
 	}
 
 	// Run stopwatch.
@@ -3827,7 +5391,7 @@ func (c *Client) sendRefresh(ctx context.Context, request *RefreshReq) (res Refr
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "Refresh",
+	ctx, span := c.cfg.Tracer.Start(ctx, "UnlinkIdentity",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3844,25 +5408,40 @@ func (c *Client) sendRefresh(ctx context.Context, request *RefreshReq) (res Refr
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/refresh"
+	var pathParts [2]string
+	pathParts[0] = "/admin/identities/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRefreshRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "Refresh", r); {
+			switch err := c.securityToken(ctx, "UnlinkIdentity", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3898,7 +5477,7 @@ func (c *Client) sendRefresh(ctx context.Context, request *RefreshReq) (res Refr
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRefreshResponse(resp)
+	result, err := decodeUnlinkIdentityResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3906,21 +5485,21 @@ func (c *Client) sendRefresh(ctx context.Context, request *RefreshReq) (res Refr
 	return result, nil
 }
 
-// Totals invokes totals operation.
+// UnlinkMyIdentity invokes unlinkMyIdentity operation.
 //
-// Get entity count totals.
+// The only identity of a user without a local password can not be unlinked.
 //
-// GET /admin/totals
-func (c *Client) Totals(ctx context.Context) (*TotalsOK, error) {
-	res, err := c.sendTotals(ctx)
+// DELETE /identities/{id}
+func (c *Client) UnlinkMyIdentity(ctx context.Context, params UnlinkMyIdentityParams) (UnlinkMyIdentityRes, error) {
+	res, err := c.sendUnlinkMyIdentity(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTotals(ctx context.Context) (res *TotalsOK, err error) {
+func (c *Client) sendUnlinkMyIdentity(ctx context.Context, params UnlinkMyIdentityParams) (res UnlinkMyIdentityRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("totals"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/totals"),
+		otelogen.OperationID("unlinkMyIdentity"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/identities/{id}"),
 	}
 
 	// Run stopwatch.
@@ -3935,7 +5514,7 @@ func (c *Client) sendTotals(ctx context.Context) (res *TotalsOK, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "Totals",
+	ctx, span := c.cfg.Tracer.Start(ctx, "UnlinkMyIdentity",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3952,12 +5531,30 @@ func (c *Client) sendTotals(ctx context.Context) (res *TotalsOK, err error) {
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/admin/totals"
+	var pathParts [2]string
+	pathParts[0] = "/identities/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3967,7 +5564,7 @@ func (c *Client) sendTotals(ctx context.Context) (res *TotalsOK, err error) {
 		var satisfied bitset
 		{
 			stage = "Security:Token"
-			switch err := c.securityToken(ctx, "Totals", r); {
+			switch err := c.securityToken(ctx, "UnlinkMyIdentity", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4003,7 +5600,7 @@ func (c *Client) sendTotals(ctx context.Context) (res *TotalsOK, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTotalsResponse(resp)
+	result, err := decodeUnlinkMyIdentityResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10,6 +10,14 @@ func (s *CreateLocalUserOK) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *DisableMFAOK) setDefaults() {
+	{
+		val := string("MFA Disabled")
+		s.Message = val
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *LoginBadRequest) setDefaults() {
 	{
@@ -18,6 +26,22 @@ func (s *LoginBadRequest) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *LoginExchangeUnauthorized) setDefaults() {
+	{
+		val := string("Not Authorized")
+		s.Message.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *LoginMFAUnauthorized) setDefaults() {
+	{
+		val := string("Not Authorized")
+		s.Message.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *LoginUnauthorized) setDefaults() {
 	{
@@ -42,6 +66,30 @@ func (s *RefreshUnauthorized) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *ResetMFAOK) setDefaults() {
+	{
+		val := string("MFA Disabled")
+		s.Message = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *UnlinkIdentityOK) setDefaults() {
+	{
+		val := string("Identity Unlinked")
+		s.Message = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *UnlinkMyIdentityOK) setDefaults() {
+	{
+		val := string("Identity Unlinked")
+		s.Message = val
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *UpdateLocalUserPasswordOK) setDefaults() {
 	{
@@ -20,6 +20,157 @@ import (
 	"github.com/ogen-go/ogen/otelogen"
 )
 
+// handleActivateMFARequest handles activateMFA operation.
+//
+// Enables MFA for the signed in user when code was made with the enrolled secret. Recovery codes are
+// only returned once.
+//
+// POST /mfa/activate
+func (s *Server) handleActivateMFARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("activateMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/mfa/activate"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ActivateMFA",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ActivateMFA",
+			ID:   "activateMFA",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ActivateMFA", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	request, close, err := s.decodeActivateMFARequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ActivateMFARes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ActivateMFA",
+			OperationSummary: "Enable MFA",
+			OperationID:      "activateMFA",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *ActivateMFAReq
+			Params   = struct{}
+			Response = ActivateMFARes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ActivateMFA(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ActivateMFA(ctx, request)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeActivateMFAResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleAvailableProvidersRequest handles available_providers operation.
 //
 // Get available providers.
@@ -242,6 +393,142 @@ func (s *Server) handleCapabilitiesRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
+// handleClusterStatusRequest handles cluster_status operation.
+//
+// Reports discovered peers, the result of the last public key fetch from each peer, the merged key
+// cache expiry and any key ids published by more than one peer with different key material.
+//
+// GET /cluster
+func (s *Server) handleClusterStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("cluster_status"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/cluster"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ClusterStatus",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ClusterStatus",
+			ID:   "cluster_status",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ClusterStatus", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response *ClusterStatusOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ClusterStatus",
+			OperationSummary: "Get the status of this instance's view of the cluster",
+			OperationID:      "cluster_status",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *ClusterStatusOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ClusterStatus(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ClusterStatus(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeClusterStatusResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleCreateClaimRequest handles createClaim operation.
 //
 // Creates a new Claim and persists it to storage.
@@ -1442,20 +1729,20 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleListClaimRequest handles listClaim operation.
+// handleDisableMFARequest handles disableMFA operation.
 //
-// List Claims.
+// Disable MFA.
 //
-// GET /admin/claims
-func (s *Server) handleListClaimRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /mfa/disable
+func (s *Server) handleDisableMFARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaim"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claims"),
+		otelogen.OperationID("disableMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/mfa/disable"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaim",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DisableMFA",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1480,15 +1767,15 @@ func (s *Server) handleListClaimRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListClaim",
-			ID:   "listClaim",
+			Name: "DisableMFA",
+			ID:   "disableMFA",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListClaim", r)
+			sctx, ok, err := s.securityToken(ctx, "DisableMFA", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1528,42 +1815,38 @@ func (s *Server) handleListClaimRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-	params, err := decodeListClaimParams(args, argsEscaped, r)
+	request, close, err := s.decodeDisableMFARequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListClaimRes
+	var response DisableMFARes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListClaim",
-			OperationSummary: "List Claims",
-			OperationID:      "listClaim",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "itemsPerPage",
-					In:   "query",
-				}: params.ItemsPerPage,
-			},
-			Raw: r,
+			OperationName:    "DisableMFA",
+			OperationSummary: "Disable MFA",
+			OperationID:      "disableMFA",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ListClaimParams
-			Response = ListClaimRes
+			Request  = *DisableMFAReq
+			Params   = struct{}
+			Response = DisableMFARes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1572,14 +1855,14 @@ func (s *Server) handleListClaimRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackListClaimParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListClaim(ctx, params)
+				response, err = s.h.DisableMFA(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListClaim(ctx, params)
+		response, err = s.h.DisableMFA(ctx, request)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -1587,7 +1870,7 @@ func (s *Server) handleListClaimRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListClaimResponse(response, w, span); err != nil {
+	if err := encodeDisableMFAResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1596,20 +1879,21 @@ func (s *Server) handleListClaimRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleListClaimClaimGroupsRequest handles listClaimClaimGroups operation.
+// handleEnrollMFARequest handles enrollMFA operation.
 //
-// List attached ClaimGroups.
+// Creates a new TOTP secret for the signed in user. MFA is enabled once a code made with the secret
+// is sent to /mfa/activate.
 //
-// GET /admin/claims/{id}/claim-groups
-func (s *Server) handleListClaimClaimGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /mfa/enroll
+func (s *Server) handleEnrollMFARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimClaimGroups"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claims/{id}/claim-groups"),
+		otelogen.OperationID("enrollMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/mfa/enroll"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimClaimGroups",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "EnrollMFA",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1634,15 +1918,15 @@ func (s *Server) handleListClaimClaimGroupsRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListClaimClaimGroups",
-			ID:   "listClaimClaimGroups",
+			Name: "EnrollMFA",
+			ID:   "enrollMFA",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListClaimClaimGroups", r)
+			sctx, ok, err := s.securityToken(ctx, "EnrollMFA", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1682,46 +1966,23 @@ func (s *Server) handleListClaimClaimGroupsRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeListClaimClaimGroupsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response ListClaimClaimGroupsRes
+	var response EnrollMFARes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListClaimClaimGroups",
-			OperationSummary: "List attached ClaimGroups",
-			OperationID:      "listClaimClaimGroups",
+			OperationName:    "EnrollMFA",
+			OperationSummary: "Start MFA enrollment",
+			OperationID:      "enrollMFA",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "itemsPerPage",
-					In:   "query",
-				}: params.ItemsPerPage,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListClaimClaimGroupsParams
-			Response = ListClaimClaimGroupsRes
+			Params   = struct{}
+			Response = EnrollMFARes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1730,14 +1991,14 @@ func (s *Server) handleListClaimClaimGroupsRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackListClaimClaimGroupsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListClaimClaimGroups(ctx, params)
+				response, err = s.h.EnrollMFA(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListClaimClaimGroups(ctx, params)
+		response, err = s.h.EnrollMFA(ctx)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -1745,7 +2006,7 @@ func (s *Server) handleListClaimClaimGroupsRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeListClaimClaimGroupsResponse(response, w, span); err != nil {
+	if err := encodeEnrollMFAResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1754,20 +2015,21 @@ func (s *Server) handleListClaimClaimGroupsRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleListClaimGroupRequest handles listClaimGroup operation.
+// handleLinkIdentityRequest handles linkIdentity operation.
 //
-// List ClaimGroups.
+// Links the account with subject at provider to the user without logging in to the provider. The
+// account must not be linked to another user.
 //
-// GET /admin/claim-groups
-func (s *Server) handleListClaimGroupRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /admin/identities
+func (s *Server) handleLinkIdentityRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroup"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups"),
+		otelogen.OperationID("linkIdentity"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/admin/identities"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroup",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "LinkIdentity",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1792,15 +2054,15 @@ func (s *Server) handleListClaimGroupRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListClaimGroup",
-			ID:   "listClaimGroup",
+			Name: "LinkIdentity",
+			ID:   "linkIdentity",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListClaimGroup", r)
+			sctx, ok, err := s.securityToken(ctx, "LinkIdentity", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1840,42 +2102,38 @@ func (s *Server) handleListClaimGroupRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeListClaimGroupParams(args, argsEscaped, r)
+	request, close, err := s.decodeLinkIdentityRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListClaimGroupRes
+	var response LinkIdentityRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListClaimGroup",
-			OperationSummary: "List ClaimGroups",
-			OperationID:      "listClaimGroup",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "itemsPerPage",
-					In:   "query",
-				}: params.ItemsPerPage,
-			},
-			Raw: r,
+			OperationName:    "LinkIdentity",
+			OperationSummary: "Link a provider account to a user",
+			OperationID:      "linkIdentity",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ListClaimGroupParams
-			Response = ListClaimGroupRes
+			Request  = *LinkIdentityReq
+			Params   = struct{}
+			Response = LinkIdentityRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1884,14 +2142,14 @@ func (s *Server) handleListClaimGroupRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackListClaimGroupParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListClaimGroup(ctx, params)
+				response, err = s.h.LinkIdentity(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListClaimGroup(ctx, params)
+		response, err = s.h.LinkIdentity(ctx, request)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -1899,7 +2157,7 @@ func (s *Server) handleListClaimGroupRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListClaimGroupResponse(response, w, span); err != nil {
+	if err := encodeLinkIdentityResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1908,20 +2166,21 @@ func (s *Server) handleListClaimGroupRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListClaimGroupClaimsRequest handles listClaimGroupClaims operation.
+// handleLinkMyIdentityRequest handles linkMyIdentity operation.
 //
-// List attached Claims.
+// The user proves the account is theirs by logging in to the provider. Token based providers take
+// the provider token as the password.
 //
-// GET /admin/claim-groups/{id}/claims
-func (s *Server) handleListClaimGroupClaimsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /identities
+func (s *Server) handleLinkMyIdentityRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroupClaims"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/claims"),
+		otelogen.OperationID("linkMyIdentity"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/identities"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroupClaims",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "LinkMyIdentity",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1946,15 +2205,15 @@ func (s *Server) handleListClaimGroupClaimsRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListClaimGroupClaims",
-			ID:   "listClaimGroupClaims",
+			Name: "LinkMyIdentity",
+			ID:   "linkMyIdentity",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListClaimGroupClaims", r)
+			sctx, ok, err := s.securityToken(ctx, "LinkMyIdentity", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1994,46 +2253,38 @@ func (s *Server) handleListClaimGroupClaimsRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeListClaimGroupClaimsParams(args, argsEscaped, r)
+	request, close, err := s.decodeLinkMyIdentityRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListClaimGroupClaimsRes
+	var response LinkMyIdentityRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListClaimGroupClaims",
-			OperationSummary: "List attached Claims",
-			OperationID:      "listClaimGroupClaims",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "itemsPerPage",
-					In:   "query",
-				}: params.ItemsPerPage,
-			},
-			Raw: r,
+			OperationName:    "LinkMyIdentity",
+			OperationSummary: "Link a provider account to the signed in user",
+			OperationID:      "linkMyIdentity",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ListClaimGroupClaimsParams
-			Response = ListClaimGroupClaimsRes
+			Request  = *LinkMyIdentityReq
+			Params   = struct{}
+			Response = LinkMyIdentityRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2042,14 +2293,14 @@ func (s *Server) handleListClaimGroupClaimsRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackListClaimGroupClaimsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListClaimGroupClaims(ctx, params)
+				response, err = s.h.LinkMyIdentity(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListClaimGroupClaims(ctx, params)
+		response, err = s.h.LinkMyIdentity(ctx, request)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -2057,7 +2308,7 @@ func (s *Server) handleListClaimGroupClaimsRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeListClaimGroupClaimsResponse(response, w, span); err != nil {
+	if err := encodeLinkMyIdentityResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2066,20 +2317,20 @@ func (s *Server) handleListClaimGroupClaimsRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleListClaimGroupGroupLinksRequest handles listClaimGroupGroupLinks operation.
+// handleListClaimRequest handles listClaim operation.
 //
-// List attached GroupLinks.
+// List Claims.
 //
-// GET /admin/claim-groups/{id}/group-links
-func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/claims
+func (s *Server) handleListClaimRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroupGroupLinks"),
+		otelogen.OperationID("listClaim"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/group-links"),
+		semconv.HTTPRouteKey.String("/admin/claims"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroupGroupLinks",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaim",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2104,15 +2355,15 @@ func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListClaimGroupGroupLinks",
-			ID:   "listClaimGroupGroupLinks",
+			Name: "ListClaim",
+			ID:   "listClaim",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListClaimGroupGroupLinks", r)
+			sctx, ok, err := s.securityToken(ctx, "ListClaim", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2152,7 +2403,7 @@ func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeListClaimGroupGroupLinksParams(args, argsEscaped, r)
+	params, err := decodeListClaimParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2163,19 +2414,15 @@ func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscap
 		return
 	}
 
-	var response ListClaimGroupGroupLinksRes
+	var response ListClaimRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListClaimGroupGroupLinks",
-			OperationSummary: "List attached GroupLinks",
-			OperationID:      "listClaimGroupGroupLinks",
+			OperationName:    "ListClaim",
+			OperationSummary: "List Claims",
+			OperationID:      "listClaim",
 			Body:             nil,
 			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
 				{
 					Name: "page",
 					In:   "query",
@@ -2190,8 +2437,8 @@ func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscap
 
 		type (
 			Request  = struct{}
-			Params   = ListClaimGroupGroupLinksParams
-			Response = ListClaimGroupGroupLinksRes
+			Params   = ListClaimParams
+			Response = ListClaimRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2200,14 +2447,14 @@ func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackListClaimGroupGroupLinksParams,
+			unpackListClaimParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListClaimGroupGroupLinks(ctx, params)
+				response, err = s.h.ListClaim(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListClaimGroupGroupLinks(ctx, params)
+		response, err = s.h.ListClaim(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -2215,7 +2462,7 @@ func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeListClaimGroupGroupLinksResponse(response, w, span); err != nil {
+	if err := encodeListClaimResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2224,20 +2471,20 @@ func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscap
 	}
 }
 
-// handleListClaimGroupUsersRequest handles listClaimGroupUsers operation.
+// handleListClaimClaimGroupsRequest handles listClaimClaimGroups operation.
 //
-// List attached Users.
+// List attached ClaimGroups.
 //
-// GET /admin/claim-groups/{id}/users
-func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/claims/{id}/claim-groups
+func (s *Server) handleListClaimClaimGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listClaimGroupUsers"),
+		otelogen.OperationID("listClaimClaimGroups"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/users"),
+		semconv.HTTPRouteKey.String("/admin/claims/{id}/claim-groups"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroupUsers",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimClaimGroups",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2262,15 +2509,15 @@ func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListClaimGroupUsers",
-			ID:   "listClaimGroupUsers",
+			Name: "ListClaimClaimGroups",
+			ID:   "listClaimClaimGroups",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListClaimGroupUsers", r)
+			sctx, ok, err := s.securityToken(ctx, "ListClaimClaimGroups", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2310,7 +2557,7 @@ func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeListClaimGroupUsersParams(args, argsEscaped, r)
+	params, err := decodeListClaimClaimGroupsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2321,13 +2568,13 @@ func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response ListClaimGroupUsersRes
+	var response ListClaimClaimGroupsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListClaimGroupUsers",
-			OperationSummary: "List attached Users",
-			OperationID:      "listClaimGroupUsers",
+			OperationName:    "ListClaimClaimGroups",
+			OperationSummary: "List attached ClaimGroups",
+			OperationID:      "listClaimClaimGroups",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -2348,8 +2595,8 @@ func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bo
 
 		type (
 			Request  = struct{}
-			Params   = ListClaimGroupUsersParams
-			Response = ListClaimGroupUsersRes
+			Params   = ListClaimClaimGroupsParams
+			Response = ListClaimClaimGroupsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2358,14 +2605,14 @@ func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackListClaimGroupUsersParams,
+			unpackListClaimClaimGroupsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListClaimGroupUsers(ctx, params)
+				response, err = s.h.ListClaimClaimGroups(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListClaimGroupUsers(ctx, params)
+		response, err = s.h.ListClaimClaimGroups(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -2373,7 +2620,7 @@ func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeListClaimGroupUsersResponse(response, w, span); err != nil {
+	if err := encodeListClaimClaimGroupsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2382,20 +2629,20 @@ func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleListGroupLinkRequest handles listGroupLink operation.
+// handleListClaimGroupRequest handles listClaimGroup operation.
 //
-// List GroupLinks.
+// List ClaimGroups.
 //
-// GET /admin/group-links
-func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/claim-groups
+func (s *Server) handleListClaimGroupRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listGroupLink"),
+		otelogen.OperationID("listClaimGroup"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/group-links"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListGroupLink",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroup",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2420,15 +2667,15 @@ func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListGroupLink",
-			ID:   "listGroupLink",
+			Name: "ListClaimGroup",
+			ID:   "listClaimGroup",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListGroupLink", r)
+			sctx, ok, err := s.securityToken(ctx, "ListClaimGroup", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2468,7 +2715,7 @@ func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeListGroupLinkParams(args, argsEscaped, r)
+	params, err := decodeListClaimGroupParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2479,13 +2726,13 @@ func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	var response ListGroupLinkRes
+	var response ListClaimGroupRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListGroupLink",
-			OperationSummary: "List GroupLinks",
-			OperationID:      "listGroupLink",
+			OperationName:    "ListClaimGroup",
+			OperationSummary: "List ClaimGroups",
+			OperationID:      "listClaimGroup",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -2502,8 +2749,8 @@ func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = ListGroupLinkParams
-			Response = ListGroupLinkRes
+			Params   = ListClaimGroupParams
+			Response = ListClaimGroupRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2512,14 +2759,14 @@ func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackListGroupLinkParams,
+			unpackListClaimGroupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListGroupLink(ctx, params)
+				response, err = s.h.ListClaimGroup(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListGroupLink(ctx, params)
+		response, err = s.h.ListClaimGroup(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -2527,7 +2774,7 @@ func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListGroupLinkResponse(response, w, span); err != nil {
+	if err := encodeListClaimGroupResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2536,20 +2783,20 @@ func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListPrivateKeyRequest handles listPrivateKey operation.
+// handleListClaimGroupClaimsRequest handles listClaimGroupClaims operation.
 //
-// List PrivateKeys.
+// List attached Claims.
 //
-// GET /admin/private-keys
-func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/claim-groups/{id}/claims
+func (s *Server) handleListClaimGroupClaimsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listPrivateKey"),
+		otelogen.OperationID("listClaimGroupClaims"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/private-keys"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/claims"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListPrivateKey",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroupClaims",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2574,15 +2821,15 @@ func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListPrivateKey",
-			ID:   "listPrivateKey",
+			Name: "ListClaimGroupClaims",
+			ID:   "listClaimGroupClaims",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListPrivateKey", r)
+			sctx, ok, err := s.securityToken(ctx, "ListClaimGroupClaims", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2622,7 +2869,7 @@ func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeListPrivateKeyParams(args, argsEscaped, r)
+	params, err := decodeListClaimGroupClaimsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2633,15 +2880,19 @@ func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	var response ListPrivateKeyRes
+	var response ListClaimGroupClaimsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListPrivateKey",
-			OperationSummary: "List PrivateKeys",
-			OperationID:      "listPrivateKey",
+			OperationName:    "ListClaimGroupClaims",
+			OperationSummary: "List attached Claims",
+			OperationID:      "listClaimGroupClaims",
 			Body:             nil,
 			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
 				{
 					Name: "page",
 					In:   "query",
@@ -2656,8 +2907,8 @@ func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = ListPrivateKeyParams
-			Response = ListPrivateKeyRes
+			Params   = ListClaimGroupClaimsParams
+			Response = ListClaimGroupClaimsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2666,14 +2917,14 @@ func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackListPrivateKeyParams,
+			unpackListClaimGroupClaimsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListPrivateKey(ctx, params)
+				response, err = s.h.ListClaimGroupClaims(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListPrivateKey(ctx, params)
+		response, err = s.h.ListClaimGroupClaims(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -2681,7 +2932,7 @@ func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListPrivateKeyResponse(response, w, span); err != nil {
+	if err := encodeListClaimGroupClaimsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2690,20 +2941,20 @@ func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListUserRequest handles listUser operation.
+// handleListClaimGroupGroupLinksRequest handles listClaimGroupGroupLinks operation.
 //
-// List Users.
+// List attached GroupLinks.
 //
-// GET /admin/users
-func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/claim-groups/{id}/group-links
+func (s *Server) handleListClaimGroupGroupLinksRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUser"),
+		otelogen.OperationID("listClaimGroupGroupLinks"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/users"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/group-links"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListUser",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroupGroupLinks",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2728,15 +2979,15 @@ func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListUser",
-			ID:   "listUser",
+			Name: "ListClaimGroupGroupLinks",
+			ID:   "listClaimGroupGroupLinks",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListUser", r)
+			sctx, ok, err := s.securityToken(ctx, "ListClaimGroupGroupLinks", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2776,7 +3027,7 @@ func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeListUserParams(args, argsEscaped, r)
+	params, err := decodeListClaimGroupGroupLinksParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2787,15 +3038,19 @@ func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	var response ListUserRes
+	var response ListClaimGroupGroupLinksRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListUser",
-			OperationSummary: "List Users",
-			OperationID:      "listUser",
+			OperationName:    "ListClaimGroupGroupLinks",
+			OperationSummary: "List attached GroupLinks",
+			OperationID:      "listClaimGroupGroupLinks",
 			Body:             nil,
 			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
 				{
 					Name: "page",
 					In:   "query",
@@ -2810,8 +3065,8 @@ func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.
 
 		type (
 			Request  = struct{}
-			Params   = ListUserParams
-			Response = ListUserRes
+			Params   = ListClaimGroupGroupLinksParams
+			Response = ListClaimGroupGroupLinksRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2820,14 +3075,14 @@ func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackListUserParams,
+			unpackListClaimGroupGroupLinksParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListUser(ctx, params)
+				response, err = s.h.ListClaimGroupGroupLinks(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListUser(ctx, params)
+		response, err = s.h.ListClaimGroupGroupLinks(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -2835,7 +3090,7 @@ func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeListUserResponse(response, w, span); err != nil {
+	if err := encodeListClaimGroupGroupLinksResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2844,20 +3099,20 @@ func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleListUserClaimGroupsRequest handles listUserClaimGroups operation.
+// handleListClaimGroupUsersRequest handles listClaimGroupUsers operation.
 //
-// List attached ClaimGroups.
+// List attached Users.
 //
-// GET /admin/users/{id}/claim-groups
-func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/claim-groups/{id}/users
+func (s *Server) handleListClaimGroupUsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUserClaimGroups"),
+		otelogen.OperationID("listClaimGroupUsers"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/users/{id}/claim-groups"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}/users"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListUserClaimGroups",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListClaimGroupUsers",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2882,15 +3137,15 @@ func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ListUserClaimGroups",
-			ID:   "listUserClaimGroups",
+			Name: "ListClaimGroupUsers",
+			ID:   "listClaimGroupUsers",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ListUserClaimGroups", r)
+			sctx, ok, err := s.securityToken(ctx, "ListClaimGroupUsers", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2930,7 +3185,7 @@ func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeListUserClaimGroupsParams(args, argsEscaped, r)
+	params, err := decodeListClaimGroupUsersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2941,13 +3196,13 @@ func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response ListUserClaimGroupsRes
+	var response ListClaimGroupUsersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ListUserClaimGroups",
-			OperationSummary: "List attached ClaimGroups",
-			OperationID:      "listUserClaimGroups",
+			OperationName:    "ListClaimGroupUsers",
+			OperationSummary: "List attached Users",
+			OperationID:      "listClaimGroupUsers",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -2968,8 +3223,8 @@ func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bo
 
 		type (
 			Request  = struct{}
-			Params   = ListUserClaimGroupsParams
-			Response = ListUserClaimGroupsRes
+			Params   = ListClaimGroupUsersParams
+			Response = ListClaimGroupUsersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2978,14 +3233,14 @@ func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackListUserClaimGroupsParams,
+			unpackListClaimGroupUsersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListUserClaimGroups(ctx, params)
+				response, err = s.h.ListClaimGroupUsers(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListUserClaimGroups(ctx, params)
+		response, err = s.h.ListClaimGroupUsers(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -2993,7 +3248,7 @@ func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeListUserClaimGroupsResponse(response, w, span); err != nil {
+	if err := encodeListClaimGroupUsersResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3002,20 +3257,20 @@ func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleLoginRequest handles login operation.
+// handleListGroupLinkRequest handles listGroupLink operation.
 //
-// Request a token.
+// List GroupLinks.
 //
-// POST /login
-func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/group-links
+func (s *Server) handleListGroupLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("login"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/login"),
+		otelogen.OperationID("listGroupLink"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/group-links"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "Login",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListGroupLink",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3040,42 +3295,1684 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "Login",
-			ID:   "login",
+			Name: "ListGroupLink",
+			ID:   "listGroupLink",
 		}
 	)
-	request, close, err := s.decodeLoginRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ListGroupLink", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListGroupLinkParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListGroupLinkRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListGroupLink",
+			OperationSummary: "List GroupLinks",
+			OperationID:      "listGroupLink",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "itemsPerPage",
+					In:   "query",
+				}: params.ItemsPerPage,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListGroupLinkParams
+			Response = ListGroupLinkRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListGroupLinkParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListGroupLink(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListGroupLink(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListGroupLinkResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListIdentitiesRequest handles listIdentities operation.
+//
+// List linked identities.
+//
+// GET /admin/identities
+func (s *Server) handleListIdentitiesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listIdentities"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/identities"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListIdentities",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ListIdentities",
+			ID:   "listIdentities",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ListIdentities", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListIdentitiesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListIdentitiesOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListIdentities",
+			OperationSummary: "List linked identities",
+			OperationID:      "listIdentities",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "username",
+					In:   "query",
+				}: params.Username,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListIdentitiesParams
+			Response = *ListIdentitiesOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListIdentitiesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListIdentities(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListIdentities(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListIdentitiesResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListMyIdentitiesRequest handles listMyIdentities operation.
+//
+// List the signed in user's identities.
+//
+// GET /identities
+func (s *Server) handleListMyIdentitiesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listMyIdentities"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/identities"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListMyIdentities",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ListMyIdentities",
+			ID:   "listMyIdentities",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ListMyIdentities", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response ListMyIdentitiesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListMyIdentities",
+			OperationSummary: "List the signed in user's identities",
+			OperationID:      "listMyIdentities",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListMyIdentitiesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListMyIdentities(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListMyIdentities(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListMyIdentitiesResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListPrivateKeyRequest handles listPrivateKey operation.
+//
+// List PrivateKeys.
+//
+// GET /admin/private-keys
+func (s *Server) handleListPrivateKeyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listPrivateKey"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/private-keys"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListPrivateKey",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ListPrivateKey",
+			ID:   "listPrivateKey",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ListPrivateKey", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListPrivateKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListPrivateKeyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListPrivateKey",
+			OperationSummary: "List PrivateKeys",
+			OperationID:      "listPrivateKey",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "itemsPerPage",
+					In:   "query",
+				}: params.ItemsPerPage,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListPrivateKeyParams
+			Response = ListPrivateKeyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListPrivateKeyParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListPrivateKey(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListPrivateKey(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListPrivateKeyResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListUserRequest handles listUser operation.
+//
+// List Users.
+//
+// GET /admin/users
+func (s *Server) handleListUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listUser"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/users"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListUser",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ListUser",
+			ID:   "listUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ListUser", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListUser",
+			OperationSummary: "List Users",
+			OperationID:      "listUser",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "itemsPerPage",
+					In:   "query",
+				}: params.ItemsPerPage,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListUserParams
+			Response = ListUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListUser(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListUser(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListUserResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListUserClaimGroupsRequest handles listUserClaimGroups operation.
+//
+// List attached ClaimGroups.
+//
+// GET /admin/users/{id}/claim-groups
+func (s *Server) handleListUserClaimGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listUserClaimGroups"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/users/{id}/claim-groups"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListUserClaimGroups",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ListUserClaimGroups",
+			ID:   "listUserClaimGroups",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ListUserClaimGroups", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListUserClaimGroupsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListUserClaimGroupsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListUserClaimGroups",
+			OperationSummary: "List attached ClaimGroups",
+			OperationID:      "listUserClaimGroups",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "itemsPerPage",
+					In:   "query",
+				}: params.ItemsPerPage,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListUserClaimGroupsParams
+			Response = ListUserClaimGroupsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListUserClaimGroupsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListUserClaimGroups(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListUserClaimGroups(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListUserClaimGroupsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLoginRequest handles login operation.
+//
+// Request a token.
+//
+// POST /login
+func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("login"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "Login",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "Login",
+			ID:   "login",
+		}
+	)
+	request, close, err := s.decodeLoginRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response LoginRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "Login",
+			OperationSummary: "Request a token",
+			OperationID:      "login",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *LoginReq
+			Params   = struct{}
+			Response = LoginRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Login(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Login(ctx, request)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLoginResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLoginExchangeRequest handles loginExchange operation.
+//
+// Redeem a login code issued after a server-side provider login.
+//
+// POST /login/exchange
+func (s *Server) handleLoginExchangeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("loginExchange"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login/exchange"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "LoginExchange",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "LoginExchange",
+			ID:   "loginExchange",
+		}
+	)
+	request, close, err := s.decodeLoginExchangeRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response LoginExchangeRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "LoginExchange",
+			OperationSummary: "Redeem a login code issued after a server-side provider login",
+			OperationID:      "loginExchange",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *LoginExchangeReq
+			Params   = struct{}
+			Response = LoginExchangeRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.LoginExchange(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.LoginExchange(ctx, request)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLoginExchangeResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLoginMFARequest handles loginMFA operation.
+//
+// Answer an MFA challenge to receive a token.
+//
+// POST /login/mfa
+func (s *Server) handleLoginMFARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("loginMFA"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login/mfa"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "LoginMFA",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "LoginMFA",
+			ID:   "loginMFA",
+		}
+	)
+	request, close, err := s.decodeLoginMFARequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response LoginMFARes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "LoginMFA",
+			OperationSummary: "Answer an MFA challenge to receive a token",
+			OperationID:      "loginMFA",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *LoginMFAReq
+			Params   = struct{}
+			Response = LoginMFARes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.LoginMFA(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.LoginMFA(ctx, request)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLoginMFAResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePkeysRequest handles pkeys operation.
+//
+// Returns JWKS (merged from all peers when clustered). Optional query: local=true or local=1 to
+// return only this node's keys (used by peers to avoid recursion).
+//
+// GET /pkeys
+func (s *Server) handlePkeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pkeys"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/pkeys"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "Pkeys",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response *PkeysOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "Pkeys",
+			OperationSummary: "Get current valid public keys",
+			OperationID:      "pkeys",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *PkeysOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Pkeys(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Pkeys(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePkeysResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleProviderHealthRequest handles provider_health operation.
+//
+// Reports the circuit breaker state of each foreign provider, the number of consecutive logins that
+// failed because the provider could not be reached and the times of the last successful and failed
+// logins. Providers are degraded while logins are failing or the circuit breaker is not closed.
+//
+// GET /provider_health
+func (s *Server) handleProviderHealthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("provider_health"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/provider_health"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ProviderHealth",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ProviderHealth",
+			ID:   "provider_health",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ProviderHealth", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response *ProviderHealthOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ProviderHealth",
+			OperationSummary: "Get the health of the configured login providers",
+			OperationID:      "provider_health",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *ProviderHealthOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ProviderHealth(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ProviderHealth(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeProviderHealthResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleReadClaimRequest handles readClaim operation.
+//
+// Finds the Claim with the requested ID and returns it.
+//
+// GET /admin/claims/{id}
+func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("readClaim"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/claims/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadClaim",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ReadClaim",
+			ID:   "readClaim",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ReadClaim", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeReadClaimParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ReadClaimRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ReadClaim",
+			OperationSummary: "Find a Claim by ID",
+			OperationID:      "readClaim",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ReadClaimParams
+			Response = ReadClaimRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackReadClaimParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ReadClaim(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ReadClaim(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeReadClaimResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleReadClaimGroupRequest handles readClaimGroup operation.
+//
+// Finds the ClaimGroup with the requested ID and returns it.
+//
+// GET /admin/claim-groups/{id}
+func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("readClaimGroup"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadClaimGroup",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
 	}()
 
-	var response LoginRes
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ReadClaimGroup",
+			ID:   "readClaimGroup",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ReadClaimGroup", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeReadClaimGroupParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ReadClaimGroupRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "Login",
-			OperationSummary: "Request a token",
-			OperationID:      "login",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    "ReadClaimGroup",
+			OperationSummary: "Find a ClaimGroup by ID",
+			OperationID:      "readClaimGroup",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *LoginReq
-			Params   = struct{}
-			Response = LoginRes
+			Request  = struct{}
+			Params   = ReadClaimGroupParams
+			Response = ReadClaimGroupRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3084,14 +4981,14 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		](
 			m,
 			mreq,
-			nil,
+			unpackReadClaimGroupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Login(ctx, request)
+				response, err = s.h.ReadClaimGroup(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Login(ctx, request)
+		response, err = s.h.ReadClaimGroup(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -3099,7 +4996,7 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		return
 	}
 
-	if err := encodeLoginResponse(response, w, span); err != nil {
+	if err := encodeReadClaimGroupResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3108,20 +5005,20 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 	}
 }
 
-// handlePkeysRequest handles pkeys operation.
+// handleReadGroupLinkRequest handles readGroupLink operation.
 //
-// Get current valid public keys.
+// Finds the GroupLink with the requested ID and returns it.
 //
-// GET /pkeys
-func (s *Server) handlePkeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/group-links/{id}
+func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pkeys"),
+		otelogen.OperationID("readGroupLink"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/pkeys"),
+		semconv.HTTPRouteKey.String("/admin/group-links/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "Pkeys",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadGroupLink",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3144,25 +5041,88 @@ func (s *Server) handlePkeysRequest(args [0]string, argsEscaped bool, w http.Res
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ReadGroupLink",
+			ID:   "readGroupLink",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityToken(ctx, "ReadGroupLink", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Token",
+					Err:              err,
+				}
+				recordError("Security:Token", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
 
-	var response *PkeysOK
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeReadGroupLinkParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ReadGroupLinkRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "Pkeys",
-			OperationSummary: "Get current valid public keys",
-			OperationID:      "pkeys",
+			OperationName:    "ReadGroupLink",
+			OperationSummary: "Find a GroupLink by ID",
+			OperationID:      "readGroupLink",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *PkeysOK
+			Params   = ReadGroupLinkParams
+			Response = ReadGroupLinkRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3171,14 +5131,14 @@ func (s *Server) handlePkeysRequest(args [0]string, argsEscaped bool, w http.Res
 		](
 			m,
 			mreq,
-			nil,
+			unpackReadGroupLinkParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Pkeys(ctx)
+				response, err = s.h.ReadGroupLink(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Pkeys(ctx)
+		response, err = s.h.ReadGroupLink(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -3186,7 +5146,7 @@ func (s *Server) handlePkeysRequest(args [0]string, argsEscaped bool, w http.Res
 		return
 	}
 
-	if err := encodePkeysResponse(response, w, span); err != nil {
+	if err := encodeReadGroupLinkResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3195,20 +5155,20 @@ func (s *Server) handlePkeysRequest(args [0]string, argsEscaped bool, w http.Res
 	}
 }
 
-// handleReadClaimRequest handles readClaim operation.
+// handleReadGroupLinkClaimGroupRequest handles readGroupLinkClaimGroup operation.
 //
-// Finds the Claim with the requested ID and returns it.
+// Find the attached ClaimGroup of the GroupLink with the given ID.
 //
-// GET /admin/claims/{id}
-func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/group-links/{id}/claim-group
+func (s *Server) handleReadGroupLinkClaimGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readClaim"),
+		otelogen.OperationID("readGroupLinkClaimGroup"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claims/{id}"),
+		semconv.HTTPRouteKey.String("/admin/group-links/{id}/claim-group"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadClaim",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadGroupLinkClaimGroup",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3233,15 +5193,15 @@ func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ReadClaim",
-			ID:   "readClaim",
+			Name: "ReadGroupLinkClaimGroup",
+			ID:   "readGroupLinkClaimGroup",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ReadClaim", r)
+			sctx, ok, err := s.securityToken(ctx, "ReadGroupLinkClaimGroup", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3281,7 +5241,7 @@ func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http
 			return
 		}
 	}
-	params, err := decodeReadClaimParams(args, argsEscaped, r)
+	params, err := decodeReadGroupLinkClaimGroupParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3292,13 +5252,13 @@ func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http
 		return
 	}
 
-	var response ReadClaimRes
+	var response ReadGroupLinkClaimGroupRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ReadClaim",
-			OperationSummary: "Find a Claim by ID",
-			OperationID:      "readClaim",
+			OperationName:    "ReadGroupLinkClaimGroup",
+			OperationSummary: "Find the attached ClaimGroup",
+			OperationID:      "readGroupLinkClaimGroup",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -3311,8 +5271,8 @@ func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http
 
 		type (
 			Request  = struct{}
-			Params   = ReadClaimParams
-			Response = ReadClaimRes
+			Params   = ReadGroupLinkClaimGroupParams
+			Response = ReadGroupLinkClaimGroupRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3321,14 +5281,14 @@ func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackReadClaimParams,
+			unpackReadGroupLinkClaimGroupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReadClaim(ctx, params)
+				response, err = s.h.ReadGroupLinkClaimGroup(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReadClaim(ctx, params)
+		response, err = s.h.ReadGroupLinkClaimGroup(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -3336,7 +5296,7 @@ func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeReadClaimResponse(response, w, span); err != nil {
+	if err := encodeReadGroupLinkClaimGroupResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3345,20 +5305,20 @@ func (s *Server) handleReadClaimRequest(args [1]string, argsEscaped bool, w http
 	}
 }
 
-// handleReadClaimGroupRequest handles readClaimGroup operation.
+// handleReadPrivateKeyRequest handles readPrivateKey operation.
 //
-// Finds the ClaimGroup with the requested ID and returns it.
+// Finds the PrivateKey with the requested ID and returns it.
 //
-// GET /admin/claim-groups/{id}
-func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/private-keys/{id}
+func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readClaimGroup"),
+		otelogen.OperationID("readPrivateKey"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/claim-groups/{id}"),
+		semconv.HTTPRouteKey.String("/admin/private-keys/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadClaimGroup",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadPrivateKey",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3383,15 +5343,15 @@ func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ReadClaimGroup",
-			ID:   "readClaimGroup",
+			Name: "ReadPrivateKey",
+			ID:   "readPrivateKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ReadClaimGroup", r)
+			sctx, ok, err := s.securityToken(ctx, "ReadPrivateKey", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3431,7 +5391,7 @@ func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeReadClaimGroupParams(args, argsEscaped, r)
+	params, err := decodeReadPrivateKeyParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3442,13 +5402,13 @@ func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response ReadClaimGroupRes
+	var response ReadPrivateKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ReadClaimGroup",
-			OperationSummary: "Find a ClaimGroup by ID",
-			OperationID:      "readClaimGroup",
+			OperationName:    "ReadPrivateKey",
+			OperationSummary: "Find a PrivateKey by ID",
+			OperationID:      "readPrivateKey",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -3461,8 +5421,8 @@ func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = ReadClaimGroupParams
-			Response = ReadClaimGroupRes
+			Params   = ReadPrivateKeyParams
+			Response = ReadPrivateKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3471,14 +5431,14 @@ func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackReadClaimGroupParams,
+			unpackReadPrivateKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReadClaimGroup(ctx, params)
+				response, err = s.h.ReadPrivateKey(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReadClaimGroup(ctx, params)
+		response, err = s.h.ReadPrivateKey(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -3486,7 +5446,7 @@ func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeReadClaimGroupResponse(response, w, span); err != nil {
+	if err := encodeReadPrivateKeyResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3495,20 +5455,20 @@ func (s *Server) handleReadClaimGroupRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleReadGroupLinkRequest handles readGroupLink operation.
+// handleReadUserRequest handles readUser operation.
 //
-// Finds the GroupLink with the requested ID and returns it.
+// Finds the User with the requested ID and returns it.
 //
-// GET /admin/group-links/{id}
-func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/users/{id}
+func (s *Server) handleReadUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readGroupLink"),
+		otelogen.OperationID("readUser"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/group-links/{id}"),
+		semconv.HTTPRouteKey.String("/admin/users/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadGroupLink",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadUser",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3533,15 +5493,15 @@ func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ReadGroupLink",
-			ID:   "readGroupLink",
+			Name: "ReadUser",
+			ID:   "readUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ReadGroupLink", r)
+			sctx, ok, err := s.securityToken(ctx, "ReadUser", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3581,7 +5541,7 @@ func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeReadGroupLinkParams(args, argsEscaped, r)
+	params, err := decodeReadUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3592,13 +5552,13 @@ func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response ReadGroupLinkRes
+	var response ReadUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ReadGroupLink",
-			OperationSummary: "Find a GroupLink by ID",
-			OperationID:      "readGroupLink",
+			OperationName:    "ReadUser",
+			OperationSummary: "Find a User by ID",
+			OperationID:      "readUser",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -3611,8 +5571,8 @@ func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = ReadGroupLinkParams
-			Response = ReadGroupLinkRes
+			Params   = ReadUserParams
+			Response = ReadUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3621,14 +5581,14 @@ func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackReadGroupLinkParams,
+			unpackReadUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReadGroupLink(ctx, params)
+				response, err = s.h.ReadUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReadGroupLink(ctx, params)
+		response, err = s.h.ReadUser(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -3636,7 +5596,7 @@ func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeReadGroupLinkResponse(response, w, span); err != nil {
+	if err := encodeReadUserResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3645,20 +5605,20 @@ func (s *Server) handleReadGroupLinkRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleReadGroupLinkClaimGroupRequest handles readGroupLinkClaimGroup operation.
+// handleRefreshRequest handles refresh operation.
 //
-// Find the attached ClaimGroup of the GroupLink with the given ID.
+// Request a refreshed token.
 //
-// GET /admin/group-links/{id}/claim-group
-func (s *Server) handleReadGroupLinkClaimGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /refresh
+func (s *Server) handleRefreshRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readGroupLinkClaimGroup"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/group-links/{id}/claim-group"),
+		otelogen.OperationID("refresh"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/refresh"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadGroupLinkClaimGroup",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "Refresh",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3683,15 +5643,15 @@ func (s *Server) handleReadGroupLinkClaimGroupRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ReadGroupLinkClaimGroup",
-			ID:   "readGroupLinkClaimGroup",
+			Name: "Refresh",
+			ID:   "refresh",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ReadGroupLinkClaimGroup", r)
+			sctx, ok, err := s.securityToken(ctx, "Refresh", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3731,38 +5691,38 @@ func (s *Server) handleReadGroupLinkClaimGroupRequest(args [1]string, argsEscape
 			return
 		}
 	}
-	params, err := decodeReadGroupLinkClaimGroupParams(args, argsEscaped, r)
+	request, close, err := s.decodeRefreshRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ReadGroupLinkClaimGroupRes
+	var response RefreshRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ReadGroupLinkClaimGroup",
-			OperationSummary: "Find the attached ClaimGroup",
-			OperationID:      "readGroupLinkClaimGroup",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			OperationName:    "Refresh",
+			OperationSummary: "Request a refreshed token",
+			OperationID:      "refresh",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ReadGroupLinkClaimGroupParams
-			Response = ReadGroupLinkClaimGroupRes
+			Request  = *RefreshReq
+			Params   = struct{}
+			Response = RefreshRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3771,14 +5731,14 @@ func (s *Server) handleReadGroupLinkClaimGroupRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackReadGroupLinkClaimGroupParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReadGroupLinkClaimGroup(ctx, params)
+				response, err = s.h.Refresh(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReadGroupLinkClaimGroup(ctx, params)
+		response, err = s.h.Refresh(ctx, request)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -3786,7 +5746,7 @@ func (s *Server) handleReadGroupLinkClaimGroupRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeReadGroupLinkClaimGroupResponse(response, w, span); err != nil {
+	if err := encodeRefreshResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3795,20 +5755,20 @@ func (s *Server) handleReadGroupLinkClaimGroupRequest(args [1]string, argsEscape
 	}
 }
 
-// handleReadPrivateKeyRequest handles readPrivateKey operation.
+// handleResetMFARequest handles resetMFA operation.
 //
-// Finds the PrivateKey with the requested ID and returns it.
+// Used when a user lost their authenticator and recovery codes.
 //
-// GET /admin/private-keys/{id}
-func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /admin/mfa/{username}
+func (s *Server) handleResetMFARequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readPrivateKey"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/private-keys/{id}"),
+		otelogen.OperationID("resetMFA"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/admin/mfa/{username}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadPrivateKey",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ResetMFA",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3833,15 +5793,15 @@ func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ReadPrivateKey",
-			ID:   "readPrivateKey",
+			Name: "ResetMFA",
+			ID:   "resetMFA",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ReadPrivateKey", r)
+			sctx, ok, err := s.securityToken(ctx, "ResetMFA", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3881,7 +5841,7 @@ func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeReadPrivateKeyParams(args, argsEscaped, r)
+	params, err := decodeResetMFAParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3892,27 +5852,27 @@ func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response ReadPrivateKeyRes
+	var response ResetMFARes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ReadPrivateKey",
-			OperationSummary: "Find a PrivateKey by ID",
-			OperationID:      "readPrivateKey",
+			OperationName:    "ResetMFA",
+			OperationSummary: "Disable MFA of a user without a code",
+			OperationID:      "resetMFA",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "username",
 					In:   "path",
-				}: params.ID,
+				}: params.Username,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ReadPrivateKeyParams
-			Response = ReadPrivateKeyRes
+			Params   = ResetMFAParams
+			Response = ResetMFARes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3921,14 +5881,14 @@ func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackReadPrivateKeyParams,
+			unpackResetMFAParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReadPrivateKey(ctx, params)
+				response, err = s.h.ResetMFA(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReadPrivateKey(ctx, params)
+		response, err = s.h.ResetMFA(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -3936,7 +5896,7 @@ func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeReadPrivateKeyResponse(response, w, span); err != nil {
+	if err := encodeResetMFAResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3945,20 +5905,20 @@ func (s *Server) handleReadPrivateKeyRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleReadUserRequest handles readUser operation.
+// handleTotalsRequest handles totals operation.
 //
-// Finds the User with the requested ID and returns it.
+// Get entity count totals.
 //
-// GET /admin/users/{id}
-func (s *Server) handleReadUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/totals
+func (s *Server) handleTotalsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("readUser"),
+		otelogen.OperationID("totals"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/users/{id}"),
+		semconv.HTTPRouteKey.String("/admin/totals"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReadUser",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "Totals",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3983,15 +5943,15 @@ func (s *Server) handleReadUserRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ReadUser",
-			ID:   "readUser",
+			Name: "Totals",
+			ID:   "totals",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "ReadUser", r)
+			sctx, ok, err := s.securityToken(ctx, "Totals", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4031,38 +5991,23 @@ func (s *Server) handleReadUserRequest(args [1]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeReadUserParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response ReadUserRes
+	var response *TotalsOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ReadUser",
-			OperationSummary: "Find a User by ID",
-			OperationID:      "readUser",
+			OperationName:    "Totals",
+			OperationSummary: "Get entity count totals",
+			OperationID:      "totals",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ReadUserParams
-			Response = ReadUserRes
+			Params   = struct{}
+			Response = *TotalsOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4071,14 +6016,14 @@ func (s *Server) handleReadUserRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackReadUserParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReadUser(ctx, params)
+				response, err = s.h.Totals(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReadUser(ctx, params)
+		response, err = s.h.Totals(ctx)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -4086,7 +6031,7 @@ func (s *Server) handleReadUserRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeReadUserResponse(response, w, span); err != nil {
+	if err := encodeTotalsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4095,20 +6040,20 @@ func (s *Server) handleReadUserRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleRefreshRequest handles refresh operation.
+// handleUnlinkIdentityRequest handles unlinkIdentity operation.
 //
-// Request a refreshed token.
+// The only identity of a user without a local password can not be unlinked.
 //
-// POST /refresh
-func (s *Server) handleRefreshRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /admin/identities/{id}
+func (s *Server) handleUnlinkIdentityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("refresh"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/refresh"),
+		otelogen.OperationID("unlinkIdentity"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/admin/identities/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "Refresh",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "UnlinkIdentity",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4133,15 +6078,15 @@ func (s *Server) handleRefreshRequest(args [0]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "Refresh",
-			ID:   "refresh",
+			Name: "UnlinkIdentity",
+			ID:   "unlinkIdentity",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "Refresh", r)
+			sctx, ok, err := s.securityToken(ctx, "UnlinkIdentity", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4181,38 +6126,38 @@ func (s *Server) handleRefreshRequest(args [0]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	request, close, err := s.decodeRefreshRequest(r)
+	params, err := decodeUnlinkIdentityParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeRequest", err)
+		recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response RefreshRes
+	var response UnlinkIdentityRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "Refresh",
-			OperationSummary: "Request a refreshed token",
-			OperationID:      "refresh",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    "UnlinkIdentity",
+			OperationSummary: "Unlink a provider account from its user",
+			OperationID:      "unlinkIdentity",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RefreshReq
-			Params   = struct{}
-			Response = RefreshRes
+			Request  = struct{}
+			Params   = UnlinkIdentityParams
+			Response = UnlinkIdentityRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4221,14 +6166,14 @@ func (s *Server) handleRefreshRequest(args [0]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			nil,
+			unpackUnlinkIdentityParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Refresh(ctx, request)
+				response, err = s.h.UnlinkIdentity(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Refresh(ctx, request)
+		response, err = s.h.UnlinkIdentity(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -4236,7 +6181,7 @@ func (s *Server) handleRefreshRequest(args [0]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeRefreshResponse(response, w, span); err != nil {
+	if err := encodeUnlinkIdentityResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4245,20 +6190,20 @@ func (s *Server) handleRefreshRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleTotalsRequest handles totals operation.
+// handleUnlinkMyIdentityRequest handles unlinkMyIdentity operation.
 //
-// Get entity count totals.
+// The only identity of a user without a local password can not be unlinked.
 //
-// GET /admin/totals
-func (s *Server) handleTotalsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /identities/{id}
+func (s *Server) handleUnlinkMyIdentityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("totals"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/admin/totals"),
+		otelogen.OperationID("unlinkMyIdentity"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/identities/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "Totals",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "UnlinkMyIdentity",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4283,15 +6228,15 @@ func (s *Server) handleTotalsRequest(args [0]string, argsEscaped bool, w http.Re
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "Totals",
-			ID:   "totals",
+			Name: "UnlinkMyIdentity",
+			ID:   "unlinkMyIdentity",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityToken(ctx, "Totals", r)
+			sctx, ok, err := s.securityToken(ctx, "UnlinkMyIdentity", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4331,23 +6276,38 @@ func (s *Server) handleTotalsRequest(args [0]string, argsEscaped bool, w http.Re
 			return
 		}
 	}
+	params, err := decodeUnlinkMyIdentityParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *TotalsOK
+	var response UnlinkMyIdentityRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "Totals",
-			OperationSummary: "Get entity count totals",
-			OperationID:      "totals",
+			OperationName:    "UnlinkMyIdentity",
+			OperationSummary: "Unlink a provider account from the signed in user",
+			OperationID:      "unlinkMyIdentity",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *TotalsOK
+			Params   = UnlinkMyIdentityParams
+			Response = UnlinkMyIdentityRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4356,14 +6316,14 @@ func (s *Server) handleTotalsRequest(args [0]string, argsEscaped bool, w http.Re
 		](
 			m,
 			mreq,
-			nil,
+			unpackUnlinkMyIdentityParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Totals(ctx)
+				response, err = s.h.UnlinkMyIdentity(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Totals(ctx)
+		response, err = s.h.UnlinkMyIdentity(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -4371,7 +6331,7 @@ func (s *Server) handleTotalsRequest(args [0]string, argsEscaped bool, w http.Re
 		return
 	}
 
-	if err := encodeTotalsResponse(response, w, span); err != nil {
+	if err := encodeUnlinkMyIdentityResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
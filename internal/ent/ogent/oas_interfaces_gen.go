@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package ogent
 
+type ActivateMFARes interface {
+	activateMFARes()
+}
+
 type CreateClaimGroupRes interface {
 	createClaimGroupRes()
 }
@@ -33,6 +37,22 @@ type DeleteUserRes interface {
 	deleteUserRes()
 }
 
+type DisableMFARes interface {
+	disableMFARes()
+}
+
+type EnrollMFARes interface {
+	enrollMFARes()
+}
+
+type LinkIdentityRes interface {
+	linkIdentityRes()
+}
+
+type LinkMyIdentityRes interface {
+	linkMyIdentityRes()
+}
+
 type ListClaimClaimGroupsRes interface {
 	listClaimClaimGroupsRes()
 }
@@ -61,6 +81,10 @@ type ListGroupLinkRes interface {
 	listGroupLinkRes()
 }
 
+type ListMyIdentitiesRes interface {
+	listMyIdentitiesRes()
+}
+
 type ListPrivateKeyRes interface {
 	listPrivateKeyRes()
 }
@@ -73,6 +97,14 @@ type ListUserRes interface {
 	listUserRes()
 }
 
+type LoginExchangeRes interface {
+	loginExchangeRes()
+}
+
+type LoginMFARes interface {
+	loginMFARes()
+}
+
 type LoginRes interface {
 	loginRes()
 }
@@ -105,6 +137,18 @@ type RefreshRes interface {
 	refreshRes()
 }
 
+type ResetMFARes interface {
+	resetMFARes()
+}
+
+type UnlinkIdentityRes interface {
+	unlinkIdentityRes()
+}
+
+type UnlinkMyIdentityRes interface {
+	unlinkMyIdentityRes()
+}
+
 type UpdateClaimGroupRes interface {
 	updateClaimGroupRes()
 }
@@ -14,7 +14,7 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-func decodeAvailableProvidersResponse(resp *http.Response) (res *AvailableProvidersOK, _ error) {
+func decodeActivateMFAResponse(resp *http.Response) (res ActivateMFARes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -30,7 +30,7 @@ func decodeAvailableProvidersResponse(resp *http.Response) (res *AvailableProvid
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response AvailableProvidersOK
+			var response ActivateMFAOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -60,6 +60,82 @@ func decodeAvailableProvidersResponse(resp *http.Response) (res *AvailableProvid
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ActivateMFABadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeAvailableProvidersResponse(resp *http.Response) (res *AvailableProvidersOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response AvailableProvidersOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
@@ -114,6 +190,56 @@ func decodeCapabilitiesResponse(resp *http.Response) (res *CapabilitiesOK, _ err
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
+func decodeClusterStatusResponse(resp *http.Response) (res *ClusterStatusOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ClusterStatusOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
 func decodeCreateClaimResponse(resp *http.Response) (res CreateClaimRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
@@ -1224,7 +1350,7 @@ func decodeDeleteUserResponse(resp *http.Response) (res DeleteUserRes, _ error)
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
+func decodeDisableMFAResponse(resp *http.Response) (res DisableMFARes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1240,7 +1366,7 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListClaimOKApplicationJSON
+			var response DisableMFAOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1257,15 +1383,6 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -1284,7 +1401,7 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R400
+			var response DisableMFABadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1305,43 +1422,14 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
 
-			var response R404
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 409:
-		// Code 409.
+func decodeEnrollMFAResponse(resp *http.Response) (res EnrollMFARes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1354,7 +1442,7 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R409
+			var response EnrollMFAOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1375,8 +1463,8 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 500:
-		// Code 500.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1389,7 +1477,7 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R500
+			var response EnrollMFABadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1414,7 +1502,7 @@ func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaimGroupsRes, _ error) {
+func decodeLinkIdentityResponse(resp *http.Response) (res LinkIdentityRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1430,7 +1518,7 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListClaimClaimGroupsOKApplicationJSON
+			var response LinkIdentityOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1447,15 +1535,6 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -1474,7 +1553,7 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R400
+			var response LinkIdentityBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1495,43 +1574,14 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
 
-			var response R404
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 409:
-		// Code 409.
+func decodeLinkMyIdentityResponse(resp *http.Response) (res LinkMyIdentityRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1544,7 +1594,7 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R409
+			var response LinkMyIdentityOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1565,8 +1615,8 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 500:
-		// Code 500.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1579,7 +1629,7 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R500
+			var response LinkMyIdentityBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1600,11 +1650,14 @@ func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaim
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		return &LinkMyIdentityUnauthorized{}, nil
 	}
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListClaimGroupResponse(resp *http.Response) (res ListClaimGroupRes, _ error) {
+func decodeListClaimResponse(resp *http.Response) (res ListClaimRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1620,7 +1673,7 @@ func decodeListClaimGroupResponse(resp *http.Response) (res ListClaimGroupRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListClaimGroupOKApplicationJSON
+			var response ListClaimOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1794,7 +1847,7 @@ func decodeListClaimGroupResponse(resp *http.Response) (res ListClaimGroupRes, _
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListClaimGroupClaimsResponse(resp *http.Response) (res ListClaimGroupClaimsRes, _ error) {
+func decodeListClaimClaimGroupsResponse(resp *http.Response) (res ListClaimClaimGroupsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1810,7 +1863,7 @@ func decodeListClaimGroupClaimsResponse(resp *http.Response) (res ListClaimGroup
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListClaimGroupClaimsOKApplicationJSON
+			var response ListClaimClaimGroupsOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1984,7 +2037,7 @@ func decodeListClaimGroupClaimsResponse(resp *http.Response) (res ListClaimGroup
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListClaimGroupGroupLinksResponse(resp *http.Response) (res ListClaimGroupGroupLinksRes, _ error) {
+func decodeListClaimGroupResponse(resp *http.Response) (res ListClaimGroupRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2000,7 +2053,7 @@ func decodeListClaimGroupGroupLinksResponse(resp *http.Response) (res ListClaimG
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListClaimGroupGroupLinksOKApplicationJSON
+			var response ListClaimGroupOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2174,7 +2227,7 @@ func decodeListClaimGroupGroupLinksResponse(resp *http.Response) (res ListClaimG
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListClaimGroupUsersResponse(resp *http.Response) (res ListClaimGroupUsersRes, _ error) {
+func decodeListClaimGroupClaimsResponse(resp *http.Response) (res ListClaimGroupClaimsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2190,7 +2243,7 @@ func decodeListClaimGroupUsersResponse(resp *http.Response) (res ListClaimGroupU
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListClaimGroupUsersOKApplicationJSON
+			var response ListClaimGroupClaimsOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2364,7 +2417,7 @@ func decodeListClaimGroupUsersResponse(resp *http.Response) (res ListClaimGroupU
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListGroupLinkResponse(resp *http.Response) (res ListGroupLinkRes, _ error) {
+func decodeListClaimGroupGroupLinksResponse(resp *http.Response) (res ListClaimGroupGroupLinksRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2380,7 +2433,7 @@ func decodeListGroupLinkResponse(resp *http.Response) (res ListGroupLinkRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListGroupLinkOKApplicationJSON
+			var response ListClaimGroupGroupLinksOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2554,7 +2607,7 @@ func decodeListGroupLinkResponse(resp *http.Response) (res ListGroupLinkRes, _ e
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListPrivateKeyResponse(resp *http.Response) (res ListPrivateKeyRes, _ error) {
+func decodeListClaimGroupUsersResponse(resp *http.Response) (res ListClaimGroupUsersRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2570,7 +2623,7 @@ func decodeListPrivateKeyResponse(resp *http.Response) (res ListPrivateKeyRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListPrivateKeyOKApplicationJSON
+			var response ListClaimGroupUsersOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2744,7 +2797,7 @@ func decodeListPrivateKeyResponse(resp *http.Response) (res ListPrivateKeyRes, _
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListUserResponse(resp *http.Response) (res ListUserRes, _ error) {
+func decodeListGroupLinkResponse(resp *http.Response) (res ListGroupLinkRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2760,7 +2813,7 @@ func decodeListUserResponse(resp *http.Response) (res ListUserRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListUserOKApplicationJSON
+			var response ListGroupLinkOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2934,7 +2987,7 @@ func decodeListUserResponse(resp *http.Response) (res ListUserRes, _ error) {
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGroupsRes, _ error) {
+func decodeListIdentitiesResponse(resp *http.Response) (res *ListIdentitiesOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2950,7 +3003,7 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListUserClaimGroupsOKApplicationJSON
+			var response ListIdentitiesOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2980,8 +3033,14 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeListMyIdentitiesResponse(resp *http.Response) (res ListMyIdentitiesRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2994,7 +3053,7 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R400
+			var response ListMyIdentitiesOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3011,12 +3070,21 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3029,7 +3097,7 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R404
+			var response ListMyIdentitiesBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3050,8 +3118,14 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 409:
-		// Code 409.
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeListPrivateKeyResponse(resp *http.Response) (res ListPrivateKeyRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3064,7 +3138,7 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R409
+			var response ListPrivateKeyOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3081,12 +3155,21 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 500:
-		// Code 500.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3099,7 +3182,7 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R500
+			var response R400
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3120,14 +3203,8 @@ func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGr
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCode(resp.StatusCode)
-}
-
-func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3140,7 +3217,7 @@ func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response LoginOK
+			var response R404
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3161,8 +3238,8 @@ func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 409:
+		// Code 409.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3175,7 +3252,7 @@ func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response LoginBadRequest
+			var response R409
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3196,8 +3273,8 @@ func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 500:
+		// Code 500.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3210,7 +3287,7 @@ func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response LoginUnauthorized
+			var response R500
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3235,7 +3312,7 @@ func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodePkeysResponse(resp *http.Response) (res *PkeysOK, _ error) {
+func decodeListUserResponse(resp *http.Response) (res ListUserRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3251,7 +3328,7 @@ func decodePkeysResponse(resp *http.Response) (res *PkeysOK, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PkeysOK
+			var response ListUserOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3281,47 +3358,6 @@ func decodePkeysResponse(resp *http.Response) (res *PkeysOK, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCode(resp.StatusCode)
-}
-
-func decodeReadClaimResponse(resp *http.Response) (res ReadClaimRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response ClaimRead
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
 	case 400:
 		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -3466,7 +3502,7 @@ func decodeReadClaimResponse(resp *http.Response) (res ReadClaimRes, _ error) {
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeReadClaimGroupResponse(resp *http.Response) (res ReadClaimGroupRes, _ error) {
+func decodeListUserClaimGroupsResponse(resp *http.Response) (res ListUserClaimGroupsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3482,7 +3518,7 @@ func decodeReadClaimGroupResponse(resp *http.Response) (res ReadClaimGroupRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ClaimGroupRead
+			var response ListUserClaimGroupsOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3499,6 +3535,15 @@ func decodeReadClaimGroupResponse(resp *http.Response) (res ReadClaimGroupRes, _
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3647,7 +3692,7 @@ func decodeReadClaimGroupResponse(resp *http.Response) (res ReadClaimGroupRes, _
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ error) {
+func decodeLoginResponse(resp *http.Response) (res LoginRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3663,7 +3708,7 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GroupLinkRead
+			var response LoginOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3684,8 +3729,8 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 202:
+		// Code 202.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3698,7 +3743,7 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R400
+			var response LoginAccepted
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3719,8 +3764,8 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3733,7 +3778,7 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R404
+			var response LoginBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3754,8 +3799,8 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 409:
-		// Code 409.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3768,7 +3813,7 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R409
+			var response LoginUnauthorized
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3789,8 +3834,14 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 500:
-		// Code 500.
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeLoginExchangeResponse(resp *http.Response) (res LoginExchangeRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3803,7 +3854,7 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R500
+			var response LoginExchangeOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3824,14 +3875,8 @@ func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCode(resp.StatusCode)
-}
-
-func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLinkClaimGroupRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3844,7 +3889,7 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GroupLinkClaimGroupRead
+			var response LoginExchangeUnauthorized
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3865,8 +3910,14 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeLoginMFAResponse(resp *http.Response) (res LoginMFARes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3879,7 +3930,7 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R400
+			var response LoginMFAOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3900,8 +3951,8 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3914,7 +3965,7 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R404
+			var response LoginMFAUnauthorized
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3935,8 +3986,14 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 409:
-		// Code 409.
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodePkeysResponse(resp *http.Response) (res *PkeysOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3949,7 +4006,7 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R409
+			var response PkeysOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3966,12 +4023,27 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 500:
-		// Code 500.
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeProviderHealthResponse(resp *http.Response) (res *ProviderHealthOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3984,7 +4056,7 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response R500
+			var response ProviderHealthOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4001,6 +4073,15 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -4009,7 +4090,7 @@ func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLi
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeReadPrivateKeyResponse(resp *http.Response) (res ReadPrivateKeyRes, _ error) {
+func decodeReadClaimResponse(resp *http.Response) (res ReadClaimRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4025,7 +4106,7 @@ func decodeReadPrivateKeyResponse(resp *http.Response) (res ReadPrivateKeyRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PrivateKeyRead
+			var response ClaimRead
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4190,7 +4271,7 @@ func decodeReadPrivateKeyResponse(resp *http.Response) (res ReadPrivateKeyRes, _
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeReadUserResponse(resp *http.Response) (res ReadUserRes, _ error) {
+func decodeReadClaimGroupResponse(resp *http.Response) (res ReadClaimGroupRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4206,7 +4287,7 @@ func decodeReadUserResponse(resp *http.Response) (res ReadUserRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UserRead
+			var response ClaimGroupRead
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4371,7 +4452,7 @@ func decodeReadUserResponse(resp *http.Response) (res ReadUserRes, _ error) {
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeRefreshResponse(resp *http.Response) (res RefreshRes, _ error) {
+func decodeReadGroupLinkResponse(resp *http.Response) (res ReadGroupLinkRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4387,7 +4468,7 @@ func decodeRefreshResponse(resp *http.Response) (res RefreshRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RefreshOK
+			var response GroupLinkRead
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4408,8 +4489,8 @@ func decodeRefreshResponse(resp *http.Response) (res RefreshRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4422,7 +4503,112 @@ func decodeRefreshResponse(resp *http.Response) (res RefreshRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RefreshUnauthorized
+			var response R400
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R404
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R409
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 500:
+		// Code 500.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R500
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4447,7 +4633,7 @@ func decodeRefreshResponse(resp *http.Response) (res RefreshRes, _ error) {
 	return res, validate.UnexpectedStatusCode(resp.StatusCode)
 }
 
-func decodeTotalsResponse(resp *http.Response) (res *TotalsOK, _ error) {
+func decodeReadGroupLinkClaimGroupResponse(resp *http.Response) (res ReadGroupLinkClaimGroupRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4463,7 +4649,924 @@ func decodeTotalsResponse(resp *http.Response) (res *TotalsOK, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response TotalsOK
+			var response GroupLinkClaimGroupRead
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R400
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R404
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R409
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 500:
+		// Code 500.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R500
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeReadPrivateKeyResponse(resp *http.Response) (res ReadPrivateKeyRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PrivateKeyRead
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R400
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R404
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R409
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 500:
+		// Code 500.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R500
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeReadUserResponse(resp *http.Response) (res ReadUserRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UserRead
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R400
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R404
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R409
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 500:
+		// Code 500.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response R500
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeRefreshResponse(resp *http.Response) (res RefreshRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RefreshOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RefreshUnauthorized
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeResetMFAResponse(resp *http.Response) (res ResetMFARes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ResetMFAOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ResetMFABadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeTotalsResponse(resp *http.Response) (res *TotalsOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response TotalsOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeUnlinkIdentityResponse(resp *http.Response) (res UnlinkIdentityRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UnlinkIdentityOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UnlinkIdentityBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UnlinkIdentityNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCode(resp.StatusCode)
+}
+
+func decodeUnlinkMyIdentityResponse(resp *http.Response) (res UnlinkMyIdentityRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UnlinkMyIdentityOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UnlinkMyIdentityBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UnlinkMyIdentityNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -424,6 +424,58 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							elem = origElem
 						}
 
+						elem = origElem
+					case 'i': // Prefix: "identities"
+						origElem := elem
+						if l := len("identities"); len(elem) >= l && elem[0:l] == "identities" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListIdentitiesRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleLinkIdentityRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleUnlinkIdentityRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
 						elem = origElem
 					case 'l': // Prefix: "localuser"
 						origElem := elem
@@ -447,6 +499,34 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
+						elem = origElem
+					case 'm': // Prefix: "mfa/"
+						origElem := elem
+						if l := len("mfa/"); len(elem) >= l && elem[0:l] == "mfa/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "username"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleResetMFARequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+
 						elem = origElem
 					case 'p': // Prefix: "private-keys"
 						origElem := elem
@@ -632,25 +712,113 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				elem = origElem
-			case 'c': // Prefix: "capabilities"
+			case 'c': // Prefix: "c"
 				origElem := elem
-				if l := len("capabilities"); len(elem) >= l && elem[0:l] == "capabilities" {
+				if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "apabilities"
+					origElem := elem
+					if l := len("apabilities"); len(elem) >= l && elem[0:l] == "apabilities" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleCapabilitiesRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				case 'l': // Prefix: "luster"
+					origElem := elem
+					if l := len("luster"); len(elem) >= l && elem[0:l] == "luster" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleClusterStatusRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'i': // Prefix: "identities"
+				origElem := elem
+				if l := len("identities"); len(elem) >= l && elem[0:l] == "identities" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "GET":
-						s.handleCapabilitiesRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleListMyIdentitiesRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleLinkMyIdentityRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET")
+						s.notAllowed(w, r, "GET,POST")
 					}
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleUnlinkMyIdentityRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 'l': // Prefix: "login"
@@ -662,7 +830,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "POST":
 						s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
@@ -672,26 +839,199 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "exchange"
+						origElem := elem
+						if l := len("exchange"); len(elem) >= l && elem[0:l] == "exchange" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleLoginExchangeRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 'm': // Prefix: "mfa"
+						origElem := elem
+						if l := len("mfa"); len(elem) >= l && elem[0:l] == "mfa" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleLoginMFARequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
-			case 'p': // Prefix: "pkeys"
+			case 'm': // Prefix: "mfa/"
 				origElem := elem
-				if l := len("pkeys"); len(elem) >= l && elem[0:l] == "pkeys" {
+				if l := len("mfa/"); len(elem) >= l && elem[0:l] == "mfa/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handlePkeysRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "activate"
+					origElem := elem
+					if l := len("activate"); len(elem) >= l && elem[0:l] == "activate" {
+						elem = elem[l:]
+					} else {
+						break
 					}
 
-					return
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleActivateMFARequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				case 'd': // Prefix: "disable"
+					origElem := elem
+					if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleDisableMFARequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				case 'e': // Prefix: "enroll"
+					origElem := elem
+					if l := len("enroll"); len(elem) >= l && elem[0:l] == "enroll" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleEnrollMFARequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'p': // Prefix: "p"
+				origElem := elem
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'k': // Prefix: "keys"
+					origElem := elem
+					if l := len("keys"); len(elem) >= l && elem[0:l] == "keys" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handlePkeysRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				case 'r': // Prefix: "rovider_health"
+					origElem := elem
+					if l := len("rovider_health"); len(elem) >= l && elem[0:l] == "rovider_health" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleProviderHealthRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
 				}
 
 				elem = origElem
@@ -1237,8 +1577,72 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
-
-								elem = origElem
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 'i': // Prefix: "identities"
+						origElem := elem
+						if l := len("identities"); len(elem) >= l && elem[0:l] == "identities" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = "ListIdentities"
+								r.summary = "List linked identities"
+								r.operationID = "listIdentities"
+								r.pathPattern = "/admin/identities"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = "LinkIdentity"
+								r.summary = "Link a provider account to a user"
+								r.operationID = "linkIdentity"
+								r.pathPattern = "/admin/identities"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									// Leaf: UnlinkIdentity
+									r.name = "UnlinkIdentity"
+									r.summary = "Unlink a provider account from its user"
+									r.operationID = "unlinkIdentity"
+									r.pathPattern = "/admin/identities/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
 							}
 
 							elem = origElem
@@ -1278,6 +1682,36 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+						elem = origElem
+					case 'm': // Prefix: "mfa/"
+						origElem := elem
+						if l := len("mfa/"); len(elem) >= l && elem[0:l] == "mfa/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "username"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								// Leaf: ResetMFA
+								r.name = "ResetMFA"
+								r.summary = "Disable MFA of a user without a code"
+								r.operationID = "resetMFA"
+								r.pathPattern = "/admin/mfa/{username}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
 						elem = origElem
 					case 'p': // Prefix: "private-keys"
 						origElem := elem
@@ -1493,9 +1927,74 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'c': // Prefix: "capabilities"
+			case 'c': // Prefix: "c"
+				origElem := elem
+				if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "apabilities"
+					origElem := elem
+					if l := len("apabilities"); len(elem) >= l && elem[0:l] == "apabilities" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: Capabilities
+							r.name = "Capabilities"
+							r.summary = "Get server capabilities"
+							r.operationID = "capabilities"
+							r.pathPattern = "/capabilities"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'l': // Prefix: "luster"
+					origElem := elem
+					if l := len("luster"); len(elem) >= l && elem[0:l] == "luster" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: ClusterStatus
+							r.name = "ClusterStatus"
+							r.summary = "Get the status of this instance's view of the cluster"
+							r.operationID = "cluster_status"
+							r.pathPattern = "/cluster"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'i': // Prefix: "identities"
 				origElem := elem
-				if l := len("capabilities"); len(elem) >= l && elem[0:l] == "capabilities" {
+				if l := len("identities"); len(elem) >= l && elem[0:l] == "identities" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1504,11 +2003,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						// Leaf: Capabilities
-						r.name = "Capabilities"
-						r.summary = "Get server capabilities"
-						r.operationID = "capabilities"
-						r.pathPattern = "/capabilities"
+						r.name = "ListMyIdentities"
+						r.summary = "List the signed in user's identities"
+						r.operationID = "listMyIdentities"
+						r.pathPattern = "/identities"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = "LinkMyIdentity"
+						r.summary = "Link a provider account to the signed in user"
+						r.operationID = "linkMyIdentity"
+						r.pathPattern = "/identities"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -1516,6 +2022,38 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							// Leaf: UnlinkMyIdentity
+							r.name = "UnlinkMyIdentity"
+							r.summary = "Unlink a provider account from the signed in user"
+							r.operationID = "unlinkMyIdentity"
+							r.pathPattern = "/identities/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 'l': // Prefix: "login"
@@ -1529,7 +2067,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "POST":
-						// Leaf: Login
 						r.name = "Login"
 						r.summary = "Request a token"
 						r.operationID = "login"
@@ -1541,30 +2078,227 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "exchange"
+						origElem := elem
+						if l := len("exchange"); len(elem) >= l && elem[0:l] == "exchange" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: LoginExchange
+								r.name = "LoginExchange"
+								r.summary = "Redeem a login code issued after a server-side provider login"
+								r.operationID = "loginExchange"
+								r.pathPattern = "/login/exchange"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'm': // Prefix: "mfa"
+						origElem := elem
+						if l := len("mfa"); len(elem) >= l && elem[0:l] == "mfa" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: LoginMFA
+								r.name = "LoginMFA"
+								r.summary = "Answer an MFA challenge to receive a token"
+								r.operationID = "loginMFA"
+								r.pathPattern = "/login/mfa"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
-			case 'p': // Prefix: "pkeys"
+			case 'm': // Prefix: "mfa/"
 				origElem := elem
-				if l := len("pkeys"); len(elem) >= l && elem[0:l] == "pkeys" {
+				if l := len("mfa/"); len(elem) >= l && elem[0:l] == "mfa/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						// Leaf: Pkeys
-						r.name = "Pkeys"
-						r.summary = "Get current valid public keys"
-						r.operationID = "pkeys"
-						r.pathPattern = "/pkeys"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "activate"
+					origElem := elem
+					if l := len("activate"); len(elem) >= l && elem[0:l] == "activate" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: ActivateMFA
+							r.name = "ActivateMFA"
+							r.summary = "Enable MFA"
+							r.operationID = "activateMFA"
+							r.pathPattern = "/mfa/activate"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'd': // Prefix: "disable"
+					origElem := elem
+					if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: DisableMFA
+							r.name = "DisableMFA"
+							r.summary = "Disable MFA"
+							r.operationID = "disableMFA"
+							r.pathPattern = "/mfa/disable"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'e': // Prefix: "enroll"
+					origElem := elem
+					if l := len("enroll"); len(elem) >= l && elem[0:l] == "enroll" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: EnrollMFA
+							r.name = "EnrollMFA"
+							r.summary = "Start MFA enrollment"
+							r.operationID = "enrollMFA"
+							r.pathPattern = "/mfa/enroll"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'p': // Prefix: "p"
+				origElem := elem
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'k': // Prefix: "keys"
+					origElem := elem
+					if l := len("keys"); len(elem) >= l && elem[0:l] == "keys" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: Pkeys
+							r.name = "Pkeys"
+							r.summary = "Get current valid public keys"
+							r.operationID = "pkeys"
+							r.pathPattern = "/pkeys"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'r': // Prefix: "rovider_health"
+					origElem := elem
+					if l := len("rovider_health"); len(elem) >= l && elem[0:l] == "rovider_health" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: ProviderHealth
+							r.name = "ProviderHealth"
+							r.summary = "Get the health of the configured login providers"
+							r.operationID = "provider_health"
+							r.pathPattern = "/provider_health"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
 					}
+
+					elem = origElem
 				}
 
 				elem = origElem
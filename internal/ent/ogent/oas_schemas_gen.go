@@ -9,70 +9,130 @@ import (
 	"github.com/go-faster/jx"
 )
 
-type AvailableProvidersOKItem struct {
+type ActivateMFABadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ActivateMFABadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *ActivateMFABadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ActivateMFABadRequest) activateMFARes() {}
+
+type ActivateMFAOK struct {
+	// Single use codes accepted instead of an authenticator code.
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// GetRecoveryCodes returns the value of RecoveryCodes.
+func (s *ActivateMFAOK) GetRecoveryCodes() []string {
+	return s.RecoveryCodes
+}
+
+// SetRecoveryCodes sets the value of RecoveryCodes.
+func (s *ActivateMFAOK) SetRecoveryCodes(val []string) {
+	s.RecoveryCodes = val
+}
+
+func (*ActivateMFAOK) activateMFARes() {}
+
+type ActivateMFAReq struct {
+	// Code from the user's authenticator app.
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *ActivateMFAReq) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *ActivateMFAReq) SetCode(val string) {
+	s.Code = val
+}
+
+type AvailableProvidersOK struct {
+	Providers []AvailableProvidersOKProvidersItem `json:"providers"`
+	// Base path for the admin UI when served behind a proxy, e.g. /auth; empty when admin is at /admin/.
+	BaseAdminPath OptString `json:"base_admin_path"`
+}
+
+// GetProviders returns the value of Providers.
+func (s *AvailableProvidersOK) GetProviders() []AvailableProvidersOKProvidersItem {
+	return s.Providers
+}
+
+// GetBaseAdminPath returns the value of BaseAdminPath.
+func (s *AvailableProvidersOK) GetBaseAdminPath() OptString {
+	return s.BaseAdminPath
+}
+
+// SetProviders sets the value of Providers.
+func (s *AvailableProvidersOK) SetProviders(val []AvailableProvidersOKProvidersItem) {
+	s.Providers = val
+}
+
+// SetBaseAdminPath sets the value of BaseAdminPath.
+func (s *AvailableProvidersOK) SetBaseAdminPath(val OptString) {
+	s.BaseAdminPath = val
+}
+
+type AvailableProvidersOKProvidersItem struct {
 	// Name of provider.
 	Name string `json:"name"`
 	// Type of provider.
 	ProviderType string `json:"provider_type"`
 	// Type specification of provider.
 	TypeSpec string `json:"type_spec"`
+	// Whether logins to the provider are currently failing.
+	Degraded OptBool `json:"degraded"`
 }
 
 // GetName returns the value of Name.
-func (s *AvailableProvidersOKItem) GetName() string {
+func (s *AvailableProvidersOKProvidersItem) GetName() string {
 	return s.Name
 }
 
 // GetProviderType returns the value of ProviderType.
-func (s *AvailableProvidersOKItem) GetProviderType() string {
+func (s *AvailableProvidersOKProvidersItem) GetProviderType() string {
 	return s.ProviderType
 }
 
 // GetTypeSpec returns the value of TypeSpec.
-func (s *AvailableProvidersOKItem) GetTypeSpec() string {
+func (s *AvailableProvidersOKProvidersItem) GetTypeSpec() string {
 	return s.TypeSpec
 }
 
+// GetDegraded returns the value of Degraded.
+func (s *AvailableProvidersOKProvidersItem) GetDegraded() OptBool {
+	return s.Degraded
+}
+
 // SetName sets the value of Name.
-func (s *AvailableProvidersOKItem) SetName(val string) {
+func (s *AvailableProvidersOKProvidersItem) SetName(val string) {
 	s.Name = val
 }
 
 // SetProviderType sets the value of ProviderType.
-func (s *AvailableProvidersOKItem) SetProviderType(val string) {
+func (s *AvailableProvidersOKProvidersItem) SetProviderType(val string) {
 	s.ProviderType = val
 }
 
 // SetTypeSpec sets the value of TypeSpec.
-func (s *AvailableProvidersOKItem) SetTypeSpec(val string) {
+func (s *AvailableProvidersOKProvidersItem) SetTypeSpec(val string) {
 	s.TypeSpec = val
 }
 
-type AvailableProvidersOK struct {
-	// Array of available providers.
-	Providers []AvailableProvidersOKItem `json:"providers"`
-	// Base path for the admin UI when served behind a proxy, e.g. /auth; empty when admin is at /admin/.
-	BaseAdminPath string `json:"base_admin_path,omitempty"`
-}
-
-// GetProviders returns the value of Providers.
-func (s *AvailableProvidersOK) GetProviders() []AvailableProvidersOKItem {
-	return s.Providers
-}
-
-// SetProviders sets the value of Providers.
-func (s *AvailableProvidersOK) SetProviders(val []AvailableProvidersOKItem) {
-	s.Providers = val
-}
-
-// GetBaseAdminPath returns the value of BaseAdminPath.
-func (s *AvailableProvidersOK) GetBaseAdminPath() string {
-	return s.BaseAdminPath
-}
-
-// SetBaseAdminPath sets the value of BaseAdminPath.
-func (s *AvailableProvidersOK) SetBaseAdminPath(val string) {
-	s.BaseAdminPath = val
+// SetDegraded sets the value of Degraded.
+func (s *AvailableProvidersOKProvidersItem) SetDegraded(val OptBool) {
+	s.Degraded = val
 }
 
 type CapabilitiesOK struct {
@@ -700,6 +760,181 @@ func (s *ClaimUpdate) SetDescription(val string) {
 
 func (*ClaimUpdate) updateClaimRes() {}
 
+type ClusterStatusOK struct {
+	// Whether cluster mode is enabled.
+	Enabled bool `json:"enabled"`
+	// Configured instance id of this replica.
+	InstanceID string `json:"instance_id"`
+	// Whether at least one public key merge has completed.
+	Merged bool `json:"merged"`
+	// Time of the last public key merge.
+	LastMerge OptDateTime `json:"last_merge"`
+	// Time the merged public key cache expires.
+	CacheExpiry OptDateTime                    `json:"cache_expiry"`
+	Peers       []ClusterStatusOKPeersItem     `json:"peers"`
+	Conflicts   []ClusterStatusOKConflictsItem `json:"conflicts"`
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *ClusterStatusOK) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetInstanceID returns the value of InstanceID.
+func (s *ClusterStatusOK) GetInstanceID() string {
+	return s.InstanceID
+}
+
+// GetMerged returns the value of Merged.
+func (s *ClusterStatusOK) GetMerged() bool {
+	return s.Merged
+}
+
+// GetLastMerge returns the value of LastMerge.
+func (s *ClusterStatusOK) GetLastMerge() OptDateTime {
+	return s.LastMerge
+}
+
+// GetCacheExpiry returns the value of CacheExpiry.
+func (s *ClusterStatusOK) GetCacheExpiry() OptDateTime {
+	return s.CacheExpiry
+}
+
+// GetPeers returns the value of Peers.
+func (s *ClusterStatusOK) GetPeers() []ClusterStatusOKPeersItem {
+	return s.Peers
+}
+
+// GetConflicts returns the value of Conflicts.
+func (s *ClusterStatusOK) GetConflicts() []ClusterStatusOKConflictsItem {
+	return s.Conflicts
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *ClusterStatusOK) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetInstanceID sets the value of InstanceID.
+func (s *ClusterStatusOK) SetInstanceID(val string) {
+	s.InstanceID = val
+}
+
+// SetMerged sets the value of Merged.
+func (s *ClusterStatusOK) SetMerged(val bool) {
+	s.Merged = val
+}
+
+// SetLastMerge sets the value of LastMerge.
+func (s *ClusterStatusOK) SetLastMerge(val OptDateTime) {
+	s.LastMerge = val
+}
+
+// SetCacheExpiry sets the value of CacheExpiry.
+func (s *ClusterStatusOK) SetCacheExpiry(val OptDateTime) {
+	s.CacheExpiry = val
+}
+
+// SetPeers sets the value of Peers.
+func (s *ClusterStatusOK) SetPeers(val []ClusterStatusOKPeersItem) {
+	s.Peers = val
+}
+
+// SetConflicts sets the value of Conflicts.
+func (s *ClusterStatusOK) SetConflicts(val []ClusterStatusOKConflictsItem) {
+	s.Conflicts = val
+}
+
+type ClusterStatusOKConflictsItem struct {
+	// Conflicting key id.
+	Kid string `json:"kid"`
+	// Sources that published the key id. The first source is used.
+	Sources []string `json:"sources"`
+}
+
+// GetKid returns the value of Kid.
+func (s *ClusterStatusOKConflictsItem) GetKid() string {
+	return s.Kid
+}
+
+// GetSources returns the value of Sources.
+func (s *ClusterStatusOKConflictsItem) GetSources() []string {
+	return s.Sources
+}
+
+// SetKid sets the value of Kid.
+func (s *ClusterStatusOKConflictsItem) SetKid(val string) {
+	s.Kid = val
+}
+
+// SetSources sets the value of Sources.
+func (s *ClusterStatusOKConflictsItem) SetSources(val []string) {
+	s.Sources = val
+}
+
+type ClusterStatusOKPeersItem struct {
+	// Base URL of the peer.
+	URL string `json:"url"`
+	// Time of the last successful public key fetch.
+	LastSuccess OptDateTime `json:"last_success"`
+	// Error from the most recent fetch.
+	Error OptString `json:"error"`
+	// Key ids published by the peer.
+	KeyIds []string `json:"key_ids"`
+	// Expiry of the peer's public key set.
+	Expires OptDateTime `json:"expires"`
+}
+
+// GetURL returns the value of URL.
+func (s *ClusterStatusOKPeersItem) GetURL() string {
+	return s.URL
+}
+
+// GetLastSuccess returns the value of LastSuccess.
+func (s *ClusterStatusOKPeersItem) GetLastSuccess() OptDateTime {
+	return s.LastSuccess
+}
+
+// GetError returns the value of Error.
+func (s *ClusterStatusOKPeersItem) GetError() OptString {
+	return s.Error
+}
+
+// GetKeyIds returns the value of KeyIds.
+func (s *ClusterStatusOKPeersItem) GetKeyIds() []string {
+	return s.KeyIds
+}
+
+// GetExpires returns the value of Expires.
+func (s *ClusterStatusOKPeersItem) GetExpires() OptDateTime {
+	return s.Expires
+}
+
+// SetURL sets the value of URL.
+func (s *ClusterStatusOKPeersItem) SetURL(val string) {
+	s.URL = val
+}
+
+// SetLastSuccess sets the value of LastSuccess.
+func (s *ClusterStatusOKPeersItem) SetLastSuccess(val OptDateTime) {
+	s.LastSuccess = val
+}
+
+// SetError sets the value of Error.
+func (s *ClusterStatusOKPeersItem) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetKeyIds sets the value of KeyIds.
+func (s *ClusterStatusOKPeersItem) SetKeyIds(val []string) {
+	s.KeyIds = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *ClusterStatusOKPeersItem) SetExpires(val OptDateTime) {
+	s.Expires = val
+}
+
 type CreateClaimGroupReq struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
@@ -969,6 +1204,100 @@ type DeleteUserNoContent struct{}
 
 func (*DeleteUserNoContent) deleteUserRes() {}
 
+type DisableMFABadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DisableMFABadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DisableMFABadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*DisableMFABadRequest) disableMFARes() {}
+
+type DisableMFAOK struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DisableMFAOK) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DisableMFAOK) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*DisableMFAOK) disableMFARes() {}
+
+type DisableMFAReq struct {
+	// Code from the user's authenticator app or a recovery code.
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *DisableMFAReq) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *DisableMFAReq) SetCode(val string) {
+	s.Code = val
+}
+
+type EnrollMFABadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *EnrollMFABadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *EnrollMFABadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*EnrollMFABadRequest) enrollMFARes() {}
+
+type EnrollMFAOK struct {
+	// Base32 encoded TOTP secret.
+	Secret string `json:"secret"`
+	// Otpauth:// url of the secret for QR codes.
+	URI string `json:"uri"`
+}
+
+// GetSecret returns the value of Secret.
+func (s *EnrollMFAOK) GetSecret() string {
+	return s.Secret
+}
+
+// GetURI returns the value of URI.
+func (s *EnrollMFAOK) GetURI() string {
+	return s.URI
+}
+
+// SetSecret sets the value of Secret.
+func (s *EnrollMFAOK) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetURI sets the value of URI.
+func (s *EnrollMFAOK) SetURI(val string) {
+	s.URI = val
+}
+
+func (*EnrollMFAOK) enrollMFARes() {}
+
 // Ref: #/components/schemas/GroupLink_ClaimGroupRead
 type GroupLinkClaimGroupRead struct {
 	ID          int    `json:"id"`
@@ -1162,62 +1491,718 @@ func (s *GroupLinkUpdate) SetResourceSpec(val string) {
 
 func (*GroupLinkUpdate) updateGroupLinkRes() {}
 
-type ListClaimClaimGroupsOKApplicationJSON []ClaimClaimGroupsList
+type LinkIdentityBadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
 
-func (*ListClaimClaimGroupsOKApplicationJSON) listClaimClaimGroupsRes() {}
+// GetMessage returns the value of Message.
+func (s *LinkIdentityBadRequest) GetMessage() string {
+	return s.Message
+}
 
-type ListClaimGroupClaimsOKApplicationJSON []ClaimGroupClaimsList
+// SetMessage sets the value of Message.
+func (s *LinkIdentityBadRequest) SetMessage(val string) {
+	s.Message = val
+}
 
-func (*ListClaimGroupClaimsOKApplicationJSON) listClaimGroupClaimsRes() {}
+func (*LinkIdentityBadRequest) linkIdentityRes() {}
 
-type ListClaimGroupGroupLinksOKApplicationJSON []ClaimGroupGroupLinksList
+type LinkIdentityOK struct {
+	// Identity id.
+	ID int `json:"id"`
+	// Username of the linked user.
+	Username string `json:"username"`
+	// Type of provider, i.e. LDAP or OIDC.
+	ProviderType string `json:"provider_type"`
+	// Name of provider.
+	Provider string `json:"provider"`
+	// Stable id of the account at the provider.
+	Subject string `json:"subject"`
+	// Time the identity was linked.
+	Created time.Time `json:"created"`
+}
 
-func (*ListClaimGroupGroupLinksOKApplicationJSON) listClaimGroupGroupLinksRes() {}
+// GetID returns the value of ID.
+func (s *LinkIdentityOK) GetID() int {
+	return s.ID
+}
 
-type ListClaimGroupOKApplicationJSON []ClaimGroupList
+// GetUsername returns the value of Username.
+func (s *LinkIdentityOK) GetUsername() string {
+	return s.Username
+}
 
-func (*ListClaimGroupOKApplicationJSON) listClaimGroupRes() {}
+// GetProviderType returns the value of ProviderType.
+func (s *LinkIdentityOK) GetProviderType() string {
+	return s.ProviderType
+}
 
-type ListClaimGroupUsersOKApplicationJSON []ClaimGroupUsersList
+// GetProvider returns the value of Provider.
+func (s *LinkIdentityOK) GetProvider() string {
+	return s.Provider
+}
 
-func (*ListClaimGroupUsersOKApplicationJSON) listClaimGroupUsersRes() {}
+// GetSubject returns the value of Subject.
+func (s *LinkIdentityOK) GetSubject() string {
+	return s.Subject
+}
 
-type ListClaimOKApplicationJSON []ClaimList
+// GetCreated returns the value of Created.
+func (s *LinkIdentityOK) GetCreated() time.Time {
+	return s.Created
+}
 
-func (*ListClaimOKApplicationJSON) listClaimRes() {}
+// SetID sets the value of ID.
+func (s *LinkIdentityOK) SetID(val int) {
+	s.ID = val
+}
 
-type ListGroupLinkOKApplicationJSON []GroupLinkList
+// SetUsername sets the value of Username.
+func (s *LinkIdentityOK) SetUsername(val string) {
+	s.Username = val
+}
 
-func (*ListGroupLinkOKApplicationJSON) listGroupLinkRes() {}
+// SetProviderType sets the value of ProviderType.
+func (s *LinkIdentityOK) SetProviderType(val string) {
+	s.ProviderType = val
+}
 
-type ListPrivateKeyOKApplicationJSON []PrivateKeyList
+// SetProvider sets the value of Provider.
+func (s *LinkIdentityOK) SetProvider(val string) {
+	s.Provider = val
+}
 
-func (*ListPrivateKeyOKApplicationJSON) listPrivateKeyRes() {}
+// SetSubject sets the value of Subject.
+func (s *LinkIdentityOK) SetSubject(val string) {
+	s.Subject = val
+}
 
-type ListUserClaimGroupsOKApplicationJSON []UserClaimGroupsList
+// SetCreated sets the value of Created.
+func (s *LinkIdentityOK) SetCreated(val time.Time) {
+	s.Created = val
+}
+
+func (*LinkIdentityOK) linkIdentityRes() {}
+
+type LinkIdentityReq struct {
+	// User to link the account to.
+	Username string `json:"username"`
+	// Name of provider.
+	Provider string `json:"provider"`
+	// Stable id of the account at the provider, i.e. the OIDC sub claim or LDAP objectGUID.
+	Subject string `json:"subject"`
+}
+
+// GetUsername returns the value of Username.
+func (s *LinkIdentityReq) GetUsername() string {
+	return s.Username
+}
+
+// GetProvider returns the value of Provider.
+func (s *LinkIdentityReq) GetProvider() string {
+	return s.Provider
+}
+
+// GetSubject returns the value of Subject.
+func (s *LinkIdentityReq) GetSubject() string {
+	return s.Subject
+}
+
+// SetUsername sets the value of Username.
+func (s *LinkIdentityReq) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *LinkIdentityReq) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *LinkIdentityReq) SetSubject(val string) {
+	s.Subject = val
+}
+
+type LinkMyIdentityBadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *LinkMyIdentityBadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *LinkMyIdentityBadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*LinkMyIdentityBadRequest) linkMyIdentityRes() {}
+
+type LinkMyIdentityOK struct {
+	// Identity id.
+	ID int `json:"id"`
+	// Username of the linked user.
+	Username string `json:"username"`
+	// Type of provider, i.e. LDAP or OIDC.
+	ProviderType string `json:"provider_type"`
+	// Name of provider.
+	Provider string `json:"provider"`
+	// Stable id of the account at the provider.
+	Subject string `json:"subject"`
+	// Time the identity was linked.
+	Created time.Time `json:"created"`
+}
+
+// GetID returns the value of ID.
+func (s *LinkMyIdentityOK) GetID() int {
+	return s.ID
+}
+
+// GetUsername returns the value of Username.
+func (s *LinkMyIdentityOK) GetUsername() string {
+	return s.Username
+}
+
+// GetProviderType returns the value of ProviderType.
+func (s *LinkMyIdentityOK) GetProviderType() string {
+	return s.ProviderType
+}
+
+// GetProvider returns the value of Provider.
+func (s *LinkMyIdentityOK) GetProvider() string {
+	return s.Provider
+}
+
+// GetSubject returns the value of Subject.
+func (s *LinkMyIdentityOK) GetSubject() string {
+	return s.Subject
+}
+
+// GetCreated returns the value of Created.
+func (s *LinkMyIdentityOK) GetCreated() time.Time {
+	return s.Created
+}
+
+// SetID sets the value of ID.
+func (s *LinkMyIdentityOK) SetID(val int) {
+	s.ID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *LinkMyIdentityOK) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetProviderType sets the value of ProviderType.
+func (s *LinkMyIdentityOK) SetProviderType(val string) {
+	s.ProviderType = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *LinkMyIdentityOK) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *LinkMyIdentityOK) SetSubject(val string) {
+	s.Subject = val
+}
+
+// SetCreated sets the value of Created.
+func (s *LinkMyIdentityOK) SetCreated(val time.Time) {
+	s.Created = val
+}
+
+func (*LinkMyIdentityOK) linkMyIdentityRes() {}
+
+type LinkMyIdentityReq struct {
+	// Name of provider.
+	Provider string `json:"provider"`
+	// Username at the provider.
+	Username string `json:"username"`
+	// Password or token for the provider.
+	Password string `json:"password"`
+}
+
+// GetProvider returns the value of Provider.
+func (s *LinkMyIdentityReq) GetProvider() string {
+	return s.Provider
+}
+
+// GetUsername returns the value of Username.
+func (s *LinkMyIdentityReq) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *LinkMyIdentityReq) GetPassword() string {
+	return s.Password
+}
+
+// SetProvider sets the value of Provider.
+func (s *LinkMyIdentityReq) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetUsername sets the value of Username.
+func (s *LinkMyIdentityReq) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LinkMyIdentityReq) SetPassword(val string) {
+	s.Password = val
+}
+
+// LinkMyIdentityUnauthorized is response for LinkMyIdentity operation.
+type LinkMyIdentityUnauthorized struct{}
+
+func (*LinkMyIdentityUnauthorized) linkMyIdentityRes() {}
+
+type ListClaimClaimGroupsOKApplicationJSON []ClaimClaimGroupsList
+
+func (*ListClaimClaimGroupsOKApplicationJSON) listClaimClaimGroupsRes() {}
+
+type ListClaimGroupClaimsOKApplicationJSON []ClaimGroupClaimsList
+
+func (*ListClaimGroupClaimsOKApplicationJSON) listClaimGroupClaimsRes() {}
+
+type ListClaimGroupGroupLinksOKApplicationJSON []ClaimGroupGroupLinksList
+
+func (*ListClaimGroupGroupLinksOKApplicationJSON) listClaimGroupGroupLinksRes() {}
+
+type ListClaimGroupOKApplicationJSON []ClaimGroupList
+
+func (*ListClaimGroupOKApplicationJSON) listClaimGroupRes() {}
+
+type ListClaimGroupUsersOKApplicationJSON []ClaimGroupUsersList
+
+func (*ListClaimGroupUsersOKApplicationJSON) listClaimGroupUsersRes() {}
+
+type ListClaimOKApplicationJSON []ClaimList
+
+func (*ListClaimOKApplicationJSON) listClaimRes() {}
+
+type ListGroupLinkOKApplicationJSON []GroupLinkList
+
+func (*ListGroupLinkOKApplicationJSON) listGroupLinkRes() {}
+
+type ListIdentitiesOK struct {
+	Identities []ListIdentitiesOKIdentitiesItem `json:"identities"`
+}
+
+// GetIdentities returns the value of Identities.
+func (s *ListIdentitiesOK) GetIdentities() []ListIdentitiesOKIdentitiesItem {
+	return s.Identities
+}
+
+// SetIdentities sets the value of Identities.
+func (s *ListIdentitiesOK) SetIdentities(val []ListIdentitiesOKIdentitiesItem) {
+	s.Identities = val
+}
+
+type ListIdentitiesOKIdentitiesItem struct {
+	// Identity id.
+	ID int `json:"id"`
+	// Username of the linked user.
+	Username string `json:"username"`
+	// Type of provider, i.e. LDAP or OIDC.
+	ProviderType string `json:"provider_type"`
+	// Name of provider.
+	Provider string `json:"provider"`
+	// Stable id of the account at the provider.
+	Subject string `json:"subject"`
+	// Time the identity was linked.
+	Created time.Time `json:"created"`
+}
+
+// GetID returns the value of ID.
+func (s *ListIdentitiesOKIdentitiesItem) GetID() int {
+	return s.ID
+}
+
+// GetUsername returns the value of Username.
+func (s *ListIdentitiesOKIdentitiesItem) GetUsername() string {
+	return s.Username
+}
+
+// GetProviderType returns the value of ProviderType.
+func (s *ListIdentitiesOKIdentitiesItem) GetProviderType() string {
+	return s.ProviderType
+}
+
+// GetProvider returns the value of Provider.
+func (s *ListIdentitiesOKIdentitiesItem) GetProvider() string {
+	return s.Provider
+}
+
+// GetSubject returns the value of Subject.
+func (s *ListIdentitiesOKIdentitiesItem) GetSubject() string {
+	return s.Subject
+}
+
+// GetCreated returns the value of Created.
+func (s *ListIdentitiesOKIdentitiesItem) GetCreated() time.Time {
+	return s.Created
+}
+
+// SetID sets the value of ID.
+func (s *ListIdentitiesOKIdentitiesItem) SetID(val int) {
+	s.ID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *ListIdentitiesOKIdentitiesItem) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetProviderType sets the value of ProviderType.
+func (s *ListIdentitiesOKIdentitiesItem) SetProviderType(val string) {
+	s.ProviderType = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *ListIdentitiesOKIdentitiesItem) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *ListIdentitiesOKIdentitiesItem) SetSubject(val string) {
+	s.Subject = val
+}
+
+// SetCreated sets the value of Created.
+func (s *ListIdentitiesOKIdentitiesItem) SetCreated(val time.Time) {
+	s.Created = val
+}
+
+type ListMyIdentitiesBadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ListMyIdentitiesBadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *ListMyIdentitiesBadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ListMyIdentitiesBadRequest) listMyIdentitiesRes() {}
+
+type ListMyIdentitiesOK struct {
+	Identities []ListMyIdentitiesOKIdentitiesItem `json:"identities"`
+}
+
+// GetIdentities returns the value of Identities.
+func (s *ListMyIdentitiesOK) GetIdentities() []ListMyIdentitiesOKIdentitiesItem {
+	return s.Identities
+}
+
+// SetIdentities sets the value of Identities.
+func (s *ListMyIdentitiesOK) SetIdentities(val []ListMyIdentitiesOKIdentitiesItem) {
+	s.Identities = val
+}
+
+func (*ListMyIdentitiesOK) listMyIdentitiesRes() {}
+
+type ListMyIdentitiesOKIdentitiesItem struct {
+	// Identity id.
+	ID int `json:"id"`
+	// Username of the linked user.
+	Username string `json:"username"`
+	// Type of provider, i.e. LDAP or OIDC.
+	ProviderType string `json:"provider_type"`
+	// Name of provider.
+	Provider string `json:"provider"`
+	// Stable id of the account at the provider.
+	Subject string `json:"subject"`
+	// Time the identity was linked.
+	Created time.Time `json:"created"`
+}
+
+// GetID returns the value of ID.
+func (s *ListMyIdentitiesOKIdentitiesItem) GetID() int {
+	return s.ID
+}
+
+// GetUsername returns the value of Username.
+func (s *ListMyIdentitiesOKIdentitiesItem) GetUsername() string {
+	return s.Username
+}
+
+// GetProviderType returns the value of ProviderType.
+func (s *ListMyIdentitiesOKIdentitiesItem) GetProviderType() string {
+	return s.ProviderType
+}
+
+// GetProvider returns the value of Provider.
+func (s *ListMyIdentitiesOKIdentitiesItem) GetProvider() string {
+	return s.Provider
+}
+
+// GetSubject returns the value of Subject.
+func (s *ListMyIdentitiesOKIdentitiesItem) GetSubject() string {
+	return s.Subject
+}
+
+// GetCreated returns the value of Created.
+func (s *ListMyIdentitiesOKIdentitiesItem) GetCreated() time.Time {
+	return s.Created
+}
+
+// SetID sets the value of ID.
+func (s *ListMyIdentitiesOKIdentitiesItem) SetID(val int) {
+	s.ID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *ListMyIdentitiesOKIdentitiesItem) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetProviderType sets the value of ProviderType.
+func (s *ListMyIdentitiesOKIdentitiesItem) SetProviderType(val string) {
+	s.ProviderType = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *ListMyIdentitiesOKIdentitiesItem) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *ListMyIdentitiesOKIdentitiesItem) SetSubject(val string) {
+	s.Subject = val
+}
+
+// SetCreated sets the value of Created.
+func (s *ListMyIdentitiesOKIdentitiesItem) SetCreated(val time.Time) {
+	s.Created = val
+}
+
+type ListPrivateKeyOKApplicationJSON []PrivateKeyList
+
+func (*ListPrivateKeyOKApplicationJSON) listPrivateKeyRes() {}
+
+type ListUserClaimGroupsOKApplicationJSON []UserClaimGroupsList
+
+func (*ListUserClaimGroupsOKApplicationJSON) listUserClaimGroupsRes() {}
+
+type ListUserOKApplicationJSON []UserList
+
+func (*ListUserOKApplicationJSON) listUserRes() {}
+
+type LoginAccepted struct {
+	// Challenge to answer with a code from the user's authenticator app.
+	MfaChallenge string `json:"mfa_challenge"`
+	// Username of the user who logged in.
+	Username string `json:"username"`
+}
+
+// GetMfaChallenge returns the value of MfaChallenge.
+func (s *LoginAccepted) GetMfaChallenge() string {
+	return s.MfaChallenge
+}
+
+// GetUsername returns the value of Username.
+func (s *LoginAccepted) GetUsername() string {
+	return s.Username
+}
+
+// SetMfaChallenge sets the value of MfaChallenge.
+func (s *LoginAccepted) SetMfaChallenge(val string) {
+	s.MfaChallenge = val
+}
+
+// SetUsername sets the value of Username.
+func (s *LoginAccepted) SetUsername(val string) {
+	s.Username = val
+}
+
+func (*LoginAccepted) loginRes() {}
+
+type LoginBadRequest struct {
+	// Error Message.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *LoginBadRequest) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *LoginBadRequest) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*LoginBadRequest) loginRes() {}
+
+type LoginExchangeOK struct {
+	// JWT Token.
+	Token string `json:"token"`
+	// Token to get a new token with the same claims. Must be used before token expires.
+	Refresh string `json:"refresh"`
+	// Username of the user who logged in.
+	Username string `json:"username"`
+}
+
+// GetToken returns the value of Token.
+func (s *LoginExchangeOK) GetToken() string {
+	return s.Token
+}
+
+// GetRefresh returns the value of Refresh.
+func (s *LoginExchangeOK) GetRefresh() string {
+	return s.Refresh
+}
+
+// GetUsername returns the value of Username.
+func (s *LoginExchangeOK) GetUsername() string {
+	return s.Username
+}
+
+// SetToken sets the value of Token.
+func (s *LoginExchangeOK) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRefresh sets the value of Refresh.
+func (s *LoginExchangeOK) SetRefresh(val string) {
+	s.Refresh = val
+}
+
+// SetUsername sets the value of Username.
+func (s *LoginExchangeOK) SetUsername(val string) {
+	s.Username = val
+}
 
-func (*ListUserClaimGroupsOKApplicationJSON) listUserClaimGroupsRes() {}
+func (*LoginExchangeOK) loginExchangeRes() {}
 
-type ListUserOKApplicationJSON []UserList
+// Login code.
+type LoginExchangeReq struct {
+	// One-time code from the code query parameter of the login redirect.
+	Code string `json:"code"`
+}
 
-func (*ListUserOKApplicationJSON) listUserRes() {}
+// GetCode returns the value of Code.
+func (s *LoginExchangeReq) GetCode() string {
+	return s.Code
+}
 
-type LoginBadRequest struct {
+// SetCode sets the value of Code.
+func (s *LoginExchangeReq) SetCode(val string) {
+	s.Code = val
+}
+
+type LoginExchangeUnauthorized struct {
 	// Error Message.
 	Message OptString `json:"message"`
 }
 
 // GetMessage returns the value of Message.
-func (s *LoginBadRequest) GetMessage() OptString {
+func (s *LoginExchangeUnauthorized) GetMessage() OptString {
 	return s.Message
 }
 
 // SetMessage sets the value of Message.
-func (s *LoginBadRequest) SetMessage(val OptString) {
+func (s *LoginExchangeUnauthorized) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*LoginBadRequest) loginRes() {}
+func (*LoginExchangeUnauthorized) loginExchangeRes() {}
+
+type LoginMFAOK struct {
+	// JWT Token.
+	Token string `json:"token"`
+	// Token to get a new token with the same claims. Must be used before token expires.
+	Refresh string `json:"refresh"`
+	// Username of the user who logged in.
+	Username string `json:"username"`
+}
+
+// GetToken returns the value of Token.
+func (s *LoginMFAOK) GetToken() string {
+	return s.Token
+}
+
+// GetRefresh returns the value of Refresh.
+func (s *LoginMFAOK) GetRefresh() string {
+	return s.Refresh
+}
+
+// GetUsername returns the value of Username.
+func (s *LoginMFAOK) GetUsername() string {
+	return s.Username
+}
+
+// SetToken sets the value of Token.
+func (s *LoginMFAOK) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRefresh sets the value of Refresh.
+func (s *LoginMFAOK) SetRefresh(val string) {
+	s.Refresh = val
+}
+
+// SetUsername sets the value of Username.
+func (s *LoginMFAOK) SetUsername(val string) {
+	s.Username = val
+}
+
+func (*LoginMFAOK) loginMFARes() {}
+
+type LoginMFAReq struct {
+	// Challenge returned by login.
+	Challenge string `json:"challenge"`
+	// Code from the user's authenticator app or a recovery code.
+	Code string `json:"code"`
+}
+
+// GetChallenge returns the value of Challenge.
+func (s *LoginMFAReq) GetChallenge() string {
+	return s.Challenge
+}
+
+// GetCode returns the value of Code.
+func (s *LoginMFAReq) GetCode() string {
+	return s.Code
+}
+
+// SetChallenge sets the value of Challenge.
+func (s *LoginMFAReq) SetChallenge(val string) {
+	s.Challenge = val
+}
+
+// SetCode sets the value of Code.
+func (s *LoginMFAReq) SetCode(val string) {
+	s.Code = val
+}
+
+type LoginMFAUnauthorized struct {
+	// Error Message.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *LoginMFAUnauthorized) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *LoginMFAUnauthorized) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*LoginMFAUnauthorized) loginMFARes() {}
 
 type LoginOK struct {
 	// JWT Token.
@@ -1401,6 +2386,52 @@ func (o OptBool) Or(d bool) bool {
 	return d
 }
 
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
 	return OptInt{
@@ -1884,6 +2915,107 @@ func (s *PrivateKeyRead) SetExpires(val time.Time) {
 
 func (*PrivateKeyRead) readPrivateKeyRes() {}
 
+type ProviderHealthOK struct {
+	Providers []ProviderHealthOKProvidersItem `json:"providers"`
+}
+
+// GetProviders returns the value of Providers.
+func (s *ProviderHealthOK) GetProviders() []ProviderHealthOKProvidersItem {
+	return s.Providers
+}
+
+// SetProviders sets the value of Providers.
+func (s *ProviderHealthOK) SetProviders(val []ProviderHealthOKProvidersItem) {
+	s.Providers = val
+}
+
+type ProviderHealthOKProvidersItem struct {
+	// Name of provider.
+	Name string `json:"name"`
+	// Circuit breaker state: closed, open or half_open.
+	State string `json:"state"`
+	// Whether logins to the provider are failing or rejected.
+	Degraded bool `json:"degraded"`
+	// Number of consecutive logins that failed with a source error.
+	ConsecutiveFailures int `json:"consecutive_failures"`
+	// Time of the last login the provider answered.
+	LastSuccess OptDateTime `json:"last_success"`
+	// Time of the last login that failed with a source error.
+	LastFailure OptDateTime `json:"last_failure"`
+	// Error from the last failed login.
+	Error OptString `json:"error"`
+}
+
+// GetName returns the value of Name.
+func (s *ProviderHealthOKProvidersItem) GetName() string {
+	return s.Name
+}
+
+// GetState returns the value of State.
+func (s *ProviderHealthOKProvidersItem) GetState() string {
+	return s.State
+}
+
+// GetDegraded returns the value of Degraded.
+func (s *ProviderHealthOKProvidersItem) GetDegraded() bool {
+	return s.Degraded
+}
+
+// GetConsecutiveFailures returns the value of ConsecutiveFailures.
+func (s *ProviderHealthOKProvidersItem) GetConsecutiveFailures() int {
+	return s.ConsecutiveFailures
+}
+
+// GetLastSuccess returns the value of LastSuccess.
+func (s *ProviderHealthOKProvidersItem) GetLastSuccess() OptDateTime {
+	return s.LastSuccess
+}
+
+// GetLastFailure returns the value of LastFailure.
+func (s *ProviderHealthOKProvidersItem) GetLastFailure() OptDateTime {
+	return s.LastFailure
+}
+
+// GetError returns the value of Error.
+func (s *ProviderHealthOKProvidersItem) GetError() OptString {
+	return s.Error
+}
+
+// SetName sets the value of Name.
+func (s *ProviderHealthOKProvidersItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetState sets the value of State.
+func (s *ProviderHealthOKProvidersItem) SetState(val string) {
+	s.State = val
+}
+
+// SetDegraded sets the value of Degraded.
+func (s *ProviderHealthOKProvidersItem) SetDegraded(val bool) {
+	s.Degraded = val
+}
+
+// SetConsecutiveFailures sets the value of ConsecutiveFailures.
+func (s *ProviderHealthOKProvidersItem) SetConsecutiveFailures(val int) {
+	s.ConsecutiveFailures = val
+}
+
+// SetLastSuccess sets the value of LastSuccess.
+func (s *ProviderHealthOKProvidersItem) SetLastSuccess(val OptDateTime) {
+	s.LastSuccess = val
+}
+
+// SetLastFailure sets the value of LastFailure.
+func (s *ProviderHealthOKProvidersItem) SetLastFailure(val OptDateTime) {
+	s.LastFailure = val
+}
+
+// SetError sets the value of Error.
+func (s *ProviderHealthOKProvidersItem) SetError(val OptString) {
+	s.Error = val
+}
+
 type R400 struct {
 	Code   int    `json:"code"`
 	Status string `json:"status"`
@@ -2199,6 +3331,39 @@ func (s *RefreshUnauthorized) SetMessage(val OptString) {
 
 func (*RefreshUnauthorized) refreshRes() {}
 
+type ResetMFABadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ResetMFABadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *ResetMFABadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ResetMFABadRequest) resetMFARes() {}
+
+type ResetMFAOK struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ResetMFAOK) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *ResetMFAOK) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ResetMFAOK) resetMFARes() {}
+
 type Token struct {
 	Token string
 }
@@ -2252,6 +3417,106 @@ func (s *TotalsOK) SetClaimGroups(val int) {
 	s.ClaimGroups = val
 }
 
+type UnlinkIdentityBadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UnlinkIdentityBadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UnlinkIdentityBadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnlinkIdentityBadRequest) unlinkIdentityRes() {}
+
+type UnlinkIdentityNotFound struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UnlinkIdentityNotFound) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UnlinkIdentityNotFound) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnlinkIdentityNotFound) unlinkIdentityRes() {}
+
+type UnlinkIdentityOK struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UnlinkIdentityOK) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UnlinkIdentityOK) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnlinkIdentityOK) unlinkIdentityRes() {}
+
+type UnlinkMyIdentityBadRequest struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UnlinkMyIdentityBadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UnlinkMyIdentityBadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnlinkMyIdentityBadRequest) unlinkMyIdentityRes() {}
+
+type UnlinkMyIdentityNotFound struct {
+	// Error Message.
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UnlinkMyIdentityNotFound) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UnlinkMyIdentityNotFound) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnlinkMyIdentityNotFound) unlinkMyIdentityRes() {}
+
+type UnlinkMyIdentityOK struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UnlinkMyIdentityOK) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UnlinkMyIdentityOK) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnlinkMyIdentityOK) unlinkMyIdentityRes() {}
+
 type UpdateClaimGroupReq struct {
 	Name        OptString `json:"name"`
 	Description OptString `json:"description"`
@@ -8,6 +8,13 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// ActivateMFA implements activateMFA operation.
+	//
+	// Enables MFA for the signed in user when code was made with the enrolled secret. Recovery codes are
+	// only returned once.
+	//
+	// POST /mfa/activate
+	ActivateMFA(ctx context.Context, req *ActivateMFAReq) (ActivateMFARes, error)
 	// AvailableProviders implements available_providers operation.
 	//
 	// Get available providers.
@@ -20,6 +27,13 @@ type Handler interface {
 	//
 	// GET /capabilities
 	Capabilities(ctx context.Context) (*CapabilitiesOK, error)
+	// ClusterStatus implements cluster_status operation.
+	//
+	// Reports discovered peers, the result of the last public key fetch from each peer, the merged key
+	// cache expiry and any key ids published by more than one peer with different key material.
+	//
+	// GET /cluster
+	ClusterStatus(ctx context.Context) (*ClusterStatusOK, error)
 	// CreateClaim implements createClaim operation.
 	//
 	// Creates a new Claim and persists it to storage.
@@ -68,6 +82,33 @@ type Handler interface {
 	//
 	// DELETE /admin/users/{id}
 	DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error)
+	// DisableMFA implements disableMFA operation.
+	//
+	// Disable MFA.
+	//
+	// POST /mfa/disable
+	DisableMFA(ctx context.Context, req *DisableMFAReq) (DisableMFARes, error)
+	// EnrollMFA implements enrollMFA operation.
+	//
+	// Creates a new TOTP secret for the signed in user. MFA is enabled once a code made with the secret
+	// is sent to /mfa/activate.
+	//
+	// POST /mfa/enroll
+	EnrollMFA(ctx context.Context) (EnrollMFARes, error)
+	// LinkIdentity implements linkIdentity operation.
+	//
+	// Links the account with subject at provider to the user without logging in to the provider. The
+	// account must not be linked to another user.
+	//
+	// POST /admin/identities
+	LinkIdentity(ctx context.Context, req *LinkIdentityReq) (LinkIdentityRes, error)
+	// LinkMyIdentity implements linkMyIdentity operation.
+	//
+	// The user proves the account is theirs by logging in to the provider. Token based providers take
+	// the provider token as the password.
+	//
+	// POST /identities
+	LinkMyIdentity(ctx context.Context, req *LinkMyIdentityReq) (LinkMyIdentityRes, error)
 	// ListClaim implements listClaim operation.
 	//
 	// List Claims.
@@ -110,6 +151,18 @@ type Handler interface {
 	//
 	// GET /admin/group-links
 	ListGroupLink(ctx context.Context, params ListGroupLinkParams) (ListGroupLinkRes, error)
+	// ListIdentities implements listIdentities operation.
+	//
+	// List linked identities.
+	//
+	// GET /admin/identities
+	ListIdentities(ctx context.Context, params ListIdentitiesParams) (*ListIdentitiesOK, error)
+	// ListMyIdentities implements listMyIdentities operation.
+	//
+	// List the signed in user's identities.
+	//
+	// GET /identities
+	ListMyIdentities(ctx context.Context) (ListMyIdentitiesRes, error)
 	// ListPrivateKey implements listPrivateKey operation.
 	//
 	// List PrivateKeys.
@@ -134,12 +187,33 @@ type Handler interface {
 	//
 	// POST /login
 	Login(ctx context.Context, req *LoginReq) (LoginRes, error)
+	// LoginExchange implements loginExchange operation.
+	//
+	// Redeem a login code issued after a server-side provider login.
+	//
+	// POST /login/exchange
+	LoginExchange(ctx context.Context, req *LoginExchangeReq) (LoginExchangeRes, error)
+	// LoginMFA implements loginMFA operation.
+	//
+	// Answer an MFA challenge to receive a token.
+	//
+	// POST /login/mfa
+	LoginMFA(ctx context.Context, req *LoginMFAReq) (LoginMFARes, error)
 	// Pkeys implements pkeys operation.
 	//
-	// Get current valid public keys.
+	// Returns JWKS (merged from all peers when clustered). Optional query: local=true or local=1 to
+	// return only this node's keys (used by peers to avoid recursion).
 	//
 	// GET /pkeys
 	Pkeys(ctx context.Context) (*PkeysOK, error)
+	// ProviderHealth implements provider_health operation.
+	//
+	// Reports the circuit breaker state of each foreign provider, the number of consecutive logins that
+	// failed because the provider could not be reached and the times of the last successful and failed
+	// logins. Providers are degraded while logins are failing or the circuit breaker is not closed.
+	//
+	// GET /provider_health
+	ProviderHealth(ctx context.Context) (*ProviderHealthOK, error)
 	// ReadClaim implements readClaim operation.
 	//
 	// Finds the Claim with the requested ID and returns it.
@@ -182,12 +256,30 @@ type Handler interface {
 	//
 	// POST /refresh
 	Refresh(ctx context.Context, req *RefreshReq) (RefreshRes, error)
+	// ResetMFA implements resetMFA operation.
+	//
+	// Used when a user lost their authenticator and recovery codes.
+	//
+	// DELETE /admin/mfa/{username}
+	ResetMFA(ctx context.Context, params ResetMFAParams) (ResetMFARes, error)
 	// Totals implements totals operation.
 	//
 	// Get entity count totals.
 	//
 	// GET /admin/totals
 	Totals(ctx context.Context) (*TotalsOK, error)
+	// UnlinkIdentity implements unlinkIdentity operation.
+	//
+	// The only identity of a user without a local password can not be unlinked.
+	//
+	// DELETE /admin/identities/{id}
+	UnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) (UnlinkIdentityRes, error)
+	// UnlinkMyIdentity implements unlinkMyIdentity operation.
+	//
+	// The only identity of a user without a local password can not be unlinked.
+	//
+	// DELETE /identities/{id}
+	UnlinkMyIdentity(ctx context.Context, params UnlinkMyIdentityParams) (UnlinkMyIdentityRes, error)
 	// UpdateClaim implements updateClaim operation.
 	//
 	// Updates a Claim and persists changes to storage.
@@ -13,6 +13,16 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// ActivateMFA implements activateMFA operation.
+//
+// Enables MFA for the signed in user when code was made with the enrolled secret. Recovery codes are
+// only returned once.
+//
+// POST /mfa/activate
+func (UnimplementedHandler) ActivateMFA(ctx context.Context, req *ActivateMFAReq) (r ActivateMFARes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AvailableProviders implements available_providers operation.
 //
 // Get available providers.
@@ -31,6 +41,16 @@ func (UnimplementedHandler) Capabilities(ctx context.Context) (r *CapabilitiesOK
 	return r, ht.ErrNotImplemented
 }
 
+// ClusterStatus implements cluster_status operation.
+//
+// Reports discovered peers, the result of the last public key fetch from each peer, the merged key
+// cache expiry and any key ids published by more than one peer with different key material.
+//
+// GET /cluster
+func (UnimplementedHandler) ClusterStatus(ctx context.Context) (r *ClusterStatusOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateClaim implements createClaim operation.
 //
 // Creates a new Claim and persists it to storage.
@@ -103,6 +123,45 @@ func (UnimplementedHandler) DeleteUser(ctx context.Context, params DeleteUserPar
 	return r, ht.ErrNotImplemented
 }
 
+// DisableMFA implements disableMFA operation.
+//
+// Disable MFA.
+//
+// POST /mfa/disable
+func (UnimplementedHandler) DisableMFA(ctx context.Context, req *DisableMFAReq) (r DisableMFARes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EnrollMFA implements enrollMFA operation.
+//
+// Creates a new TOTP secret for the signed in user. MFA is enabled once a code made with the secret
+// is sent to /mfa/activate.
+//
+// POST /mfa/enroll
+func (UnimplementedHandler) EnrollMFA(ctx context.Context) (r EnrollMFARes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LinkIdentity implements linkIdentity operation.
+//
+// Links the account with subject at provider to the user without logging in to the provider. The
+// account must not be linked to another user.
+//
+// POST /admin/identities
+func (UnimplementedHandler) LinkIdentity(ctx context.Context, req *LinkIdentityReq) (r LinkIdentityRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LinkMyIdentity implements linkMyIdentity operation.
+//
+// The user proves the account is theirs by logging in to the provider. Token based providers take
+// the provider token as the password.
+//
+// POST /identities
+func (UnimplementedHandler) LinkMyIdentity(ctx context.Context, req *LinkMyIdentityReq) (r LinkMyIdentityRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListClaim implements listClaim operation.
 //
 // List Claims.
@@ -166,6 +225,24 @@ func (UnimplementedHandler) ListGroupLink(ctx context.Context, params ListGroupL
 	return r, ht.ErrNotImplemented
 }
 
+// ListIdentities implements listIdentities operation.
+//
+// List linked identities.
+//
+// GET /admin/identities
+func (UnimplementedHandler) ListIdentities(ctx context.Context, params ListIdentitiesParams) (r *ListIdentitiesOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMyIdentities implements listMyIdentities operation.
+//
+// List the signed in user's identities.
+//
+// GET /identities
+func (UnimplementedHandler) ListMyIdentities(ctx context.Context) (r ListMyIdentitiesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListPrivateKey implements listPrivateKey operation.
 //
 // List PrivateKeys.
@@ -202,15 +279,45 @@ func (UnimplementedHandler) Login(ctx context.Context, req *LoginReq) (r LoginRe
 	return r, ht.ErrNotImplemented
 }
 
+// LoginExchange implements loginExchange operation.
+//
+// Redeem a login code issued after a server-side provider login.
+//
+// POST /login/exchange
+func (UnimplementedHandler) LoginExchange(ctx context.Context, req *LoginExchangeReq) (r LoginExchangeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LoginMFA implements loginMFA operation.
+//
+// Answer an MFA challenge to receive a token.
+//
+// POST /login/mfa
+func (UnimplementedHandler) LoginMFA(ctx context.Context, req *LoginMFAReq) (r LoginMFARes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Pkeys implements pkeys operation.
 //
-// Get current valid public keys.
+// Returns JWKS (merged from all peers when clustered). Optional query: local=true or local=1 to
+// return only this node's keys (used by peers to avoid recursion).
 //
 // GET /pkeys
 func (UnimplementedHandler) Pkeys(ctx context.Context) (r *PkeysOK, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// ProviderHealth implements provider_health operation.
+//
+// Reports the circuit breaker state of each foreign provider, the number of consecutive logins that
+// failed because the provider could not be reached and the times of the last successful and failed
+// logins. Providers are degraded while logins are failing or the circuit breaker is not closed.
+//
+// GET /provider_health
+func (UnimplementedHandler) ProviderHealth(ctx context.Context) (r *ProviderHealthOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ReadClaim implements readClaim operation.
 //
 // Finds the Claim with the requested ID and returns it.
@@ -274,6 +381,15 @@ func (UnimplementedHandler) Refresh(ctx context.Context, req *RefreshReq) (r Ref
 	return r, ht.ErrNotImplemented
 }
 
+// ResetMFA implements resetMFA operation.
+//
+// Used when a user lost their authenticator and recovery codes.
+//
+// DELETE /admin/mfa/{username}
+func (UnimplementedHandler) ResetMFA(ctx context.Context, params ResetMFAParams) (r ResetMFARes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Totals implements totals operation.
 //
 // Get entity count totals.
@@ -283,6 +399,24 @@ func (UnimplementedHandler) Totals(ctx context.Context) (r *TotalsOK, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// UnlinkIdentity implements unlinkIdentity operation.
+//
+// The only identity of a user without a local password can not be unlinked.
+//
+// DELETE /admin/identities/{id}
+func (UnimplementedHandler) UnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) (r UnlinkIdentityRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlinkMyIdentity implements unlinkMyIdentity operation.
+//
+// The only identity of a user without a local password can not be unlinked.
+//
+// DELETE /identities/{id}
+func (UnimplementedHandler) UnlinkMyIdentity(ctx context.Context, params UnlinkMyIdentityParams) (r UnlinkMyIdentityRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateClaim implements updateClaim operation.
 //
 // Updates a Claim and persists changes to storage.
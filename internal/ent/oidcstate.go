@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"stoke/internal/ent/oidcstate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OIDCState is the model entity for the OIDCState schema.
+type OIDCState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// CodeVerifier holds the value of the "code_verifier" field.
+	CodeVerifier string `json:"-"`
+	// NextURL holds the value of the "next_url" field.
+	NextURL string `json:"next_url,omitempty"`
+	// Xfer holds the value of the "xfer" field.
+	Xfer string `json:"xfer,omitempty"`
+	// AccessToken holds the value of the "access_token" field.
+	AccessToken string `json:"-"`
+	// Expires holds the value of the "expires" field.
+	Expires      time.Time `json:"expires,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OIDCState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oidcstate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case oidcstate.FieldState, oidcstate.FieldProvider, oidcstate.FieldNonce, oidcstate.FieldCodeVerifier, oidcstate.FieldNextURL, oidcstate.FieldXfer, oidcstate.FieldAccessToken:
+			values[i] = new(sql.NullString)
+		case oidcstate.FieldExpires:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OIDCState fields.
+func (os *OIDCState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oidcstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			os.ID = int(value.Int64)
+		case oidcstate.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				os.State = value.String
+			}
+		case oidcstate.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				os.Provider = value.String
+			}
+		case oidcstate.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				os.Nonce = value.String
+			}
+		case oidcstate.FieldCodeVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_verifier", values[i])
+			} else if value.Valid {
+				os.CodeVerifier = value.String
+			}
+		case oidcstate.FieldNextURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field next_url", values[i])
+			} else if value.Valid {
+				os.NextURL = value.String
+			}
+		case oidcstate.FieldXfer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field xfer", values[i])
+			} else if value.Valid {
+				os.Xfer = value.String
+			}
+		case oidcstate.FieldAccessToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_token", values[i])
+			} else if value.Valid {
+				os.AccessToken = value.String
+			}
+		case oidcstate.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				os.Expires = value.Time
+			}
+		default:
+			os.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OIDCState.
+// This includes values selected through modifiers, order, etc.
+func (os *OIDCState) Value(name string) (ent.Value, error) {
+	return os.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OIDCState.
+// Note that you need to call OIDCState.Unwrap() before calling this method if this OIDCState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (os *OIDCState) Update() *OIDCStateUpdateOne {
+	return NewOIDCStateClient(os.config).UpdateOne(os)
+}
+
+// Unwrap unwraps the OIDCState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (os *OIDCState) Unwrap() *OIDCState {
+	_tx, ok := os.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OIDCState is not a transactional entity")
+	}
+	os.config.driver = _tx.drv
+	return os
+}
+
+// String implements the fmt.Stringer.
+func (os *OIDCState) String() string {
+	var builder strings.Builder
+	builder.WriteString("OIDCState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", os.ID))
+	builder.WriteString("state=")
+	builder.WriteString(os.State)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(os.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(os.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("code_verifier=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("next_url=")
+	builder.WriteString(os.NextURL)
+	builder.WriteString(", ")
+	builder.WriteString("xfer=")
+	builder.WriteString(os.Xfer)
+	builder.WriteString(", ")
+	builder.WriteString("access_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires=")
+	builder.WriteString(os.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OIDCStates is a parsable slice of OIDCState.
+type OIDCStates []*OIDCState
@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oidcstate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the oidcstate type in the database.
+	Label = "oidc_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldNonce holds the string denoting the nonce field in the database.
+	FieldNonce = "nonce"
+	// FieldCodeVerifier holds the string denoting the code_verifier field in the database.
+	FieldCodeVerifier = "code_verifier"
+	// FieldNextURL holds the string denoting the next_url field in the database.
+	FieldNextURL = "next_url"
+	// FieldXfer holds the string denoting the xfer field in the database.
+	FieldXfer = "xfer"
+	// FieldAccessToken holds the string denoting the access_token field in the database.
+	FieldAccessToken = "access_token"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// Table holds the table name of the oidcstate in the database.
+	Table = "oidc_states"
+)
+
+// Columns holds all SQL columns for oidcstate fields.
+var Columns = []string{
+	FieldID,
+	FieldState,
+	FieldProvider,
+	FieldNonce,
+	FieldCodeVerifier,
+	FieldNextURL,
+	FieldXfer,
+	FieldAccessToken,
+	FieldExpires,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNextURL holds the default value on creation for the "next_url" field.
+	DefaultNextURL string
+	// DefaultXfer holds the default value on creation for the "xfer" field.
+	DefaultXfer string
+	// DefaultAccessToken holds the default value on creation for the "access_token" field.
+	DefaultAccessToken string
+)
+
+// OrderOption defines the ordering options for the OIDCState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByNonce orders the results by the nonce field.
+func ByNonce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNonce, opts...).ToFunc()
+}
+
+// ByCodeVerifier orders the results by the code_verifier field.
+func ByCodeVerifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeVerifier, opts...).ToFunc()
+}
+
+// ByNextURL orders the results by the next_url field.
+func ByNextURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextURL, opts...).ToFunc()
+}
+
+// ByXfer orders the results by the xfer field.
+func ByXfer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldXfer, opts...).ToFunc()
+}
+
+// ByAccessToken orders the results by the access_token field.
+func ByAccessToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessToken, opts...).ToFunc()
+}
+
+// ByExpires orders the results by the expires field.
+func ByExpires(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpires, opts...).ToFunc()
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oidcstate
+
+import (
+	"stoke/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldID, id))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldState, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldProvider, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldNonce, v))
+}
+
+// CodeVerifier applies equality check predicate on the "code_verifier" field. It's identical to CodeVerifierEQ.
+func CodeVerifier(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// NextURL applies equality check predicate on the "next_url" field. It's identical to NextURLEQ.
+func NextURL(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldNextURL, v))
+}
+
+// Xfer applies equality check predicate on the "xfer" field. It's identical to XferEQ.
+func Xfer(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldXfer, v))
+}
+
+// AccessToken applies equality check predicate on the "access_token" field. It's identical to AccessTokenEQ.
+func AccessToken(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldAccessToken, v))
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldExpires, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldState, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// CodeVerifierEQ applies the EQ predicate on the "code_verifier" field.
+func CodeVerifierEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierNEQ applies the NEQ predicate on the "code_verifier" field.
+func CodeVerifierNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIn applies the In predicate on the "code_verifier" field.
+func CodeVerifierIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierNotIn applies the NotIn predicate on the "code_verifier" field.
+func CodeVerifierNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierGT applies the GT predicate on the "code_verifier" field.
+func CodeVerifierGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierGTE applies the GTE predicate on the "code_verifier" field.
+func CodeVerifierGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLT applies the LT predicate on the "code_verifier" field.
+func CodeVerifierLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLTE applies the LTE predicate on the "code_verifier" field.
+func CodeVerifierLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContains applies the Contains predicate on the "code_verifier" field.
+func CodeVerifierContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasPrefix applies the HasPrefix predicate on the "code_verifier" field.
+func CodeVerifierHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasSuffix applies the HasSuffix predicate on the "code_verifier" field.
+func CodeVerifierHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierEqualFold applies the EqualFold predicate on the "code_verifier" field.
+func CodeVerifierEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContainsFold applies the ContainsFold predicate on the "code_verifier" field.
+func CodeVerifierContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldCodeVerifier, v))
+}
+
+// NextURLEQ applies the EQ predicate on the "next_url" field.
+func NextURLEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldNextURL, v))
+}
+
+// NextURLNEQ applies the NEQ predicate on the "next_url" field.
+func NextURLNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldNextURL, v))
+}
+
+// NextURLIn applies the In predicate on the "next_url" field.
+func NextURLIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldNextURL, vs...))
+}
+
+// NextURLNotIn applies the NotIn predicate on the "next_url" field.
+func NextURLNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldNextURL, vs...))
+}
+
+// NextURLGT applies the GT predicate on the "next_url" field.
+func NextURLGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldNextURL, v))
+}
+
+// NextURLGTE applies the GTE predicate on the "next_url" field.
+func NextURLGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldNextURL, v))
+}
+
+// NextURLLT applies the LT predicate on the "next_url" field.
+func NextURLLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldNextURL, v))
+}
+
+// NextURLLTE applies the LTE predicate on the "next_url" field.
+func NextURLLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldNextURL, v))
+}
+
+// NextURLContains applies the Contains predicate on the "next_url" field.
+func NextURLContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldNextURL, v))
+}
+
+// NextURLHasPrefix applies the HasPrefix predicate on the "next_url" field.
+func NextURLHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldNextURL, v))
+}
+
+// NextURLHasSuffix applies the HasSuffix predicate on the "next_url" field.
+func NextURLHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldNextURL, v))
+}
+
+// NextURLEqualFold applies the EqualFold predicate on the "next_url" field.
+func NextURLEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldNextURL, v))
+}
+
+// NextURLContainsFold applies the ContainsFold predicate on the "next_url" field.
+func NextURLContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldNextURL, v))
+}
+
+// XferEQ applies the EQ predicate on the "xfer" field.
+func XferEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldXfer, v))
+}
+
+// XferNEQ applies the NEQ predicate on the "xfer" field.
+func XferNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldXfer, v))
+}
+
+// XferIn applies the In predicate on the "xfer" field.
+func XferIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldXfer, vs...))
+}
+
+// XferNotIn applies the NotIn predicate on the "xfer" field.
+func XferNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldXfer, vs...))
+}
+
+// XferGT applies the GT predicate on the "xfer" field.
+func XferGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldXfer, v))
+}
+
+// XferGTE applies the GTE predicate on the "xfer" field.
+func XferGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldXfer, v))
+}
+
+// XferLT applies the LT predicate on the "xfer" field.
+func XferLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldXfer, v))
+}
+
+// XferLTE applies the LTE predicate on the "xfer" field.
+func XferLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldXfer, v))
+}
+
+// XferContains applies the Contains predicate on the "xfer" field.
+func XferContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldXfer, v))
+}
+
+// XferHasPrefix applies the HasPrefix predicate on the "xfer" field.
+func XferHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldXfer, v))
+}
+
+// XferHasSuffix applies the HasSuffix predicate on the "xfer" field.
+func XferHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldXfer, v))
+}
+
+// XferEqualFold applies the EqualFold predicate on the "xfer" field.
+func XferEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldXfer, v))
+}
+
+// XferContainsFold applies the ContainsFold predicate on the "xfer" field.
+func XferContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldXfer, v))
+}
+
+// AccessTokenEQ applies the EQ predicate on the "access_token" field.
+func AccessTokenEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldAccessToken, v))
+}
+
+// AccessTokenNEQ applies the NEQ predicate on the "access_token" field.
+func AccessTokenNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldAccessToken, v))
+}
+
+// AccessTokenIn applies the In predicate on the "access_token" field.
+func AccessTokenIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldAccessToken, vs...))
+}
+
+// AccessTokenNotIn applies the NotIn predicate on the "access_token" field.
+func AccessTokenNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldAccessToken, vs...))
+}
+
+// AccessTokenGT applies the GT predicate on the "access_token" field.
+func AccessTokenGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldAccessToken, v))
+}
+
+// AccessTokenGTE applies the GTE predicate on the "access_token" field.
+func AccessTokenGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldAccessToken, v))
+}
+
+// AccessTokenLT applies the LT predicate on the "access_token" field.
+func AccessTokenLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldAccessToken, v))
+}
+
+// AccessTokenLTE applies the LTE predicate on the "access_token" field.
+func AccessTokenLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldAccessToken, v))
+}
+
+// AccessTokenContains applies the Contains predicate on the "access_token" field.
+func AccessTokenContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldAccessToken, v))
+}
+
+// AccessTokenHasPrefix applies the HasPrefix predicate on the "access_token" field.
+func AccessTokenHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldAccessToken, v))
+}
+
+// AccessTokenHasSuffix applies the HasSuffix predicate on the "access_token" field.
+func AccessTokenHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldAccessToken, v))
+}
+
+// AccessTokenEqualFold applies the EqualFold predicate on the "access_token" field.
+func AccessTokenEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldAccessToken, v))
+}
+
+// AccessTokenContainsFold applies the ContainsFold predicate on the "access_token" field.
+func AccessTokenContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldAccessToken, v))
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldExpires, v))
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldExpires, v))
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldExpires, vs...))
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldExpires, vs...))
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldExpires, v))
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldExpires, v))
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldExpires, v))
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldExpires, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OIDCState) predicate.OIDCState {
+	return predicate.OIDCState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OIDCState) predicate.OIDCState {
+	return predicate.OIDCState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OIDCState) predicate.OIDCState {
+	return predicate.OIDCState(sql.NotPredicates(p))
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/oidcstate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OIDCStateCreate is the builder for creating a OIDCState entity.
+type OIDCStateCreate struct {
+	config
+	mutation *OIDCStateMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (osc *OIDCStateCreate) SetState(s string) *OIDCStateCreate {
+	osc.mutation.SetState(s)
+	return osc
+}
+
+// SetProvider sets the "provider" field.
+func (osc *OIDCStateCreate) SetProvider(s string) *OIDCStateCreate {
+	osc.mutation.SetProvider(s)
+	return osc
+}
+
+// SetNonce sets the "nonce" field.
+func (osc *OIDCStateCreate) SetNonce(s string) *OIDCStateCreate {
+	osc.mutation.SetNonce(s)
+	return osc
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (osc *OIDCStateCreate) SetCodeVerifier(s string) *OIDCStateCreate {
+	osc.mutation.SetCodeVerifier(s)
+	return osc
+}
+
+// SetNextURL sets the "next_url" field.
+func (osc *OIDCStateCreate) SetNextURL(s string) *OIDCStateCreate {
+	osc.mutation.SetNextURL(s)
+	return osc
+}
+
+// SetNillableNextURL sets the "next_url" field if the given value is not nil.
+func (osc *OIDCStateCreate) SetNillableNextURL(s *string) *OIDCStateCreate {
+	if s != nil {
+		osc.SetNextURL(*s)
+	}
+	return osc
+}
+
+// SetXfer sets the "xfer" field.
+func (osc *OIDCStateCreate) SetXfer(s string) *OIDCStateCreate {
+	osc.mutation.SetXfer(s)
+	return osc
+}
+
+// SetNillableXfer sets the "xfer" field if the given value is not nil.
+func (osc *OIDCStateCreate) SetNillableXfer(s *string) *OIDCStateCreate {
+	if s != nil {
+		osc.SetXfer(*s)
+	}
+	return osc
+}
+
+// SetAccessToken sets the "access_token" field.
+func (osc *OIDCStateCreate) SetAccessToken(s string) *OIDCStateCreate {
+	osc.mutation.SetAccessToken(s)
+	return osc
+}
+
+// SetNillableAccessToken sets the "access_token" field if the given value is not nil.
+func (osc *OIDCStateCreate) SetNillableAccessToken(s *string) *OIDCStateCreate {
+	if s != nil {
+		osc.SetAccessToken(*s)
+	}
+	return osc
+}
+
+// SetExpires sets the "expires" field.
+func (osc *OIDCStateCreate) SetExpires(t time.Time) *OIDCStateCreate {
+	osc.mutation.SetExpires(t)
+	return osc
+}
+
+// Mutation returns the OIDCStateMutation object of the builder.
+func (osc *OIDCStateCreate) Mutation() *OIDCStateMutation {
+	return osc.mutation
+}
+
+// Save creates the OIDCState in the database.
+func (osc *OIDCStateCreate) Save(ctx context.Context) (*OIDCState, error) {
+	osc.defaults()
+	return withHooks(ctx, osc.sqlSave, osc.mutation, osc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (osc *OIDCStateCreate) SaveX(ctx context.Context) *OIDCState {
+	v, err := osc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (osc *OIDCStateCreate) Exec(ctx context.Context) error {
+	_, err := osc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osc *OIDCStateCreate) ExecX(ctx context.Context) {
+	if err := osc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (osc *OIDCStateCreate) defaults() {
+	if _, ok := osc.mutation.NextURL(); !ok {
+		v := oidcstate.DefaultNextURL
+		osc.mutation.SetNextURL(v)
+	}
+	if _, ok := osc.mutation.Xfer(); !ok {
+		v := oidcstate.DefaultXfer
+		osc.mutation.SetXfer(v)
+	}
+	if _, ok := osc.mutation.AccessToken(); !ok {
+		v := oidcstate.DefaultAccessToken
+		osc.mutation.SetAccessToken(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (osc *OIDCStateCreate) check() error {
+	if _, ok := osc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "OIDCState.state"`)}
+	}
+	if _, ok := osc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "OIDCState.provider"`)}
+	}
+	if _, ok := osc.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "OIDCState.nonce"`)}
+	}
+	if _, ok := osc.mutation.CodeVerifier(); !ok {
+		return &ValidationError{Name: "code_verifier", err: errors.New(`ent: missing required field "OIDCState.code_verifier"`)}
+	}
+	if _, ok := osc.mutation.NextURL(); !ok {
+		return &ValidationError{Name: "next_url", err: errors.New(`ent: missing required field "OIDCState.next_url"`)}
+	}
+	if _, ok := osc.mutation.Xfer(); !ok {
+		return &ValidationError{Name: "xfer", err: errors.New(`ent: missing required field "OIDCState.xfer"`)}
+	}
+	if _, ok := osc.mutation.AccessToken(); !ok {
+		return &ValidationError{Name: "access_token", err: errors.New(`ent: missing required field "OIDCState.access_token"`)}
+	}
+	if _, ok := osc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New(`ent: missing required field "OIDCState.expires"`)}
+	}
+	return nil
+}
+
+func (osc *OIDCStateCreate) sqlSave(ctx context.Context) (*OIDCState, error) {
+	if err := osc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := osc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, osc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	osc.mutation.id = &_node.ID
+	osc.mutation.done = true
+	return _node, nil
+}
+
+func (osc *OIDCStateCreate) createSpec() (*OIDCState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OIDCState{config: osc.config}
+		_spec = sqlgraph.NewCreateSpec(oidcstate.Table, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeInt))
+	)
+	if value, ok := osc.mutation.State(); ok {
+		_spec.SetField(oidcstate.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := osc.mutation.Provider(); ok {
+		_spec.SetField(oidcstate.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := osc.mutation.Nonce(); ok {
+		_spec.SetField(oidcstate.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := osc.mutation.CodeVerifier(); ok {
+		_spec.SetField(oidcstate.FieldCodeVerifier, field.TypeString, value)
+		_node.CodeVerifier = value
+	}
+	if value, ok := osc.mutation.NextURL(); ok {
+		_spec.SetField(oidcstate.FieldNextURL, field.TypeString, value)
+		_node.NextURL = value
+	}
+	if value, ok := osc.mutation.Xfer(); ok {
+		_spec.SetField(oidcstate.FieldXfer, field.TypeString, value)
+		_node.Xfer = value
+	}
+	if value, ok := osc.mutation.AccessToken(); ok {
+		_spec.SetField(oidcstate.FieldAccessToken, field.TypeString, value)
+		_node.AccessToken = value
+	}
+	if value, ok := osc.mutation.Expires(); ok {
+		_spec.SetField(oidcstate.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// OIDCStateCreateBulk is the builder for creating many OIDCState entities in bulk.
+type OIDCStateCreateBulk struct {
+	config
+	err      error
+	builders []*OIDCStateCreate
+}
+
+// Save creates the OIDCState entities in the database.
+func (oscb *OIDCStateCreateBulk) Save(ctx context.Context) ([]*OIDCState, error) {
+	if oscb.err != nil {
+		return nil, oscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oscb.builders))
+	nodes := make([]*OIDCState, len(oscb.builders))
+	mutators := make([]Mutator, len(oscb.builders))
+	for i := range oscb.builders {
+		func(i int, root context.Context) {
+			builder := oscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OIDCStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oscb *OIDCStateCreateBulk) SaveX(ctx context.Context) []*OIDCState {
+	v, err := oscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oscb *OIDCStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := oscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oscb *OIDCStateCreateBulk) ExecX(ctx context.Context) {
+	if err := oscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"stoke/internal/ent/oidcstate"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OIDCStateDelete is the builder for deleting a OIDCState entity.
+type OIDCStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *OIDCStateMutation
+}
+
+// Where appends a list predicates to the OIDCStateDelete builder.
+func (osd *OIDCStateDelete) Where(ps ...predicate.OIDCState) *OIDCStateDelete {
+	osd.mutation.Where(ps...)
+	return osd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (osd *OIDCStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, osd.sqlExec, osd.mutation, osd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osd *OIDCStateDelete) ExecX(ctx context.Context) int {
+	n, err := osd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (osd *OIDCStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(oidcstate.Table, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeInt))
+	if ps := osd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, osd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	osd.mutation.done = true
+	return affected, err
+}
+
+// OIDCStateDeleteOne is the builder for deleting a single OIDCState entity.
+type OIDCStateDeleteOne struct {
+	osd *OIDCStateDelete
+}
+
+// Where appends a list predicates to the OIDCStateDelete builder.
+func (osdo *OIDCStateDeleteOne) Where(ps ...predicate.OIDCState) *OIDCStateDeleteOne {
+	osdo.osd.mutation.Where(ps...)
+	return osdo
+}
+
+// Exec executes the deletion query.
+func (osdo *OIDCStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := osdo.osd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oidcstate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osdo *OIDCStateDeleteOne) ExecX(ctx context.Context) {
+	if err := osdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"stoke/internal/ent/oidcstate"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OIDCStateQuery is the builder for querying OIDCState entities.
+type OIDCStateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []oidcstate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OIDCState
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OIDCStateQuery builder.
+func (osq *OIDCStateQuery) Where(ps ...predicate.OIDCState) *OIDCStateQuery {
+	osq.predicates = append(osq.predicates, ps...)
+	return osq
+}
+
+// Limit the number of records to be returned by this query.
+func (osq *OIDCStateQuery) Limit(limit int) *OIDCStateQuery {
+	osq.ctx.Limit = &limit
+	return osq
+}
+
+// Offset to start from.
+func (osq *OIDCStateQuery) Offset(offset int) *OIDCStateQuery {
+	osq.ctx.Offset = &offset
+	return osq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (osq *OIDCStateQuery) Unique(unique bool) *OIDCStateQuery {
+	osq.ctx.Unique = &unique
+	return osq
+}
+
+// Order specifies how the records should be ordered.
+func (osq *OIDCStateQuery) Order(o ...oidcstate.OrderOption) *OIDCStateQuery {
+	osq.order = append(osq.order, o...)
+	return osq
+}
+
+// First returns the first OIDCState entity from the query.
+// Returns a *NotFoundError when no OIDCState was found.
+func (osq *OIDCStateQuery) First(ctx context.Context) (*OIDCState, error) {
+	nodes, err := osq.Limit(1).All(setContextOp(ctx, osq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oidcstate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (osq *OIDCStateQuery) FirstX(ctx context.Context) *OIDCState {
+	node, err := osq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OIDCState ID from the query.
+// Returns a *NotFoundError when no OIDCState ID was found.
+func (osq *OIDCStateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = osq.Limit(1).IDs(setContextOp(ctx, osq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oidcstate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (osq *OIDCStateQuery) FirstIDX(ctx context.Context) int {
+	id, err := osq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OIDCState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OIDCState entity is found.
+// Returns a *NotFoundError when no OIDCState entities are found.
+func (osq *OIDCStateQuery) Only(ctx context.Context) (*OIDCState, error) {
+	nodes, err := osq.Limit(2).All(setContextOp(ctx, osq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oidcstate.Label}
+	default:
+		return nil, &NotSingularError{oidcstate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (osq *OIDCStateQuery) OnlyX(ctx context.Context) *OIDCState {
+	node, err := osq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OIDCState ID in the query.
+// Returns a *NotSingularError when more than one OIDCState ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (osq *OIDCStateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = osq.Limit(2).IDs(setContextOp(ctx, osq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oidcstate.Label}
+	default:
+		err = &NotSingularError{oidcstate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (osq *OIDCStateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := osq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OIDCStates.
+func (osq *OIDCStateQuery) All(ctx context.Context) ([]*OIDCState, error) {
+	ctx = setContextOp(ctx, osq.ctx, "All")
+	if err := osq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OIDCState, *OIDCStateQuery]()
+	return withInterceptors[[]*OIDCState](ctx, osq, qr, osq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (osq *OIDCStateQuery) AllX(ctx context.Context) []*OIDCState {
+	nodes, err := osq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OIDCState IDs.
+func (osq *OIDCStateQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if osq.ctx.Unique == nil && osq.path != nil {
+		osq.Unique(true)
+	}
+	ctx = setContextOp(ctx, osq.ctx, "IDs")
+	if err = osq.Select(oidcstate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (osq *OIDCStateQuery) IDsX(ctx context.Context) []int {
+	ids, err := osq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (osq *OIDCStateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, osq.ctx, "Count")
+	if err := osq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, osq, querierCount[*OIDCStateQuery](), osq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (osq *OIDCStateQuery) CountX(ctx context.Context) int {
+	count, err := osq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (osq *OIDCStateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, osq.ctx, "Exist")
+	switch _, err := osq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (osq *OIDCStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := osq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OIDCStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (osq *OIDCStateQuery) Clone() *OIDCStateQuery {
+	if osq == nil {
+		return nil
+	}
+	return &OIDCStateQuery{
+		config:     osq.config,
+		ctx:        osq.ctx.Clone(),
+		order:      append([]oidcstate.OrderOption{}, osq.order...),
+		inters:     append([]Interceptor{}, osq.inters...),
+		predicates: append([]predicate.OIDCState{}, osq.predicates...),
+		// clone intermediate query.
+		sql:  osq.sql.Clone(),
+		path: osq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OIDCState.Query().
+//		GroupBy(oidcstate.FieldState).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (osq *OIDCStateQuery) GroupBy(field string, fields ...string) *OIDCStateGroupBy {
+	osq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OIDCStateGroupBy{build: osq}
+	grbuild.flds = &osq.ctx.Fields
+	grbuild.label = oidcstate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//	}
+//
+//	client.OIDCState.Query().
+//		Select(oidcstate.FieldState).
+//		Scan(ctx, &v)
+func (osq *OIDCStateQuery) Select(fields ...string) *OIDCStateSelect {
+	osq.ctx.Fields = append(osq.ctx.Fields, fields...)
+	sbuild := &OIDCStateSelect{OIDCStateQuery: osq}
+	sbuild.label = oidcstate.Label
+	sbuild.flds, sbuild.scan = &osq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OIDCStateSelect configured with the given aggregations.
+func (osq *OIDCStateQuery) Aggregate(fns ...AggregateFunc) *OIDCStateSelect {
+	return osq.Select().Aggregate(fns...)
+}
+
+func (osq *OIDCStateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range osq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, osq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range osq.ctx.Fields {
+		if !oidcstate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if osq.path != nil {
+		prev, err := osq.path(ctx)
+		if err != nil {
+			return err
+		}
+		osq.sql = prev
+	}
+	return nil
+}
+
+func (osq *OIDCStateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OIDCState, error) {
+	var (
+		nodes = []*OIDCState{}
+		_spec = osq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OIDCState).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OIDCState{config: osq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, osq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (osq *OIDCStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := osq.querySpec()
+	_spec.Node.Columns = osq.ctx.Fields
+	if len(osq.ctx.Fields) > 0 {
+		_spec.Unique = osq.ctx.Unique != nil && *osq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, osq.driver, _spec)
+}
+
+func (osq *OIDCStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(oidcstate.Table, oidcstate.Columns, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeInt))
+	_spec.From = osq.sql
+	if unique := osq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if osq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := osq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidcstate.FieldID)
+		for i := range fields {
+			if fields[i] != oidcstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := osq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := osq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := osq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := osq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (osq *OIDCStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(osq.driver.Dialect())
+	t1 := builder.Table(oidcstate.Table)
+	columns := osq.ctx.Fields
+	if len(columns) == 0 {
+		columns = oidcstate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if osq.sql != nil {
+		selector = osq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if osq.ctx.Unique != nil && *osq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range osq.predicates {
+		p(selector)
+	}
+	for _, p := range osq.order {
+		p(selector)
+	}
+	if offset := osq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := osq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OIDCStateGroupBy is the group-by builder for OIDCState entities.
+type OIDCStateGroupBy struct {
+	selector
+	build *OIDCStateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (osgb *OIDCStateGroupBy) Aggregate(fns ...AggregateFunc) *OIDCStateGroupBy {
+	osgb.fns = append(osgb.fns, fns...)
+	return osgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (osgb *OIDCStateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, osgb.build.ctx, "GroupBy")
+	if err := osgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OIDCStateQuery, *OIDCStateGroupBy](ctx, osgb.build, osgb, osgb.build.inters, v)
+}
+
+func (osgb *OIDCStateGroupBy) sqlScan(ctx context.Context, root *OIDCStateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(osgb.fns))
+	for _, fn := range osgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*osgb.flds)+len(osgb.fns))
+		for _, f := range *osgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*osgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := osgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OIDCStateSelect is the builder for selecting fields of OIDCState entities.
+type OIDCStateSelect struct {
+	*OIDCStateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oss *OIDCStateSelect) Aggregate(fns ...AggregateFunc) *OIDCStateSelect {
+	oss.fns = append(oss.fns, fns...)
+	return oss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oss *OIDCStateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oss.ctx, "Select")
+	if err := oss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OIDCStateQuery, *OIDCStateSelect](ctx, oss.OIDCStateQuery, oss, oss.inters, v)
+}
+
+func (oss *OIDCStateSelect) sqlScan(ctx context.Context, root *OIDCStateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oss.fns))
+	for _, fn := range oss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
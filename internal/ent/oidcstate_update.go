@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/oidcstate"
+	"stoke/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OIDCStateUpdate is the builder for updating OIDCState entities.
+type OIDCStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OIDCStateMutation
+}
+
+// Where appends a list predicates to the OIDCStateUpdate builder.
+func (osu *OIDCStateUpdate) Where(ps ...predicate.OIDCState) *OIDCStateUpdate {
+	osu.mutation.Where(ps...)
+	return osu
+}
+
+// Mutation returns the OIDCStateMutation object of the builder.
+func (osu *OIDCStateUpdate) Mutation() *OIDCStateMutation {
+	return osu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (osu *OIDCStateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, osu.sqlSave, osu.mutation, osu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (osu *OIDCStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := osu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (osu *OIDCStateUpdate) Exec(ctx context.Context) error {
+	_, err := osu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osu *OIDCStateUpdate) ExecX(ctx context.Context) {
+	if err := osu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (osu *OIDCStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidcstate.Table, oidcstate.Columns, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeInt))
+	if ps := osu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, osu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	osu.mutation.done = true
+	return n, nil
+}
+
+// OIDCStateUpdateOne is the builder for updating a single OIDCState entity.
+type OIDCStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OIDCStateMutation
+}
+
+// Mutation returns the OIDCStateMutation object of the builder.
+func (osuo *OIDCStateUpdateOne) Mutation() *OIDCStateMutation {
+	return osuo.mutation
+}
+
+// Where appends a list predicates to the OIDCStateUpdate builder.
+func (osuo *OIDCStateUpdateOne) Where(ps ...predicate.OIDCState) *OIDCStateUpdateOne {
+	osuo.mutation.Where(ps...)
+	return osuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (osuo *OIDCStateUpdateOne) Select(field string, fields ...string) *OIDCStateUpdateOne {
+	osuo.fields = append([]string{field}, fields...)
+	return osuo
+}
+
+// Save executes the query and returns the updated OIDCState entity.
+func (osuo *OIDCStateUpdateOne) Save(ctx context.Context) (*OIDCState, error) {
+	return withHooks(ctx, osuo.sqlSave, osuo.mutation, osuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (osuo *OIDCStateUpdateOne) SaveX(ctx context.Context) *OIDCState {
+	node, err := osuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (osuo *OIDCStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := osuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osuo *OIDCStateUpdateOne) ExecX(ctx context.Context) {
+	if err := osuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (osuo *OIDCStateUpdateOne) sqlSave(ctx context.Context) (_node *OIDCState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidcstate.Table, oidcstate.Columns, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeInt))
+	id, ok := osuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OIDCState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := osuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidcstate.FieldID)
+		for _, f := range fields {
+			if !oidcstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oidcstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := osuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &OIDCState{config: osuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, osuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	osuo.mutation.done = true
+	return _node, nil
+}
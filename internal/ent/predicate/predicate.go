@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// CachedCredential is the predicate function for cachedcredential builders.
+type CachedCredential func(*sql.Selector)
+
 // Claim is the predicate function for claim builders.
 type Claim func(*sql.Selector)
 
@@ -18,8 +21,29 @@ type DBInitFile func(*sql.Selector)
 // GroupLink is the predicate function for grouplink builders.
 type GroupLink func(*sql.Selector)
 
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
+// Lease is the predicate function for lease builders.
+type Lease func(*sql.Selector)
+
+// LoginCode is the predicate function for logincode builders.
+type LoginCode func(*sql.Selector)
+
+// LogoutToken is the predicate function for logouttoken builders.
+type LogoutToken func(*sql.Selector)
+
+// MFAChallenge is the predicate function for mfachallenge builders.
+type MFAChallenge func(*sql.Selector)
+
+// OIDCState is the predicate function for oidcstate builders.
+type OIDCState func(*sql.Selector)
+
 // PrivateKey is the predicate function for privatekey builders.
 type PrivateKey func(*sql.Selector)
 
+// ProviderSession is the predicate function for providersession builders.
+type ProviderSession func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -109,6 +109,30 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The CachedCredentialQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CachedCredentialQueryRuleFunc func(context.Context, *ent.CachedCredentialQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CachedCredentialQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CachedCredentialQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CachedCredentialQuery", q)
+}
+
+// The CachedCredentialMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CachedCredentialMutationRuleFunc func(context.Context, *ent.CachedCredentialMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CachedCredentialMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CachedCredentialMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CachedCredentialMutation", m)
+}
+
 // The ClaimQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ClaimQueryRuleFunc func(context.Context, *ent.ClaimQuery) error
@@ -205,6 +229,150 @@ func (f GroupLinkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutat
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.GroupLinkMutation", m)
 }
 
+// The IdentityQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type IdentityQueryRuleFunc func(context.Context, *ent.IdentityQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f IdentityQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.IdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.IdentityQuery", q)
+}
+
+// The IdentityMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type IdentityMutationRuleFunc func(context.Context, *ent.IdentityMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f IdentityMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.IdentityMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.IdentityMutation", m)
+}
+
+// The LeaseQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LeaseQueryRuleFunc func(context.Context, *ent.LeaseQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LeaseQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LeaseQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LeaseQuery", q)
+}
+
+// The LeaseMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LeaseMutationRuleFunc func(context.Context, *ent.LeaseMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LeaseMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LeaseMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LeaseMutation", m)
+}
+
+// The LoginCodeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LoginCodeQueryRuleFunc func(context.Context, *ent.LoginCodeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LoginCodeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LoginCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LoginCodeQuery", q)
+}
+
+// The LoginCodeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LoginCodeMutationRuleFunc func(context.Context, *ent.LoginCodeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LoginCodeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LoginCodeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoginCodeMutation", m)
+}
+
+// The LogoutTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LogoutTokenQueryRuleFunc func(context.Context, *ent.LogoutTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LogoutTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LogoutTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LogoutTokenQuery", q)
+}
+
+// The LogoutTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LogoutTokenMutationRuleFunc func(context.Context, *ent.LogoutTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LogoutTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LogoutTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LogoutTokenMutation", m)
+}
+
+// The MFAChallengeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MFAChallengeQueryRuleFunc func(context.Context, *ent.MFAChallengeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MFAChallengeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MFAChallengeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MFAChallengeQuery", q)
+}
+
+// The MFAChallengeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MFAChallengeMutationRuleFunc func(context.Context, *ent.MFAChallengeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MFAChallengeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MFAChallengeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MFAChallengeMutation", m)
+}
+
+// The OIDCStateQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OIDCStateQueryRuleFunc func(context.Context, *ent.OIDCStateQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OIDCStateQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OIDCStateQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OIDCStateQuery", q)
+}
+
+// The OIDCStateMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OIDCStateMutationRuleFunc func(context.Context, *ent.OIDCStateMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OIDCStateMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OIDCStateMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OIDCStateMutation", m)
+}
+
 // The PrivateKeyQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PrivateKeyQueryRuleFunc func(context.Context, *ent.PrivateKeyQuery) error
@@ -229,6 +397,30 @@ func (f PrivateKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Muta
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PrivateKeyMutation", m)
 }
 
+// The ProviderSessionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ProviderSessionQueryRuleFunc func(context.Context, *ent.ProviderSessionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ProviderSessionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ProviderSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ProviderSessionQuery", q)
+}
+
+// The ProviderSessionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ProviderSessionMutationRuleFunc func(context.Context, *ent.ProviderSessionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ProviderSessionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ProviderSessionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ProviderSessionMutation", m)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
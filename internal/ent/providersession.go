@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"stoke/internal/ent/providersession"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProviderSession is the model entity for the ProviderSession schema.
+type ProviderSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Session holds the value of the "session" field.
+	Session string `json:"session,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Sid holds the value of the "sid" field.
+	Sid string `json:"sid,omitempty"`
+	// Revoked holds the value of the "revoked" field.
+	Revoked bool `json:"revoked,omitempty"`
+	// Created holds the value of the "created" field.
+	Created time.Time `json:"created,omitempty"`
+	// Expires holds the value of the "expires" field.
+	Expires      time.Time `json:"expires,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case providersession.FieldRevoked:
+			values[i] = new(sql.NullBool)
+		case providersession.FieldID:
+			values[i] = new(sql.NullInt64)
+		case providersession.FieldSession, providersession.FieldProvider, providersession.FieldSubject, providersession.FieldSid:
+			values[i] = new(sql.NullString)
+		case providersession.FieldCreated, providersession.FieldExpires:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderSession fields.
+func (ps *ProviderSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case providersession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ps.ID = int(value.Int64)
+		case providersession.FieldSession:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session", values[i])
+			} else if value.Valid {
+				ps.Session = value.String
+			}
+		case providersession.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				ps.Provider = value.String
+			}
+		case providersession.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				ps.Subject = value.String
+			}
+		case providersession.FieldSid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sid", values[i])
+			} else if value.Valid {
+				ps.Sid = value.String
+			}
+		case providersession.FieldRevoked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked", values[i])
+			} else if value.Valid {
+				ps.Revoked = value.Bool
+			}
+		case providersession.FieldCreated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created", values[i])
+			} else if value.Valid {
+				ps.Created = value.Time
+			}
+		case providersession.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				ps.Expires = value.Time
+			}
+		default:
+			ps.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderSession.
+// This includes values selected through modifiers, order, etc.
+func (ps *ProviderSession) Value(name string) (ent.Value, error) {
+	return ps.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProviderSession.
+// Note that you need to call ProviderSession.Unwrap() before calling this method if this ProviderSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ps *ProviderSession) Update() *ProviderSessionUpdateOne {
+	return NewProviderSessionClient(ps.config).UpdateOne(ps)
+}
+
+// Unwrap unwraps the ProviderSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ps *ProviderSession) Unwrap() *ProviderSession {
+	_tx, ok := ps.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderSession is not a transactional entity")
+	}
+	ps.config.driver = _tx.drv
+	return ps
+}
+
+// String implements the fmt.Stringer.
+func (ps *ProviderSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ps.ID))
+	builder.WriteString("session=")
+	builder.WriteString(ps.Session)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(ps.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(ps.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("sid=")
+	builder.WriteString(ps.Sid)
+	builder.WriteString(", ")
+	builder.WriteString("revoked=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Revoked))
+	builder.WriteString(", ")
+	builder.WriteString("created=")
+	builder.WriteString(ps.Created.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires=")
+	builder.WriteString(ps.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderSessions is a parsable slice of ProviderSession.
+type ProviderSessions []*ProviderSession
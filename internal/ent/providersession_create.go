@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/providersession"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProviderSessionCreate is the builder for creating a ProviderSession entity.
+type ProviderSessionCreate struct {
+	config
+	mutation *ProviderSessionMutation
+	hooks    []Hook
+}
+
+// SetSession sets the "session" field.
+func (psc *ProviderSessionCreate) SetSession(s string) *ProviderSessionCreate {
+	psc.mutation.SetSession(s)
+	return psc
+}
+
+// SetProvider sets the "provider" field.
+func (psc *ProviderSessionCreate) SetProvider(s string) *ProviderSessionCreate {
+	psc.mutation.SetProvider(s)
+	return psc
+}
+
+// SetSubject sets the "subject" field.
+func (psc *ProviderSessionCreate) SetSubject(s string) *ProviderSessionCreate {
+	psc.mutation.SetSubject(s)
+	return psc
+}
+
+// SetSid sets the "sid" field.
+func (psc *ProviderSessionCreate) SetSid(s string) *ProviderSessionCreate {
+	psc.mutation.SetSid(s)
+	return psc
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (psc *ProviderSessionCreate) SetNillableSid(s *string) *ProviderSessionCreate {
+	if s != nil {
+		psc.SetSid(*s)
+	}
+	return psc
+}
+
+// SetRevoked sets the "revoked" field.
+func (psc *ProviderSessionCreate) SetRevoked(b bool) *ProviderSessionCreate {
+	psc.mutation.SetRevoked(b)
+	return psc
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (psc *ProviderSessionCreate) SetNillableRevoked(b *bool) *ProviderSessionCreate {
+	if b != nil {
+		psc.SetRevoked(*b)
+	}
+	return psc
+}
+
+// SetCreated sets the "created" field.
+func (psc *ProviderSessionCreate) SetCreated(t time.Time) *ProviderSessionCreate {
+	psc.mutation.SetCreated(t)
+	return psc
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (psc *ProviderSessionCreate) SetNillableCreated(t *time.Time) *ProviderSessionCreate {
+	if t != nil {
+		psc.SetCreated(*t)
+	}
+	return psc
+}
+
+// SetExpires sets the "expires" field.
+func (psc *ProviderSessionCreate) SetExpires(t time.Time) *ProviderSessionCreate {
+	psc.mutation.SetExpires(t)
+	return psc
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (psc *ProviderSessionCreate) SetNillableExpires(t *time.Time) *ProviderSessionCreate {
+	if t != nil {
+		psc.SetExpires(*t)
+	}
+	return psc
+}
+
+// Mutation returns the ProviderSessionMutation object of the builder.
+func (psc *ProviderSessionCreate) Mutation() *ProviderSessionMutation {
+	return psc.mutation
+}
+
+// Save creates the ProviderSession in the database.
+func (psc *ProviderSessionCreate) Save(ctx context.Context) (*ProviderSession, error) {
+	psc.defaults()
+	return withHooks(ctx, psc.sqlSave, psc.mutation, psc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psc *ProviderSessionCreate) SaveX(ctx context.Context) *ProviderSession {
+	v, err := psc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psc *ProviderSessionCreate) Exec(ctx context.Context) error {
+	_, err := psc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psc *ProviderSessionCreate) ExecX(ctx context.Context) {
+	if err := psc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psc *ProviderSessionCreate) defaults() {
+	if _, ok := psc.mutation.Sid(); !ok {
+		v := providersession.DefaultSid
+		psc.mutation.SetSid(v)
+	}
+	if _, ok := psc.mutation.Revoked(); !ok {
+		v := providersession.DefaultRevoked
+		psc.mutation.SetRevoked(v)
+	}
+	if _, ok := psc.mutation.Created(); !ok {
+		v := providersession.DefaultCreated()
+		psc.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psc *ProviderSessionCreate) check() error {
+	if _, ok := psc.mutation.Session(); !ok {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required field "ProviderSession.session"`)}
+	}
+	if _, ok := psc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "ProviderSession.provider"`)}
+	}
+	if _, ok := psc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "ProviderSession.subject"`)}
+	}
+	if _, ok := psc.mutation.Sid(); !ok {
+		return &ValidationError{Name: "sid", err: errors.New(`ent: missing required field "ProviderSession.sid"`)}
+	}
+	if _, ok := psc.mutation.Revoked(); !ok {
+		return &ValidationError{Name: "revoked", err: errors.New(`ent: missing required field "ProviderSession.revoked"`)}
+	}
+	if _, ok := psc.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New(`ent: missing required field "ProviderSession.created"`)}
+	}
+	return nil
+}
+
+func (psc *ProviderSessionCreate) sqlSave(ctx context.Context) (*ProviderSession, error) {
+	if err := psc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := psc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	psc.mutation.id = &_node.ID
+	psc.mutation.done = true
+	return _node, nil
+}
+
+func (psc *ProviderSessionCreate) createSpec() (*ProviderSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderSession{config: psc.config}
+		_spec = sqlgraph.NewCreateSpec(providersession.Table, sqlgraph.NewFieldSpec(providersession.FieldID, field.TypeInt))
+	)
+	if value, ok := psc.mutation.Session(); ok {
+		_spec.SetField(providersession.FieldSession, field.TypeString, value)
+		_node.Session = value
+	}
+	if value, ok := psc.mutation.Provider(); ok {
+		_spec.SetField(providersession.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := psc.mutation.Subject(); ok {
+		_spec.SetField(providersession.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := psc.mutation.Sid(); ok {
+		_spec.SetField(providersession.FieldSid, field.TypeString, value)
+		_node.Sid = value
+	}
+	if value, ok := psc.mutation.Revoked(); ok {
+		_spec.SetField(providersession.FieldRevoked, field.TypeBool, value)
+		_node.Revoked = value
+	}
+	if value, ok := psc.mutation.Created(); ok {
+		_spec.SetField(providersession.FieldCreated, field.TypeTime, value)
+		_node.Created = value
+	}
+	if value, ok := psc.mutation.Expires(); ok {
+		_spec.SetField(providersession.FieldExpires, field.TypeTime, value)
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// ProviderSessionCreateBulk is the builder for creating many ProviderSession entities in bulk.
+type ProviderSessionCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderSessionCreate
+}
+
+// Save creates the ProviderSession entities in the database.
+func (pscb *ProviderSessionCreateBulk) Save(ctx context.Context) ([]*ProviderSession, error) {
+	if pscb.err != nil {
+		return nil, pscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pscb.builders))
+	nodes := make([]*ProviderSession, len(pscb.builders))
+	mutators := make([]Mutator, len(pscb.builders))
+	for i := range pscb.builders {
+		func(i int, root context.Context) {
+			builder := pscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pscb *ProviderSessionCreateBulk) SaveX(ctx context.Context) []*ProviderSession {
+	v, err := pscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pscb *ProviderSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pscb *ProviderSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := pscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
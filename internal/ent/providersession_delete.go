@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"stoke/internal/ent/predicate"
+	"stoke/internal/ent/providersession"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProviderSessionDelete is the builder for deleting a ProviderSession entity.
+type ProviderSessionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProviderSessionMutation
+}
+
+// Where appends a list predicates to the ProviderSessionDelete builder.
+func (psd *ProviderSessionDelete) Where(ps ...predicate.ProviderSession) *ProviderSessionDelete {
+	psd.mutation.Where(ps...)
+	return psd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psd *ProviderSessionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, psd.sqlExec, psd.mutation, psd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psd *ProviderSessionDelete) ExecX(ctx context.Context) int {
+	n, err := psd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psd *ProviderSessionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(providersession.Table, sqlgraph.NewFieldSpec(providersession.FieldID, field.TypeInt))
+	if ps := psd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	psd.mutation.done = true
+	return affected, err
+}
+
+// ProviderSessionDeleteOne is the builder for deleting a single ProviderSession entity.
+type ProviderSessionDeleteOne struct {
+	psd *ProviderSessionDelete
+}
+
+// Where appends a list predicates to the ProviderSessionDelete builder.
+func (psdo *ProviderSessionDeleteOne) Where(ps ...predicate.ProviderSession) *ProviderSessionDeleteOne {
+	psdo.psd.mutation.Where(ps...)
+	return psdo
+}
+
+// Exec executes the deletion query.
+func (psdo *ProviderSessionDeleteOne) Exec(ctx context.Context) error {
+	n, err := psdo.psd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{providersession.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psdo *ProviderSessionDeleteOne) ExecX(ctx context.Context) {
+	if err := psdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
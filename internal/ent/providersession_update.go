@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent/predicate"
+	"stoke/internal/ent/providersession"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProviderSessionUpdate is the builder for updating ProviderSession entities.
+type ProviderSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderSessionMutation
+}
+
+// Where appends a list predicates to the ProviderSessionUpdate builder.
+func (psu *ProviderSessionUpdate) Where(ps ...predicate.ProviderSession) *ProviderSessionUpdate {
+	psu.mutation.Where(ps...)
+	return psu
+}
+
+// SetRevoked sets the "revoked" field.
+func (psu *ProviderSessionUpdate) SetRevoked(b bool) *ProviderSessionUpdate {
+	psu.mutation.SetRevoked(b)
+	return psu
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (psu *ProviderSessionUpdate) SetNillableRevoked(b *bool) *ProviderSessionUpdate {
+	if b != nil {
+		psu.SetRevoked(*b)
+	}
+	return psu
+}
+
+// SetExpires sets the "expires" field.
+func (psu *ProviderSessionUpdate) SetExpires(t time.Time) *ProviderSessionUpdate {
+	psu.mutation.SetExpires(t)
+	return psu
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (psu *ProviderSessionUpdate) SetNillableExpires(t *time.Time) *ProviderSessionUpdate {
+	if t != nil {
+		psu.SetExpires(*t)
+	}
+	return psu
+}
+
+// ClearExpires clears the value of the "expires" field.
+func (psu *ProviderSessionUpdate) ClearExpires() *ProviderSessionUpdate {
+	psu.mutation.ClearExpires()
+	return psu
+}
+
+// Mutation returns the ProviderSessionMutation object of the builder.
+func (psu *ProviderSessionUpdate) Mutation() *ProviderSessionMutation {
+	return psu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (psu *ProviderSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, psu.sqlSave, psu.mutation, psu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psu *ProviderSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := psu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (psu *ProviderSessionUpdate) Exec(ctx context.Context) error {
+	_, err := psu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psu *ProviderSessionUpdate) ExecX(ctx context.Context) {
+	if err := psu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (psu *ProviderSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(providersession.Table, providersession.Columns, sqlgraph.NewFieldSpec(providersession.FieldID, field.TypeInt))
+	if ps := psu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psu.mutation.Revoked(); ok {
+		_spec.SetField(providersession.FieldRevoked, field.TypeBool, value)
+	}
+	if value, ok := psu.mutation.Expires(); ok {
+		_spec.SetField(providersession.FieldExpires, field.TypeTime, value)
+	}
+	if psu.mutation.ExpiresCleared() {
+		_spec.ClearField(providersession.FieldExpires, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, psu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	psu.mutation.done = true
+	return n, nil
+}
+
+// ProviderSessionUpdateOne is the builder for updating a single ProviderSession entity.
+type ProviderSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderSessionMutation
+}
+
+// SetRevoked sets the "revoked" field.
+func (psuo *ProviderSessionUpdateOne) SetRevoked(b bool) *ProviderSessionUpdateOne {
+	psuo.mutation.SetRevoked(b)
+	return psuo
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (psuo *ProviderSessionUpdateOne) SetNillableRevoked(b *bool) *ProviderSessionUpdateOne {
+	if b != nil {
+		psuo.SetRevoked(*b)
+	}
+	return psuo
+}
+
+// SetExpires sets the "expires" field.
+func (psuo *ProviderSessionUpdateOne) SetExpires(t time.Time) *ProviderSessionUpdateOne {
+	psuo.mutation.SetExpires(t)
+	return psuo
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (psuo *ProviderSessionUpdateOne) SetNillableExpires(t *time.Time) *ProviderSessionUpdateOne {
+	if t != nil {
+		psuo.SetExpires(*t)
+	}
+	return psuo
+}
+
+// ClearExpires clears the value of the "expires" field.
+func (psuo *ProviderSessionUpdateOne) ClearExpires() *ProviderSessionUpdateOne {
+	psuo.mutation.ClearExpires()
+	return psuo
+}
+
+// Mutation returns the ProviderSessionMutation object of the builder.
+func (psuo *ProviderSessionUpdateOne) Mutation() *ProviderSessionMutation {
+	return psuo.mutation
+}
+
+// Where appends a list predicates to the ProviderSessionUpdate builder.
+func (psuo *ProviderSessionUpdateOne) Where(ps ...predicate.ProviderSession) *ProviderSessionUpdateOne {
+	psuo.mutation.Where(ps...)
+	return psuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (psuo *ProviderSessionUpdateOne) Select(field string, fields ...string) *ProviderSessionUpdateOne {
+	psuo.fields = append([]string{field}, fields...)
+	return psuo
+}
+
+// Save executes the query and returns the updated ProviderSession entity.
+func (psuo *ProviderSessionUpdateOne) Save(ctx context.Context) (*ProviderSession, error) {
+	return withHooks(ctx, psuo.sqlSave, psuo.mutation, psuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psuo *ProviderSessionUpdateOne) SaveX(ctx context.Context) *ProviderSession {
+	node, err := psuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (psuo *ProviderSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := psuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psuo *ProviderSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := psuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (psuo *ProviderSessionUpdateOne) sqlSave(ctx context.Context) (_node *ProviderSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(providersession.Table, providersession.Columns, sqlgraph.NewFieldSpec(providersession.FieldID, field.TypeInt))
+	id, ok := psuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := psuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providersession.FieldID)
+		for _, f := range fields {
+			if !providersession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != providersession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := psuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psuo.mutation.Revoked(); ok {
+		_spec.SetField(providersession.FieldRevoked, field.TypeBool, value)
+	}
+	if value, ok := psuo.mutation.Expires(); ok {
+		_spec.SetField(providersession.FieldExpires, field.TypeTime, value)
+	}
+	if psuo.mutation.ExpiresCleared() {
+		_spec.ClearField(providersession.FieldExpires, field.TypeTime)
+	}
+	_node = &ProviderSession{config: psuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, psuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	psuo.mutation.done = true
+	return _node, nil
+}
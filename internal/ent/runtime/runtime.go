@@ -6,6 +6,10 @@ import (
 	"context"
 	"stoke/internal/ent/claim"
 	"stoke/internal/ent/claimgroup"
+	"stoke/internal/ent/identity"
+	"stoke/internal/ent/mfachallenge"
+	"stoke/internal/ent/oidcstate"
+	"stoke/internal/ent/providersession"
 	"stoke/internal/ent/schema"
 	"stoke/internal/ent/user"
 	"time"
@@ -36,6 +40,46 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescCreated is the schema descriptor for created field.
+	identityDescCreated := identityFields[3].Descriptor()
+	// identity.DefaultCreated holds the default value on creation for the created field.
+	identity.DefaultCreated = identityDescCreated.Default.(func() time.Time)
+	mfachallengeFields := schema.MFAChallenge{}.Fields()
+	_ = mfachallengeFields
+	// mfachallengeDescAttempts is the schema descriptor for attempts field.
+	mfachallengeDescAttempts := mfachallengeFields[3].Descriptor()
+	// mfachallenge.DefaultAttempts holds the default value on creation for the attempts field.
+	mfachallenge.DefaultAttempts = mfachallengeDescAttempts.Default.(int)
+	oidcstateFields := schema.OIDCState{}.Fields()
+	_ = oidcstateFields
+	// oidcstateDescNextURL is the schema descriptor for next_url field.
+	oidcstateDescNextURL := oidcstateFields[4].Descriptor()
+	// oidcstate.DefaultNextURL holds the default value on creation for the next_url field.
+	oidcstate.DefaultNextURL = oidcstateDescNextURL.Default.(string)
+	// oidcstateDescXfer is the schema descriptor for xfer field.
+	oidcstateDescXfer := oidcstateFields[5].Descriptor()
+	// oidcstate.DefaultXfer holds the default value on creation for the xfer field.
+	oidcstate.DefaultXfer = oidcstateDescXfer.Default.(string)
+	// oidcstateDescAccessToken is the schema descriptor for access_token field.
+	oidcstateDescAccessToken := oidcstateFields[6].Descriptor()
+	// oidcstate.DefaultAccessToken holds the default value on creation for the access_token field.
+	oidcstate.DefaultAccessToken = oidcstateDescAccessToken.Default.(string)
+	providersessionFields := schema.ProviderSession{}.Fields()
+	_ = providersessionFields
+	// providersessionDescSid is the schema descriptor for sid field.
+	providersessionDescSid := providersessionFields[3].Descriptor()
+	// providersession.DefaultSid holds the default value on creation for the sid field.
+	providersession.DefaultSid = providersessionDescSid.Default.(string)
+	// providersessionDescRevoked is the schema descriptor for revoked field.
+	providersessionDescRevoked := providersessionFields[4].Descriptor()
+	// providersession.DefaultRevoked holds the default value on creation for the revoked field.
+	providersession.DefaultRevoked = providersessionDescRevoked.Default.(bool)
+	// providersessionDescCreated is the schema descriptor for created field.
+	providersessionDescCreated := providersessionFields[5].Descriptor()
+	// providersession.DefaultCreated holds the default value on creation for the created field.
+	providersession.DefaultCreated = providersessionDescCreated.Default.(func() time.Time)
 	user.Policy = privacy.NewPolicies(schema.User{})
 	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -47,8 +91,16 @@ func init() {
 	}
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescMfaEnabled is the schema descriptor for mfa_enabled field.
+	userDescMfaEnabled := userFields[8].Descriptor()
+	// user.DefaultMfaEnabled holds the default value on creation for the mfa_enabled field.
+	user.DefaultMfaEnabled = userDescMfaEnabled.Default.(bool)
+	// userDescMfaFailures is the schema descriptor for mfa_failures field.
+	userDescMfaFailures := userFields[11].Descriptor()
+	// user.DefaultMfaFailures holds the default value on creation for the mfa_failures field.
+	user.DefaultMfaFailures = userDescMfaFailures.Default.(int)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[7].Descriptor()
+	userDescCreatedAt := userFields[13].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
@@ -12,6 +12,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// CachedCredential is the client for interacting with the CachedCredential builders.
+	CachedCredential *CachedCredentialClient
 	// Claim is the client for interacting with the Claim builders.
 	Claim *ClaimClient
 	// ClaimGroup is the client for interacting with the ClaimGroup builders.
@@ -20,8 +22,22 @@ type Tx struct {
 	DBInitFile *DBInitFileClient
 	// GroupLink is the client for interacting with the GroupLink builders.
 	GroupLink *GroupLinkClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// LoginCode is the client for interacting with the LoginCode builders.
+	LoginCode *LoginCodeClient
+	// LogoutToken is the client for interacting with the LogoutToken builders.
+	LogoutToken *LogoutTokenClient
+	// MFAChallenge is the client for interacting with the MFAChallenge builders.
+	MFAChallenge *MFAChallengeClient
+	// OIDCState is the client for interacting with the OIDCState builders.
+	OIDCState *OIDCStateClient
 	// PrivateKey is the client for interacting with the PrivateKey builders.
 	PrivateKey *PrivateKeyClient
+	// ProviderSession is the client for interacting with the ProviderSession builders.
+	ProviderSession *ProviderSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -155,11 +171,19 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.CachedCredential = NewCachedCredentialClient(tx.config)
 	tx.Claim = NewClaimClient(tx.config)
 	tx.ClaimGroup = NewClaimGroupClient(tx.config)
 	tx.DBInitFile = NewDBInitFileClient(tx.config)
 	tx.GroupLink = NewGroupLinkClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
+	tx.Lease = NewLeaseClient(tx.config)
+	tx.LoginCode = NewLoginCodeClient(tx.config)
+	tx.LogoutToken = NewLogoutTokenClient(tx.config)
+	tx.MFAChallenge = NewMFAChallengeClient(tx.config)
+	tx.OIDCState = NewOIDCStateClient(tx.config)
 	tx.PrivateKey = NewPrivateKeyClient(tx.config)
+	tx.ProviderSession = NewProviderSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -170,7 +194,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Claim.QueryXXX(), the query will be executed
+// applies a query, for example: CachedCredential.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
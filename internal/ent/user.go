@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"stoke/internal/ent/user"
 	"strings"
@@ -31,6 +32,18 @@ type User struct {
 	Password string `json:"password,omitempty"`
 	// Salt holds the value of the "salt" field.
 	Salt string `json:"salt,omitempty"`
+	// MfaSecret holds the value of the "mfa_secret" field.
+	MfaSecret string `json:"-"`
+	// MfaEnabled holds the value of the "mfa_enabled" field.
+	MfaEnabled bool `json:"mfa_enabled,omitempty"`
+	// MfaRecoveryCodes holds the value of the "mfa_recovery_codes" field.
+	MfaRecoveryCodes []string `json:"-"`
+	// MfaLastStep holds the value of the "mfa_last_step" field.
+	MfaLastStep int64 `json:"mfa_last_step,omitempty"`
+	// MfaFailures holds the value of the "mfa_failures" field.
+	MfaFailures int `json:"mfa_failures,omitempty"`
+	// MfaLockedUntil holds the value of the "mfa_locked_until" field.
+	MfaLockedUntil time.Time `json:"mfa_locked_until,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -43,9 +56,11 @@ type User struct {
 type UserEdges struct {
 	// ClaimGroups holds the value of the claim_groups edge.
 	ClaimGroups []*ClaimGroup `json:"claim_groups,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*Identity `json:"identities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ClaimGroupsOrErr returns the ClaimGroups value or an error if the edge
@@ -57,16 +72,29 @@ func (e UserEdges) ClaimGroupsOrErr() ([]*ClaimGroup, error) {
 	return nil, &NotLoadedError{edge: "claim_groups"}
 }
 
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[1] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldMfaRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldMfaEnabled:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldMfaLastStep, user.FieldMfaFailures:
 			values[i] = new(sql.NullInt64)
-		case user.FieldFname, user.FieldLname, user.FieldSource, user.FieldEmail, user.FieldUsername, user.FieldPassword, user.FieldSalt:
+		case user.FieldFname, user.FieldLname, user.FieldSource, user.FieldEmail, user.FieldUsername, user.FieldPassword, user.FieldSalt, user.FieldMfaSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldMfaLockedUntil, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -131,6 +159,44 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Salt = value.String
 			}
+		case user.FieldMfaSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_secret", values[i])
+			} else if value.Valid {
+				u.MfaSecret = value.String
+			}
+		case user.FieldMfaEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_enabled", values[i])
+			} else if value.Valid {
+				u.MfaEnabled = value.Bool
+			}
+		case user.FieldMfaRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.MfaRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field mfa_recovery_codes: %w", err)
+				}
+			}
+		case user.FieldMfaLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_last_step", values[i])
+			} else if value.Valid {
+				u.MfaLastStep = value.Int64
+			}
+		case user.FieldMfaFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_failures", values[i])
+			} else if value.Valid {
+				u.MfaFailures = int(value.Int64)
+			}
+		case user.FieldMfaLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_locked_until", values[i])
+			} else if value.Valid {
+				u.MfaLockedUntil = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -155,6 +221,11 @@ func (u *User) QueryClaimGroups() *ClaimGroupQuery {
 	return NewUserClient(u.config).QueryClaimGroups(u)
 }
 
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *IdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -199,6 +270,22 @@ func (u *User) String() string {
 	builder.WriteString("salt=")
 	builder.WriteString(u.Salt)
 	builder.WriteString(", ")
+	builder.WriteString("mfa_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mfa_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.MfaEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mfa_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.MfaLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_failures=")
+	builder.WriteString(fmt.Sprintf("%v", u.MfaFailures))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_locked_until=")
+	builder.WriteString(u.MfaLockedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
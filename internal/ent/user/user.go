@@ -29,10 +29,24 @@ const (
 	FieldPassword = "password"
 	// FieldSalt holds the string denoting the salt field in the database.
 	FieldSalt = "salt"
+	// FieldMfaSecret holds the string denoting the mfa_secret field in the database.
+	FieldMfaSecret = "mfa_secret"
+	// FieldMfaEnabled holds the string denoting the mfa_enabled field in the database.
+	FieldMfaEnabled = "mfa_enabled"
+	// FieldMfaRecoveryCodes holds the string denoting the mfa_recovery_codes field in the database.
+	FieldMfaRecoveryCodes = "mfa_recovery_codes"
+	// FieldMfaLastStep holds the string denoting the mfa_last_step field in the database.
+	FieldMfaLastStep = "mfa_last_step"
+	// FieldMfaFailures holds the string denoting the mfa_failures field in the database.
+	FieldMfaFailures = "mfa_failures"
+	// FieldMfaLockedUntil holds the string denoting the mfa_locked_until field in the database.
+	FieldMfaLockedUntil = "mfa_locked_until"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeClaimGroups holds the string denoting the claim_groups edge name in mutations.
 	EdgeClaimGroups = "claim_groups"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// ClaimGroupsTable is the table that holds the claim_groups relation/edge. The primary key declared below.
@@ -40,6 +54,13 @@ const (
 	// ClaimGroupsInverseTable is the table name for the ClaimGroup entity.
 	// It exists in this package in order to avoid circular dependency with the "claimgroup" package.
 	ClaimGroupsInverseTable = "claim_groups"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "identities"
+	// IdentitiesInverseTable is the table name for the Identity entity.
+	// It exists in this package in order to avoid circular dependency with the "identity" package.
+	IdentitiesInverseTable = "identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_identities"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -52,6 +73,12 @@ var Columns = []string{
 	FieldUsername,
 	FieldPassword,
 	FieldSalt,
+	FieldMfaSecret,
+	FieldMfaEnabled,
+	FieldMfaRecoveryCodes,
+	FieldMfaLastStep,
+	FieldMfaFailures,
+	FieldMfaLockedUntil,
 	FieldCreatedAt,
 }
 
@@ -79,6 +106,10 @@ func ValidColumn(column string) bool {
 var (
 	Hooks  [1]ent.Hook
 	Policy ent.Policy
+	// DefaultMfaEnabled holds the default value on creation for the "mfa_enabled" field.
+	DefaultMfaEnabled bool
+	// DefaultMfaFailures holds the default value on creation for the "mfa_failures" field.
+	DefaultMfaFailures int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -126,6 +157,31 @@ func BySalt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSalt, opts...).ToFunc()
 }
 
+// ByMfaSecret orders the results by the mfa_secret field.
+func ByMfaSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaSecret, opts...).ToFunc()
+}
+
+// ByMfaEnabled orders the results by the mfa_enabled field.
+func ByMfaEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaEnabled, opts...).ToFunc()
+}
+
+// ByMfaLastStep orders the results by the mfa_last_step field.
+func ByMfaLastStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaLastStep, opts...).ToFunc()
+}
+
+// ByMfaFailures orders the results by the mfa_failures field.
+func ByMfaFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaFailures, opts...).ToFunc()
+}
+
+// ByMfaLockedUntil orders the results by the mfa_locked_until field.
+func ByMfaLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaLockedUntil, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -144,6 +200,20 @@ func ByClaimGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newClaimGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newClaimGroupsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -151,3 +221,10 @@ func newClaimGroupsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, ClaimGroupsTable, ClaimGroupsPrimaryKey...),
 	)
 }
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
@@ -90,6 +90,31 @@ func Salt(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldSalt, v))
 }
 
+// MfaSecret applies equality check predicate on the "mfa_secret" field. It's identical to MfaSecretEQ.
+func MfaSecret(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaSecret, v))
+}
+
+// MfaEnabled applies equality check predicate on the "mfa_enabled" field. It's identical to MfaEnabledEQ.
+func MfaEnabled(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaEnabled, v))
+}
+
+// MfaLastStep applies equality check predicate on the "mfa_last_step" field. It's identical to MfaLastStepEQ.
+func MfaLastStep(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaLastStep, v))
+}
+
+// MfaFailures applies equality check predicate on the "mfa_failures" field. It's identical to MfaFailuresEQ.
+func MfaFailures(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaFailures, v))
+}
+
+// MfaLockedUntil applies equality check predicate on the "mfa_locked_until" field. It's identical to MfaLockedUntilEQ.
+func MfaLockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaLockedUntil, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -570,6 +595,241 @@ func SaltContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldSalt, v))
 }
 
+// MfaSecretEQ applies the EQ predicate on the "mfa_secret" field.
+func MfaSecretEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaSecret, v))
+}
+
+// MfaSecretNEQ applies the NEQ predicate on the "mfa_secret" field.
+func MfaSecretNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaSecret, v))
+}
+
+// MfaSecretIn applies the In predicate on the "mfa_secret" field.
+func MfaSecretIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMfaSecret, vs...))
+}
+
+// MfaSecretNotIn applies the NotIn predicate on the "mfa_secret" field.
+func MfaSecretNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMfaSecret, vs...))
+}
+
+// MfaSecretGT applies the GT predicate on the "mfa_secret" field.
+func MfaSecretGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMfaSecret, v))
+}
+
+// MfaSecretGTE applies the GTE predicate on the "mfa_secret" field.
+func MfaSecretGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMfaSecret, v))
+}
+
+// MfaSecretLT applies the LT predicate on the "mfa_secret" field.
+func MfaSecretLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMfaSecret, v))
+}
+
+// MfaSecretLTE applies the LTE predicate on the "mfa_secret" field.
+func MfaSecretLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMfaSecret, v))
+}
+
+// MfaSecretContains applies the Contains predicate on the "mfa_secret" field.
+func MfaSecretContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldMfaSecret, v))
+}
+
+// MfaSecretHasPrefix applies the HasPrefix predicate on the "mfa_secret" field.
+func MfaSecretHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldMfaSecret, v))
+}
+
+// MfaSecretHasSuffix applies the HasSuffix predicate on the "mfa_secret" field.
+func MfaSecretHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldMfaSecret, v))
+}
+
+// MfaSecretIsNil applies the IsNil predicate on the "mfa_secret" field.
+func MfaSecretIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaSecret))
+}
+
+// MfaSecretNotNil applies the NotNil predicate on the "mfa_secret" field.
+func MfaSecretNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaSecret))
+}
+
+// MfaSecretEqualFold applies the EqualFold predicate on the "mfa_secret" field.
+func MfaSecretEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldMfaSecret, v))
+}
+
+// MfaSecretContainsFold applies the ContainsFold predicate on the "mfa_secret" field.
+func MfaSecretContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldMfaSecret, v))
+}
+
+// MfaEnabledEQ applies the EQ predicate on the "mfa_enabled" field.
+func MfaEnabledEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaEnabled, v))
+}
+
+// MfaEnabledNEQ applies the NEQ predicate on the "mfa_enabled" field.
+func MfaEnabledNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaEnabled, v))
+}
+
+// MfaRecoveryCodesIsNil applies the IsNil predicate on the "mfa_recovery_codes" field.
+func MfaRecoveryCodesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaRecoveryCodes))
+}
+
+// MfaRecoveryCodesNotNil applies the NotNil predicate on the "mfa_recovery_codes" field.
+func MfaRecoveryCodesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaRecoveryCodes))
+}
+
+// MfaLastStepEQ applies the EQ predicate on the "mfa_last_step" field.
+func MfaLastStepEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaLastStep, v))
+}
+
+// MfaLastStepNEQ applies the NEQ predicate on the "mfa_last_step" field.
+func MfaLastStepNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaLastStep, v))
+}
+
+// MfaLastStepIn applies the In predicate on the "mfa_last_step" field.
+func MfaLastStepIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMfaLastStep, vs...))
+}
+
+// MfaLastStepNotIn applies the NotIn predicate on the "mfa_last_step" field.
+func MfaLastStepNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMfaLastStep, vs...))
+}
+
+// MfaLastStepGT applies the GT predicate on the "mfa_last_step" field.
+func MfaLastStepGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMfaLastStep, v))
+}
+
+// MfaLastStepGTE applies the GTE predicate on the "mfa_last_step" field.
+func MfaLastStepGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMfaLastStep, v))
+}
+
+// MfaLastStepLT applies the LT predicate on the "mfa_last_step" field.
+func MfaLastStepLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMfaLastStep, v))
+}
+
+// MfaLastStepLTE applies the LTE predicate on the "mfa_last_step" field.
+func MfaLastStepLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMfaLastStep, v))
+}
+
+// MfaLastStepIsNil applies the IsNil predicate on the "mfa_last_step" field.
+func MfaLastStepIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaLastStep))
+}
+
+// MfaLastStepNotNil applies the NotNil predicate on the "mfa_last_step" field.
+func MfaLastStepNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaLastStep))
+}
+
+// MfaFailuresEQ applies the EQ predicate on the "mfa_failures" field.
+func MfaFailuresEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaFailures, v))
+}
+
+// MfaFailuresNEQ applies the NEQ predicate on the "mfa_failures" field.
+func MfaFailuresNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaFailures, v))
+}
+
+// MfaFailuresIn applies the In predicate on the "mfa_failures" field.
+func MfaFailuresIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMfaFailures, vs...))
+}
+
+// MfaFailuresNotIn applies the NotIn predicate on the "mfa_failures" field.
+func MfaFailuresNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMfaFailures, vs...))
+}
+
+// MfaFailuresGT applies the GT predicate on the "mfa_failures" field.
+func MfaFailuresGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMfaFailures, v))
+}
+
+// MfaFailuresGTE applies the GTE predicate on the "mfa_failures" field.
+func MfaFailuresGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMfaFailures, v))
+}
+
+// MfaFailuresLT applies the LT predicate on the "mfa_failures" field.
+func MfaFailuresLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMfaFailures, v))
+}
+
+// MfaFailuresLTE applies the LTE predicate on the "mfa_failures" field.
+func MfaFailuresLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMfaFailures, v))
+}
+
+// MfaLockedUntilEQ applies the EQ predicate on the "mfa_locked_until" field.
+func MfaLockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaLockedUntil, v))
+}
+
+// MfaLockedUntilNEQ applies the NEQ predicate on the "mfa_locked_until" field.
+func MfaLockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaLockedUntil, v))
+}
+
+// MfaLockedUntilIn applies the In predicate on the "mfa_locked_until" field.
+func MfaLockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMfaLockedUntil, vs...))
+}
+
+// MfaLockedUntilNotIn applies the NotIn predicate on the "mfa_locked_until" field.
+func MfaLockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMfaLockedUntil, vs...))
+}
+
+// MfaLockedUntilGT applies the GT predicate on the "mfa_locked_until" field.
+func MfaLockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMfaLockedUntil, v))
+}
+
+// MfaLockedUntilGTE applies the GTE predicate on the "mfa_locked_until" field.
+func MfaLockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMfaLockedUntil, v))
+}
+
+// MfaLockedUntilLT applies the LT predicate on the "mfa_locked_until" field.
+func MfaLockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMfaLockedUntil, v))
+}
+
+// MfaLockedUntilLTE applies the LTE predicate on the "mfa_locked_until" field.
+func MfaLockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMfaLockedUntil, v))
+}
+
+// MfaLockedUntilIsNil applies the IsNil predicate on the "mfa_locked_until" field.
+func MfaLockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaLockedUntil))
+}
+
+// MfaLockedUntilNotNil applies the NotNil predicate on the "mfa_locked_until" field.
+func MfaLockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaLockedUntil))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -633,6 +893,29 @@ func HasClaimGroupsWith(preds ...predicate.ClaimGroup) predicate.User {
 	})
 }
 
+// HasIdentities applies the HasEdge predicate on the "identities" edge.
+func HasIdentities() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentitiesWith applies the HasEdge predicate on the "identities" edge with a given conditions (other predicates).
+func HasIdentitiesWith(preds ...predicate.Identity) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
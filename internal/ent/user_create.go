@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"stoke/internal/ent/claimgroup"
+	"stoke/internal/ent/identity"
 	"stoke/internal/ent/user"
 	"time"
 
@@ -79,6 +80,82 @@ func (uc *UserCreate) SetNillableSalt(s *string) *UserCreate {
 	return uc
 }
 
+// SetMfaSecret sets the "mfa_secret" field.
+func (uc *UserCreate) SetMfaSecret(s string) *UserCreate {
+	uc.mutation.SetMfaSecret(s)
+	return uc
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetMfaSecret(*s)
+	}
+	return uc
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (uc *UserCreate) SetMfaEnabled(b bool) *UserCreate {
+	uc.mutation.SetMfaEnabled(b)
+	return uc
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaEnabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetMfaEnabled(*b)
+	}
+	return uc
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (uc *UserCreate) SetMfaRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetMfaRecoveryCodes(s)
+	return uc
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (uc *UserCreate) SetMfaLastStep(i int64) *UserCreate {
+	uc.mutation.SetMfaLastStep(i)
+	return uc
+}
+
+// SetNillableMfaLastStep sets the "mfa_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetMfaLastStep(*i)
+	}
+	return uc
+}
+
+// SetMfaFailures sets the "mfa_failures" field.
+func (uc *UserCreate) SetMfaFailures(i int) *UserCreate {
+	uc.mutation.SetMfaFailures(i)
+	return uc
+}
+
+// SetNillableMfaFailures sets the "mfa_failures" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaFailures(i *int) *UserCreate {
+	if i != nil {
+		uc.SetMfaFailures(*i)
+	}
+	return uc
+}
+
+// SetMfaLockedUntil sets the "mfa_locked_until" field.
+func (uc *UserCreate) SetMfaLockedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetMfaLockedUntil(t)
+	return uc
+}
+
+// SetNillableMfaLockedUntil sets the "mfa_locked_until" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaLockedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetMfaLockedUntil(*t)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -108,6 +185,21 @@ func (uc *UserCreate) AddClaimGroups(c ...*ClaimGroup) *UserCreate {
 	return uc.AddClaimGroupIDs(ids...)
 }
 
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uc *UserCreate) AddIdentities(i ...*Identity) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -145,6 +237,14 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() error {
+	if _, ok := uc.mutation.MfaEnabled(); !ok {
+		v := user.DefaultMfaEnabled
+		uc.mutation.SetMfaEnabled(v)
+	}
+	if _, ok := uc.mutation.MfaFailures(); !ok {
+		v := user.DefaultMfaFailures
+		uc.mutation.SetMfaFailures(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		if user.DefaultCreatedAt == nil {
 			return fmt.Errorf("ent: uninitialized user.DefaultCreatedAt (forgotten import ent/runtime?)")
@@ -172,6 +272,12 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Username(); !ok {
 		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "User.username"`)}
 	}
+	if _, ok := uc.mutation.MfaEnabled(); !ok {
+		return &ValidationError{Name: "mfa_enabled", err: errors.New(`ent: missing required field "User.mfa_enabled"`)}
+	}
+	if _, ok := uc.mutation.MfaFailures(); !ok {
+		return &ValidationError{Name: "mfa_failures", err: errors.New(`ent: missing required field "User.mfa_failures"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -229,6 +335,30 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldSalt, field.TypeString, value)
 		_node.Salt = value
 	}
+	if value, ok := uc.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+		_node.MfaSecret = value
+	}
+	if value, ok := uc.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+		_node.MfaEnabled = value
+	}
+	if value, ok := uc.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+		_node.MfaRecoveryCodes = value
+	}
+	if value, ok := uc.mutation.MfaLastStep(); ok {
+		_spec.SetField(user.FieldMfaLastStep, field.TypeInt64, value)
+		_node.MfaLastStep = value
+	}
+	if value, ok := uc.mutation.MfaFailures(); ok {
+		_spec.SetField(user.FieldMfaFailures, field.TypeInt, value)
+		_node.MfaFailures = value
+	}
+	if value, ok := uc.mutation.MfaLockedUntil(); ok {
+		_spec.SetField(user.FieldMfaLockedUntil, field.TypeTime, value)
+		_node.MfaLockedUntil = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -249,6 +379,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,11 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"stoke/internal/ent/claimgroup"
+	"stoke/internal/ent/identity"
 	"stoke/internal/ent/predicate"
 	"stoke/internal/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -138,6 +141,126 @@ func (uu *UserUpdate) ClearSalt() *UserUpdate {
 	return uu
 }
 
+// SetMfaSecret sets the "mfa_secret" field.
+func (uu *UserUpdate) SetMfaSecret(s string) *UserUpdate {
+	uu.mutation.SetMfaSecret(s)
+	return uu
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetMfaSecret(*s)
+	}
+	return uu
+}
+
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (uu *UserUpdate) ClearMfaSecret() *UserUpdate {
+	uu.mutation.ClearMfaSecret()
+	return uu
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (uu *UserUpdate) SetMfaEnabled(b bool) *UserUpdate {
+	uu.mutation.SetMfaEnabled(b)
+	return uu
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaEnabled(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetMfaEnabled(*b)
+	}
+	return uu
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (uu *UserUpdate) SetMfaRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.SetMfaRecoveryCodes(s)
+	return uu
+}
+
+// AppendMfaRecoveryCodes appends s to the "mfa_recovery_codes" field.
+func (uu *UserUpdate) AppendMfaRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.AppendMfaRecoveryCodes(s)
+	return uu
+}
+
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (uu *UserUpdate) ClearMfaRecoveryCodes() *UserUpdate {
+	uu.mutation.ClearMfaRecoveryCodes()
+	return uu
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (uu *UserUpdate) SetMfaLastStep(i int64) *UserUpdate {
+	uu.mutation.ResetMfaLastStep()
+	uu.mutation.SetMfaLastStep(i)
+	return uu
+}
+
+// SetNillableMfaLastStep sets the "mfa_last_step" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaLastStep(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetMfaLastStep(*i)
+	}
+	return uu
+}
+
+// AddMfaLastStep adds i to the "mfa_last_step" field.
+func (uu *UserUpdate) AddMfaLastStep(i int64) *UserUpdate {
+	uu.mutation.AddMfaLastStep(i)
+	return uu
+}
+
+// ClearMfaLastStep clears the value of the "mfa_last_step" field.
+func (uu *UserUpdate) ClearMfaLastStep() *UserUpdate {
+	uu.mutation.ClearMfaLastStep()
+	return uu
+}
+
+// SetMfaFailures sets the "mfa_failures" field.
+func (uu *UserUpdate) SetMfaFailures(i int) *UserUpdate {
+	uu.mutation.ResetMfaFailures()
+	uu.mutation.SetMfaFailures(i)
+	return uu
+}
+
+// SetNillableMfaFailures sets the "mfa_failures" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaFailures(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetMfaFailures(*i)
+	}
+	return uu
+}
+
+// AddMfaFailures adds i to the "mfa_failures" field.
+func (uu *UserUpdate) AddMfaFailures(i int) *UserUpdate {
+	uu.mutation.AddMfaFailures(i)
+	return uu
+}
+
+// SetMfaLockedUntil sets the "mfa_locked_until" field.
+func (uu *UserUpdate) SetMfaLockedUntil(t time.Time) *UserUpdate {
+	uu.mutation.SetMfaLockedUntil(t)
+	return uu
+}
+
+// SetNillableMfaLockedUntil sets the "mfa_locked_until" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaLockedUntil(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetMfaLockedUntil(*t)
+	}
+	return uu
+}
+
+// ClearMfaLockedUntil clears the value of the "mfa_locked_until" field.
+func (uu *UserUpdate) ClearMfaLockedUntil() *UserUpdate {
+	uu.mutation.ClearMfaLockedUntil()
+	return uu
+}
+
 // AddClaimGroupIDs adds the "claim_groups" edge to the ClaimGroup entity by IDs.
 func (uu *UserUpdate) AddClaimGroupIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddClaimGroupIDs(ids...)
@@ -153,6 +276,21 @@ func (uu *UserUpdate) AddClaimGroups(c ...*ClaimGroup) *UserUpdate {
 	return uu.AddClaimGroupIDs(ids...)
 }
 
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uu *UserUpdate) AddIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddIdentityIDs(ids...)
+	return uu
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uu *UserUpdate) AddIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddIdentityIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -179,6 +317,27 @@ func (uu *UserUpdate) RemoveClaimGroups(c ...*ClaimGroup) *UserUpdate {
 	return uu.RemoveClaimGroupIDs(ids...)
 }
 
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uu *UserUpdate) ClearIdentities() *UserUpdate {
+	uu.mutation.ClearIdentities()
+	return uu
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uu *UserUpdate) RemoveIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uu *UserUpdate) RemoveIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveIdentityIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
@@ -242,6 +401,47 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.SaltCleared() {
 		_spec.ClearField(user.FieldSalt, field.TypeString)
 	}
+	if value, ok := uu.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+	}
+	if uu.mutation.MfaSecretCleared() {
+		_spec.ClearField(user.FieldMfaSecret, field.TypeString)
+	}
+	if value, ok := uu.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedMfaRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodes, value)
+		})
+	}
+	if uu.mutation.MfaRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.MfaLastStep(); ok {
+		_spec.SetField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedMfaLastStep(); ok {
+		_spec.AddField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if uu.mutation.MfaLastStepCleared() {
+		_spec.ClearField(user.FieldMfaLastStep, field.TypeInt64)
+	}
+	if value, ok := uu.mutation.MfaFailures(); ok {
+		_spec.SetField(user.FieldMfaFailures, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedMfaFailures(); ok {
+		_spec.AddField(user.FieldMfaFailures, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.MfaLockedUntil(); ok {
+		_spec.SetField(user.FieldMfaLockedUntil, field.TypeTime, value)
+	}
+	if uu.mutation.MfaLockedUntilCleared() {
+		_spec.ClearField(user.FieldMfaLockedUntil, field.TypeTime)
+	}
 	if uu.mutation.ClaimGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -287,6 +487,51 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -417,6 +662,126 @@ func (uuo *UserUpdateOne) ClearSalt() *UserUpdateOne {
 	return uuo
 }
 
+// SetMfaSecret sets the "mfa_secret" field.
+func (uuo *UserUpdateOne) SetMfaSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetMfaSecret(s)
+	return uuo
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetMfaSecret(*s)
+	}
+	return uuo
+}
+
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (uuo *UserUpdateOne) ClearMfaSecret() *UserUpdateOne {
+	uuo.mutation.ClearMfaSecret()
+	return uuo
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (uuo *UserUpdateOne) SetMfaEnabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetMfaEnabled(b)
+	return uuo
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaEnabled(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetMfaEnabled(*b)
+	}
+	return uuo
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (uuo *UserUpdateOne) SetMfaRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.SetMfaRecoveryCodes(s)
+	return uuo
+}
+
+// AppendMfaRecoveryCodes appends s to the "mfa_recovery_codes" field.
+func (uuo *UserUpdateOne) AppendMfaRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.AppendMfaRecoveryCodes(s)
+	return uuo
+}
+
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (uuo *UserUpdateOne) ClearMfaRecoveryCodes() *UserUpdateOne {
+	uuo.mutation.ClearMfaRecoveryCodes()
+	return uuo
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (uuo *UserUpdateOne) SetMfaLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.ResetMfaLastStep()
+	uuo.mutation.SetMfaLastStep(i)
+	return uuo
+}
+
+// SetNillableMfaLastStep sets the "mfa_last_step" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaLastStep(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetMfaLastStep(*i)
+	}
+	return uuo
+}
+
+// AddMfaLastStep adds i to the "mfa_last_step" field.
+func (uuo *UserUpdateOne) AddMfaLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.AddMfaLastStep(i)
+	return uuo
+}
+
+// ClearMfaLastStep clears the value of the "mfa_last_step" field.
+func (uuo *UserUpdateOne) ClearMfaLastStep() *UserUpdateOne {
+	uuo.mutation.ClearMfaLastStep()
+	return uuo
+}
+
+// SetMfaFailures sets the "mfa_failures" field.
+func (uuo *UserUpdateOne) SetMfaFailures(i int) *UserUpdateOne {
+	uuo.mutation.ResetMfaFailures()
+	uuo.mutation.SetMfaFailures(i)
+	return uuo
+}
+
+// SetNillableMfaFailures sets the "mfa_failures" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaFailures(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetMfaFailures(*i)
+	}
+	return uuo
+}
+
+// AddMfaFailures adds i to the "mfa_failures" field.
+func (uuo *UserUpdateOne) AddMfaFailures(i int) *UserUpdateOne {
+	uuo.mutation.AddMfaFailures(i)
+	return uuo
+}
+
+// SetMfaLockedUntil sets the "mfa_locked_until" field.
+func (uuo *UserUpdateOne) SetMfaLockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetMfaLockedUntil(t)
+	return uuo
+}
+
+// SetNillableMfaLockedUntil sets the "mfa_locked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaLockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetMfaLockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearMfaLockedUntil clears the value of the "mfa_locked_until" field.
+func (uuo *UserUpdateOne) ClearMfaLockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearMfaLockedUntil()
+	return uuo
+}
+
 // AddClaimGroupIDs adds the "claim_groups" edge to the ClaimGroup entity by IDs.
 func (uuo *UserUpdateOne) AddClaimGroupIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddClaimGroupIDs(ids...)
@@ -432,6 +797,21 @@ func (uuo *UserUpdateOne) AddClaimGroups(c ...*ClaimGroup) *UserUpdateOne {
 	return uuo.AddClaimGroupIDs(ids...)
 }
 
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uuo *UserUpdateOne) AddIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddIdentityIDs(ids...)
+	return uuo
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) AddIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddIdentityIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -458,6 +838,27 @@ func (uuo *UserUpdateOne) RemoveClaimGroups(c ...*ClaimGroup) *UserUpdateOne {
 	return uuo.RemoveClaimGroupIDs(ids...)
 }
 
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) ClearIdentities() *UserUpdateOne {
+	uuo.mutation.ClearIdentities()
+	return uuo
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uuo *UserUpdateOne) RemoveIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveIdentityIDs(ids...)
+	return uuo
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uuo *UserUpdateOne) RemoveIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveIdentityIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -551,6 +952,47 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.SaltCleared() {
 		_spec.ClearField(user.FieldSalt, field.TypeString)
 	}
+	if value, ok := uuo.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+	}
+	if uuo.mutation.MfaSecretCleared() {
+		_spec.ClearField(user.FieldMfaSecret, field.TypeString)
+	}
+	if value, ok := uuo.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedMfaRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodes, value)
+		})
+	}
+	if uuo.mutation.MfaRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.MfaLastStep(); ok {
+		_spec.SetField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedMfaLastStep(); ok {
+		_spec.AddField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if uuo.mutation.MfaLastStepCleared() {
+		_spec.ClearField(user.FieldMfaLastStep, field.TypeInt64)
+	}
+	if value, ok := uuo.mutation.MfaFailures(); ok {
+		_spec.SetField(user.FieldMfaFailures, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedMfaFailures(); ok {
+		_spec.AddField(user.FieldMfaFailures, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.MfaLockedUntil(); ok {
+		_spec.SetField(user.FieldMfaLockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.MfaLockedUntilCleared() {
+		_spec.ClearField(user.FieldMfaLockedUntil, field.TypeTime)
+	}
 	if uuo.mutation.ClaimGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -596,6 +1038,51 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"slices"
 	"stoke/internal/cluster"
 	"stoke/internal/tel"
 	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
 	"hppr.dev/stoke"
 )
 
@@ -32,6 +34,30 @@ type FederatedTokenIssuer struct {
 	mu         sync.RWMutex
 	cacheBytes []byte
 	cacheExpiry time.Time
+
+	statusMu   sync.RWMutex
+	status     ClusterStatus
+}
+
+// ClusterStatus is a snapshot of the last JWKS merge
+type ClusterStatus struct {
+	// Whether at least one merge has completed since start up
+	Merged      bool
+	LastMerge   time.Time
+	CacheExpiry time.Time
+	Peers       []PeerStatus
+	Conflicts   []cluster.KeyConflict
+}
+
+// PeerStatus is the last known state of a single peer
+type PeerStatus struct {
+	URL         string
+	// Last time the peer's keys were fetched successfully. Kept across failed fetches.
+	LastSuccess time.Time
+	// Error from the most recent fetch, empty if it succeeded
+	LastError   string
+	KeyIds      []string
+	Expires     time.Time
 }
 
 // NewFederatedTokenIssuer returns a TokenIssuer that delegates IssueToken, RefreshToken,
@@ -61,11 +87,30 @@ func (f *FederatedTokenIssuer) RefreshToken(jwtToken *jwt.Token, refreshToken st
 }
 
 // WithContext stores this federated issuer in context so the web layer (IssuerFromCtx as PublicKeyStore)
-// uses the merged set for PublicKeys and ParseClaims.
+// uses the merged set for PublicKeys and ParseClaims. Cluster metrics are registered here.
 func (f *FederatedTokenIssuer) WithContext(ctx context.Context) context.Context {
+	if err := f.registerMetrics(); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Could not create cluster metrics instrumentation")
+	}
 	return context.WithValue(ctx, issuerCtxKey{}, f)
 }
 
+// ActiveKeyExpires returns when the inner issuer's signing key expires, or the zero time if it can't tell
+func (f *FederatedTokenIssuer) ActiveKeyExpires() time.Time {
+	if r, ok := f.Inner.(ActiveKeyReporter); ok {
+		return r.ActiveKeyExpires()
+	}
+	return time.Time{}
+}
+
+// Deregister removes this replica from discovery if the discoverer supports it. Called on shutdown.
+func (f *FederatedTokenIssuer) Deregister(ctx context.Context) error {
+	if d, ok := f.Discoverer.(cluster.Deregisterer); ok {
+		return d.Deregister(ctx)
+	}
+	return nil
+}
+
 // PublicKeys returns the merged JWKS from Inner and all peers, for clients that need
 // to verify tokens issued by any replica. Cached until the merged JWKS "exp" (minus a
 // small buffer), or RefreshSec if exp is missing. If ctx has LocalKeysOnly set
@@ -100,6 +145,7 @@ func (f *FederatedTokenIssuer) PublicKeys(ctx context.Context) ([]byte, error) {
 		f.cacheBytes = merged
 		f.cacheExpiry = expiry
 		f.mu.Unlock()
+		f.setCacheExpiry(expiry)
 	}
 
 	return merged, nil
@@ -135,6 +181,7 @@ func (f *FederatedTokenIssuer) ParseClaims(ctx context.Context, token string, cl
 			f.cacheBytes = merged
 			f.cacheExpiry = expiry
 			f.mu.Unlock()
+			f.setCacheExpiry(expiry)
 		}
 	}
 
@@ -192,5 +239,71 @@ func (f *FederatedTokenIssuer) getMergedJWKSBytes(ctx context.Context) ([]byte,
 	if err != nil {
 		return nil, err
 	}
-	return cluster.MergeJWKS(localJWKS, peerURLs, f.HTTPClient)
+	merged, report, err := cluster.MergeJWKSWithReport(localJWKS, peerURLs, f.HTTPClient)
+	if err != nil {
+		return nil, err
+	}
+	f.recordReport(ctx, report)
+	return merged, nil
+}
+
+// Status returns a copy of the status of the last merge
+func (f *FederatedTokenIssuer) Status() ClusterStatus {
+	f.statusMu.RLock()
+	defer f.statusMu.RUnlock()
+	status := f.status
+	status.Peers = slices.Clone(f.status.Peers)
+	status.Conflicts = slices.Clone(f.status.Conflicts)
+	return status
+}
+
+func (f *FederatedTokenIssuer) setCacheExpiry(expiry time.Time) {
+	f.statusMu.Lock()
+	f.status.CacheExpiry = expiry
+	f.statusMu.Unlock()
+}
+
+// recordReport updates the cluster status from a merge report and warns about conflicting kids
+func (f *FederatedTokenIssuer) recordReport(ctx context.Context, report *cluster.MergeReport) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "FederatedTokenIssuer").
+		Logger()
+
+	for _, c := range report.Conflicts {
+		logger.Warn().
+			Str("kid", c.KeyId).
+			Strs("sources", c.Sources).
+			Msg("Multiple sources published the same kid with different key material. Only the first was kept. Check that instance_id is unique per replica.")
+	}
+
+	f.statusMu.Lock()
+	defer f.statusMu.Unlock()
+
+	previous := make(map[string]time.Time, len(f.status.Peers))
+	for _, p := range f.status.Peers {
+		previous[p.URL] = p.LastSuccess
+	}
+
+	peers := make([]PeerStatus, len(report.Peers))
+	for i, r := range report.Peers {
+		peers[i] = PeerStatus{
+			URL:         r.URL,
+			LastSuccess: r.Fetched,
+			LastError:   r.Error,
+			KeyIds:      r.KeyIds,
+			Expires:     r.Expires,
+		}
+		if r.Fetched.IsZero() {
+			peers[i].LastSuccess = previous[r.URL]
+			logger.Warn().
+				Str("peer", r.URL).
+				Str("error", r.Error).
+				Msg("Could not fetch public keys from peer")
+		}
+	}
+
+	f.status.Merged = true
+	f.status.LastMerge = time.Now()
+	f.status.Peers = peers
+	f.status.Conflicts = report.Conflicts
 }
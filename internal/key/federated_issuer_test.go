@@ -195,3 +195,53 @@ func TestFederatedTokenIssuer_ParseClaims_VerifiesTokenFromPeer(t *testing.T) {
 		t.Error("parsed token should be valid")
 	}
 }
+
+func TestFederatedTokenIssuer_Status_KeepsLastSuccessAfterFailure(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	localBytes, _ := json.Marshal(stoke.JWKSet{Expires: exp})
+	peerBytes, _ := json.Marshal(stoke.JWKSet{
+		Expires: exp,
+		Keys:    []*stoke.JWK{{KeyId: "peer-p-0", KeyType: "EC", Use: "sig", Curve: "P-256", X: "x", Y: "y"}},
+	})
+
+	fail := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(peerBytes)
+	}))
+	defer srv.Close()
+
+	federated := NewFederatedTokenIssuer(
+		&mockFederatedInner{publicKeysBytes: localBytes},
+		&cluster.StaticDiscoverer{URLs: []string{srv.URL}},
+		nil, "", 0,
+	).(*FederatedTokenIssuer)
+
+	if federated.Status().Merged {
+		t.Fatal("status reported a merge before any merge happened")
+	}
+
+	ctx := context.Background()
+	if _, err := federated.PublicKeys(ctx); err != nil {
+		t.Fatalf("PublicKeys: %v", err)
+	}
+	first := federated.Status()
+	if !first.Merged || len(first.Peers) != 1 || first.Peers[0].LastSuccess.IsZero() || first.Peers[0].LastError != "" {
+		t.Fatalf("unexpected status after successful merge: %+v", first)
+	}
+
+	fail = true
+	if _, err := federated.PublicKeys(ctx); err != nil {
+		t.Fatalf("PublicKeys: %v", err)
+	}
+	second := federated.Status()
+	if second.Peers[0].LastError == "" {
+		t.Error("expected an error to be recorded for the failing peer")
+	}
+	if !second.Peers[0].LastSuccess.Equal(first.Peers[0].LastSuccess) {
+		t.Errorf("last success was not kept: got %v, want %v", second.Peers[0].LastSuccess, first.Peers[0].LastSuccess)
+	}
+}
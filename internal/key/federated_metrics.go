@@ -0,0 +1,89 @@
+package key
+
+import (
+	"context"
+	"stoke/internal/tel"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// registerMetrics exposes the cluster status through observable gauges.
+// Values are read from the last merge when the metrics are collected.
+func (f *FederatedTokenIssuer) registerMetrics() error {
+	meter := tel.GetMeter()
+
+	peers, err := meter.Int64ObservableGauge(
+		"stoke_cluster_peers",
+		metric.WithDescription("Number of peers discovered during the last JWKS merge"),
+	)
+	if err != nil {
+		return err
+	}
+	peerUp, err := meter.Int64ObservableGauge(
+		"stoke_cluster_peer_up",
+		metric.WithDescription("Whether the last public key fetch from the peer succeeded (1) or failed (0)"),
+	)
+	if err != nil {
+		return err
+	}
+	peerLastSuccess, err := meter.Int64ObservableGauge(
+		"stoke_cluster_peer_last_success",
+		metric.WithDescription("Unix time of the last successful public key fetch from the peer"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+	peerKeys, err := meter.Int64ObservableGauge(
+		"stoke_cluster_peer_keys",
+		metric.WithDescription("Number of public keys published by the peer"),
+	)
+	if err != nil {
+		return err
+	}
+	conflicts, err := meter.Int64ObservableGauge(
+		"stoke_cluster_kid_conflicts",
+		metric.WithDescription("Number of key ids published by more than one source with different key material"),
+	)
+	if err != nil {
+		return err
+	}
+	cacheExpiry, err := meter.Int64ObservableGauge(
+		"stoke_cluster_cache_expiry",
+		metric.WithDescription("Unix time that the merged public key cache expires"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(
+		func(_ context.Context, o metric.Observer) error {
+			status := f.Status()
+			if !status.Merged {
+				return nil
+			}
+			o.ObserveInt64(peers, int64(len(status.Peers)))
+			o.ObserveInt64(conflicts, int64(len(status.Conflicts)))
+			if !status.CacheExpiry.IsZero() {
+				o.ObserveInt64(cacheExpiry, status.CacheExpiry.Unix())
+			}
+			for _, p := range status.Peers {
+				attrs := metric.WithAttributes(attribute.String("peer", p.URL))
+				up := int64(0)
+				if p.LastError == "" {
+					up = 1
+				}
+				o.ObserveInt64(peerUp, up, attrs)
+				o.ObserveInt64(peerKeys, int64(len(p.KeyIds)), attrs)
+				if !p.LastSuccess.IsZero() {
+					o.ObserveInt64(peerLastSuccess, p.LastSuccess.Unix(), attrs)
+				}
+			}
+			return nil
+		},
+		peers, peerUp, peerLastSuccess, peerKeys, conflicts, cacheExpiry,
+	)
+	return err
+}
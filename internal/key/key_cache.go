@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"stoke/internal/ent"
 	"stoke/internal/ent/privatekey"
+	"stoke/internal/lifecycle"
 	"stoke/internal/tel"
 	"sync"
 	"time"
@@ -40,8 +41,12 @@ type PrivateKeyCache[P PrivateKey] struct {
 	PersistKeys bool
 	// KeyIdPrefix makes key ids unique per server (e.g. "stoke1" -> "stoke1-p-0"). Empty = "p-0", "p-1".
 	KeyIdPrefix string
+	// Cipher encrypts persisted keys. Nil stores keys in plain text.
+	Cipher *KeyCipher
 
 	activeKey int
+	// keyIds overrides index based key ids when keys are shared between replicas
+	keyIds []string
 	keyPairsMutex sync.RWMutex
 	KeyPairs []KeyPair[P]
 }
@@ -49,15 +54,21 @@ type PrivateKeyCache[P PrivateKey] struct {
 // NewPrivateKeyCache initializes a new PrivateKeyCache and starts a management goroutine.
 // keyIdPrefix, when non-empty, is prepended to key ids (e.g. "stoke1" -> "stoke1-p-0") so kids are unique per server in HA.
 func NewPrivateKeyCache[P PrivateKey](tokenDur, keyDur time.Duration, persistKeys bool, keyPair KeyPair[P], ctx context.Context, keyIdPrefix string) (*PrivateKeyCache[P], error) {
+	return NewEncryptedPrivateKeyCache(tokenDur, keyDur, persistKeys, keyPair, ctx, keyIdPrefix, nil)
+}
+
+// NewEncryptedPrivateKeyCache behaves like NewPrivateKeyCache and encrypts persisted keys with cipher.
+func NewEncryptedPrivateKeyCache[P PrivateKey](tokenDur, keyDur time.Duration, persistKeys bool, keyPair KeyPair[P], ctx context.Context, keyIdPrefix string, cipher *KeyCipher) (*PrivateKeyCache[P], error) {
 	c := &PrivateKeyCache[P]{
 		Ctx:         ctx,
 		TokenDuration: tokenDur,
 		KeyDuration: keyDur,
 		PersistKeys: persistKeys,
 		KeyIdPrefix: keyIdPrefix,
+		Cipher:      cipher,
 	}
 	err := c.Bootstrap(ctx, keyPair)
-	go c.goManage(ctx)
+	lifecycle.Go(ctx, func() { c.goManage(ctx) })
 	return c, err
 }
 
@@ -124,6 +135,9 @@ func (c *PrivateKeyCache[P]) CurrentID() int         { return c.activeKey }
 
 // keyIdForIndex returns the JWK key id for key at index i (unique per server when KeyIdPrefix is set).
 func (c *PrivateKeyCache[P]) keyIdForIndex(i int) string {
+	if i < len(c.keyIds) {
+		return c.keyIds[i]
+	}
 	if c.KeyIdPrefix == "" {
 		return fmt.Sprintf("p-%d", i)
 	}
@@ -202,6 +216,14 @@ func (c *PrivateKeyCache[P]) Generate(ctx context.Context) error {
 		Msg("Generated new key.")
 
 	if c.PersistKeys {
+		text, err := c.Cipher.Encrypt(newKey.Encode())
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Msg("Could not encrypt private key")
+			return nil
+		}
+
 		tx, err := ent.FromContext(ctx).Tx(ctx)
 		if err != nil {
 			logger.Error().
@@ -210,7 +232,7 @@ func (c *PrivateKeyCache[P]) Generate(ctx context.Context) error {
 		}
 
 		_, err = tx.PrivateKey.Create().
-			SetText(newKey.Encode()).
+			SetText(text).
 			SetExpires(newKey.ExpiresAt()).
 			Save(ctx)
 		if err != nil {
@@ -264,7 +286,12 @@ func (c *PrivateKeyCache[P]) Bootstrap(ctx context.Context, pair KeyPair[P]) err
 		pair.SetExpires(now.Add(c.KeyDuration))
 	} else {
 		pair.SetExpires(pk.Expires)
-		err := pair.Decode(pk.Text)
+		text, err := c.Cipher.Decrypt(pk.Text)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not decrypt private key text from database")
+			return err
+		}
+		err = pair.Decode(text)
 		if err != nil {
 			logger.Error().Err(err).Msg("Could not decode private key text from database")
 			return err
@@ -0,0 +1,73 @@
+package key
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+const encryptedKeyPrefix = "enc:"
+
+// KeyCipher encrypts private keys before they are written to the database.
+// Keys are sealed with AES-256-GCM using a key derived from the configured secret.
+type KeyCipher struct {
+	aead cipher.AEAD
+}
+
+// NewKeyCipher derives an encryption key from secret
+func NewKeyCipher(secret string) (*KeyCipher, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("key encryption secret must not be empty")
+	}
+	sum := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyCipher{ aead: aead }, nil
+}
+
+// Encrypt seals an encoded private key. A nil cipher returns the text unchanged.
+func (k *KeyCipher) Encrypt(text string) (string, error) {
+	if k == nil {
+		return text, nil
+	}
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := k.aead.Seal(nonce, nonce, []byte(text), nil)
+	return encryptedKeyPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a sealed private key. Text without the encrypted prefix is returned as is
+// so keys persisted before encryption was configured can still be read.
+func (k *KeyCipher) Decrypt(text string) (string, error) {
+	if !strings.HasPrefix(text, encryptedKeyPrefix) {
+		return text, nil
+	}
+	if k == nil {
+		return "", fmt.Errorf("private key is encrypted but no key encryption secret is configured")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(text, encryptedKeyPrefix))
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < k.aead.NonceSize() {
+		return "", fmt.Errorf("encrypted private key is too short")
+	}
+	nonce, data := sealed[:k.aead.NonceSize()], sealed[k.aead.NonceSize():]
+	plain, err := k.aead.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
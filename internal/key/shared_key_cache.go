@@ -0,0 +1,239 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"stoke/internal/cluster"
+	"stoke/internal/ent"
+	"stoke/internal/ent/privatekey"
+	"stoke/internal/lifecycle"
+	"stoke/internal/tel"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vincentfree/opentelemetry/otelzerolog"
+)
+
+// How long a replica waits for the leader to create the first key at startup
+const sharedBootstrapWait = time.Minute
+
+// sharedKeyState tracks keys loaded from the database for a shared key cache
+type sharedKeyState[P PrivateKey] struct {
+	elector  cluster.Elector
+	template KeyPair[P]
+	poll     time.Duration
+	loaded   map[int]KeyPair[P]
+	leader   bool
+}
+
+// NewSharedPrivateKeyCache creates a key cache whose keys are stored in the database and shared by all replicas.
+// Only the replica holding the elector's lease generates new keys and removes expired ones.
+// Every replica reloads the key set from the database every poll interval.
+func NewSharedPrivateKeyCache[P PrivateKey](tokenDur, keyDur time.Duration, pair KeyPair[P], elector cluster.Elector, cipher *KeyCipher, poll time.Duration, ctx context.Context) (*PrivateKeyCache[P], error) {
+	c := &PrivateKeyCache[P]{
+		Ctx:           ctx,
+		TokenDuration: tokenDur,
+		KeyDuration:   keyDur,
+		PersistKeys:   true,
+		Cipher:        cipher,
+	}
+	state := &sharedKeyState[P]{
+		elector:  elector,
+		template: pair,
+		poll:     poll,
+		loaded:   make(map[int]KeyPair[P]),
+	}
+
+	if err := c.bootstrapShared(ctx, state); err != nil {
+		return c, err
+	}
+	lifecycle.Go(ctx, func() { c.goManageShared(ctx, state) })
+	return c, nil
+}
+
+func (c *PrivateKeyCache[P]) bootstrapShared(ctx context.Context, state *sharedKeyState[P]) error {
+	logger := zerolog.Ctx(ctx)
+	logger.Info().Msg("Bootstraping shared key cache.")
+
+	deadline := time.Now().Add(sharedBootstrapWait)
+	for {
+		c.syncShared(ctx, state)
+		if len(c.KeyPairs) > 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("No shared signing keys were found in the database after %s", sharedBootstrapWait)
+		}
+
+		logger.Info().Msg("Waiting for the cluster leader to create a signing key...")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (c *PrivateKeyCache[P]) goManageShared(ctx context.Context, state *sharedKeyState[P]) {
+	logger := zerolog.Ctx(ctx).With().
+			Str("component", "PrivateKeyCache.SharedManagement").
+			Logger()
+
+	logger.Info().
+		Dur("poll", state.poll).
+		Msg("Starting shared key cache management...")
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info().Msg("Context canceled. Stopping.")
+			if state.leader {
+				// ctx is already cancelled, so release on a context that keeps its values
+				if err := state.elector.Release(context.WithoutCancel(ctx)); err != nil {
+					logger.Error().Err(err).Msg("Could not release key management lease")
+				}
+			}
+			return
+		case <-time.After(state.poll):
+			c.syncShared(logger.WithContext(ctx), state)
+		}
+	}
+}
+
+// syncShared rotates keys when this replica is the leader and then reloads keys from the database
+func (c *PrivateKeyCache[P]) syncShared(ctx context.Context, state *sharedKeyState[P]) {
+	logger := zerolog.Ctx(ctx)
+	ctx, span := tel.GetTracer().Start(ctx, "PrivateKeyCache.SyncShared")
+	defer span.End()
+
+	leader, err := state.elector.TryAcquire(ctx)
+	if err != nil {
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not acquire key management lease")
+		leader = false
+	}
+	if leader != state.leader {
+		logger.Info().
+			Bool("leader", leader).
+			Msg("Key management leadership changed")
+		state.leader = leader
+	}
+
+	if leader {
+		if err := c.rotateShared(ctx, state); err != nil {
+			logger.Error().
+				Func(otelzerolog.AddTracingContext(span)).
+				Err(err).
+				Msg("Could not rotate shared signing keys")
+		}
+	}
+
+	if err := c.reloadShared(ctx, state); err != nil {
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not reload shared signing keys")
+	}
+}
+
+// rotateShared creates a new key when the newest key is close to expiring and removes expired keys.
+// Only called by the leader.
+func (c *PrivateKeyCache[P]) rotateShared(ctx context.Context, state *sharedKeyState[P]) error {
+	logger := zerolog.Ctx(ctx)
+	db := ent.FromContext(ctx)
+	now := time.Now()
+
+	if _, err := db.PrivateKey.Delete().Where(privatekey.ExpiresLT(now)).Exec(ctx); err != nil {
+		logger.Error().Err(err).Msg("Could not delete expired keys from database.")
+	}
+
+	newest, err := db.PrivateKey.Query().
+		Where(privatekey.ExpiresGT(now)).
+		Order(ent.Desc(privatekey.FieldExpires)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	// Leave room for followers to load the key before clients refresh their JWKS
+	if newest != nil && time.Until(newest.Expires) > c.TokenDuration * 2 + state.poll {
+		return nil
+	}
+
+	newKey, err := state.template.Generate()
+	if err != nil {
+		return err
+	}
+	newKey.SetExpires(now.Add(c.KeyDuration))
+
+	text, err := c.Cipher.Encrypt(newKey.Encode())
+	if err != nil {
+		return err
+	}
+	if err := db.PrivateKey.Create().SetText(text).SetExpires(newKey.ExpiresAt()).Exec(ctx); err != nil {
+		return err
+	}
+
+	logger.Info().
+		Time("expires", newKey.ExpiresAt()).
+		Str("publicKey", newKey.PublicString()).
+		Msg("Generated new shared key.")
+	return nil
+}
+
+// reloadShared replaces the cached keys with the non-expired keys in the database.
+// A key becomes active one token duration after it was created so every replica has loaded it first.
+func (c *PrivateKeyCache[P]) reloadShared(ctx context.Context, state *sharedKeyState[P]) error {
+	now := time.Now()
+	rows, err := ent.FromContext(ctx).PrivateKey.Query().
+		Where(privatekey.ExpiresGT(now)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Expires.Before(rows[j].Expires) })
+
+	var pairs []KeyPair[P]
+	var ids []string
+	loaded := make(map[int]KeyPair[P], len(rows))
+	for _, row := range rows {
+		pair, ok := state.loaded[row.ID]
+		if !ok {
+			text, err := c.Cipher.Decrypt(row.Text)
+			if err != nil {
+				return err
+			}
+			pair, err = state.template.Generate()
+			if err != nil {
+				return err
+			}
+			if err := pair.Decode(text); err != nil {
+				return err
+			}
+			pair.SetExpires(row.Expires)
+		}
+		loaded[row.ID] = pair
+		pairs = append(pairs, pair)
+		ids = append(ids, "s-" + strconv.Itoa(row.ID))
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	active := 0
+	for i, pair := range pairs {
+		if !pair.ExpiresAt().Add(c.TokenDuration - c.KeyDuration).After(now) {
+			active = i
+		}
+	}
+
+	c.keyPairsMutex.Lock()
+	c.KeyPairs = pairs
+	c.keyIds = ids
+	c.activeKey = active
+	c.keyPairsMutex.Unlock()
+	state.loaded = loaded
+	return nil
+}
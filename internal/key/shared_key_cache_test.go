@@ -0,0 +1,90 @@
+package key_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"stoke/internal/ent"
+	"stoke/internal/key"
+	"stoke/internal/testutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mockElector struct {
+	leader bool
+}
+
+func (m *mockElector) TryAcquire(context.Context) (bool, error) { return m.leader, nil }
+func (m *mockElector) Release(context.Context) error             { return nil }
+
+func TestKeyCipherRoundTrip(t *testing.T) {
+	cipher, err := key.NewKeyCipher("secret")
+	if err != nil {
+		t.Fatalf("NewKeyCipher: %v", err)
+	}
+	sealed, err := cipher.Encrypt("plain key text")
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if !strings.HasPrefix(sealed, "enc:") || strings.Contains(sealed, "plain key text") {
+		t.Fatalf("key text was not encrypted: %s", sealed)
+	}
+	plain, err := cipher.Decrypt(sealed)
+	if err != nil || plain != "plain key text" {
+		t.Fatalf("Decrypt returned %q, %v", plain, err)
+	}
+
+	other, _ := key.NewKeyCipher("other")
+	if _, err := other.Decrypt(sealed); err == nil {
+		t.Fatal("Decrypt with the wrong secret should fail")
+	}
+}
+
+func TestKeyCipherPassesThroughPlainText(t *testing.T) {
+	cipher, _ := key.NewKeyCipher("secret")
+	plain, err := cipher.Decrypt("not encrypted")
+	if err != nil || plain != "not encrypted" {
+		t.Fatalf("Decrypt returned %q, %v", plain, err)
+	}
+}
+
+func TestSharedPrivateKeyCacheFollowerUsesLeaderKeys(t *testing.T) {
+	ctx := testutil.NewMockContext(testutil.WithDatabase(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cipher, _ := key.NewKeyCipher("secret")
+
+	leader, err := key.NewSharedPrivateKeyCache[ed25519.PrivateKey](time.Minute, time.Hour, &key.EdDSAKeyPair{}, &mockElector{leader: true}, cipher, time.Second, ctx)
+	if err != nil {
+		t.Fatalf("Failed to create leader cache: %v", err)
+	}
+	follower, err := key.NewSharedPrivateKeyCache[ed25519.PrivateKey](time.Minute, time.Hour, &key.EdDSAKeyPair{}, &mockElector{}, cipher, time.Second, ctx)
+	if err != nil {
+		t.Fatalf("Failed to create follower cache: %v", err)
+	}
+
+	if !leader.CurrentKey().Key().Equal(follower.CurrentKey().Key()) {
+		t.Error("Follower did not load the leader's key")
+	}
+	if leader.CurrentKeyId() != follower.CurrentKeyId() {
+		t.Errorf("Key ids differ between replicas: %s != %s", leader.CurrentKeyId(), follower.CurrentKeyId())
+	}
+
+	pk := ent.FromContext(ctx).PrivateKey.Query().FirstX(ctx)
+	if !strings.HasPrefix(pk.Text, "enc:") {
+		t.Error("Shared key was not encrypted in the database")
+	}
+}
+
+func TestSharedPrivateKeyCacheFollowerFailsWithoutKeys(t *testing.T) {
+	ctx := testutil.NewMockContext(testutil.WithDatabase(t))
+	ctx, cancel := context.WithTimeout(ctx, 50 * time.Millisecond)
+	defer cancel()
+
+	cipher, _ := key.NewKeyCipher("secret")
+	if _, err := key.NewSharedPrivateKeyCache[ed25519.PrivateKey](time.Minute, time.Hour, &key.EdDSAKeyPair{}, &mockElector{}, cipher, time.Second, ctx); err == nil {
+		t.Error("Follower should fail to start when no leader creates a key")
+	}
+}
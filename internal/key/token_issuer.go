@@ -50,6 +50,18 @@ type AsymetricTokenIssuer[P PrivateKey]  struct {
 	KeyCache[P]
 }
 
+// ActiveKeyReporter is implemented by issuers that can report the expiry of the key they sign with
+type ActiveKeyReporter interface {
+	ActiveKeyExpires() time.Time
+}
+
+// ActiveKeyExpires returns when the current signing key expires
+func (a *AsymetricTokenIssuer[P]) ActiveKeyExpires() time.Time {
+	a.ReadLock()
+	defer a.ReadUnlock()
+	return a.CurrentKey().ExpiresAt()
+}
+
 func (a *AsymetricTokenIssuer[P]) WithContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, issuerCtxKey{}, a)
 }
@@ -0,0 +1,157 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type ctxKey struct{}
+
+// StopFunc is run during shutdown after the server has drained and background work has stopped
+type StopFunc func(context.Context) error
+
+type stopStep struct {
+	name string
+	fn   StopFunc
+}
+
+// Manager runs the http server until a termination signal is received and then shuts down in order:
+// drain the server, cancel background goroutines, then run the registered stop steps.
+type Manager struct {
+	// How long to wait for in-flight requests and background goroutines to finish
+	Drain time.Duration
+	// Signals that start a shutdown. Defaults to SIGINT and SIGTERM
+	Signals []os.Signal
+
+	cancel     context.CancelFunc
+	background sync.WaitGroup
+	steps      []stopStep
+}
+
+// NewManager creates a lifecycle manager with the given drain period
+func NewManager(drain time.Duration) *Manager {
+	return &Manager{
+		Drain:   drain,
+		Signals: []os.Signal{ syscall.SIGINT, syscall.SIGTERM },
+	}
+}
+
+// WithContext returns a context that is cancelled when shutdown starts and that carries the manager
+// so background goroutines can be tracked with Go.
+func (m *Manager) WithContext(ctx context.Context) context.Context {
+	ctx, m.cancel = context.WithCancel(ctx)
+	return context.WithValue(ctx, ctxKey{}, m)
+}
+
+// FromContext returns the manager stored in ctx, or nil if not set
+func FromContext(ctx context.Context) *Manager {
+	m, _ := ctx.Value(ctxKey{}).(*Manager)
+	return m
+}
+
+// Go starts fn in a goroutine. If ctx carries a manager, shutdown waits for fn to return
+// before running stop steps. fn must return once ctx is cancelled.
+func Go(ctx context.Context, fn func()) {
+	m := FromContext(ctx)
+	if m == nil {
+		go fn()
+		return
+	}
+	m.background.Add(1)
+	go func() {
+		defer m.background.Done()
+		fn()
+	}()
+}
+
+// OnStop registers a step to run during shutdown. Steps run in the order they were registered.
+func (m *Manager) OnStop(name string, fn StopFunc) {
+	m.steps = append(m.steps, stopStep{ name: name, fn: fn })
+}
+
+// Serve runs server until it fails or a signal is received and then shuts everything down.
+// Returns the joined errors from serving and from each shutdown step.
+func (m *Manager) Serve(ctx context.Context, server *http.Server) error {
+	logger := zerolog.Ctx(ctx).With().Str("component", "lifecycle.Manager").Logger()
+
+	sigCtx, stop := signal.NotifyContext(context.Background(), m.Signals...)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if server.TLSConfig != nil {
+			serveErr <- server.ListenAndServeTLS("", "")
+		} else {
+			serveErr <- server.ListenAndServe()
+		}
+	}()
+
+	var err error
+	select {
+	case <-sigCtx.Done():
+		logger.Info().Msg("Received termination signal. Shutting down...")
+	case err = <-serveErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			err = nil
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("An error occurred with the http server")
+		}
+	}
+
+	return errors.Join(err, m.Shutdown(ctx, server))
+}
+
+// Shutdown drains server, cancels background goroutines and runs the stop steps
+func (m *Manager) Shutdown(ctx context.Context, server *http.Server) error {
+	logger := zerolog.Ctx(ctx).With().Str("component", "lifecycle.Manager").Logger()
+
+	// ctx is cancelled below, so shutdown work runs on a separate context
+	drainCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), m.Drain)
+	defer cancel()
+
+	var err error
+	if server != nil {
+		logger.Info().Dur("drain", m.Drain).Msg("Draining http server...")
+		if sErr := server.Shutdown(drainCtx); sErr != nil {
+			logger.Error().Err(sErr).Msg("Could not drain http server")
+			err = errors.Join(err, sErr)
+		}
+	}
+
+	if m.cancel != nil {
+		m.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		m.background.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		logger.Debug().Msg("Background tasks stopped.")
+	case <-drainCtx.Done():
+		logger.Warn().Msg("Timed out waiting for background tasks to stop")
+	}
+
+	stepCtx, stepCancel := context.WithTimeout(context.WithoutCancel(ctx), m.Drain)
+	defer stepCancel()
+	for _, step := range m.steps {
+		if sErr := step.fn(stepCtx); sErr != nil {
+			logger.Error().Err(sErr).Str("step", step.name).Msg("Shutdown step failed")
+			err = errors.Join(err, sErr)
+			continue
+		}
+		logger.Debug().Str("step", step.name).Msg("Shutdown step finished")
+	}
+	return err
+}
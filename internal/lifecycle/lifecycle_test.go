@@ -0,0 +1,83 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestShutdownCancelsBackgroundBeforeStopSteps(t *testing.T) {
+	m := NewManager(time.Second)
+	ctx := m.WithContext(context.Background())
+
+	var order []string
+	stopped := make(chan struct{})
+	Go(ctx, func() {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		order = append(order, "background")
+		close(stopped)
+	})
+	m.OnStop("first", func(context.Context) error {
+		order = append(order, "first")
+		return nil
+	})
+	m.OnStop("second", func(context.Context) error {
+		order = append(order, "second")
+		return nil
+	})
+
+	if err := m.Shutdown(ctx, nil); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	<-stopped
+
+	want := []string{"background", "first", "second"}
+	if len(order) != len(want) {
+		t.Fatalf("unexpected shutdown order: %v", order)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("unexpected shutdown order: %v", order)
+		}
+	}
+}
+
+func TestShutdownRunsAllStepsAndJoinsErrors(t *testing.T) {
+	m := NewManager(time.Second)
+	ctx := m.WithContext(context.Background())
+
+	failure := errors.New("step failed")
+	ran := false
+	m.OnStop("fails", func(context.Context) error { return failure })
+	m.OnStop("runs", func(context.Context) error {
+		ran = true
+		return nil
+	})
+
+	err := m.Shutdown(ctx, nil)
+	if !errors.Is(err, failure) {
+		t.Errorf("expected step error to be returned, got %v", err)
+	}
+	if !ran {
+		t.Error("steps after a failed step should still run")
+	}
+}
+
+func TestShutdownTimesOutWaitingForBackground(t *testing.T) {
+	m := NewManager(20 * time.Millisecond)
+	ctx := m.WithContext(context.Background())
+
+	block := make(chan struct{})
+	defer close(block)
+	Go(ctx, func() { <-block })
+
+	start := time.Now()
+	if err := m.Shutdown(ctx, nil); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("Shutdown did not give up on background tasks after the drain period")
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CachedCredential holds a password verifier from the last successful login with a foreign provider.
+// It lets users log in while the provider is unreachable, until the credential expires.
+type CachedCredential struct {
+	ent.Schema
+}
+
+func (CachedCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			Immutable(),
+		field.String("username").
+			Immutable(),
+		field.String("verifier").
+			Sensitive(),
+		field.String("salt").
+			Sensitive(),
+		field.Time("expires"),
+	}
+}
+
+func (CachedCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "username").
+			Unique(),
+	}
+}
+
+func (CachedCredential) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (CachedCredential) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity links a user to an account at a foreign provider by the provider's stable subject,
+// i.e. the OIDC sub claim or an LDAP objectGUID or DN.
+// A user may have identities with several providers; each provider account belongs to one user.
+type Identity struct {
+	ent.Schema
+}
+
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider_type").
+			Immutable(),
+		field.String("provider_name").
+			Immutable(),
+		field.String("subject").
+			Immutable(),
+		field.Time("created").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("identities").
+			Unique().
+			Required().
+			Annotations(
+				entoas.Skip(true),
+			),
+	}
+}
+
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider_name", "subject").
+			Unique(),
+	}
+}
+
+func (Identity) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (Identity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Lease is a named lock held by a single replica until it expires.
+// Used to elect a leader for cluster wide background work.
+type Lease struct {
+	ent.Schema
+}
+
+func (Lease) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Unique().
+			Immutable(),
+		field.String("holder"),
+		field.Time("expires"),
+	}
+}
+
+func (Lease) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (Lease) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
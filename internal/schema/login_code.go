@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginCode holds tokens issued after a server-side provider login until the application redeems the code.
+// Codes are random, single use and short lived.
+type LoginCode struct {
+	ent.Schema
+}
+
+func (LoginCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			Unique().
+			Immutable().
+			Sensitive(),
+		field.String("username").
+			Immutable(),
+		field.String("token").
+			Immutable().
+			Sensitive(),
+		field.String("refresh").
+			Immutable().
+			Sensitive(),
+		field.Time("expires").
+			Immutable(),
+	}
+}
+
+func (LoginCode) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (LoginCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LogoutToken records the jti of an accepted back-channel logout token until the token expires,
+// so a captured logout token can not be replayed.
+type LogoutToken struct {
+	ent.Schema
+}
+
+func (LogoutToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			Immutable(),
+		field.String("jti").
+			Immutable(),
+		field.Time("expires").
+			Immutable(),
+	}
+}
+
+func (LogoutToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "jti").
+			Unique(),
+	}
+}
+
+func (LogoutToken) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (LogoutToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// MFAChallenge holds the claims of a password login until the user answers it with a second factor.
+// Challenges are random, single use and short lived.
+type MFAChallenge struct {
+	ent.Schema
+}
+
+func (MFAChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("challenge").
+			Unique().
+			Immutable().
+			Sensitive(),
+		field.String("username").
+			Immutable(),
+		field.JSON("claims", map[string]string{}).
+			Immutable().
+			Sensitive().
+			Annotations(
+				entoas.Skip(true),
+			),
+		field.Int("attempts").
+			Default(0),
+		field.Time("expires").
+			Immutable(),
+	}
+}
+
+func (MFAChallenge) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (MFAChallenge) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// OIDCState holds the values of an in-flight OIDC authorization request.
+// Rows are keyed by the random state sent to the provider so any replica can complete the callback.
+type OIDCState struct {
+	ent.Schema
+}
+
+func (OIDCState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("state").
+			Unique().
+			Immutable(),
+		field.String("provider").
+			Immutable(),
+		field.String("nonce").
+			Immutable(),
+		field.String("code_verifier").
+			Immutable().
+			Sensitive(),
+		field.String("next_url").
+			Immutable().
+			Default(""),
+		field.String("xfer").
+			Immutable().
+			Default(""),
+		field.String("access_token").
+			Immutable().
+			Sensitive().
+			Default(""),
+		field.Time("expires").
+			Immutable(),
+	}
+}
+
+func (OIDCState) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (OIDCState) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
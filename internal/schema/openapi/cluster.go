@@ -0,0 +1,54 @@
+package openapi
+
+import "github.com/ogen-go/ogen"
+
+func addClusterStatusEndpoint(spec *ogen.Spec, security ogen.SecurityRequirements) error {
+	pathItem := ogen.NewPathItem().
+		SetDescription("Cluster status").
+		SetGet(ogen.NewOperation().
+			SetOperationID("cluster_status").
+			SetSummary("Get the status of this instance's view of the cluster").
+			SetDescription("Reports discovered peers, the result of the last public key fetch from each peer, the merged key cache expiry and any key ids published by more than one peer with different key material.").
+			AddResponse("200", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("enabled").SetSchema(ogen.Bool().SetDescription("Whether cluster mode is enabled")),
+						*ogen.NewProperty().SetName("instance_id").SetSchema(ogen.String().SetDescription("Configured instance id of this replica")),
+						*ogen.NewProperty().SetName("merged").SetSchema(ogen.Bool().SetDescription("Whether at least one public key merge has completed")),
+						*ogen.NewProperty().SetName("last_merge").SetSchema(ogen.DateTime().SetDescription("Time of the last public key merge")),
+						*ogen.NewProperty().SetName("cache_expiry").SetSchema(ogen.DateTime().SetDescription("Time the merged public key cache expires")),
+						*ogen.NewProperty().SetName("peers").SetSchema(ogen.NewSchema().
+							SetType("array").
+							SetItems(ogen.NewSchema().
+								SetType("object").
+								SetProperties(&ogen.Properties{
+									*ogen.NewProperty().SetName("url").SetSchema(ogen.String().SetDescription("Base URL of the peer")),
+									*ogen.NewProperty().SetName("last_success").SetSchema(ogen.DateTime().SetDescription("Time of the last successful public key fetch")),
+									*ogen.NewProperty().SetName("error").SetSchema(ogen.String().SetDescription("Error from the most recent fetch")),
+									*ogen.NewProperty().SetName("key_ids").SetSchema(ogen.String().AsArray().SetDescription("Key ids published by the peer")),
+									*ogen.NewProperty().SetName("expires").SetSchema(ogen.DateTime().SetDescription("Expiry of the peer's public key set")),
+								}).
+								SetRequired([]string{"url", "key_ids"}),
+							),
+						),
+						*ogen.NewProperty().SetName("conflicts").SetSchema(ogen.NewSchema().
+							SetType("array").
+							SetItems(ogen.NewSchema().
+								SetType("object").
+								SetProperties(&ogen.Properties{
+									*ogen.NewProperty().SetName("kid").SetSchema(ogen.String().SetDescription("Conflicting key id")),
+									*ogen.NewProperty().SetName("sources").SetSchema(ogen.String().AsArray().SetDescription("Sources that published the key id. The first source is used.")),
+								}).
+								SetRequired([]string{"kid", "sources"}),
+							),
+						),
+					}).
+					SetRequired([]string{"enabled", "instance_id", "merged", "peers", "conflicts"}),
+				),
+			),
+		)
+	pathItem.Get.Security = security
+	spec.AddPathItem("/cluster", pathItem)
+	return nil
+}
@@ -22,8 +22,13 @@ func CustomizeSpec(g *gen.Graph, spec *ogen.Spec) error {
 	addTotalsEndpoint(spec, security)
 	addRefreshEndpoint(spec, security)
 	addCapabilitesEndpoint(spec, security)
+	addClusterStatusEndpoint(spec, security)
+	addProviderHealthEndpoint(spec, security)
+	addIdentityEndpoints(spec, security)
+	addMFAEndpoints(spec, security)
 	
 	addLoginEndpoint(spec)
+	addLoginExchangeEndpoint(spec)
 	addPkeysEndpoint(spec)
 	addAvailableProvidersEndpoint(spec)
 	return nil
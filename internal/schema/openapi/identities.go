@@ -0,0 +1,156 @@
+package openapi
+
+import (
+	"encoding/json"
+
+	"github.com/ogen-go/ogen"
+)
+
+func identitySchema() *ogen.Schema {
+	return ogen.NewSchema().
+		SetType("object").
+		SetProperties(&ogen.Properties{
+			*ogen.NewProperty().SetName("id").SetSchema(ogen.Int().SetDescription("Identity id")),
+			*ogen.NewProperty().SetName("username").SetSchema(ogen.String().SetDescription("Username of the linked user")),
+			*ogen.NewProperty().SetName("provider_type").SetSchema(ogen.String().SetDescription("Type of provider, i.e. LDAP or OIDC")),
+			*ogen.NewProperty().SetName("provider").SetSchema(ogen.String().SetDescription("Name of provider")),
+			*ogen.NewProperty().SetName("subject").SetSchema(ogen.String().SetDescription("Stable id of the account at the provider")),
+			*ogen.NewProperty().SetName("created").SetSchema(ogen.DateTime().SetDescription("Time the identity was linked")),
+		}).
+		SetRequired([]string{"id", "username", "provider_type", "provider", "subject", "created"})
+}
+
+func identityListResponse() *ogen.Response {
+	return ogen.NewResponse().
+		AddContent("application/json", ogen.NewSchema().
+			SetType("object").
+			SetProperties(&ogen.Properties{
+				*ogen.NewProperty().SetName("identities").SetSchema(ogen.NewSchema().
+					SetType("array").
+					SetItems(identitySchema()),
+				),
+			}).
+			SetRequired([]string{"identities"}),
+		)
+}
+
+func identityMessageResponse(message string) *ogen.Response {
+	schema := ogen.String().SetDescription("Error Message")
+	if message != "" {
+		schema = ogen.String().SetDefault(json.RawMessage(`"` + message + `"`))
+	}
+	return ogen.NewResponse().
+		AddContent("application/json", ogen.NewSchema().
+			SetType("object").
+			SetProperties(&ogen.Properties{
+				*ogen.NewProperty().SetName("message").SetSchema(schema),
+			}).
+			SetRequired([]string{"message"}),
+		)
+}
+
+func identityIdParameter() *ogen.Parameter {
+	return ogen.NewParameter().
+		InPath().
+		SetName("id").
+		SetDescription("Identity id").
+		SetRequired(true).
+		SetSchema(ogen.Int())
+}
+
+func addIdentityEndpoints(spec *ogen.Spec, security ogen.SecurityRequirements) error {
+	adminItem := ogen.NewPathItem().
+		SetDescription("Identities linking users to provider accounts").
+		SetGet(ogen.NewOperation().
+			SetOperationID("listIdentities").
+			SetSummary("List linked identities").
+			AddParameters(ogen.NewParameter().
+				InQuery().
+				SetName("username").
+				SetDescription("Only list identities of this user").
+				SetSchema(ogen.String()),
+			).
+			AddResponse("200", identityListResponse()),
+		).
+		SetPost(ogen.NewOperation().
+			SetOperationID("linkIdentity").
+			SetSummary("Link a provider account to a user").
+			SetDescription("Links the account with subject at provider to the user without logging in to the provider. The account must not be linked to another user.").
+			SetRequestBody(ogen.NewRequestBody().
+				SetRequired(true).
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetRequired([]string{"username", "provider", "subject"}).
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("username").SetSchema(ogen.String().SetDescription("User to link the account to")),
+						*ogen.NewProperty().SetName("provider").SetSchema(ogen.String().SetDescription("Name of provider")),
+						*ogen.NewProperty().SetName("subject").SetSchema(ogen.String().SetDescription("Stable id of the account at the provider, i.e. the OIDC sub claim or LDAP objectGUID")),
+					}),
+				),
+			).
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("200", ogen.NewResponse().AddContent("application/json", identitySchema())),
+		)
+	adminItem.Get.Security = security
+	adminItem.Post.Security = security
+	spec.AddPathItem("/admin/identities", adminItem)
+
+	adminIdItem := ogen.NewPathItem().
+		SetDelete(ogen.NewOperation().
+			SetOperationID("unlinkIdentity").
+			SetSummary("Unlink a provider account from its user").
+			SetDescription("The only identity of a user without a local password can not be unlinked.").
+			AddParameters(identityIdParameter()).
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("404", identityMessageResponse("")).
+			AddResponse("200", identityMessageResponse("Identity Unlinked")),
+		)
+	adminIdItem.Delete.Security = security
+	spec.AddPathItem("/admin/identities/{id}", adminIdItem)
+
+	selfItem := ogen.NewPathItem().
+		SetDescription("Identities linked to the signed in user").
+		SetGet(ogen.NewOperation().
+			SetOperationID("listMyIdentities").
+			SetSummary("List the signed in user's identities").
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("200", identityListResponse()),
+		).
+		SetPost(ogen.NewOperation().
+			SetOperationID("linkMyIdentity").
+			SetSummary("Link a provider account to the signed in user").
+			SetDescription("The user proves the account is theirs by logging in to the provider. Token based providers take the provider token as the password.").
+			SetRequestBody(ogen.NewRequestBody().
+				SetRequired(true).
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetRequired([]string{"provider", "username", "password"}).
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("provider").SetSchema(ogen.String().SetDescription("Name of provider")),
+						*ogen.NewProperty().SetName("username").SetSchema(ogen.String().SetDescription("Username at the provider")),
+						*ogen.NewProperty().SetName("password").SetSchema(ogen.String().SetDescription("Password or token for the provider")),
+					}),
+				),
+			).
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("401", ogen.NewResponse().SetDescription("Provider login failed")).
+			AddResponse("200", ogen.NewResponse().AddContent("application/json", identitySchema())),
+		)
+	selfItem.Get.Security = security
+	selfItem.Post.Security = security
+	spec.AddPathItem("/identities", selfItem)
+
+	selfIdItem := ogen.NewPathItem().
+		SetDelete(ogen.NewOperation().
+			SetOperationID("unlinkMyIdentity").
+			SetSummary("Unlink a provider account from the signed in user").
+			SetDescription("The only identity of a user without a local password can not be unlinked.").
+			AddParameters(identityIdParameter()).
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("404", identityMessageResponse("")).
+			AddResponse("200", identityMessageResponse("Identity Unlinked")),
+		)
+	selfIdItem.Delete.Security = security
+	spec.AddPathItem("/identities/{id}", selfIdItem)
+	return nil
+}
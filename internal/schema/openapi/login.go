@@ -78,6 +78,25 @@ func addLoginEndpoint(spec *ogen.Spec) error {
 					SetRequired([]string{"username", "token", "refresh"}),
 				),
 			).
+			AddResponse("202", ogen.NewResponse().
+				SetDescription("The user has MFA enabled. Answer the challenge at /login/mfa to receive a token").
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().
+							SetName("mfa_challenge").
+							SetSchema(ogen.String().
+								SetDescription("Challenge to answer with a code from the user's authenticator app"),
+							),
+						*ogen.NewProperty().
+							SetName("username").
+							SetSchema(ogen.String().
+								SetDescription("Username of the user who logged in"),
+							),
+					}).
+					SetRequired([]string{"username", "mfa_challenge"}),
+				),
+			).
 			AddResponse("401", ogen.NewResponse().
 				AddContent("application/json", ogen.NewSchema().
 					SetType("object").
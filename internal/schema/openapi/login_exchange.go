@@ -0,0 +1,69 @@
+package openapi
+
+import (
+	"encoding/json"
+
+	"github.com/ogen-go/ogen"
+)
+
+func addLoginExchangeEndpoint(spec *ogen.Spec) error {
+	pathItem := ogen.NewPathItem().
+		SetDescription("Exchange a one-time login code for tokens").
+		SetPost(ogen.NewOperation().
+			SetOperationID("loginExchange").
+			SetSummary("Redeem a login code issued after a server-side provider login").
+			SetRequestBody(ogen.NewRequestBody().
+				SetRequired(true).
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetDescription("Login code").
+					SetRequired([]string{"code"}).
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().
+							SetName("code").
+							SetSchema(ogen.String().
+								SetDescription("One-time code from the code query parameter of the login redirect"),
+							),
+					}),
+				),
+			).
+			AddResponse("200", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().
+							SetName("token").
+							SetSchema(ogen.String().
+								SetDescription("JWT Token"),
+							),
+						*ogen.NewProperty().
+							SetName("refresh").
+							SetSchema(ogen.String().
+								SetDescription("Token to get a new token with the same claims. Must be used before token expires"),
+							),
+						*ogen.NewProperty().
+							SetName("username").
+							SetSchema(ogen.String().
+								SetDescription("Username of the user who logged in"),
+							),
+					}).
+					SetRequired([]string{"username", "token", "refresh"}),
+				),
+			).
+			AddResponse("401", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().
+							SetName("message").
+							SetSchema(ogen.String().
+								SetDescription("Error Message").
+								SetDefault(json.RawMessage(`"Not Authorized"`)),
+							),
+					}),
+				),
+			),
+		)
+	spec.AddPathItem("/login/exchange", pathItem)
+	return nil
+}
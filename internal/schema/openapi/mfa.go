@@ -0,0 +1,137 @@
+package openapi
+
+import (
+	"encoding/json"
+
+	"github.com/ogen-go/ogen"
+)
+
+func mfaCodeRequest(description string) *ogen.RequestBody {
+	return ogen.NewRequestBody().
+		SetRequired(true).
+		AddContent("application/json", ogen.NewSchema().
+			SetType("object").
+			SetRequired([]string{"code"}).
+			SetProperties(&ogen.Properties{
+				*ogen.NewProperty().SetName("code").SetSchema(ogen.String().SetDescription(description)),
+			}),
+		)
+}
+
+func addMFAEndpoints(spec *ogen.Spec, security ogen.SecurityRequirements) error {
+	loginItem := ogen.NewPathItem().
+		SetDescription("Second step of logins of users with MFA enabled").
+		SetPost(ogen.NewOperation().
+			SetOperationID("loginMFA").
+			SetSummary("Answer an MFA challenge to receive a token").
+			SetRequestBody(ogen.NewRequestBody().
+				SetRequired(true).
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetRequired([]string{"challenge", "code"}).
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("challenge").SetSchema(ogen.String().SetDescription("Challenge returned by login")),
+						*ogen.NewProperty().SetName("code").SetSchema(ogen.String().SetDescription("Code from the user's authenticator app or a recovery code")),
+					}),
+				),
+			).
+			AddResponse("200", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("token").SetSchema(ogen.String().SetDescription("JWT Token")),
+						*ogen.NewProperty().SetName("refresh").SetSchema(ogen.String().SetDescription("Token to get a new token with the same claims. Must be used before token expires")),
+						*ogen.NewProperty().SetName("username").SetSchema(ogen.String().SetDescription("Username of the user who logged in")),
+					}).
+					SetRequired([]string{"username", "token", "refresh"}),
+				),
+			).
+			AddResponse("401", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("message").SetSchema(ogen.String().
+							SetDescription("Error Message").
+							SetDefault(json.RawMessage(`"Not Authorized"`)),
+						),
+					}),
+				),
+			),
+		)
+	spec.AddPathItem("/login/mfa", loginItem)
+
+	enrollItem := ogen.NewPathItem().
+		SetDescription("TOTP multi-factor authentication of the signed in local user").
+		SetPost(ogen.NewOperation().
+			SetOperationID("enrollMFA").
+			SetSummary("Start MFA enrollment").
+			SetDescription("Creates a new TOTP secret for the signed in user. MFA is enabled once a code made with the secret is sent to /mfa/activate.").
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("200", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("secret").SetSchema(ogen.String().SetDescription("Base32 encoded TOTP secret")),
+						*ogen.NewProperty().SetName("uri").SetSchema(ogen.String().SetDescription("otpauth:// url of the secret for QR codes")),
+					}).
+					SetRequired([]string{"secret", "uri"}),
+				),
+			),
+		)
+	enrollItem.Post.Security = security
+	spec.AddPathItem("/mfa/enroll", enrollItem)
+
+	activateItem := ogen.NewPathItem().
+		SetPost(ogen.NewOperation().
+			SetOperationID("activateMFA").
+			SetSummary("Enable MFA").
+			SetDescription("Enables MFA for the signed in user when code was made with the enrolled secret. Recovery codes are only returned once.").
+			SetRequestBody(mfaCodeRequest("Code from the user's authenticator app")).
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("200", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("recovery_codes").SetSchema(ogen.NewSchema().
+							SetType("array").
+							SetDescription("Single use codes accepted instead of an authenticator code").
+							SetItems(ogen.String()),
+						),
+					}).
+					SetRequired([]string{"recovery_codes"}),
+				),
+			),
+		)
+	activateItem.Post.Security = security
+	spec.AddPathItem("/mfa/activate", activateItem)
+
+	disableItem := ogen.NewPathItem().
+		SetPost(ogen.NewOperation().
+			SetOperationID("disableMFA").
+			SetSummary("Disable MFA").
+			SetRequestBody(mfaCodeRequest("Code from the user's authenticator app or a recovery code")).
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("200", identityMessageResponse("MFA Disabled")),
+		)
+	disableItem.Post.Security = security
+	spec.AddPathItem("/mfa/disable", disableItem)
+
+	resetItem := ogen.NewPathItem().
+		SetDelete(ogen.NewOperation().
+			SetOperationID("resetMFA").
+			SetSummary("Disable MFA of a user without a code").
+			SetDescription("Used when a user lost their authenticator and recovery codes.").
+			AddParameters(ogen.NewParameter().
+				InPath().
+				SetName("username").
+				SetDescription("Username of the local user").
+				SetRequired(true).
+				SetSchema(ogen.String()),
+			).
+			AddResponse("400", identityMessageResponse("")).
+			AddResponse("200", identityMessageResponse("MFA Disabled")),
+		)
+	resetItem.Delete.Security = security
+	spec.AddPathItem("/admin/mfa/{username}", resetItem)
+	return nil
+}
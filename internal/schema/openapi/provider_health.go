@@ -0,0 +1,40 @@
+package openapi
+
+import "github.com/ogen-go/ogen"
+
+func addProviderHealthEndpoint(spec *ogen.Spec, security ogen.SecurityRequirements) error {
+	pathItem := ogen.NewPathItem().
+		SetDescription("Provider health").
+		SetGet(ogen.NewOperation().
+			SetOperationID("provider_health").
+			SetSummary("Get the health of the configured login providers").
+			SetDescription("Reports the circuit breaker state of each foreign provider, the number of consecutive logins that failed because the provider could not be reached and the times of the last successful and failed logins. Providers are degraded while logins are failing or the circuit breaker is not closed.").
+			AddResponse("200", ogen.NewResponse().
+				AddContent("application/json", ogen.NewSchema().
+					SetType("object").
+					SetProperties(&ogen.Properties{
+						*ogen.NewProperty().SetName("providers").SetSchema(ogen.NewSchema().
+							SetType("array").
+							SetItems(ogen.NewSchema().
+								SetType("object").
+								SetProperties(&ogen.Properties{
+									*ogen.NewProperty().SetName("name").SetSchema(ogen.String().SetDescription("Name of provider")),
+									*ogen.NewProperty().SetName("state").SetSchema(ogen.String().SetDescription("Circuit breaker state: closed, open or half_open")),
+									*ogen.NewProperty().SetName("degraded").SetSchema(ogen.Bool().SetDescription("Whether logins to the provider are failing or rejected")),
+									*ogen.NewProperty().SetName("consecutive_failures").SetSchema(ogen.Int().SetDescription("Number of consecutive logins that failed with a source error")),
+									*ogen.NewProperty().SetName("last_success").SetSchema(ogen.DateTime().SetDescription("Time of the last login the provider answered")),
+									*ogen.NewProperty().SetName("last_failure").SetSchema(ogen.DateTime().SetDescription("Time of the last login that failed with a source error")),
+									*ogen.NewProperty().SetName("error").SetSchema(ogen.String().SetDescription("Error from the last failed login")),
+								}).
+								SetRequired([]string{"name", "state", "degraded", "consecutive_failures"}),
+							),
+						),
+					}).
+					SetRequired([]string{"providers"}),
+				),
+			),
+		)
+	pathItem.Get.Security = security
+	spec.AddPathItem("/provider_health", pathItem)
+	return nil
+}
@@ -20,13 +20,13 @@ func addAvailableProvidersEndpoint(spec *ogen.Spec) error {
 									*ogen.NewProperty().SetName("name").SetSchema(ogen.String().SetDescription("Name of provider")),
 									*ogen.NewProperty().SetName("provider_type").SetSchema(ogen.String().SetDescription("Type of provider")),
 									*ogen.NewProperty().SetName("type_spec").SetSchema(ogen.String().SetDescription("Type specification of provider")),
+									*ogen.NewProperty().SetName("degraded").SetSchema(ogen.Bool().SetDescription("Whether logins to the provider are currently failing")),
 								}).
 								SetRequired([]string{"name", "provider_type", "type_spec"}),
 							),
 						),
 						*ogen.NewProperty().SetName("base_admin_path").SetSchema(ogen.String().SetDescription("Base path for the admin UI when served behind a proxy, e.g. /auth; empty when admin is at /admin/")),
-					}).
-					SetRequired([]string{"providers"}),
+					}),
 				),
 			),
 		)
@@ -3,6 +3,7 @@ package policy
 import (
 	"context"
 	"stoke/internal/ent/privacy"
+	"sync/atomic"
 
 	"hppr.dev/stoke"
 )
@@ -23,18 +24,31 @@ type policyConfig struct {
 }
 
 func ConfigurePolicies(usernames, claims, groups []string, usernameClaim string, readOnly, allowSuperuserOverride bool, ctx context.Context) context.Context {
-	return context.WithValue(ctx, policyConfigCtxKey, &policyConfig{
+	holder := &atomic.Pointer[policyConfig]{}
+	holder.Store(newPolicyConfig(usernames, claims, groups, usernameClaim, readOnly, allowSuperuserOverride))
+	return context.WithValue(ctx, policyConfigCtxKey, holder)
+}
+
+// ReconfigurePolicies replaces the policy configuration stored by ConfigurePolicies.
+// Contexts derived from ctx see the new configuration immediately.
+func ReconfigurePolicies(usernames, claims, groups []string, usernameClaim string, readOnly, allowSuperuserOverride bool, ctx context.Context) {
+	ctx.Value(policyConfigCtxKey).(*atomic.Pointer[policyConfig]).
+		Store(newPolicyConfig(usernames, claims, groups, usernameClaim, readOnly, allowSuperuserOverride))
+}
+
+func newPolicyConfig(usernames, claims, groups []string, usernameClaim string, readOnly, allowSuperuserOverride bool) *policyConfig {
+	return &policyConfig{
 		protectedUsernames:       usernames,
 		protectedClaimShortNames: claims,
 		protectedGroupNames:      groups,
 		usernameClaim:            usernameClaim,
 		readOnlyMode:             readOnly,
 		allowSuperuserOverride:   allowSuperuserOverride,
-	})
+	}
 }
 
 func policyFromCtx(ctx context.Context) *policyConfig {
-	return ctx.Value(policyConfigCtxKey).(*policyConfig)
+	return ctx.Value(policyConfigCtxKey).(*atomic.Pointer[policyConfig]).Load()
 }
 
 func isInReadOnlyMode(ctx context.Context) bool {
@@ -64,6 +78,11 @@ func BypassDatabasePolicies(ctx context.Context) context.Context {
 	return context.WithValue(ctx, allowDBInitKey, true)
 }
 
+// EnforceDatabasePolicies undoes BypassDatabasePolicies for changes made with ctx
+func EnforceDatabasePolicies(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allowDBInitKey, nil)
+}
+
 func hasBypassSet(ctx context.Context) bool {
 	return ctx.Value(allowDBInitKey) != nil
 }
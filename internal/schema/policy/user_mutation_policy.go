@@ -5,6 +5,7 @@ import (
 	"slices"
 	"stoke/internal/ent"
 
+	"stoke/internal/ent/claim"
 	"stoke/internal/ent/claimgroup"
 	"stoke/internal/ent/privacy"
 
@@ -20,6 +21,7 @@ type UserMutationPolicy struct {}
 //   * Allows the logged in user to modify themselves
 //   * Allows superusers to modify anyone else
 //   * Disallows assigning superuser priviledges from non-superusers
+//   * Disallows linking identities to superusers from non-superusers
 func (p UserMutationPolicy) EvalMutation(ctx context.Context, m ent.Mutation) error {
 	userM := m.(*ent.UserMutation)
 
@@ -65,6 +67,11 @@ func (p UserMutationPolicy) EvalMutation(ctx context.Context, m ent.Mutation) er
 		return err
 	}
 
+	if err := p.denyLinkingSuperuserFromNonSuperuser(ctx, userM, user, claims); err != nil {
+		logger.Warn().Err(err).Msg("Non-superuser trying to link an identity to a superuser")
+		return err
+	}
+
 	if err := p.allowChangesToSelf(ctx, user, claims); err != nil {
 		logger.Info().Err(err).Msg("Self allowed update")
 		return err
@@ -157,3 +164,31 @@ func (p UserMutationPolicy) denyAssigningSuperuserFromNonSuperuser(ctx context.C
 	}
 	return nil
 }
+
+// An identity linked to a user logs in as that user, so linking one to a superuser grants superuser priviledges
+func (p UserMutationPolicy) denyLinkingSuperuserFromNonSuperuser(ctx context.Context, userM *ent.UserMutation, user *ent.User, claims map[string]string) error {
+	if !slices.Contains(userM.AddedEdges(), "identities") || claims["stk"] == "S" {
+		return nil
+	}
+
+	isSuper, err := userM.Client().User.QueryClaimGroups(user).
+		QueryClaims().
+		Where(
+			claim.ShortNameEQ("stk"),
+			claim.ValueEQ("S"),
+		).
+		Exist(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().
+			Err(err).
+			Msg("Could not determine target user claims")
+		return privacy.Denyf("Could not determine target user claims")
+	}
+	if isSuper {
+		zerolog.Ctx(ctx).Warn().
+			Str("username", user.Username).
+			Msg("Non-superuser identity link to superuser denied")
+		return privacy.Denyf("Cannot link identities to a superuser from non superuser")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProviderSession ties tokens issued after a provider login to the provider's session.
+// Sessions that the provider logs out are revoked and their tokens can no longer be refreshed.
+// Sessions are kept as long as a token that carries them can be refreshed and are removed once they expire.
+type ProviderSession struct {
+	ent.Schema
+}
+
+func (ProviderSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("session").
+			Unique().
+			Immutable(),
+		field.String("provider").
+			Immutable(),
+		field.String("subject").
+			Immutable(),
+		field.String("sid").
+			Immutable().
+			Default(""),
+		field.Bool("revoked").
+			Default(false),
+		field.Time("created").
+			Immutable().
+			Default(time.Now),
+		// Sessions created before expiries were recorded have none and are treated as expired
+		field.Time("expires").
+			Optional(),
+	}
+}
+
+func (ProviderSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject"),
+		index.Fields("provider", "sid"),
+		index.Fields("expires"),
+	}
+}
+
+func (ProviderSession) Mixins() []ent.Mixin {
+	return []ent.Mixin{
+		Common{},
+	}
+}
+
+func (ProviderSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
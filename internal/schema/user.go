@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/contrib/entoas"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -36,6 +37,38 @@ func (User) Fields() []ent.Field {
 					Annotations(
 						entoas.Skip(true),
 					),
+				field.String("mfa_secret").
+					Optional().
+					Sensitive().
+					Annotations(
+						entoas.Skip(true),
+					),
+				field.Bool("mfa_enabled").
+					Default(false).
+					Annotations(
+						entoas.Skip(true),
+					),
+				field.Strings("mfa_recovery_codes").
+					Optional().
+					Sensitive().
+					Annotations(
+						entoas.Skip(true),
+					),
+				field.Int64("mfa_last_step").
+					Optional().
+					Annotations(
+						entoas.Skip(true),
+					),
+				field.Int("mfa_failures").
+					Default(0).
+					Annotations(
+						entoas.Skip(true),
+					),
+				field.Time("mfa_locked_until").
+					Optional().
+					Annotations(
+						entoas.Skip(true),
+					),
 				field.Time("created_at").
 					Immutable().
 					Default(time.Now).
@@ -47,6 +80,11 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge {
 		edge.From("claim_groups", ClaimGroup.Type).
 			Ref("users"),
+		edge.To("identities", Identity.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entoas.Skip(true),
+			),
 	}
 }
 
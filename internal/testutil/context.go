@@ -3,7 +3,7 @@ package testutil
 import (
 	"context"
 	"os"
-	"stoke/internal/schema/policy"
+	"stoke/internal/ent/schema/policy"
 
 	"github.com/rs/zerolog"
 )
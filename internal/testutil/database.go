@@ -32,6 +32,13 @@ func WithDatabase(t *testing.T, mutations ...DatabaseMutation) ContextOption {
 	}
 }
 
+// Evaluates database policies for changes made by the code under test. Must come after WithDatabase
+func EnforcePolicies() ContextOption {
+	return func(ctx context.Context) context.Context {
+		return policy.EnforceDatabasePolicies(ctx)
+	}
+}
+
 // Creates a key that doesn't expire until year 5000
 func ForeverKey() DatabaseMutation {
 	return KeyWithExpires(time.Date(5000, time.January, 10, 10, 10, 10, 10, time.UTC))
@@ -79,6 +86,27 @@ func DefaultUser() DatabaseMutation {
 	}
 }
 
+// Creates a local user in a group that a foreign provider links to with link, for provider tests
+// username: other, source: LOCAL
+// Groups:
+//   * platform -> platform team, linked by link
+//     * Platform Claim (plt=Y) -> Platform team member
+func ProviderLinkedGroup(link GroupOption) DatabaseMutation {
+	return func(client *ent.Client) {
+		User(
+			UserInfo("other", "user", "other", "other@example"),
+			Source("LOCAL"),
+			Group(
+				GroupInfo("platform", "platform team"),
+				link,
+				Claim(
+					ClaimInfo("Platform Claim", "plt", "Y", "Platform team member"),
+				),
+			),
+		)(client)
+	}
+}
+
 type UserOption func(*ent.UserCreate)
 
 // Creates a user with the given options.
@@ -152,10 +180,16 @@ func GroupInfo(name, desc string) GroupOption {
 
 // Creates an LDAP group link and adds it to the group
 func LDAPLink(providerName, groupName string) GroupOption {
+	return ProviderLink("LDAP", providerName, groupName)
+}
+
+// Creates a group link for a provider and adds it to the group.
+// linkType is the provider type prefix of the link type, i.e. OIDC for OIDC:<providerName>
+func ProviderLink(linkType, providerName, resourceSpec string) GroupOption {
 	return func(c *ent.ClaimGroupCreate) {
 		link := c.Mutation().Client().GroupLink.Create().
-			SetResourceSpec(groupName).
-			SetType("LDAP:" + providerName).
+			SetResourceSpec(resourceSpec).
+			SetType(linkType + ":" + providerName).
 			SaveX(bypassCtx)
 		c.AddGroupLinks(link)
 	}
@@ -0,0 +1,113 @@
+package usr
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultBreakerThreshold    = 5
+	defaultBreakerOpenDuration = 30 * time.Second
+)
+
+type BreakerState uint8
+const (
+	// Calls are allowed
+	BREAKER_CLOSED BreakerState = iota
+	// Calls are rejected until the open duration has passed
+	BREAKER_OPEN
+	// A single probe call is allowed to decide whether to close the breaker again
+	BREAKER_HALF_OPEN
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BREAKER_CLOSED:
+		return "closed"
+	case BREAKER_OPEN:
+		return "open"
+	case BREAKER_HALF_OPEN:
+		return "half_open"
+	}
+	return "undefined"
+}
+
+// circuitBreaker stops calls to a backend after Threshold consecutive failures.
+// After OpenDuration one probe call is let through; its outcome closes or reopens the breaker.
+// The zero value is a closed breaker using the default threshold and open duration.
+type circuitBreaker struct {
+	Threshold    int
+	OpenDuration time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *circuitBreaker) threshold() int {
+	if b.Threshold > 0 {
+		return b.Threshold
+	}
+	return defaultBreakerThreshold
+}
+
+func (b *circuitBreaker) openDuration() time.Duration {
+	if b.OpenDuration > 0 {
+		return b.OpenDuration
+	}
+	return defaultBreakerOpenDuration
+}
+
+// allow returns true if a call may be made. In the half open state only the first caller is allowed.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.stateLocked(now) {
+	case BREAKER_OPEN:
+		return false
+	case BREAKER_HALF_OPEN:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+	}
+	return true
+}
+
+// success closes the breaker
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.probing = false
+	b.openUntil = time.Time{}
+}
+
+// failure counts a failed call and opens the breaker at the threshold or when a probe fails
+func (b *circuitBreaker) failure(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.probing || b.failures >= b.threshold() {
+		b.openUntil = now.Add(b.openDuration())
+	}
+	b.probing = false
+}
+
+func (b *circuitBreaker) state(now time.Time) BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stateLocked(now)
+}
+
+func (b *circuitBreaker) stateLocked(now time.Time) BreakerState {
+	if b.openUntil.IsZero() {
+		return BREAKER_CLOSED
+	}
+	if now.Before(b.openUntil) {
+		return BREAKER_OPEN
+	}
+	return BREAKER_HALF_OPEN
+}
@@ -11,3 +11,15 @@ func ProviderFromCtx(ctx context.Context) *ProviderList {
 func (l *ProviderList) WithContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, providerCtxKey{}, l)
 }
+
+type sessionIssuerCtxKey struct {}
+
+// WithSessionIssuer sets the issuer used by providers that complete logins on the server
+func WithSessionIssuer(issuer SessionIssuer, ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionIssuerCtxKey{}, issuer)
+}
+
+func sessionIssuerFromCtx(ctx context.Context) SessionIssuer {
+	issuer, _ := ctx.Value(sessionIssuerCtxKey{}).(SessionIssuer)
+	return issuer
+}
@@ -0,0 +1,56 @@
+package usr
+
+import (
+	"context"
+	"time"
+
+	"stoke/internal/lifecycle"
+
+	"github.com/rs/zerolog"
+)
+
+// How often providers are checked for a due directory sync
+const directorySyncTick = time.Minute
+
+// directorySyncer is implemented by providers that can sync users with their directory in the background
+type directorySyncer interface {
+	syncDue(now time.Time) bool
+	runScheduledSync(ctx context.Context)
+}
+
+// StartDirectorySync runs due directory syncs for the configured foreign providers until ctx is cancelled.
+// Providers are looked up on every tick, so providers replaced by a configuration reload stop syncing.
+func (p *ProviderList) StartDirectorySync(ctx context.Context) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "usr.DirectorySync").
+		Logger()
+
+	lifecycle.Go(ctx, func() {
+		ticker := time.NewTicker(directorySyncTick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Debug().Msg("Context canceled. Stopping directory sync.")
+				return
+			case now := <-ticker.C:
+				p.runDueSyncs(now, logger.WithContext(ctx))
+			}
+		}
+	})
+}
+
+func (p *ProviderList) runDueSyncs(now time.Time, ctx context.Context) {
+	p.foreignMutex.RLock()
+	var due []directorySyncer
+	for _, prov := range p.foreignProviders {
+		if syncer, ok := prov.(directorySyncer); ok && syncer.syncDue(now) {
+			due = append(due, syncer)
+		}
+	}
+	p.foreignMutex.RUnlock()
+
+	for _, syncer := range due {
+		syncer.runScheduledSync(ctx)
+	}
+}
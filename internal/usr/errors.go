@@ -8,4 +8,24 @@ var (
 	UserNotFoundError   = errors.New("User not found")
 	AuthSourceError     = errors.New("An error occured with the authentication source")
 	OIDCTokenRetrievalError = errors.New("Could not retrieve token from token url")
+	OIDCStateError      = errors.New("Unknown or expired oidc state")
+	LoginCodeError      = errors.New("Unknown, expired or redeemed login code")
+	SessionRevokedError = errors.New("Provider session has been logged out or expired")
+	LogoutTokenReplayError = errors.New("Logout token was already used")
+	SAMLResponseError   = errors.New("Invalid SAML response")
+	PasswordPolicyError = errors.New("New password does not meet the password policy")
+	PasswordChangeNotAllowedError = errors.New("Password changes are not allowed for this user")
+	UnknownProviderError = errors.New("Unknown provider")
+	IdentityLinkedError  = errors.New("Identity is linked to another user")
+	IdentityNotLinkedError = errors.New("A user with this username or email exists but is not linked to this identity")
+	IdentityNotFoundError = errors.New("Identity not found")
+	LastIdentityError    = errors.New("Can not unlink the only identity of a user without a local password")
+	ProvisioningRejectedError = errors.New("User is not allowed by the provider's provisioning rules")
+	MFANotAvailableError = errors.New("MFA is only available to local users when it is configured")
+	MFAEnabledError      = errors.New("MFA is already enabled")
+	MFANotEnabledError   = errors.New("MFA is not enabled")
+	MFANotEnrolledError  = errors.New("MFA enrollment has not been started")
+	MFACodeError         = errors.New("Invalid MFA code")
+	MFAChallengeError    = errors.New("Unknown or expired MFA challenge")
+	MFALockedError       = errors.New("Too many wrong MFA codes. Try again later")
 )
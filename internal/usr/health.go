@@ -0,0 +1,155 @@
+package usr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// How long all provider health checks together may take. Checks that have not finished by then are reported as failed
+const providerHealthCheckTimeout = 3 * time.Second
+
+// healthChecker is implemented by providers that can check whether their upstream is reachable
+type healthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+// CheckForeignProviders checks every foreign provider that supports health checks at the same time.
+// The result maps provider names to nil or the error returned by the check.
+func (p *ProviderList) CheckForeignProviders(ctx context.Context) map[string]error {
+	p.foreignMutex.RLock()
+	checkers := make(map[string]healthChecker)
+	for name, prov := range p.foreignProviders {
+		if hc, ok := prov.(healthChecker); ok {
+			checkers[name] = hc
+		}
+	}
+	p.foreignMutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, providerHealthCheckTimeout)
+	defer cancel()
+
+	type checkResult struct {
+		name string
+		err  error
+	}
+	// Buffered so checks that finish after the timeout do not block
+	done := make(chan checkResult, len(checkers))
+	results := make(map[string]error, len(checkers))
+	for name, hc := range checkers {
+		results[name] = errors.New("health check did not finish in time")
+		go func() {
+			done <- checkResult{ name, hc.CheckHealth(ctx) }
+		}()
+	}
+	for range checkers {
+		select {
+		case r := <-done:
+			results[r.name] = r.err
+		case <-ctx.Done():
+			return results
+		}
+	}
+	return results
+}
+
+// CheckHealth opens and closes a connection to each LDAP server and updates which servers logins fail over from.
+// The provider is healthy while any server is reachable.
+func (l *ldapUserProvider) CheckHealth(ctx context.Context) error {
+	var opts []ldap.DialOpt
+	if deadline, ok := ctx.Deadline(); ok {
+		opts = append(opts, ldap.DialWithDialer(&net.Dialer{ Deadline: deadline }))
+	}
+
+	var errs []error
+	for _, u := range l.ServerURLs {
+		if ctx.Err() != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", u, ctx.Err()))
+			continue
+		}
+		conn, err := l.connect(u, opts...)
+		l.markServer(u, err == nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", u, err))
+			continue
+		}
+		conn.Close()
+	}
+	if len(errs) == len(l.ServerURLs) {
+		return fmt.Errorf("%w: %v", LDAPError, errors.Join(errs...))
+	}
+	return nil
+}
+
+// CheckHealth sends a request to the provider's token or authorization endpoint.
+// Any HTTP response means the provider is reachable.
+func (o *oidcUserProvider) CheckHealth(ctx context.Context) error {
+	target := o.TokenURL
+	if target == nil || target.String() == "" {
+		target = o.AuthenticationURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, target.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+// CheckHealth sends a request to the provider's token endpoint.
+// Any HTTP response means the provider is reachable.
+func (o *oauth2UserProvider) CheckHealth(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, o.TokenURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+// CheckHealth sends a request to the IdP's single sign on url.
+// Any HTTP response means the provider is reachable.
+func (s *samlUserProvider) CheckHealth(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.IdP.SSOURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+// CheckHealth reports the webhook unhealthy while its circuit breaker is open.
+// Otherwise sends a request to the webhook; any HTTP response means it is reachable.
+func (w *webhookUserProvider) CheckHealth(ctx context.Context) error {
+	if w.breaker.state(time.Now()) == BREAKER_OPEN {
+		return fmt.Errorf("%w: webhook circuit is open", AuthSourceError)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, w.URL.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := w.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+// CheckHealth loads the htpasswd and groups files if they changed
+func (h *htpasswdUserProvider) CheckHealth(ctx context.Context) error {
+	return h.reloadIfChanged()
+}
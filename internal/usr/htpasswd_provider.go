@@ -0,0 +1,302 @@
+package usr
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"stoke/internal/ent"
+	"stoke/internal/ent/grouplink"
+	"stoke/internal/tel"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/rs/zerolog"
+	"github.com/vincentfree/opentelemetry/otelzerolog"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// HtpasswdProfile holds the user fields for an htpasswd user
+type HtpasswdProfile struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	// Defaults to the username
+	Email     string `json:"email"`
+}
+
+// Sidecar file with the group memberships and profiles of htpasswd users
+type htpasswdGroupsFile struct {
+	// Group name to usernames. Group names are matched against HTPASSWD:<name> group links
+	Groups map[string][]string        `json:"groups"`
+	Users  map[string]HtpasswdProfile `json:"users"`
+}
+
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// Read-only provider backed by an htpasswd file of bcrypt or argon2 hashes and a YAML file of group memberships.
+// Files are reloaded on the next login after they change.
+type htpasswdUserProvider struct {
+	Name         string
+	PasswordFile string
+	GroupsFile   string
+
+	dbSourceName string
+
+	mu           sync.RWMutex
+	versions     [2]fileVersion
+	hashes       map[string]string
+	profiles     map[string]HtpasswdProfile
+	memberOf     map[string][]string
+}
+
+// Creates a new htpasswd provider. Files are read on first use
+func NewHtpasswdUserProvider(name, passwordFile, groupsFile string) *htpasswdUserProvider {
+	return &htpasswdUserProvider{
+		Name:         name,
+		PasswordFile: passwordFile,
+		GroupsFile:   groupsFile,
+		dbSourceName: "HTPASSWD:" + name,
+	}
+}
+
+// UpdateUserClaims checks the password against the htpasswd file and applies the user's groups
+func (h *htpasswdUserProvider) UpdateUserClaims(username, password string, ctx context.Context) (*ent.User, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "htpasswdUserProvider.UpdateUserClaims").
+		Str("provider", h.Name).
+		Str("username", username).
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "htpasswdUserProvider.UpdateUserClaims")
+	defer span.End()
+
+	if err := h.reloadIfChanged(); err != nil {
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not load htpasswd files")
+		return nil, AuthSourceError
+	}
+
+	h.mu.RLock()
+	hash, found := h.hashes[username]
+	profile := h.profiles[username]
+	groups := h.memberOf[username]
+	h.mu.RUnlock()
+
+	if !found {
+		return nil, UserNotFoundError
+	}
+	if ok, err := checkHtpasswdHash(hash, password); err != nil || !ok {
+		logger.Debug().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Password did not match")
+		return nil, AuthenticationError
+	}
+
+	u, err := h.getOrCreateUser(username, profile, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundLinks, err := ent.FromContext(ctx).GroupLink.Query().
+		Where(
+			grouplink.And(
+				grouplink.TypeEQ(h.dbSourceName),
+				grouplink.ResourceSpecIn(groups...),
+			),
+		).
+		WithClaimGroup(func (q *ent.ClaimGroupQuery) {
+			q.WithClaims()
+		}).
+		All(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not get group links.")
+		return nil, err
+	} else if len(foundLinks) == 0 {
+		logger.Error().Strs("groups", groups).Msg("No group links found")
+		return nil, NoLinkedGroupsError
+	}
+
+	add, del := findGroupChanges(u, foundLinks, h.dbSourceName)
+	if u, err = applyGroupChanges(add, del, u, ctx) ; err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Failed to update htpasswd groups to local user")
+		return nil, err
+	}
+
+	return retreiveLocalUser(u.Username, ctx)
+}
+
+func (h *htpasswdUserProvider) getOrCreateUser(username string, profile HtpasswdProfile, ctx context.Context) (*ent.User, error) {
+	email := profile.Email
+	if email == "" {
+		email = username
+	}
+
+	return identityUser(
+		externalIdentity{
+			ProviderType: h.identityType(),
+			ProviderName: h.Name,
+			Subject: username,
+		},
+		h.dbSourceName,
+		username,
+		func() (*ent.User, error) {
+			return ent.FromContext(ctx).User.Create().
+				SetFname(profile.FirstName).
+				SetLname(profile.LastName).
+				SetEmail(email).
+				SetUsername(username).
+				SetSource(h.dbSourceName).
+				Save(ctx)
+		},
+		ctx,
+	)
+}
+
+// reloadIfChanged rereads both files when either one's modification time or size changed
+func (h *htpasswdUserProvider) reloadIfChanged() error {
+	var current [2]fileVersion
+	for i, name := range []string{ h.PasswordFile, h.GroupsFile } {
+		if name == "" {
+			continue
+		}
+		stat, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		current[i] = fileVersion{ modTime: stat.ModTime(), size: stat.Size() }
+	}
+
+	h.mu.RLock()
+	unchanged := h.hashes != nil && current == h.versions
+	h.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	hashes, err := readHtpasswdFile(h.PasswordFile)
+	if err != nil {
+		return err
+	}
+	sidecar := htpasswdGroupsFile{}
+	if h.GroupsFile != "" {
+		contents, err := os.ReadFile(h.GroupsFile)
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(contents, &sidecar); err != nil {
+			return fmt.Errorf("%s: %w", h.GroupsFile, err)
+		}
+	}
+	memberOf := make(map[string][]string)
+	for group, members := range sidecar.Groups {
+		for _, member := range members {
+			memberOf[member] = append(memberOf[member], group)
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.versions = current
+	h.hashes = hashes
+	h.profiles = sidecar.Users
+	h.memberOf = memberOf
+	return nil
+}
+
+// readHtpasswdFile reads username:hash lines. Blank lines and lines starting with # are ignored
+func readHtpasswdFile(name string) (map[string]string, error) {
+	contents, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		username, hash, found := strings.Cut(text, ":")
+		if !found || username == "" {
+			return nil, fmt.Errorf("%s:%d: expected username:hash", name, line)
+		}
+		if !strings.HasPrefix(hash, "$2") && !strings.HasPrefix(hash, "$argon2") {
+			return nil, fmt.Errorf("%s:%d: only bcrypt and argon2 hashes are supported", name, line)
+		}
+		hashes[username] = hash
+	}
+	return hashes, scanner.Err()
+}
+
+// checkHtpasswdHash compares a password with a bcrypt ($2a$, $2b$, $2y$) or PHC formatted argon2i/argon2id hash
+func checkHtpasswdHash(hash, password string) (bool, error) {
+	if strings.HasPrefix(hash, "$2") {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	// $argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[2] != "v=19" {
+		return false, errors.New("Malformed argon2 hash")
+	}
+	var memory, iterations uint32
+	var threads uint8
+	for _, param := range strings.Split(parts[3], ",") {
+		key, value, _ := strings.Cut(param, "=")
+		n, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return false, errors.New("Malformed argon2 parameters")
+		}
+		switch key {
+		case "m":
+			memory = uint32(n)
+		case "t":
+			iterations = uint32(n)
+		case "p":
+			threads = uint8(n)
+		}
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, err
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, err
+	}
+	if memory == 0 || iterations == 0 || threads == 0 || len(expected) == 0 {
+		return false, errors.New("Malformed argon2 parameters")
+	}
+
+	var actual []byte
+	switch parts[1] {
+	case "argon2id":
+		actual = argon2.IDKey([]byte(password), salt, iterations, memory, threads, uint32(len(expected)))
+	case "argon2i":
+		actual = argon2.Key([]byte(password), salt, iterations, memory, threads, uint32(len(expected)))
+	default:
+		return false, fmt.Errorf("Unsupported hash %s", parts[1])
+	}
+	return subtle.ConstantTimeCompare(actual, expected) == 1, nil
+}
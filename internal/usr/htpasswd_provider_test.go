@@ -0,0 +1,107 @@
+package usr_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func writeHtpasswdFiles(t *testing.T, groups string) (string, string) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("bcryptpass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := []byte("0123456789abcdef")
+	argonHash := "$argon2id$v=19$m=1024,t=1,p=1$" +
+		base64.RawStdEncoding.EncodeToString(salt) + "$" +
+		base64.RawStdEncoding.EncodeToString(argon2.IDKey([]byte("argonpass"), salt, 1, 1024, 1, 32))
+
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "htpasswd")
+	groupsFile := filepath.Join(dir, "groups.yaml")
+	contents := "# edge users\nbcryptuser:" + string(bcryptHash) + "\n\nargonuser:" + argonHash + "\n"
+	if err := os.WriteFile(passwordFile, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(groupsFile, []byte(groups), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return passwordFile, groupsFile
+}
+
+func htpasswdTestContext(t *testing.T) context.Context {
+	return tu.NewMockContext(
+		tu.WithDatabase(t, tu.ProviderLinkedGroup(tu.ProviderLink("HTPASSWD", "edge", "operators"))),
+	)
+}
+
+const htpasswdGroups = `
+groups:
+  operators: [ bcryptuser, argonuser ]
+users:
+  bcryptuser:
+    first_name: Bea
+    last_name: Crypt
+    email: bea@edge.example
+`
+
+func TestHtpasswdAuthenticatesBcryptAndArgon2Users(t *testing.T) {
+	ctx := htpasswdTestContext(t)
+	passwordFile, groupsFile := writeHtpasswdFiles(t, htpasswdGroups)
+	p := usr.NewHtpasswdUserProvider("edge", passwordFile, groupsFile)
+
+	u, err := p.UpdateUserClaims("bcryptuser", "bcryptpass", ctx)
+	if err != nil {
+		t.Fatalf("bcrypt user could not log in: %v", err)
+	}
+	if u.Source != "HTPASSWD:edge" || u.Email != "bea@edge.example" || u.Fname != "Bea" {
+		t.Errorf("User was not created from the profile: %+v", u)
+	}
+	if _, claims := getUserAndClaims("bcryptuser", ctx); len(claims) != 1 || claims[0].ShortName != "plt" {
+		t.Errorf("Linked group was not applied: %v", claims)
+	}
+
+	if u, err := p.UpdateUserClaims("argonuser", "argonpass", ctx); err != nil || u.Email != "argonuser" {
+		t.Fatalf("argon2 user could not log in: %v %v", u, err)
+	}
+
+	if _, err := p.UpdateUserClaims("argonuser", "bcryptpass", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Bad password returned %v", err)
+	}
+	if _, err := p.UpdateUserClaims("nobody", "bcryptpass", ctx); !errors.Is(err, usr.UserNotFoundError) {
+		t.Errorf("Unknown user returned %v", err)
+	}
+}
+
+func TestHtpasswdReloadsChangedFiles(t *testing.T) {
+	ctx := htpasswdTestContext(t)
+	passwordFile, groupsFile := writeHtpasswdFiles(t, htpasswdGroups)
+	p := usr.NewHtpasswdUserProvider("edge", passwordFile, groupsFile)
+
+	if _, err := p.UpdateUserClaims("argonuser", "argonpass", ctx); err != nil {
+		t.Fatalf("argon2 user could not log in: %v", err)
+	}
+
+	if err := os.WriteFile(groupsFile, []byte("groups:\n  operators: [ bcryptuser ]\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.UpdateUserClaims("argonuser", "argonpass", ctx); !errors.Is(err, usr.NoLinkedGroupsError) {
+		t.Errorf("Removed group membership was still used: %v", err)
+	}
+
+	if err := os.WriteFile(passwordFile, []byte("bcryptuser:{SHA}notsupported\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.UpdateUserClaims("bcryptuser", "bcryptpass", ctx); !errors.Is(err, usr.AuthSourceError) {
+		t.Errorf("Unsupported hash returned %v", err)
+	}
+}
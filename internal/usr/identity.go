@@ -0,0 +1,284 @@
+package usr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stoke/internal/ent"
+	"stoke/internal/ent/identity"
+	"stoke/internal/ent/privacy"
+	"stoke/internal/ent/schema/policy"
+	"stoke/internal/ent/user"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// externalIdentity names a user's account at a foreign provider by the provider's stable subject
+type externalIdentity struct {
+	ProviderType string
+	ProviderName string
+	Subject      string
+}
+
+// identityProvider is implemented by providers that link their accounts to users with identities
+type identityProvider interface {
+	identityType() string
+}
+
+func (l *ldapUserProvider) identityType() string     { return "LDAP" }
+func (o *oidcUserProvider) identityType() string     { return "OIDC" }
+func (o *oauth2UserProvider) identityType() string   { return "OAUTH2" }
+func (s *samlUserProvider) identityType() string     { return "SAML" }
+func (w *webhookUserProvider) identityType() string  { return "WEBHOOK" }
+func (h *htpasswdUserProvider) identityType() string { return "HTPASSWD" }
+
+type linkTargetCtxKey struct {}
+
+// withLinkTarget makes providers link the account of the current login to target instead of finding or creating a user
+func withLinkTarget(ctx context.Context, target *ent.User) context.Context {
+	return context.WithValue(ctx, linkTargetCtxKey{}, target)
+}
+
+func linkTargetFromCtx(ctx context.Context) *ent.User {
+	target, _ := ctx.Value(linkTargetCtxKey{}).(*ent.User)
+	return target
+}
+
+// identityUser returns the user linked to the provider account id.
+// An account that is not linked yet is linked to the user found by looking up lookup (a username or email), if that user came from source
+// and has no identities yet, i.e. was created before identities existed, or to the user made by create when there is no such user.
+// Users from other sources, or that are already linked to any account, are never linked automatically; IdentityNotLinkedError is returned instead.
+// An empty lookup never matches a user; the account is linked to a new user, or refused with IdentityNotLinkedError when create
+// fails because the username or email is taken.
+// LDAP users share one source, so this keeps a second directory from taking over users of the first.
+// While a signed in user links an identity (see ProviderList.LinkIdentity), the account is linked to that user.
+func identityUser(id externalIdentity, source, lookup string, create func() (*ent.User, error), ctx context.Context) (*ent.User, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "usr.identityUser").
+		Str("providerType", id.ProviderType).
+		Str("provider", id.ProviderName).
+		Str("subject", id.Subject).
+		Logger()
+
+	if id.Subject == "" {
+		logger.Error().Msg("Provider did not return a subject for the user")
+		return nil, AuthSourceError
+	}
+
+	db := ent.FromContext(ctx)
+	target := linkTargetFromCtx(ctx)
+
+	linked, err := db.Identity.Query().
+		Where(
+			identity.ProviderNameEQ(id.ProviderName),
+			identity.SubjectEQ(id.Subject),
+		).
+		WithUser().
+		Only(ctx)
+	if err == nil {
+		if target != nil && target.ID != linked.Edges.User.ID {
+			return nil, IdentityLinkedError
+		}
+		return retreiveLocalUser(linked.Edges.User.Username, ctx)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	u := target
+	if u == nil && lookup == "" {
+		logger.Info().Msg("Creating user in database without looking up existing users.")
+		if u, err = create(); ent.IsConstraintError(err) {
+			logger.Warn().Err(err).Msg("Account matches an existing user that can not be linked automatically. The user must link the identity before logging in with it")
+			return nil, IdentityNotLinkedError
+		} else if err != nil {
+			return nil, err
+		}
+	} else if u == nil {
+		u, err = retreiveLocalUser(lookup, ctx)
+		switch {
+		case ent.IsNotFound(err):
+			logger.Info().Msg("User not found, creating in database.")
+			if u, err = create(); err != nil {
+				return nil, err
+			}
+		case err != nil:
+			return nil, err
+		case !sameSource(u.Source, source):
+			logger.Warn().
+				Str("username", u.Username).
+				Str("userSource", u.Source).
+				Msg("Account matches a user from another source. The user must link the identity before logging in with it")
+			return nil, IdentityNotLinkedError
+		default:
+			linked, err := u.QueryIdentities().Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if linked {
+				logger.Warn().
+					Str("username", u.Username).
+					Msg("User is linked to another account. The user must link the identity before logging in with it")
+				return nil, IdentityNotLinkedError
+			}
+		}
+	}
+
+	if err := createIdentity(u, id, ctx); err != nil {
+		return nil, err
+	}
+	logger.Info().
+		Str("username", u.Username).
+		Msg("Linked identity to user")
+	return retreiveLocalUser(u.Username, ctx)
+}
+
+// sameSource reports whether two user sources are the same, where a bare provider type such as LDAP
+// matches a source naming the provider, such as LDAP:main_ldap
+func sameSource(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b + ":") || strings.HasPrefix(b, a + ":")
+}
+
+func createIdentity(u *ent.User, id externalIdentity, ctx context.Context) error {
+	return ent.FromContext(ctx).Identity.Create().
+		SetProviderType(id.ProviderType).
+		SetProviderName(id.ProviderName).
+		SetSubject(id.Subject).
+		SetUser(u).
+		Exec(ctx)
+}
+
+// LinkIdentity links the signed in user's account at providerName to the user with username.
+// The user proves the account is theirs by logging in to the provider with providerUsername and credential.
+// Accounts that are already linked to another user are not moved.
+func (p *ProviderList) LinkIdentity(username, providerName, providerUsername, credential string, ctx context.Context) (*ent.Identity, error) {
+	target, err := retreiveLocalUser(username, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.foreignMutex.RLock()
+	prov, found := p.foreignProviders[providerName]
+	p.foreignMutex.RUnlock()
+	if _, ok := prov.(identityProvider); !found || !ok {
+		return nil, fmt.Errorf("%w: %s", UnknownProviderError, providerName)
+	}
+
+	u, err := p.callProvider(providerName, prov, providerUsername, credential, withLinkTarget(ctx, target))
+	if err != nil && !errors.Is(err, NoLinkedGroupsError) {
+		return nil, err
+	}
+	if u == nil || u.ID != target.ID {
+		return nil, IdentityLinkedError
+	}
+
+	return ent.FromContext(ctx).Identity.Query().
+		Where(
+			identity.ProviderNameEQ(providerName),
+			identity.HasUserWith(user.IDEQ(target.ID)),
+		).
+		Order(ent.Desc(identity.FieldCreated)).
+		First(ctx)
+}
+
+// LinkIdentitySubject links the account with subject at providerName to the user with username without logging in to the provider.
+// It is meant for administrators, who are trusted to know that the account belongs to the user.
+// User policies apply: protected users and superusers can not be linked to by non-superusers, and nothing is linked in read-only mode.
+func (p *ProviderList) LinkIdentitySubject(username, providerName, subject string, ctx context.Context) (*ent.Identity, error) {
+	u, err := retreiveLocalUser(username, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.foreignMutex.RLock()
+	prov, ok := p.foreignProviders[providerName].(identityProvider)
+	p.foreignMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", UnknownProviderError, providerName)
+	}
+	if subject == "" {
+		return nil, errors.New("Subject is required")
+	}
+
+	linked, err := ent.FromContext(ctx).Identity.Query().
+		Where(
+			identity.ProviderNameEQ(providerName),
+			identity.SubjectEQ(subject),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if linked {
+		return nil, IdentityLinkedError
+	}
+
+	// Linking an identity lets its account log in as the user, so it must be allowed as a change to the user
+	link := ent.FromContext(ctx).User.UpdateOne(u).AddIdentityIDs().Mutation()
+	if err := (policy.UserMutationPolicy{}).EvalMutation(ctx, link); err != nil && !errors.Is(err, privacy.Allow) {
+		return nil, err
+	}
+
+	created, err := ent.FromContext(ctx).Identity.Create().
+		SetProviderType(prov.identityType()).
+		SetProviderName(providerName).
+		SetSubject(subject).
+		SetUser(u).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created.Edges.User = u
+	return created, nil
+}
+
+// ListIdentities returns the identities linked to the user with username, or every identity when username is empty
+func ListIdentities(username string, ctx context.Context) (ent.Identities, error) {
+	query := ent.FromContext(ctx).Identity.Query().
+		WithUser().
+		Order(ent.Asc(identity.FieldID))
+	if username != "" {
+		query = query.Where(identity.HasUserWith(user.UsernameEQ(username)))
+	}
+	return query.All(ctx)
+}
+
+// UnlinkIdentity removes the identity with id. If username is not empty, the identity must belong to that user.
+// The last identity of a user without a local password can not be removed, so users can not lock themselves out.
+func UnlinkIdentity(id int, username string, ctx context.Context) error {
+	db := ent.FromContext(ctx)
+	linked, err := db.Identity.Query().
+		Where(identity.IDEQ(id)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return IdentityNotFoundError
+	}
+	if err != nil {
+		return err
+	}
+
+	u := linked.Edges.User
+	if username != "" && u.Username != username {
+		return IdentityNotFoundError
+	}
+
+	if u.Source != LOCAL_SOURCE {
+		count, err := u.QueryIdentities().Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count <= 1 {
+			return LastIdentityError
+		}
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("component", "usr.UnlinkIdentity").
+		Str("username", u.Username).
+		Str("provider", linked.ProviderName).
+		Str("subject", linked.Subject).
+		Msg("Unlinking identity")
+	return db.Identity.DeleteOne(linked).Exec(ctx)
+}
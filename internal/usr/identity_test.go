@@ -0,0 +1,258 @@
+package usr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"stoke/internal/ent"
+	"stoke/internal/ent/identity"
+	"stoke/internal/ent/schema/policy"
+	"stoke/internal/ent/user"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+)
+
+func identityTestContext(t *testing.T, users ...tu.DatabaseMutation) context.Context {
+	return tu.NewMockContext(
+		tu.WithDatabase(t,
+			append([]tu.DatabaseMutation{
+				tu.User(
+					tu.UserInfo("local", "user", "localuser", "user@local"),
+					tu.Password("localpass"),
+					tu.Source("LOCAL"),
+					tu.Group(
+						tu.GroupInfo("edge operators", "edge operator group"),
+						tu.ProviderLink("HTPASSWD", "edge", "operators"),
+						tu.Claim(
+							tu.ClaimInfo("operator", "op", "yes", "Grants operator"),
+						),
+					),
+				),
+			}, users...)...,
+		),
+	)
+}
+
+func identityTestList(t *testing.T) *usr.ProviderList {
+	passwordFile, groupsFile := writeHtpasswdFiles(t, htpasswdGroups)
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("edge", usr.NewHtpasswdUserProvider("edge", passwordFile, groupsFile))
+	return pl
+}
+
+func TestProviderLoginCreatesIdentity(t *testing.T) {
+	pl := identityTestList(t)
+	ctx := pl.WithContext(identityTestContext(t))
+
+	u, _, err := pl.GetUserClaims("bcryptuser", "bcryptpass", "edge", ctx)
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+
+	identities, err := usr.ListIdentities(u.Username, ctx)
+	if err != nil || len(identities) != 1 {
+		t.Fatalf("Identity was not created: %v %v", identities, err)
+	}
+	if i := identities[0]; i.ProviderType != "HTPASSWD" || i.ProviderName != "edge" || i.Subject != "bcryptuser" {
+		t.Errorf("Unexpected identity: %+v", i)
+	}
+}
+
+// Users created by the provider before identities existed are linked on their next login
+func TestProviderLoginLinksExistingUserFromSameSource(t *testing.T) {
+	pl := identityTestList(t)
+	ctx := pl.WithContext(identityTestContext(t,
+		tu.User(
+			tu.UserInfo("Bea", "Crypt", "bcryptuser", "bea@edge.example"),
+			tu.Source("HTPASSWD:edge"),
+		),
+	))
+
+	u, _, err := pl.GetUserClaims("bcryptuser", "bcryptpass", "edge", ctx)
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if n := ent.FromContext(ctx).User.Query().CountX(ctx); n != 2 {
+		t.Errorf("A second user was created: %d users", n)
+	}
+	if identities, _ := usr.ListIdentities(u.Username, ctx); len(identities) != 1 {
+		t.Errorf("Existing user was not linked: %v", identities)
+	}
+}
+
+// A user from another source with the same username is never taken over
+func TestProviderLoginDoesNotLinkUserFromOtherSource(t *testing.T) {
+	pl := identityTestList(t)
+	ctx := pl.WithContext(identityTestContext(t,
+		tu.User(
+			tu.UserInfo("Other", "Person", "bcryptuser", "other@ldap.example"),
+			tu.Source("LDAP"),
+		),
+	))
+
+	if _, _, err := pl.GetUserClaims("bcryptuser", "bcryptpass", "edge", ctx); !errors.Is(err, usr.IdentityNotLinkedError) {
+		t.Fatalf("User from another source was linked: %v", err)
+	}
+}
+
+// LDAP users share a source, so a user already linked to one directory is not taken over by an account with the same username in another
+func TestLDAPLoginDoesNotLinkUserOfOtherDirectory(t *testing.T) {
+	ctx := tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("other", "person", "ldapuser", "other@corp.example"),
+				tu.Source("LDAP"),
+			),
+		),
+	)
+	db := ent.FromContext(ctx)
+	db.Identity.Create().
+		SetProviderType("LDAP").
+		SetProviderName("other_ldap").
+		SetSubject("cn=ldapuser,dc=corp,dc=example").
+		SetUser(db.User.Query().Where(user.UsernameEQ("ldapuser")).OnlyX(ctx)).
+		ExecX(ctx)
+
+	prov := provisioningTestProvider(usr.ProvisioningRules{})
+	if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); !errors.Is(err, usr.IdentityNotLinkedError) {
+		t.Fatalf("User of another directory was linked: %v", err)
+	}
+}
+
+func TestLinkIdentityToSignedInUser(t *testing.T) {
+	pl := identityTestList(t)
+	ctx := pl.WithContext(identityTestContext(t))
+
+	linked, err := pl.LinkIdentity("localuser", "edge", "bcryptuser", "bcryptpass", ctx)
+	if err != nil {
+		t.Fatalf("Could not link identity: %v", err)
+	}
+	if linked.Subject != "bcryptuser" {
+		t.Errorf("Wrong identity linked: %+v", linked)
+	}
+
+	u, claims, err := pl.GetUserClaims("bcryptuser", "bcryptpass", "edge", ctx)
+	if err != nil {
+		t.Fatalf("Login with linked identity failed: %v", err)
+	}
+	if u.Username != "localuser" || len(claims) != 1 {
+		t.Errorf("Login did not use the linked user: %v %v", u, claims)
+	}
+	if n := ent.FromContext(ctx).User.Query().CountX(ctx); n != 1 {
+		t.Errorf("A user was created for the linked identity: %d users", n)
+	}
+}
+
+func TestLinkIdentityRequiresProviderLogin(t *testing.T) {
+	pl := identityTestList(t)
+	ctx := pl.WithContext(identityTestContext(t))
+
+	if _, err := pl.LinkIdentity("localuser", "edge", "bcryptuser", "wrongpass", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Fatalf("Identity was linked without a provider login: %v", err)
+	}
+	if n := ent.FromContext(ctx).Identity.Query().CountX(ctx); n != 0 {
+		t.Errorf("Identity was created: %d", n)
+	}
+}
+
+func TestLinkIdentityDoesNotMoveLinkedAccount(t *testing.T) {
+	pl := identityTestList(t)
+	ctx := pl.WithContext(identityTestContext(t))
+
+	if _, _, err := pl.GetUserClaims("bcryptuser", "bcryptpass", "edge", ctx); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if _, err := pl.LinkIdentity("localuser", "edge", "bcryptuser", "bcryptpass", ctx); !errors.Is(err, usr.IdentityLinkedError) {
+		t.Fatalf("Linked account was moved: %v", err)
+	}
+	if _, err := pl.LinkIdentitySubject("localuser", "edge", "bcryptuser", ctx); !errors.Is(err, usr.IdentityLinkedError) {
+		t.Fatalf("Linked account was moved by subject: %v", err)
+	}
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	pl := identityTestList(t)
+	ctx := pl.WithContext(identityTestContext(t))
+
+	if _, _, err := pl.GetUserClaims("bcryptuser", "bcryptpass", "edge", ctx); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	providerIdentity := ent.FromContext(ctx).Identity.Query().
+		Where(identity.SubjectEQ("bcryptuser")).
+		OnlyX(ctx)
+	if err := usr.UnlinkIdentity(providerIdentity.ID, "", ctx); !errors.Is(err, usr.LastIdentityError) {
+		t.Errorf("Only identity of a provider user was unlinked: %v", err)
+	}
+
+	linked, err := pl.LinkIdentitySubject("localuser", "edge", "argonuser", ctx)
+	if err != nil {
+		t.Fatalf("Could not link identity by subject: %v", err)
+	}
+	if err := usr.UnlinkIdentity(linked.ID, "bcryptuser", ctx); !errors.Is(err, usr.IdentityNotFoundError) {
+		t.Errorf("Identity of another user was unlinked: %v", err)
+	}
+	if err := usr.UnlinkIdentity(linked.ID, "localuser", ctx); err != nil {
+		t.Errorf("Local user could not unlink identity: %v", err)
+	}
+}
+
+// Administrators with the user claim can not link accounts to superusers or protected users, which would let them log in as those users
+func TestLinkIdentitySubjectPolicies(t *testing.T) {
+	superuser := tu.User(
+		tu.UserInfo("super", "user", "superuser", "super@local"),
+		tu.Source("LOCAL"),
+		tu.Group(
+			tu.GroupInfo("superusers", "stoke superusers"),
+			tu.Claim(
+				tu.ClaimInfo("stoke-super", "stk", "S", "Grants superuser"),
+			),
+		),
+	)
+	adminContext := func(stk string) context.Context {
+		return tu.NewMockContext(
+			tu.WithDatabase(t,
+				superuser,
+				tu.User(
+					tu.UserInfo("local", "user", "localuser", "user@local"),
+					tu.Source("LOCAL"),
+				),
+			),
+			tu.EnforcePolicies(),
+			tu.WithToken(t,
+				tu.WithTokenClaim("stk", stk),
+				tu.WithTokenClaim("u", "admin"),
+			),
+		)
+	}
+	pl := identityTestList(t)
+	identityCount := func(ctx context.Context) int {
+		return ent.FromContext(ctx).Identity.Query().CountX(ctx)
+	}
+
+	ctx := pl.WithContext(adminContext("U"))
+	if _, err := pl.LinkIdentitySubject("superuser", "edge", "bcryptuser", ctx); err == nil {
+		t.Error("User administrator linked an identity to a superuser")
+	}
+	if n := identityCount(ctx); n != 0 {
+		t.Errorf("Denied link was stored: %d identities", n)
+	}
+
+	policy.ReconfigurePolicies([]string{ "localuser" }, nil, nil, "u", false, true, ctx)
+	if _, err := pl.LinkIdentitySubject("localuser", "edge", "bcryptuser", ctx); err == nil {
+		t.Error("User administrator linked an identity to a protected user")
+	}
+	policy.ReconfigurePolicies(nil, nil, nil, "u", true, true, ctx)
+	if _, err := pl.LinkIdentitySubject("localuser", "edge", "bcryptuser", ctx); err == nil {
+		t.Error("Identity was linked in read-only mode")
+	}
+	policy.ReconfigurePolicies(nil, nil, nil, "u", false, true, ctx)
+	if linked, err := pl.LinkIdentitySubject("localuser", "edge", "bcryptuser", ctx); err != nil || linked.Edges.User.Username != "localuser" {
+		t.Errorf("User administrator could not link an identity to a regular user: %v", err)
+	}
+
+	ctx = pl.WithContext(adminContext("S"))
+	if _, err := pl.LinkIdentitySubject("superuser", "edge", "bcryptuser", ctx); err != nil {
+		t.Errorf("Superuser could not link an identity to a superuser: %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package usr
+
+import (
+	"context"
+	"fmt"
+	"stoke/internal/ent"
+	"stoke/internal/ent/grouplink"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// LDAPAttributeClaim copies an attribute of the user's entry into issued tokens, i.e. departmentNumber as dept.
+// Every value of a multi-valued attribute is added, and joined like any other claim with several values.
+// Persisted claims are stored as provider managed groups so they show up in the database and survive directory outages.
+type LDAPAttributeClaim struct {
+	// Attribute of the user's entry
+	Attribute string
+	// Short name of the stoke claim. Defaults to Attribute
+	As        string
+	// Whether to store the claim in the database
+	Persist   bool
+}
+
+// ShortName is the stoke claim name used for the attribute
+func (a LDAPAttributeClaim) ShortName() string {
+	if a.As != "" {
+		return a.As
+	}
+	return a.Attribute
+}
+
+// attributeClaims applies the configured attribute claims of the user's entry.
+// Claims that are not persisted are added to the claims of the current login.
+// Persisted claims get a provider managed group that is linked by attribute=value.
+func (l *ldapUserProvider) attributeClaims(userEntry *ldap.Entry, ctx context.Context) error {
+	var loginClaims ent.Claims
+	for _, a := range l.AttributeClaims {
+		for _, v := range userEntry.GetAttributeValues(a.Attribute) {
+			if !a.Persist {
+				loginClaims = append(loginClaims, &ent.Claim{
+					Name:        fmt.Sprintf("%s %s", l.Name, a.ShortName()),
+					ShortName:   a.ShortName(),
+					Value:       v,
+					Description: "Passed through from " + l.Name,
+				})
+				continue
+			}
+			if err := ensureProviderClaimGroup(l.Name, "LDAP:" + l.Name, a.ShortName(), a.Attribute + "=" + v, v, ctx); err != nil {
+				return err
+			}
+		}
+	}
+	addPassthroughClaims(loginClaims, ctx)
+	return nil
+}
+
+// attributeLinks returns the group links of the persisted attribute claims that apply to the user's entry
+func (l *ldapUserProvider) attributeLinks(userEntry *ldap.Entry, ctx context.Context) (ent.GroupLinks, error) {
+	var specs []string
+	for _, a := range l.AttributeClaims {
+		if !a.Persist {
+			continue
+		}
+		for _, v := range userEntry.GetAttributeValues(a.Attribute) {
+			specs = append(specs, a.Attribute + "=" + v)
+		}
+	}
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	return ent.FromContext(ctx).GroupLink.Query().
+		Where(
+			grouplink.And(
+				grouplink.TypeEQ("LDAP:" + l.Name),
+				grouplink.ResourceSpecIn(specs...),
+			),
+		).
+		WithClaimGroup(func (q *ent.ClaimGroupQuery) {
+			q.WithClaims()
+		}).
+		All(ctx)
+}
@@ -0,0 +1,113 @@
+package usr_test
+
+import (
+	"context"
+	"slices"
+	"stoke/internal/ent"
+	"stoke/internal/ent/claimgroup"
+	"stoke/internal/usr"
+	"testing"
+)
+
+func attributeClaimsTestList(t *testing.T, attributeClaims ...usr.LDAPAttributeClaim) (*usr.ProviderList, LDAPSyncer) {
+	groupTemplate, userTemplate := createTemplates()
+	prov := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	prov.AttributeClaims = attributeClaims
+	prov.SetConnector(NewMockLDAPServer(
+		LDAPUser("adminuser", "admin", "user", "admin@hppr.dev", "adminpass"),
+		LDAPUser("ldapuser", "ldap", "user", "luser@hppr.dev", "luserpass"),
+		LDAPUserAttribute("ldapuser", "departmentNumber", "eng", "ops"),
+		LDAPUserAttribute("ldapuser", "costCenter", "cc-42"),
+		LDAPGroup("ldapuser", "ldap_group"),
+	))
+
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("main_ldap", prov)
+	return pl, prov
+}
+
+type LDAPSyncer interface {
+	SyncUsers(dryRun bool, ctx context.Context) (*usr.LDAPSyncReport, error)
+}
+
+func shortNameValues(claims ent.Claims, shortName string) []string {
+	var values []string
+	for _, c := range claims {
+		if c.ShortName == shortName {
+			values = append(values, c.Value)
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+func TestLDAPAttributeClaims(t *testing.T) {
+	pl, _ := attributeClaimsTestList(t,
+		usr.LDAPAttributeClaim{ Attribute: "departmentNumber", As: "dept" },
+		usr.LDAPAttributeClaim{ Attribute: "costCenter" },
+		usr.LDAPAttributeClaim{ Attribute: "missingAttribute" },
+	)
+	ctx := pl.WithContext(provisioningTestContext(t, true))
+
+	_, claims, err := pl.GetUserClaims("ldapuser", "luserpass", "main_ldap", ctx)
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if values := shortNameValues(claims, "dept"); !slices.Equal(values, []string{ "eng", "ops" }) {
+		t.Errorf("Multi-valued attribute was not passed through: %v", values)
+	}
+	if values := shortNameValues(claims, "costCenter"); !slices.Equal(values, []string{ "cc-42" }) {
+		t.Errorf("Attribute was not passed through with its own name: %v", values)
+	}
+	if values := shortNameValues(claims, "op"); len(values) != 1 {
+		t.Errorf("Linked group claims are missing: %v", claims)
+	}
+	if n := ent.FromContext(ctx).ClaimGroup.Query().CountX(ctx); n != 2 {
+		t.Errorf("Groups were created for attribute claims that are not persisted: %d groups", n)
+	}
+}
+
+func TestLDAPPersistedAttributeClaims(t *testing.T) {
+	pl, prov := attributeClaimsTestList(t,
+		usr.LDAPAttributeClaim{ Attribute: "departmentNumber", As: "dept", Persist: true },
+	)
+	ctx := pl.WithContext(provisioningTestContext(t, true))
+
+	for i := 0; i < 2; i++ {
+		_, claims, err := pl.GetUserClaims("ldapuser", "luserpass", "main_ldap", ctx)
+		if err != nil {
+			t.Fatalf("Login failed: %v", err)
+		}
+		if values := shortNameValues(claims, "dept"); !slices.Equal(values, []string{ "eng", "ops" }) {
+			t.Errorf("Persisted attribute claims were not issued on login %d: %v", i + 1, values)
+		}
+	}
+
+	groups := ent.FromContext(ctx).ClaimGroup.Query().
+		Where(claimgroup.NameIn("main_ldap:dept=eng", "main_ldap:dept=ops")).
+		WithUsers().
+		AllX(ctx)
+	if len(groups) != 2 {
+		t.Fatalf("Provider managed groups were not created: %v", groups)
+	}
+	for _, g := range groups {
+		if len(g.Edges.Users) != 1 || g.Edges.Users[0].Username != "ldapuser" {
+			t.Errorf("User was not added to the provider managed group %s: %v", g.Name, g.Edges.Users)
+		}
+	}
+
+	// Directory syncs keep the groups of attributes the entry still has
+	if _, err := prov.SyncUsers(false, ctx); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	_, claims := getUserAndClaims("ldapuser", ctx)
+	if values := shortNameValues(claims, "dept"); !slices.Equal(values, []string{ "eng", "ops" }) {
+		t.Errorf("Persisted attribute claims are not stored on the user: %v", values)
+	}
+}
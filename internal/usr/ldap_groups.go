@@ -0,0 +1,136 @@
+package usr
+
+import (
+	"context"
+	"text/template"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/rs/zerolog"
+)
+
+type LDAPNestedGroups uint8
+const (
+	// Only groups matched directly by the group filter are used
+	LDAP_NESTED_NONE LDAPNestedGroups = iota
+	// Active Directory resolves the whole membership chain in one search using LDAP_MATCHING_RULE_IN_CHAIN
+	LDAP_NESTED_IN_CHAIN
+	// Parent groups are found by running the group filter again for every group found, up to NestedGroupDepth levels
+	LDAP_NESTED_RECURSIVE
+)
+
+const defaultLDAPNestedGroupDepth = 10
+
+// Default filter for LDAP_NESTED_IN_CHAIN. Matches every group the user is a direct or indirect member of
+var defaultLDAPInChainFilter = template.Must(
+	template.New("in-chain-filter").Parse("(&(objectClass=group)(member:1.2.840.113556.1.4.1941:={{ .UserDN }}))"),
+)
+
+func (n LDAPNestedGroups) String() string {
+	switch n {
+	case LDAP_NESTED_NONE:
+		return "none"
+	case LDAP_NESTED_IN_CHAIN:
+		return "in_chain"
+	case LDAP_NESTED_RECURSIVE:
+		return "recursive"
+	}
+	return "undefined"
+}
+
+func (l *ldapUserProvider) nestedGroupDepth() int {
+	if l.NestedGroupDepth > 0 {
+		return l.NestedGroupDepth
+	}
+	return defaultLDAPNestedGroupDepth
+}
+
+// findUserGroups returns the names of the groups the user belongs to, including ancestor groups when nested groups are enabled
+func (l *ldapUserProvider) findUserGroups(username, userDN string, conn ldap.Client, ctx context.Context) ([]string, error) {
+	filter := l.GroupFilter
+	values := templateValues{
+		Username: ldap.EscapeFilter(username),
+		UserDN: userDN,
+	}
+	if l.NestedGroups == LDAP_NESTED_IN_CHAIN {
+		filter = defaultLDAPInChainFilter
+		if l.NestedGroupFilter != nil {
+			filter = l.NestedGroupFilter
+		}
+		values.UserDN = ldap.EscapeFilter(userDN)
+	}
+
+	response, err := l.ldapSearch(l.GroupSearchRoot, values, filter, []string{l.GroupAttribute}, conn, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := response.Entries
+	if l.NestedGroups == LDAP_NESTED_RECURSIVE {
+		if groups, err = l.findAncestorGroups(groups, conn, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var groupNames []string
+	for _, group := range groups {
+		groupNames = append(groupNames, group.GetAttributeValue(l.GroupAttribute))
+	}
+	return groupNames, nil
+}
+
+// findAncestorGroups walks up from the given groups, treating each group as a member in the group filter.
+// Groups that were already seen are not searched again, so membership cycles end the walk.
+// The walk stops after NestedGroupDepth levels.
+func (l *ldapUserProvider) findAncestorGroups(groups []*ldap.Entry, conn ldap.Client, ctx context.Context) ([]*ldap.Entry, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "ldapUserProvider.findAncestorGroups").
+		Str("provider", l.Name).
+		Logger()
+
+	seen := make(map[string]bool)
+	var all []*ldap.Entry
+	for _, group := range groups {
+		if !seen[group.DN] {
+			seen[group.DN] = true
+			all = append(all, group)
+		}
+	}
+
+	level := all
+	for depth := 0; len(level) > 0; depth++ {
+		if depth == l.nestedGroupDepth() {
+			logger.Warn().
+				Int("depth", depth).
+				Msg("Nested group depth limit reached. Ancestors above this depth are ignored")
+			break
+		}
+
+		var next []*ldap.Entry
+		for _, group := range level {
+			response, err := l.ldapSearch(
+				l.GroupSearchRoot,
+				templateValues{
+					Username: ldap.EscapeFilter(group.GetAttributeValue(l.GroupAttribute)),
+					UserDN: ldap.EscapeFilter(group.DN),
+				},
+				l.GroupFilter,
+				[]string{l.GroupAttribute},
+				conn,
+				ctx,
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, parent := range response.Entries {
+				if seen[parent.DN] {
+					continue
+				}
+				seen[parent.DN] = true
+				next = append(next, parent)
+			}
+		}
+		all = append(all, next...)
+		level = next
+	}
+	return all, nil
+}
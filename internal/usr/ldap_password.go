@@ -0,0 +1,163 @@
+package usr
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"stoke/internal/tel"
+	"unicode/utf16"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/rs/zerolog"
+	"github.com/vincentfree/opentelemetry/otelzerolog"
+)
+
+type LDAPPasswordChange uint8
+const (
+	// Passwords are changed with the RFC 3062 Password Modify extended operation
+	LDAP_PASSWORD_MODIFY LDAPPasswordChange = iota
+	// Passwords are changed by modifying the Active Directory unicodePwd attribute. Requires an encrypted connection
+	LDAP_PASSWORD_UNICODEPWD
+	// Password changes are rejected
+	LDAP_PASSWORD_DISABLED
+)
+
+func (c LDAPPasswordChange) String() string {
+	switch c {
+	case LDAP_PASSWORD_MODIFY:
+		return "password_modify"
+	case LDAP_PASSWORD_UNICODEPWD:
+		return "unicode_pwd"
+	case LDAP_PASSWORD_DISABLED:
+		return "disabled"
+	}
+	return "undefined"
+}
+
+func (l *ldapUserProvider) userSource() string {
+	return LDAP_SOURCE
+}
+
+// UpdateUserPassword changes the user's password in the directory.
+// The change is made as the user after binding with the old password.
+// When force is set the old password is not needed and the change is made as the bind user, which must be allowed to reset passwords.
+// Forced changes are rejected with PasswordChangeNotAllowedError unless AllowAdminReset is set.
+// Returns UserNotFoundError if the user is not in this directory.
+func (l *ldapUserProvider) UpdateUserPassword(username, oldPassword, newPassword string, force bool, ctx context.Context) error {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "ldapUserProvider.UpdateUserPassword").
+		Str("provider", l.Name).
+		Str("username", username).
+		Bool("force", force).
+		Stringer("passwordChange", l.PasswordChange).
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "ldapUserProvider.UpdateUserPassword")
+	defer span.End()
+
+	if l.PasswordChange == LDAP_PASSWORD_DISABLED {
+		return PasswordChangeNotAllowedError
+	}
+
+	conn, err := l.getConn(ctx)
+	if err != nil {
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not get a bound connection to an LDAP server")
+		return AuthSourceError
+	}
+	defer l.putConn(conn)
+
+	userEntry, err := l.findLDAPUser(username, conn, ctx)
+	if errors.Is(err, LDAPNotFoundError) {
+		return UserNotFoundError
+	}
+	if err != nil {
+		return AuthSourceError
+	}
+
+	if force && !l.AllowAdminReset {
+		logger.Warn().
+			Func(otelzerolog.AddTracingContext(span)).
+			Msg("Rejected forced password reset for directory user. Set allow_admin_reset to allow it")
+		return PasswordChangeNotAllowedError
+	}
+
+	if !force {
+		if err := conn.Bind(userEntry.DN, oldPassword); err != nil {
+			logger.Debug().
+				Func(otelzerolog.AddTracingContext(span)).
+				Err(err).
+				Msg("User old password did not match")
+			return AuthenticationError
+		}
+	}
+
+	switch l.PasswordChange {
+	case LDAP_PASSWORD_UNICODEPWD:
+		req := ldap.NewModifyRequest(userEntry.DN, nil)
+		if force {
+			req.Replace("unicodePwd", []string{ encodeUnicodePwd(newPassword) })
+		} else {
+			req.Delete("unicodePwd", []string{ encodeUnicodePwd(oldPassword) })
+			req.Add("unicodePwd", []string{ encodeUnicodePwd(newPassword) })
+		}
+		err = conn.Modify(req)
+	default:
+		if force {
+			oldPassword = ""
+		}
+		_, err = conn.PasswordModify(ldap.NewPasswordModifyRequest(userEntry.DN, oldPassword, newPassword))
+	}
+
+	if err != nil {
+		logger.Warn().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Directory rejected password change")
+		return passwordChangeError(err)
+	}
+
+	logger.Info().
+		Func(otelzerolog.AddTracingContext(span)).
+		Msg("Changed LDAP user password")
+	return nil
+}
+
+// passwordChangeError maps directory result codes to errors that can be shown to the user.
+// The server's diagnostic message, which usually names the violated policy, is kept.
+func passwordChangeError(err error) error {
+	var ldapErr *ldap.Error
+	if !errors.As(err, &ldapErr) {
+		return AuthSourceError
+	}
+
+	var mapped error
+	switch ldapErr.ResultCode {
+	case ldap.LDAPResultConstraintViolation:
+		mapped = PasswordPolicyError
+	case ldap.LDAPResultInvalidCredentials:
+		mapped = AuthenticationError
+	case ldap.LDAPResultInsufficientAccessRights, ldap.LDAPResultUnwillingToPerform:
+		mapped = PasswordChangeNotAllowedError
+	default:
+		return AuthSourceError
+	}
+
+	if ldapErr.Err != nil && ldapErr.Err.Error() != "" {
+		return fmt.Errorf("%w: %s", mapped, ldapErr.Err.Error())
+	}
+	return mapped
+}
+
+// encodeUnicodePwd encodes a password as the quoted UTF-16LE string Active Directory expects in unicodePwd
+func encodeUnicodePwd(password string) string {
+	encoded := utf16.Encode([]rune("\"" + password + "\""))
+	b := make([]byte, 2 * len(encoded))
+	for i, c := range encoded {
+		binary.LittleEndian.PutUint16(b[2 * i:], c)
+	}
+	return string(b)
+}
@@ -0,0 +1,132 @@
+package usr_test
+
+import (
+	"context"
+	"errors"
+	"stoke/internal/ent"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+func passwordTestContext(t *testing.T, mode usr.LDAPPasswordChange, allowAdminReset bool) (context.Context, *MockLDAPConnector) {
+	conn := newPoolTestLDAPServer()
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.PasswordChange = mode
+	ldapProvider.AllowAdminReset = allowAdminReset
+	ldapProvider.SetConnector(conn)
+
+	providers := usr.NewProviderList()
+	providers.AddForeignProvider("main_ldap", ldapProvider)
+
+	ctx := providers.WithContext(
+		tu.NewMockContext(
+			tu.WithDatabase(t,
+				tu.User(
+					tu.UserInfo("ldap", "user", "ldapuser", "luser@hppr.dev"),
+					tu.Source("LDAP"),
+				),
+				tu.User(
+					tu.UserInfo("oidc", "user", "oidcuser", "oidc@hppr.dev"),
+					tu.Source("OIDC:other"),
+				),
+			),
+		),
+	)
+	return ctx, conn
+}
+
+func TestLDAPUserPasswordChangeIsPassedToDirectory(t *testing.T) {
+	ctx, conn := passwordTestContext(t, usr.LDAP_PASSWORD_MODIFY, false)
+	providers := usr.ProviderFromCtx(ctx)
+
+	if err := providers.UpdateUserPassword("ldapuser", "wrongpass", "newpassword", false, ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Bad old password returned %v", err)
+	}
+	if err := providers.UpdateUserPassword("ldapuser", "luserpass", "short", false, ctx); !errors.Is(err, usr.PasswordPolicyError) {
+		t.Errorf("Policy violation returned %v", err)
+	}
+	if err := providers.UpdateUserPassword("ldapuser", "luserpass", "newpassword", false, ctx); err != nil {
+		t.Fatalf("Could not change password: %v", err)
+	}
+	if conn.mockClient.userPassMap["ldapuser"] != "newpassword" {
+		t.Error("Password was not changed in the directory")
+	}
+
+	// Forced changes are rejected unless admin resets are allowed
+	if err := providers.UpdateUserPassword("ldapuser", "", "resetpassword", true, ctx); !errors.Is(err, usr.PasswordChangeNotAllowedError) {
+		t.Errorf("Forced reset without allow_admin_reset returned %v", err)
+	}
+	if conn.mockClient.userPassMap["ldapuser"] != "newpassword" {
+		t.Error("Password was reset in the directory without allow_admin_reset")
+	}
+
+	// Forced changes are made as the bind user
+	ctx, conn = passwordTestContext(t, usr.LDAP_PASSWORD_MODIFY, true)
+	providers = usr.ProviderFromCtx(ctx)
+	if err := providers.UpdateUserPassword("ldapuser", "", "resetpassword", true, ctx); err != nil {
+		t.Fatalf("Could not reset password: %v", err)
+	}
+	if conn.mockClient.userPassMap["ldapuser"] != "resetpassword" {
+		t.Error("Password was not reset in the directory")
+	}
+	// Connections that were never bound as a user are not rebound when released
+	if len(conn.mockClient.binds) != 1 {
+		t.Errorf("Expected only the service account bind, got %v", conn.mockClient.binds)
+	}
+
+	for _, u := range ent.FromContext(ctx).User.Query().AllX(ctx) {
+		if u.Password != "" {
+			t.Errorf("A local password was set for %s", u.Username)
+		}
+	}
+}
+
+func TestLDAPUserPasswordChangeWithUnicodePwd(t *testing.T) {
+	ctx, conn := passwordTestContext(t, usr.LDAP_PASSWORD_UNICODEPWD, false)
+
+	if err := usr.ProviderFromCtx(ctx).UpdateUserPassword("ldapuser", "luserpass", "newpassword", false, ctx); err != nil {
+		t.Fatalf("Could not change password: %v", err)
+	}
+	if len(conn.mockClient.modifies) != 1 {
+		t.Fatalf("Expected one modify request, got %d", len(conn.mockClient.modifies))
+	}
+	changes := conn.mockClient.modifies[0].Changes
+	if len(changes) != 2 || changes[0].Operation != ldap.DeleteAttribute || changes[1].Operation != ldap.AddAttribute {
+		t.Fatalf("Expected the old password to be deleted and the new password added: %+v", changes)
+	}
+	if decodeUnicodePwd(changes[1].Modification.Vals[0]) != `"newpassword"` || changes[1].Modification.Type != "unicodePwd" {
+		t.Errorf("New password was not encoded for unicodePwd: %q", changes[1].Modification.Vals[0])
+	}
+}
+
+func TestPasswordChangeRejectedForOtherSources(t *testing.T) {
+	ctx, _ := passwordTestContext(t, usr.LDAP_PASSWORD_MODIFY, false)
+	if err := usr.ProviderFromCtx(ctx).UpdateUserPassword("oidcuser", "", "newpassword", true, ctx); !errors.Is(err, usr.PasswordChangeNotAllowedError) {
+		t.Errorf("Password change for an OIDC user returned %v", err)
+	}
+
+	ctx, _ = passwordTestContext(t, usr.LDAP_PASSWORD_DISABLED, false)
+	if err := usr.ProviderFromCtx(ctx).UpdateUserPassword("ldapuser", "luserpass", "newpassword", false, ctx); !errors.Is(err, usr.PasswordChangeNotAllowedError) {
+		t.Errorf("Disabled password change returned %v", err)
+	}
+}
+
+func decodeUnicodePwd(s string) string {
+	b := []byte(s)
+	encoded := make([]uint16, len(b) / 2)
+	for i := range encoded {
+		encoded[i] = uint16(b[2 * i]) | uint16(b[2 * i + 1]) << 8
+	}
+	return string(utf16.Decode(encoded))
+}
@@ -0,0 +1,252 @@
+package usr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultLDAPPoolSize            = 8
+	defaultLDAPPoolIdleTimeout     = 5 * time.Minute
+	defaultLDAPServerRetryInterval = 30 * time.Second
+)
+
+// ldapConn is a connection to one of the provider's servers
+type ldapConn struct {
+	ldap.Client
+	url      string
+	lastUsed time.Time
+	// Set once the connection is bound as someone other than the service account
+	userBound bool
+}
+
+// Bind binds the connection as a user. putConn binds it back to the service account before it is reused
+func (c *ldapConn) Bind(username, password string) error {
+	c.userBound = true
+	return c.Client.Bind(username, password)
+}
+
+// ldapPool keeps connections that are bound as the service account so logins do not dial and bind every time.
+// At most size connections are open at once. Servers that fail to connect are skipped until the retry interval has passed.
+type ldapPool struct {
+	once  sync.Once
+	slots chan struct{}
+
+	mu        sync.Mutex
+	idle      []*ldapConn
+	downUntil map[string]time.Time
+	// Set by Close. Released connections are closed instead of kept
+	closed    bool
+}
+
+func (l *ldapUserProvider) poolSize() int {
+	if l.PoolSize > 0 {
+		return l.PoolSize
+	}
+	return defaultLDAPPoolSize
+}
+
+func (l *ldapUserProvider) idleTimeout() time.Duration {
+	if l.PoolIdleTimeout > 0 {
+		return l.PoolIdleTimeout
+	}
+	return defaultLDAPPoolIdleTimeout
+}
+
+func (l *ldapUserProvider) retryInterval() time.Duration {
+	if l.ServerRetryInterval > 0 {
+		return l.ServerRetryInterval
+	}
+	return defaultLDAPServerRetryInterval
+}
+
+// getConn returns a connection bound as the service account, reusing an idle one when possible.
+// Waits for a connection to be released when the pool is full.
+func (l *ldapUserProvider) getConn(ctx context.Context) (*ldapConn, error) {
+	p := &l.pool
+	p.once.Do(func() {
+		p.slots = make(chan struct{}, l.poolSize())
+	})
+
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if conn := l.takeIdle(); conn != nil {
+		return conn, nil
+	}
+
+	conn, err := l.dial(ctx)
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+	return conn, nil
+}
+
+// putConn keeps the connection for reuse, rebinding it as the service account if it was bound as a user.
+// Connections that can not be rebound are closed.
+func (l *ldapUserProvider) putConn(conn *ldapConn) {
+	p := &l.pool
+	defer func() { <-p.slots }()
+
+	if conn.userBound {
+		if err := conn.Client.Bind(l.BindUserDN, l.BindUserPassword); err != nil {
+			conn.Close()
+			return
+		}
+		conn.userBound = false
+	}
+	conn.lastUsed = time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		conn.Close()
+		return
+	}
+	p.idle = append(p.idle, conn)
+}
+
+// takeIdle returns the most recently used idle connection, closing any that have been idle too long
+func (l *ldapUserProvider) takeIdle() *ldapConn {
+	p := &l.pool
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cutoff := time.Now().Add(-l.idleTimeout())
+	var fresh []*ldapConn
+	for _, conn := range p.idle {
+		if conn.lastUsed.Before(cutoff) || conn.IsClosing() {
+			conn.Close()
+			continue
+		}
+		fresh = append(fresh, conn)
+	}
+	p.idle = fresh
+
+	if len(p.idle) == 0 {
+		return nil
+	}
+	conn := p.idle[len(p.idle)-1]
+	p.idle = p.idle[:len(p.idle)-1]
+	return conn
+}
+
+// closeIdle closes all idle connections
+func (l *ldapUserProvider) closeIdle() {
+	p := &l.pool
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, conn := range p.idle {
+		conn.Close()
+	}
+	p.idle = nil
+}
+
+// Close closes the idle connections of the provider once it is replaced, e.g. by a configuration reload.
+// Connections still in use are closed when they are released.
+func (l *ldapUserProvider) Close() {
+	p := &l.pool
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+	l.closeIdle()
+}
+
+// dial connects and binds to the first server that is up, in the configured order.
+// When every server is marked down they are all tried anyway.
+func (l *ldapUserProvider) dial(ctx context.Context) (*ldapConn, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "ldapUserProvider.dial").
+		Str("provider", l.Name).
+		Logger()
+
+	urls := l.upServers()
+	var errs []error
+	for _, u := range urls {
+		conn, err := l.connect(u)
+		if err != nil {
+			logger.Warn().Err(err).Str("url", u).Msg("Could not connect to LDAP server")
+			l.markServer(u, false)
+			errs = append(errs, err)
+			continue
+		}
+		l.markServer(u, true)
+
+		if err := conn.Bind(l.BindUserDN, l.BindUserPassword); err != nil {
+			logger.Error().Err(err).Str("url", u).Msg("Bind user authentication failed")
+			conn.Close()
+			errs = append(errs, err)
+			continue
+		}
+		return &ldapConn{ Client: conn, url: u, lastUsed: time.Now() }, nil
+	}
+	return nil, fmt.Errorf("%w: %v", LDAPError, errors.Join(errs...))
+}
+
+// connect dials a server and starts TLS on plain ldap:// connections when StartTLS is configured.
+// opts are used in addition to DialOpts
+func (l *ldapUserProvider) connect(serverURL string, opts ...ldap.DialOpt) (ldap.Client, error) {
+	conn, err := l.connector.Connect(serverURL, append(slices.Clone(l.DialOpts), opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	if l.StartTLS != nil && strings.HasPrefix(serverURL, "ldap://") {
+		tlsConfig := l.StartTLS.Clone()
+		if tlsConfig.ServerName == "" {
+			if u, err := url.Parse(serverURL); err == nil {
+				tlsConfig.ServerName = u.Hostname()
+			}
+		}
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// upServers returns the servers that are not marked down, or all servers if every one is down
+func (l *ldapUserProvider) upServers() []string {
+	p := &l.pool
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	var up []string
+	for _, u := range l.ServerURLs {
+		if now.After(p.downUntil[u]) {
+			up = append(up, u)
+		}
+	}
+	if len(up) == 0 {
+		return l.ServerURLs
+	}
+	return up
+}
+
+func (l *ldapUserProvider) markServer(serverURL string, healthy bool) {
+	p := &l.pool
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.downUntil == nil {
+		p.downUntil = make(map[string]time.Time)
+	}
+	if healthy {
+		delete(p.downUntil, serverURL)
+	} else {
+		p.downUntil[serverURL] = time.Now().Add(l.retryInterval())
+	}
+}
@@ -2,13 +2,17 @@ package usr
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/base64"
 	"errors"
+	"stoke/internal/cluster"
 	"stoke/internal/ent"
 	"stoke/internal/ent/grouplink"
-	"stoke/internal/ent/predicate"
 	"stoke/internal/tel"
 	"strings"
 	"text/template"
+	"time"
+	"unicode/utf8"
 
 	"github.com/go-ldap/ldap/v3"
 	"github.com/rs/zerolog"
@@ -26,13 +30,20 @@ type LDAPConnector interface {
 
 type ldapUserProvider struct {
 	Name                  string
-	ServerURL             string
+	// Servers to connect to in order of preference. Later servers are used when earlier ones are down
+	ServerURLs            []string
 	BindUserDN            string
 	BindUserPassword      string
 
 	GroupSearchRoot       string
 	GroupFilter           *template.Template
 	GroupAttribute        string
+	// How to resolve membership in nested groups. Defaults to LDAP_NESTED_NONE
+	NestedGroups          LDAPNestedGroups
+	// Filter used with LDAP_NESTED_IN_CHAIN. Defaults to an Active Directory member:1.2.840.113556.1.4.1941: filter
+	NestedGroupFilter     *template.Template
+	// Maximum number of parent levels searched with LDAP_NESTED_RECURSIVE. Defaults to 10
+	NestedGroupDepth      int
 
 	UserSearchRoot        string
 	UserFilter            *template.Template
@@ -40,12 +51,50 @@ type ldapUserProvider struct {
 	FirstNameField        string
 	LastNameField         string
 	EmailField            string
+	// Attribute holding the stable id of users, i.e. objectGUID or entryUUID. The DN is used when empty
+	SubjectField          string
 
 	SearchTimeout         int
 
+	// Rules users must satisfy to log in and the groups new users start with
+	Provisioning          ProvisioningRules
+	// Attributes of the user's entry that are added to issued tokens as claims
+	AttributeClaims       []LDAPAttributeClaim
+
 	DialOpts 							[]ldap.DialOpt
+	// TLS configuration used to upgrade ldap:// connections with StartTLS. StartTLS is not used when nil
+	StartTLS              *tls.Config
+
+	// Maximum number of open connections. Defaults to 8
+	PoolSize              int
+	// How long a connection may sit unused before it is closed. Defaults to 5 minutes
+	PoolIdleTimeout       time.Duration
+	// How long to skip a server after failing to connect to it. Defaults to 30 seconds
+	ServerRetryInterval   time.Duration
+
+	// How passwords are changed in the directory. Defaults to LDAP_PASSWORD_MODIFY
+	PasswordChange        LDAPPasswordChange
+	// Allow forced password resets, made as the bind user without the user's old password
+	AllowAdminReset       bool
+
+	// How often users are synced with the directory. Users are only synced on login when 0
+	SyncInterval          time.Duration
+	// Report the changes a sync would make without making them
+	SyncDryRun            bool
+	// What happens to users that no longer exist or are disabled in the directory
+	Deprovision           LDAPDeprovision
+	// Percentage of the checked users a sync may deprovision. Syncs that find more users gone or disabled deprovision nobody,
+	// since a broken search root or filter would otherwise deprovision everyone. At least one user may always be deprovisioned.
+	// Defaults to 10
+	SyncMaxDeprovisionPercent int
+	// Filter that matches the user's entry when the account is disabled. Disabled accounts are not detected when nil
+	DisabledFilter        *template.Template
+	// Held while syncing so only one replica syncs. Every replica syncs when nil
+	SyncElector           cluster.Elector
+	lastSync              time.Time
 
 	connector             LDAPConnector
+	pool                  ldapPool
 }
 
 type templateValues struct {
@@ -59,7 +108,7 @@ type ldapDialer struct {}
 func NewLDAPUserProvider(name, url, bindDN, bindPass, groupSearch, groupAttribute, userSearch, fnameField, lnameField, emailField string, searchTimeout int, groupFilter, userFilter *template.Template, dialOpts ...ldap.DialOpt) *ldapUserProvider {
 	return &ldapUserProvider{
 		Name:             name,
-		ServerURL:        url,
+		ServerURLs:       []string{ url },
 		BindUserDN:       bindDN,
 		BindUserPassword: bindPass,
 		GroupSearchRoot:  groupSearch,
@@ -73,6 +122,7 @@ func NewLDAPUserProvider(name, url, bindDN, bindPass, groupSearch, groupAttribut
 		SearchTimeout:    searchTimeout,
 		DialOpts:         dialOpts,
 		connector:        ldapDialer{},
+		lastSync:         time.Now(),
 	}
 }
 
@@ -83,14 +133,16 @@ func (ldapDialer) Connect(url string, dialOpts ...ldap.DialOpt) (ldap.Client, er
 
 // Set the ldap connector to use.
 // Should only be needed for testing, but could also be used to alter connection behaviour
+// Idle connections from the previous connector are closed.
 func (l *ldapUserProvider) SetConnector(c LDAPConnector) {
+	l.closeIdle()
 	l.connector = c
 }
 
 // GetUserClaims looks up claims that are associated in LDAP
 func (l *ldapUserProvider) UpdateUserClaims(username, password string, ctx context.Context) (*ent.User, error) {
 	logger := zerolog.Ctx(ctx).With().
-		Str("url", l.ServerURL).
+		Strs("urls", l.ServerURLs).
 		Str("username", username).
 		Str("bindUserDN", l.BindUserDN).
 		Logger()
@@ -102,23 +154,16 @@ func (l *ldapUserProvider) UpdateUserClaims(username, password string, ctx conte
 		Func(otelzerolog.AddTracingContext(span)).
 		Msg("Getting user claims")
 
-	conn, err := l.connector.Connect(l.ServerURL, l.DialOpts...)
+	conn, err := l.getConn(ctx)
 	if err != nil {
 		logger.Error().
 			Func(otelzerolog.AddTracingContext(span)).
 			Err(err).
-			Msg("Could not connect to LDAP server")
-		return nil, AuthSourceError
-	}
-	defer conn.Close()
-
-	if err:= conn.Bind(l.BindUserDN, l.BindUserPassword); err != nil {
-		logger.Error().
-			Func(otelzerolog.AddTracingContext(span)).
-			Err(err).
-			Msg("Bind user authentication failed")
+			Msg("Could not get a bound connection to an LDAP server")
 		return nil, AuthSourceError
 	}
+	// Binding as the user changes the connection's identity, putConn binds back to the service account
+	defer l.putConn(conn)
 
 	usr, groupLinks, getErr := l.getOrCreateUser(username, password, conn, ctx)
 	if errors.Is(LDAPNotFoundError, getErr) || errors.Is(LDAPError, getErr) {
@@ -164,7 +209,7 @@ func (l *ldapUserProvider) UpdateUserClaims(username, password string, ctx conte
 // Creates the user if it exists in LDAP
 func (l *ldapUserProvider) getOrCreateUser(username, password string, conn ldap.Client, ctx context.Context) (*ent.User, ent.GroupLinks, error) {
 	logger := zerolog.Ctx(ctx).With().
-			Str("url", l.ServerURL).
+			Strs("urls", l.ServerURLs).
 			Str("user", username).
 			Logger()
 
@@ -173,20 +218,45 @@ func (l *ldapUserProvider) getOrCreateUser(username, password string, conn ldap.
 		return nil, nil, err
 	}
 
-	usr, dbErr := retreiveLocalUser(username, ctx)
-	if dbErr != nil && !ent.IsNotFound(dbErr) {
-		return nil, nil, dbErr
+	if err := l.Provisioning.check(l.Name, userEntry.GetAttributeValue(l.EmailField), userEntry.GetAttributeValues, ctx); err != nil {
+		return nil, nil, err
 	}
 
-	if ent.IsNotFound(dbErr) {
-		usr, err = l.createLocalUser(username, userEntry, ctx)
-		if err != nil {
-			logger.Error().
-				Err(err).
-				Str("userDN", userEntry.DN).
-				Msg("Could not create local user")
-			return nil, nil, err
-		}
+	usr, err := identityUser(
+		externalIdentity{
+			ProviderType: l.identityType(),
+			ProviderName: l.Name,
+			Subject: l.ldapSubject(userEntry),
+		},
+		LDAP_SOURCE,
+		username,
+		l.Provisioning.provisionUser(func() (*ent.User, error) {
+			return l.createLocalUser(username, userEntry, ctx)
+		}, ctx),
+		ctx,
+	)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Str("userDN", userEntry.DN).
+			Msg("Could not find or create local user")
+		return nil, nil, err
+	}
+
+	if err := l.attributeClaims(userEntry, ctx); err != nil {
+		logger.Error().
+			Err(err).
+			Str("userDN", userEntry.DN).
+			Msg("Could not apply attribute claims")
+		return nil, nil, err
+	}
+	attributeLinks, err := l.attributeLinks(userEntry, ctx)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Str("userDN", userEntry.DN).
+			Msg("Could not get users attribute claim links")
+		return nil, nil, err
 	}
 
 	groupLinks, err := l.getUserLDAPGroupLinks(username, userEntry.DN, conn, ctx)
@@ -198,6 +268,13 @@ func (l *ldapUserProvider) getOrCreateUser(username, password string, conn ldap.
 		return nil, nil, err
 	}
 
+	if len(groupLinks) == 0 && l.Provisioning.AllowUnlinked {
+		logger.Info().
+			Str("userDN", userEntry.DN).
+			Msg("User has no linked groups. Logging in with the groups the user already has")
+		return usr, attributeLinks, nil
+	}
+
 	if len(groupLinks) == 0 {
 		logger.Warn().
 			Err(err).
@@ -208,17 +285,32 @@ func (l *ldapUserProvider) getOrCreateUser(username, password string, conn ldap.
 
 	logger.Debug().
 		Int("numGroups", len(groupLinks)).
+		Int("numAttributeGroups", len(attributeLinks)).
 		Msg("Found user with group links")
 
-	return usr, groupLinks, nil
+	return usr, append(groupLinks, attributeLinks...), nil
 }
 
 func (l *ldapUserProvider) getLDAPUser(username, password string, conn ldap.Client, ctx context.Context) (*ldap.Entry, error) {
+	userEntry, err := l.findLDAPUser(username, conn, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Bind(userEntry.DN, password); err != nil {
+		return nil, AuthenticationError
+	}
+
+	return userEntry, nil
+}
+
+// Looks up the user's entry without binding as the user
+func (l *ldapUserProvider) findLDAPUser(username string, conn ldap.Client, ctx context.Context) (*ldap.Entry, error) {
 	result, err := l.ldapSearch(
 		l.UserSearchRoot,
 		templateValues{ Username: ldap.EscapeFilter(username) },
 		l.UserFilter,
-		[]string{ l.EmailField, l.FirstNameField, l.LastNameField },
+		l.userAttributes(),
 		conn,
 		ctx,
 	)
@@ -230,50 +322,56 @@ func (l *ldapUserProvider) getLDAPUser(username, password string, conn ldap.Clie
 		return nil, LDAPNotFoundError
 	}
 
-	userEntry := result.Entries[0]
-	if err := conn.Bind(userEntry.DN, password); err != nil {
-		return nil, AuthenticationError
+	return result.Entries[0], nil
+}
+
+func (l *ldapUserProvider) userAttributes() []string {
+	attributes := []string{ l.EmailField, l.FirstNameField, l.LastNameField }
+	if l.SubjectField != "" {
+		attributes = append(attributes, l.SubjectField)
+	}
+	for name := range l.Provisioning.AttributePatterns {
+		attributes = append(attributes, name)
+	}
+	for _, a := range l.AttributeClaims {
+		attributes = append(attributes, a.Attribute)
 	}
+	return attributes
+}
 
-	return userEntry, nil
+// ldapSubject is the stable subject of the user's identity: the SubjectField attribute, or the entry's DN.
+// Binary attributes such as objectGUID are base64 encoded.
+func (l *ldapUserProvider) ldapSubject(userEntry *ldap.Entry) string {
+	if l.SubjectField == "" {
+		return userEntry.DN
+	}
+	raw := userEntry.GetRawAttributeValue(l.SubjectField)
+	if utf8.Valid(raw) {
+		return string(raw)
+	}
+	return base64.StdEncoding.EncodeToString(raw)
 }
 
 func (l *ldapUserProvider) getUserLDAPGroupLinks(username, userDN string, conn ldap.Client, ctx context.Context) (ent.GroupLinks, error) {
-	response, err := l.ldapSearch(
-		l.GroupSearchRoot,
-		templateValues{
-			Username: ldap.EscapeFilter(username),
-			UserDN: userDN,
-		},
-		l.GroupFilter,
-		[]string{l.GroupAttribute},
-		conn,
-		ctx,
-	)
+	groupNames, err := l.findUserGroups(username, userDN, conn, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(response.Entries) == 0 {
+	if len(groupNames) == 0 {
 		return nil, LDAPNotFoundError
 	}
 
-	var resourceSpecMatchers []predicate.GroupLink
-	groupNames := make([]string, len(response.Entries))
-	for i, group := range response.Entries {
-		groupNames[i] = group.GetAttributeValue(l.GroupAttribute)
-		resourceSpecMatchers = append(resourceSpecMatchers, grouplink.ResourceSpecEQ(groupNames[i]))
-	}
-
 	zerolog.Ctx(ctx).Debug().
 		Strs("groupsFound", groupNames).
+		Stringer("nestedGroups", l.NestedGroups).
 		Msg("Found LDAP groups")
 
 	return ent.FromContext(ctx).GroupLink.Query().
 		Where(
 			grouplink.And(
 				grouplink.TypeEQ("LDAP:" + l.Name),
-				grouplink.Or(resourceSpecMatchers...),
+				grouplink.ResourceSpecIn(groupNames...),
 			),
 		).
 		WithClaimGroup(func (q *ent.ClaimGroupQuery) {
@@ -314,7 +412,7 @@ func (l *ldapUserProvider) createLocalUser(username string, userEntry *ldap.Entr
 
 func (l *ldapUserProvider) ldapSearch(searchRoot string, fillTemplate templateValues, filterTemplate *template.Template, attributes []string, conn ldap.Client, ctx context.Context) (*ldap.SearchResult, error) {
 	logger := zerolog.Ctx(ctx).With().
-		Str("url", l.ServerURL).
+		Strs("urls", l.ServerURLs).
 		Str("user", fillTemplate.Username).
 		Str("userDN", fillTemplate.UserDN).
 		Str("template", filterTemplate.Root.String()).
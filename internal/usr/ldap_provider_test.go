@@ -2,6 +2,7 @@ package usr_test
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"stoke/internal/ent"
@@ -10,6 +11,7 @@ import (
 	"stoke/internal/usr"
 	"testing"
 	"text/template"
+	"time"
 )
 
 type LDAPUserProvider interface {
@@ -824,6 +826,263 @@ func TestLDAPConnectorReturnsErrorWhenBadURL(t *testing.T) {
 	}
 }
 
+func ldapPoolTestContext(t *testing.T) context.Context {
+	return tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("local", "user", "localuser", "user@local"),
+				tu.Source("LOCAL"),
+				tu.Group(
+					tu.GroupInfo("user group", "user group"),
+					tu.LDAPLink("main_ldap", "ldap_group"),
+					tu.Claim(
+						tu.ClaimInfo("user", "usr", "yes", "Grants user"),
+					),
+				),
+			),
+		),
+	)
+}
+
+func newPoolTestLDAPServer(opts ...MockLDAPOption) *MockLDAPConnector {
+	return NewMockLDAPServer(append([]MockLDAPOption{
+		LDAPUser("adminuser", "admin", "user", "admin@hppr.dev", "adminpass"),
+		LDAPUser("ldapuser", "ldap", "user", "luser@hppr.dev", "luserpass"),
+		LDAPGroup("ldapuser", "ldap_group"),
+	}, opts...)...)
+}
+
+// Logins should reuse the bound service connection instead of dialing each time
+func TestLDAPReusesPooledConnections(t *testing.T) {
+	ctx := ldapPoolTestContext(t)
+	conn := newPoolTestLDAPServer()
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.SetConnector(conn)
+
+	for i := 0; i < 3; i++ {
+		if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+			t.Fatalf("Login %d failed: %v", i, err)
+		}
+	}
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "wrongpass", ctx); err == nil {
+		t.Fatal("Login with a bad password succeeded")
+	}
+	// The connection must be bound back to the service account after a failed user bind
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Login after a failed login failed: %v", err)
+	}
+	if len(conn.connects) != 1 {
+		t.Errorf("Expected one connection to be reused, dialed %d times", len(conn.connects))
+	}
+	if conn.mockClient.boundAs != "adminuser" {
+		t.Errorf("Pooled connection was left bound as %q", conn.mockClient.boundAs)
+	}
+
+	// Connections that sat idle too long are replaced
+	ldapProvider.PoolIdleTimeout = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if len(conn.connects) != 2 {
+		t.Errorf("Expected idle connection to be replaced, dialed %d times", len(conn.connects))
+	}
+}
+
+// Providers replaced by a reload close their pooled connections, including ones still in use by logins
+func TestLDAPPoolClosedWhenReplaced(t *testing.T) {
+	ctx := ldapPoolTestContext(t)
+	conn := newPoolTestLDAPServer()
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.SetConnector(conn)
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("main_ldap", ldapProvider)
+
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	pl.ReplaceForeignProviders(usr.NewProviderList())
+
+	for i := 0; i < 2; i++ {
+		if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+			t.Fatalf("Login %d after replace failed: %v", i, err)
+		}
+	}
+	if len(conn.connects) != 3 {
+		t.Errorf("Replaced provider kept pooled connections, dialed %d times", len(conn.connects))
+	}
+}
+
+// Logins should fail over to the next server and skip servers that are down
+func TestLDAPFailsOverToNextServer(t *testing.T) {
+	ctx := ldapPoolTestContext(t)
+	conn := newPoolTestLDAPServer(DownServers("ldap://primary.server"))
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://primary.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.ServerURLs = append(ldapProvider.ServerURLs, "ldap://secondary.server")
+	ldapProvider.SetConnector(conn)
+
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Login did not fail over: %v", err)
+	}
+
+	// Drop the pooled connection so the next login dials again
+	ldapProvider.SetConnector(conn)
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Login did not fail over: %v", err)
+	}
+
+	expected := []string{ "ldap://primary.server", "ldap://secondary.server", "ldap://secondary.server" }
+	if fmt.Sprint(conn.connects) != fmt.Sprint(expected) {
+		t.Errorf("Down server was not skipped: %v", conn.connects)
+	}
+}
+
+// Plain ldap connections should be upgraded with StartTLS using the server's host name
+func TestLDAPStartTLS(t *testing.T) {
+	ctx := ldapPoolTestContext(t)
+	conn := newPoolTestLDAPServer()
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server:389",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.StartTLS = &tls.Config{}
+	ldapProvider.SetConnector(conn)
+
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if conn.mockClient.startTLSName != "someldap.server" {
+		t.Errorf("StartTLS was not used with the server name: %q", conn.mockClient.startTLSName)
+	}
+}
+
+// Groups linked to an ancestor of the user's direct groups should grant claims
+// ldapuser -> devs -> eng -> ldap_group -> devs (cycle)
+func TestLDAPRecursiveNestedGroups(t *testing.T) {
+	ctx := ldapPoolTestContext(t)
+	conn := NewMockLDAPServer(
+		LDAPUser("adminuser", "admin", "user", "admin@hppr.dev", "adminpass"),
+		LDAPUser("ldapuser", "ldap", "user", "luser@hppr.dev", "luserpass"),
+		LDAPGroupMember("ldapuser", "cn=devs", "devs"),
+		LDAPGroupMember("devs", "cn=eng", "eng"),
+		LDAPGroupMember("eng", "cn=ldap_group", "ldap_group"),
+		LDAPGroupMember("ldap_group", "cn=devs", "devs"),
+	)
+
+	ldapProvider := createLDAPProvider()
+	ldapProvider.SetConnector(conn)
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err == nil {
+		t.Fatal("Nested group was used without nested groups enabled")
+	}
+
+	groupTemplate, userTemplate := createTemplates()
+	nestedProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	nestedProvider.NestedGroups = usr.LDAP_NESTED_RECURSIVE
+	nestedProvider.SetConnector(conn)
+	if _, err := nestedProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Nested group was not resolved: %v", err)
+	}
+	if _, claims := getUserAndClaims("ldapuser", ctx); len(claims) != 1 || claims[0].ShortName != "usr" {
+		t.Errorf("Unexpected claims: %v", claims)
+	}
+}
+
+// The recursive walk should not search past the configured depth
+func TestLDAPRecursiveNestedGroupsDepthLimit(t *testing.T) {
+	ctx := ldapPoolTestContext(t)
+	conn := NewMockLDAPServer(
+		LDAPUser("adminuser", "admin", "user", "admin@hppr.dev", "adminpass"),
+		LDAPUser("ldapuser", "ldap", "user", "luser@hppr.dev", "luserpass"),
+		LDAPGroupMember("ldapuser", "cn=devs", "devs"),
+		LDAPGroupMember("devs", "cn=eng", "eng"),
+		LDAPGroupMember("eng", "cn=ldap_group", "ldap_group"),
+	)
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.NestedGroups = usr.LDAP_NESTED_RECURSIVE
+	ldapProvider.NestedGroupDepth = 1
+	ldapProvider.SetConnector(conn)
+
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); !errors.Is(err, usr.NoLinkedGroupsError) {
+		t.Fatalf("Group above the depth limit was used: %v", err)
+	}
+
+	ldapProvider.NestedGroupDepth = 2
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("Group within the depth limit was not used: %v", err)
+	}
+}
+
+// In chain resolution should use the nested group filter instead of the group filter
+func TestLDAPInChainNestedGroups(t *testing.T) {
+	ctx := ldapPoolTestContext(t)
+	conn := NewMockLDAPServer(
+		LDAPUser("adminuser", "admin", "user", "admin@hppr.dev", "adminpass"),
+		LDAPUser("ldapuser", "ldap", "user", "luser@hppr.dev", "luserpass"),
+		LDAPGroupMember("ldapuser", "cn=devs", "devs"),
+		LDAPGroupMember("chain-ldapuser", "cn=devs", "devs"),
+		LDAPGroupMember("chain-ldapuser", "cn=ldap_group", "ldap_group"),
+	)
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.NestedGroups = usr.LDAP_NESTED_IN_CHAIN
+	ldapProvider.NestedGroupFilter = template.Must(template.New("chain").Parse("groupFilter:chain-{{ .UserDN }}"))
+	ldapProvider.SetConnector(conn)
+
+	if _, err := ldapProvider.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("In chain groups were not used: %v", err)
+	}
+}
+
 func createLDAPProvider() LDAPUserProvider {
 	groupTemplate, userTemplate := createTemplates()
 
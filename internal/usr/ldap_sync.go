@@ -0,0 +1,297 @@
+package usr
+
+import (
+	"context"
+	"errors"
+	"stoke/internal/ent"
+	"stoke/internal/ent/claimgroup"
+	"stoke/internal/ent/grouplink"
+	"stoke/internal/ent/schema/policy"
+	"stoke/internal/ent/user"
+	"stoke/internal/tel"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/rs/zerolog"
+	"github.com/vincentfree/opentelemetry/otelzerolog"
+)
+
+type LDAPDeprovision uint8
+const (
+	// Users that are gone from the directory are only reported
+	LDAP_DEPROVISION_NONE LDAPDeprovision = iota
+	// Users that are gone from the directory are removed from every group linked to the provider
+	LDAP_DEPROVISION_REMOVE_GROUPS
+	// Users that are gone from the directory are deleted
+	LDAP_DEPROVISION_DELETE
+)
+
+const defaultLDAPSyncMaxDeprovisionPercent = 10
+
+func (d LDAPDeprovision) String() string {
+	switch d {
+	case LDAP_DEPROVISION_NONE:
+		return "none"
+	case LDAP_DEPROVISION_REMOVE_GROUPS:
+		return "remove_groups"
+	case LDAP_DEPROVISION_DELETE:
+		return "delete"
+	}
+	return "undefined"
+}
+
+// LDAPSyncReport summarizes the changes made, or that would be made in dry run mode, by a directory sync
+type LDAPSyncReport struct {
+	Provider      string
+	DryRun        bool
+	Started       time.Time
+	Finished      time.Time
+	// Number of users compared with the directory
+	Checked       int
+	// Users whose group memberships changed
+	Updated       []string
+	// Users that no longer exist in the directory
+	Missing       []string
+	// Users whose directory accounts are disabled
+	Disabled      []string
+	// Users that were deprovisioned
+	Deprovisioned []string
+	// Set when more users were gone or disabled than the provider may deprovision in one sync, so none were
+	DeprovisionBlocked bool
+	// Users that could not be synced
+	Failed        []string
+}
+
+func (r *LDAPSyncReport) log(logger *zerolog.Logger) {
+	logger.Info().
+		Str("provider", r.Provider).
+		Bool("dryRun", r.DryRun).
+		Dur("took", r.Finished.Sub(r.Started)).
+		Int("checked", r.Checked).
+		Strs("updated", r.Updated).
+		Strs("missing", r.Missing).
+		Strs("disabled", r.Disabled).
+		Strs("deprovisioned", r.Deprovisioned).
+		Bool("deprovisionBlocked", r.DeprovisionBlocked).
+		Strs("failed", r.Failed).
+		Msg("LDAP directory sync finished")
+}
+
+// maxDeprovisions returns how many of checked users a sync may deprovision
+func (l *ldapUserProvider) maxDeprovisions(checked int) int {
+	percent := l.SyncMaxDeprovisionPercent
+	if percent <= 0 {
+		percent = defaultLDAPSyncMaxDeprovisionPercent
+	}
+	return max(checked * percent / 100, 1)
+}
+
+// syncDue returns true when SyncInterval has passed since the last scheduled sync
+func (l *ldapUserProvider) syncDue(now time.Time) bool {
+	return l.SyncInterval > 0 && now.Sub(l.lastSync) >= l.SyncInterval
+}
+
+// runScheduledSync syncs users if this replica holds the sync lease
+func (l *ldapUserProvider) runScheduledSync(ctx context.Context) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "ldapUserProvider.runScheduledSync").
+		Str("provider", l.Name).
+		Logger()
+
+	l.lastSync = time.Now()
+	if l.SyncElector != nil {
+		leader, err := l.SyncElector.TryAcquire(ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not acquire LDAP sync lease")
+			return
+		}
+		if !leader {
+			logger.Debug().Msg("Another replica holds the LDAP sync lease")
+			return
+		}
+	}
+
+	report, err := l.SyncUsers(l.SyncDryRun, ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("LDAP directory sync failed")
+		return
+	}
+	report.log(&logger)
+}
+
+// SyncUsers compares LDAP users in groups linked to this provider with the directory.
+// Group memberships are re-evaluated and users that no longer exist or are disabled are deprovisioned.
+// Nothing is changed when dryRun is set, but the report lists what would have changed.
+func (l *ldapUserProvider) SyncUsers(dryRun bool, ctx context.Context) (*LDAPSyncReport, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "ldapUserProvider.SyncUsers").
+		Str("provider", l.Name).
+		Bool("dryRun", dryRun).
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "ldapUserProvider.SyncUsers")
+	defer span.End()
+
+	report := &LDAPSyncReport{
+		Provider: l.Name,
+		DryRun: dryRun,
+		Started: time.Now(),
+	}
+
+	linkType := "LDAP:" + l.Name
+	users, err := ent.FromContext(ctx).User.Query().
+		Where(
+			user.SourceEQ(LDAP_SOURCE),
+			user.HasClaimGroupsWith(
+				claimgroup.HasGroupLinksWith(grouplink.TypeEQ(linkType)),
+			),
+		).
+		WithClaimGroups(func (q *ent.ClaimGroupQuery) {
+			q.WithGroupLinks()
+		}).
+		All(ctx)
+	if err != nil {
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not query LDAP users")
+		return nil, err
+	}
+
+	conn, err := l.getConn(ctx)
+	if err != nil {
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not get a bound connection to an LDAP server")
+		return nil, AuthSourceError
+	}
+	defer l.putConn(conn)
+
+	var gone []*ent.User
+	for _, u := range users {
+		report.Checked++
+		deprovision, err := l.syncUser(u, dryRun, report, conn, ctx)
+		if err != nil {
+			logger.Warn().
+				Func(otelzerolog.AddTracingContext(span)).
+				Err(err).
+				Str("username", u.Username).
+				Msg("Could not sync user")
+			report.Failed = append(report.Failed, u.Username)
+		}
+		if deprovision {
+			gone = append(gone, u)
+		}
+	}
+
+	if l.Deprovision != LDAP_DEPROVISION_NONE && len(gone) > l.maxDeprovisions(report.Checked) {
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Int("gone", len(gone)).
+			Int("checked", report.Checked).
+			Msg("Too many users are gone or disabled in the directory. Not deprovisioning anyone. Check the search settings or raise sync_max_deprovision_percent")
+		report.DeprovisionBlocked = true
+		gone = nil
+	}
+	for _, u := range gone {
+		if err := l.deprovisionUser(u, dryRun, report, ctx); err != nil {
+			logger.Warn().
+				Func(otelzerolog.AddTracingContext(span)).
+				Err(err).
+				Str("username", u.Username).
+				Msg("Could not deprovision user")
+			report.Failed = append(report.Failed, u.Username)
+		}
+	}
+
+	report.Finished = time.Now()
+	return report, nil
+}
+
+// syncUser updates the group memberships of u. Returns true if u is gone or disabled in the directory and should be deprovisioned
+func (l *ldapUserProvider) syncUser(u *ent.User, dryRun bool, report *LDAPSyncReport, conn ldap.Client, ctx context.Context) (bool, error) {
+	linkType := "LDAP:" + l.Name
+
+	userEntry, err := l.findLDAPUser(u.Username, conn, ctx)
+	if errors.Is(err, LDAPNotFoundError) {
+		report.Missing = append(report.Missing, u.Username)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	disabled, err := l.isDisabled(u.Username, userEntry.DN, conn, ctx)
+	if err != nil {
+		return false, err
+	}
+	if disabled {
+		report.Disabled = append(report.Disabled, u.Username)
+		return true, nil
+	}
+
+	groupLinks, err := l.getUserLDAPGroupLinks(u.Username, userEntry.DN, conn, ctx)
+	if err != nil && !errors.Is(err, LDAPNotFoundError) {
+		return false, err
+	}
+	// Groups of persisted attribute claims are created at login. Sync keeps the ones that still match the entry
+	attributeLinks, err := l.attributeLinks(userEntry, ctx)
+	if err != nil {
+		return false, err
+	}
+	groupLinks = append(groupLinks, attributeLinks...)
+
+	add, del := findGroupChanges(u, groupLinks, linkType)
+	if len(add) == 0 && len(del) == 0 {
+		return false, nil
+	}
+	report.Updated = append(report.Updated, u.Username)
+	if dryRun {
+		return false, nil
+	}
+	_, err = applyGroupChanges(add, del, u, ctx)
+	return false, err
+}
+
+// isDisabled returns true if DisabledFilter matches the user
+func (l *ldapUserProvider) isDisabled(username, userDN string, conn ldap.Client, ctx context.Context) (bool, error) {
+	if l.DisabledFilter == nil {
+		return false, nil
+	}
+	result, err := l.ldapSearch(
+		l.UserSearchRoot,
+		templateValues{
+			Username: ldap.EscapeFilter(username),
+			UserDN: ldap.EscapeFilter(userDN),
+		},
+		l.DisabledFilter,
+		[]string{ "dn" },
+		conn,
+		ctx,
+	)
+	if err != nil {
+		return false, err
+	}
+	return len(result.Entries) > 0, nil
+}
+
+func (l *ldapUserProvider) deprovisionUser(u *ent.User, dryRun bool, report *LDAPSyncReport, ctx context.Context) error {
+	if l.Deprovision == LDAP_DEPROVISION_NONE {
+		return nil
+	}
+	report.Deprovisioned = append(report.Deprovisioned, u.Username)
+	if dryRun {
+		return nil
+	}
+
+	switch l.Deprovision {
+	case LDAP_DEPROVISION_REMOVE_GROUPS:
+		_, del := findGroupChanges(u, nil, "LDAP:" + l.Name)
+		_, err := applyGroupChanges(nil, del, u, ctx)
+		return err
+	case LDAP_DEPROVISION_DELETE:
+		return ent.FromContext(ctx).User.DeleteOne(u).Exec(policy.BypassDatabasePolicies(ctx))
+	}
+	return nil
+}
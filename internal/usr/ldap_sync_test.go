@@ -0,0 +1,178 @@
+package usr_test
+
+import (
+	"context"
+	"slices"
+	"stoke/internal/ent"
+	"stoke/internal/ent/user"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+	"testing"
+	"text/template"
+)
+
+func ldapSyncTestContext(t *testing.T) context.Context {
+	ldapUser := func(username string) tu.DatabaseMutation {
+		return tu.User(
+			tu.UserInfo(username, "user", username, username + "@hppr.dev"),
+			tu.Source("LDAP"),
+			tu.GroupFromName("user group"),
+		)
+	}
+	return tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("stayuser", "user", "stayuser", "stayuser@hppr.dev"),
+				tu.Source("LDAP"),
+				tu.Group(
+					tu.GroupInfo("user group", "user group"),
+					tu.LDAPLink("main_ldap", "ldap_group"),
+					tu.Claim(
+						tu.ClaimInfo("user", "usr", "yes", "Grants user"),
+					),
+				),
+			),
+			ldapUser("leftuser"),
+			ldapUser("goneuser"),
+			ldapUser("disableduser"),
+		),
+	)
+}
+
+func newSyncTestLDAPServer() *MockLDAPConnector {
+	return NewMockLDAPServer(
+		LDAPUser("adminuser", "admin", "user", "admin@hppr.dev", "adminpass"),
+		LDAPUser("stayuser", "stay", "user", "stayuser@hppr.dev", "pass"),
+		LDAPGroup("stayuser", "ldap_group"),
+		LDAPUser("leftuser", "left", "user", "leftuser@hppr.dev", "pass"),
+		LDAPUser("disableduser", "disabled", "user", "disableduser@hppr.dev", "pass"),
+		LDAPGroup("disableduser", "ldap_group"),
+		LDAPDisabledUser("disableduser"),
+	)
+}
+
+func userGroupCount(username string, ctx context.Context) int {
+	u, err := ent.FromContext(ctx).User.Query().Where(user.UsernameEQ(username)).WithClaimGroups().Only(ctx)
+	if err != nil {
+		return -1
+	}
+	return len(u.Edges.ClaimGroups)
+}
+
+func TestLDAPSyncUsers(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		dryRun      bool
+		deprovision usr.LDAPDeprovision
+		// Expected group counts after the sync, -1 if the user is deleted
+		expected    map[string]int
+	}{
+		{ "dry run", true, usr.LDAP_DEPROVISION_DELETE, map[string]int{ "stayuser": 1, "leftuser": 1, "goneuser": 1, "disableduser": 1 } },
+		{ "report only", false, usr.LDAP_DEPROVISION_NONE, map[string]int{ "stayuser": 1, "leftuser": 0, "goneuser": 1, "disableduser": 1 } },
+		{ "remove groups", false, usr.LDAP_DEPROVISION_REMOVE_GROUPS, map[string]int{ "stayuser": 1, "leftuser": 0, "goneuser": 0, "disableduser": 0 } },
+		{ "delete", false, usr.LDAP_DEPROVISION_DELETE, map[string]int{ "stayuser": 1, "leftuser": 0, "goneuser": -1, "disableduser": -1 } },
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := ldapSyncTestContext(t)
+
+			groupTemplate, userTemplate := createTemplates()
+			ldapProvider := usr.NewLDAPUserProvider(
+				"main_ldap",
+				"ldap://someldap.server",
+				"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+				0,
+				groupTemplate, userTemplate,
+			)
+			ldapProvider.Deprovision = test.deprovision
+			ldapProvider.SyncMaxDeprovisionPercent = 50
+			ldapProvider.DisabledFilter = template.Must(template.New("disabled").Parse("userFilter:disabled-{{ .Username }}"))
+			ldapProvider.SetConnector(newSyncTestLDAPServer())
+
+			report, err := ldapProvider.SyncUsers(test.dryRun, ctx)
+			if err != nil {
+				t.Fatalf("Sync failed: %v", err)
+			}
+			if report.Checked != 4 || !slices.Equal(report.Updated, []string{ "leftuser" }) ||
+				!slices.Equal(report.Missing, []string{ "goneuser" }) || !slices.Equal(report.Disabled, []string{ "disableduser" }) {
+				t.Errorf("Unexpected report: %+v", report)
+			}
+			if test.deprovision != usr.LDAP_DEPROVISION_NONE && len(report.Deprovisioned) != 2 {
+				t.Errorf("Unexpected deprovisioned users: %v", report.Deprovisioned)
+			}
+
+			for username, groups := range test.expected {
+				if got := userGroupCount(username, ctx); got != groups {
+					t.Errorf("Expected %s to have %d groups, got %d", username, groups, got)
+				}
+			}
+		})
+	}
+}
+
+// Syncs that find more users gone than the limit allows deprovision nobody, i.e. when the search root is wrong
+func TestLDAPSyncDeprovisionLimit(t *testing.T) {
+	ctx := ldapSyncTestContext(t)
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.Deprovision = usr.LDAP_DEPROVISION_DELETE
+	ldapProvider.DisabledFilter = template.Must(template.New("disabled").Parse("userFilter:disabled-{{ .Username }}"))
+	ldapProvider.SetConnector(newSyncTestLDAPServer())
+
+	report, err := ldapProvider.SyncUsers(false, ctx)
+	if err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	if !report.DeprovisionBlocked || len(report.Deprovisioned) != 0 {
+		t.Errorf("Deprovisioning was not blocked: %+v", report)
+	}
+	for _, username := range []string{ "goneuser", "disableduser" } {
+		if userGroupCount(username, ctx) != 1 {
+			t.Errorf("%s was deprovisioned over the limit", username)
+		}
+	}
+	if userGroupCount("leftuser", ctx) != 0 {
+		t.Error("Group changes were not applied when deprovisioning was blocked")
+	}
+}
+
+// Users outside of groups linked to the provider are left alone
+func TestLDAPSyncIgnoresUnlinkedUsers(t *testing.T) {
+	ctx := tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("other", "user", "otheruser", "other@hppr.dev"),
+				tu.Source("LDAP"),
+				tu.Group(
+					tu.GroupInfo("other ldap group", "other ldap group"),
+					tu.LDAPLink("other_ldap", "ldap_group"),
+				),
+			),
+		),
+	)
+
+	groupTemplate, userTemplate := createTemplates()
+	ldapProvider := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	ldapProvider.Deprovision = usr.LDAP_DEPROVISION_DELETE
+	ldapProvider.SetConnector(newSyncTestLDAPServer())
+
+	report, err := ldapProvider.SyncUsers(false, ctx)
+	if err != nil || report.Checked != 0 {
+		t.Errorf("User linked to another provider was checked: %+v", report)
+	}
+	if userGroupCount("otheruser", ctx) != 1 {
+		t.Error("User linked to another provider was changed")
+	}
+}
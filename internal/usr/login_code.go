@@ -0,0 +1,162 @@
+package usr
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"slices"
+	"stoke/internal/ent"
+	"stoke/internal/ent/logincode"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// How long an application has to redeem a login code
+const loginCodeDuration = time.Minute
+
+// SessionIssuer issues a stoke token and refresh token for a user that authenticated with a provider.
+// Providers that complete logins on the server use it so provider tokens never reach the browser.
+type SessionIssuer func(user *ent.User, claims ent.Claims, ctx context.Context) (token, refresh string, err error)
+
+// completeServerLogin authenticates the user, issues tokens with the user's claims and redirects to target
+// with a one-time code for the tokens. Users with MFA enabled are redirected with an mfa_challenge instead,
+// which the application answers at /api/login/mfa. Failures are redirected to target with an error parameter.
+func completeServerLogin(res http.ResponseWriter, req *http.Request, target string, authenticate func(context.Context) (*ent.User, error), ctx context.Context) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "usr.completeServerLogin").
+		Logger()
+
+	redirect := func(key, value string) {
+		u, _ := url.Parse(target)
+		q := u.Query()
+		q.Set(key, value)
+		u.RawQuery = q.Encode()
+		http.Redirect(res, req, u.String(), http.StatusSeeOther)
+	}
+
+	issuer := sessionIssuerFromCtx(ctx)
+	if issuer == nil {
+		logger.Error().Msg("No session issuer available to complete login")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	ctx, passthrough := withPassthroughCollector(ctx)
+	user, err := authenticate(ctx)
+	if err != nil {
+		logger.Debug().Err(err).Msg("Could not update user claims")
+		redirect("error", "access_denied")
+		return
+	}
+
+	providers := ProviderFromCtx(ctx)
+	claims := append(allUserClaims(user), passthrough.Claims()...)
+	if providers.MFAEnrollmentRequired(user, claims) {
+		logger.Warn().Str("username", user.Username).Msg("Leaving stk claims out of token until the user enables MFA")
+		claims = WithoutAdminClaims(claims)
+	}
+
+	if providers.MFAEnabled(user) {
+		tokenMap := TokenClaims(claims)
+		tokenMap["amr"] = AMRFederated
+		challenge, err := providers.CreateMFAChallenge(user.Username, tokenMap, ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not create MFA challenge")
+			redirect("error", "server_error")
+			return
+		}
+		logger.Info().Str("username", user.Username).Msg("Login requires MFA")
+		redirect("mfa_challenge", challenge)
+		return
+	}
+
+	token, refresh, err := issuer(user, claims, ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not issue token")
+		redirect("error", "server_error")
+		return
+	}
+
+	code, err := createLoginCode(user.Username, token, refresh, ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not save login code")
+		redirect("error", "server_error")
+		return
+	}
+
+	logger.Info().Str("username", user.Username).Msg("Completed login")
+	redirect("code", code)
+}
+
+// TokenClaims merges claims into token claims, joining the values of claims with the same short name with commas
+func TokenClaims(claims ent.Claims) map[string]string {
+	tokenMap := make(map[string]string)
+	for _, c := range claims {
+		if value, exists := tokenMap[c.ShortName]; exists {
+			tokenMap[c.ShortName] = value + "," + c.Value
+		} else {
+			tokenMap[c.ShortName] = c.Value
+		}
+	}
+	return tokenMap
+}
+
+// FilteredTokenClaims is TokenClaims for the claims whose short name is in filter, or for all claims when filter is empty.
+// The provider session claim is always kept, even if it was filtered out, so refreshes are checked against the provider session
+func FilteredTokenClaims(claims ent.Claims, filter []string) map[string]string {
+	if len(filter) > 0 {
+		claims = slices.DeleteFunc(slices.Clone(claims), func(c *ent.Claim) bool {
+			return c.ShortName != SessionClaim && !slices.Contains(filter, c.ShortName)
+		})
+	}
+	return TokenClaims(claims)
+}
+
+// createLoginCode stores issued tokens under a new random one-time code
+func createLoginCode(username, token, refresh string, ctx context.Context) (string, error) {
+	db := ent.FromContext(ctx)
+	now := time.Now()
+
+	if _, err := db.LoginCode.Delete().Where(logincode.ExpiresLT(now)).Exec(ctx); err != nil {
+		return "", err
+	}
+
+	code := base64.RawURLEncoding.EncodeToString(newNonce())
+	err := db.LoginCode.Create().
+		SetCode(code).
+		SetUsername(username).
+		SetToken(token).
+		SetRefresh(refresh).
+		SetExpires(now.Add(loginCodeDuration)).
+		Exec(ctx)
+	return code, err
+}
+
+// RedeemLoginCode returns the username and tokens stored under code. Each code can only be redeemed once.
+// Returns LoginCodeError if the code is unknown, expired or already redeemed.
+func RedeemLoginCode(code string, ctx context.Context) (username, token, refresh string, err error) {
+	db := ent.FromContext(ctx)
+
+	stored, err := db.LoginCode.Query().
+		Where(
+			logincode.CodeEQ(code),
+			logincode.ExpiresGT(time.Now()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", "", "", LoginCodeError
+	} else if err != nil {
+		return "", "", "", err
+	}
+
+	deleted, err := db.LoginCode.Delete().Where(logincode.IDEQ(stored.ID)).Exec(ctx)
+	if err != nil {
+		return "", "", "", err
+	}
+	if deleted == 0 {
+		return "", "", "", LoginCodeError
+	}
+	return stored.Username, stored.Token, stored.Refresh, nil
+}
@@ -0,0 +1,518 @@
+package usr
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"stoke/internal/ent"
+	"stoke/internal/ent/mfachallenge"
+	"stoke/internal/ent/schema/policy"
+	"stoke/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/rs/zerolog"
+)
+
+// TOTP parameters from RFC 6238 that authenticator apps use by default
+const (
+	totpPeriod = 30
+	totpDigits = 6
+	// Codes of the steps before and after the current one are accepted to allow for clock drift
+	totpSkew = 1
+)
+
+const (
+	// Number of recovery codes given to users when they enable MFA
+	mfaRecoveryCodes = 10
+	// How long a login has to answer an MFA challenge when no duration is configured
+	defaultMFAChallengeTTL = 5 * time.Minute
+	// Wrong codes after which an MFA challenge is removed
+	mfaChallengeAttempts = 5
+	// Consecutive wrong codes of a user, over any number of challenges, after which their MFA is locked
+	mfaLockoutFailures = 5
+	// How long the first lockout lasts. Each further lockout doubles it, up to mfaLockoutMax
+	mfaLockoutBase = time.Minute
+	mfaLockoutMax = 24 * time.Hour
+)
+
+// Values of the amr claim (RFC 8176) of issued tokens
+const (
+	// Login with a password checked by stoke or its directory
+	AMRPassword = "pwd"
+	// Login completed by a foreign provider. Not registered by RFC 8176
+	AMRFederated = "fed"
+	// Appended to the amr claim of a login that answered an MFA challenge
+	AMRMFA = "otp,mfa"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// SecretCipher seals secrets before they are stored in the database, i.e. key.KeyCipher
+type SecretCipher interface {
+	Encrypt(text string) (string, error)
+	Decrypt(text string) (string, error)
+}
+
+// MFA configures TOTP multi-factor authentication for local users.
+// Users with MFA enabled must answer a challenge with a code from their authenticator, or a recovery code, after their password is checked.
+type MFA struct {
+	// Seals the TOTP secrets of users. MFA is not available when nil
+	Cipher SecretCipher
+	// Issuer shown in authenticator apps
+	Issuer string
+	// Local users holding stk claims must enable MFA. Their stk claims are left out of tokens until they do
+	RequireForAdmins bool
+	// How long a login has to answer an MFA challenge
+	ChallengeTTL time.Duration
+}
+
+func (m MFA) available(u *ent.User) bool {
+	return m.Cipher != nil && u.Source == LOCAL_SOURCE
+}
+
+func (m MFA) challengeTTL() time.Duration {
+	if m.ChallengeTTL > 0 {
+		return m.ChallengeTTL
+	}
+	return defaultMFAChallengeTTL
+}
+
+// SetMFA sets the multi-factor authentication settings for local users
+func (p *ProviderList) SetMFA(mfa MFA) {
+	p.foreignMutex.Lock()
+	defer p.foreignMutex.Unlock()
+	p.mfa = mfa
+}
+
+func (p *ProviderList) mfaSettings() MFA {
+	p.foreignMutex.RLock()
+	defer p.foreignMutex.RUnlock()
+	return p.mfa
+}
+
+// MFAEnabled reports whether a login of u must answer an MFA challenge before a token is issued
+func (p *ProviderList) MFAEnabled(u *ent.User) bool {
+	return p.mfaSettings().available(u) && u.MfaEnabled
+}
+
+// MFAEnrollmentRequired reports whether claims hold stk claims that u may only receive after enabling MFA
+func (p *ProviderList) MFAEnrollmentRequired(u *ent.User, claims ent.Claims) bool {
+	m := p.mfaSettings()
+	return m.RequireForAdmins && m.available(u) && !u.MfaEnabled && slices.ContainsFunc(claims, isAdminClaim)
+}
+
+// WithoutAdminClaims returns claims without stk claims
+func WithoutAdminClaims(claims ent.Claims) ent.Claims {
+	return slices.DeleteFunc(slices.Clone(claims), isAdminClaim)
+}
+
+func isAdminClaim(c *ent.Claim) bool {
+	return c.ShortName == "stk"
+}
+
+// EnrollMFA starts TOTP enrollment for the local user with username.
+// Returns the secret to enter in an authenticator app and an otpauth:// url for QR codes.
+// The secret replaces any unfinished enrollment and is only used once ActivateMFA verifies a code made with it.
+func (p *ProviderList) EnrollMFA(username string, ctx context.Context) (secret, uri string, err error) {
+	m := p.mfaSettings()
+	u, err := retreiveLocalUser(username, ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if !m.available(u) {
+		return "", "", MFANotAvailableError
+	}
+	if u.MfaEnabled {
+		return "", "", MFAEnabledError
+	}
+
+	raw := make([]byte, 20)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	secret = totpEncoding.EncodeToString(raw)
+	sealed, err := m.Cipher.Encrypt(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = u.Update().
+		SetMfaSecret(sealed).
+		SetMfaEnabled(false).
+		ClearMfaRecoveryCodes().
+		ClearMfaLastStep().
+		Exec(policy.BypassDatabasePolicies(ctx))
+	if err != nil {
+		return "", "", err
+	}
+
+	issuer := m.Issuer
+	if issuer == "" {
+		issuer = "stoke"
+	}
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(totpDigits))
+	q.Set("period", fmt.Sprint(totpPeriod))
+	uri = (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + u.Username,
+		RawQuery: q.Encode(),
+	}).String()
+	return secret, uri, nil
+}
+
+// ActivateMFA enables MFA for the user with username when code was made with the secret from EnrollMFA.
+// Returns the user's recovery codes. They are only stored hashed and can not be shown again.
+func (p *ProviderList) ActivateMFA(username, code string, ctx context.Context) ([]string, error) {
+	m := p.mfaSettings()
+	u, err := retreiveLocalUser(username, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !m.available(u) {
+		return nil, MFANotAvailableError
+	}
+	if u.MfaEnabled {
+		return nil, MFAEnabledError
+	}
+	if u.MfaSecret == "" {
+		return nil, MFANotEnrolledError
+	}
+	if err := m.checkTOTP(u, code, ctx); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = u.Update().
+		SetMfaEnabled(true).
+		SetMfaRecoveryCodes(hashes).
+		Exec(policy.BypassDatabasePolicies(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("component", "usr.ActivateMFA").
+		Str("username", u.Username).
+		Msg("Enabled MFA")
+	return codes, nil
+}
+
+// DisableMFA turns MFA off for the user with username when code is a valid TOTP or recovery code
+func (p *ProviderList) DisableMFA(username, code string, ctx context.Context) error {
+	m := p.mfaSettings()
+	u, err := retreiveLocalUser(username, ctx)
+	if err != nil {
+		return err
+	}
+	if !m.available(u) {
+		return MFANotAvailableError
+	}
+	if !u.MfaEnabled {
+		return MFANotEnabledError
+	}
+	if err := m.verifyCode(u, code, ctx); err != nil {
+		return err
+	}
+	return clearMFA(u, policy.BypassDatabasePolicies(ctx))
+}
+
+// ResetMFA turns MFA off for the user with username without a code.
+// It is meant for administrators helping users that lost their authenticator and recovery codes,
+// so the user mutation policies apply to the change.
+func ResetMFA(username string, ctx context.Context) error {
+	u, err := retreiveLocalUser(username, ctx)
+	if err != nil {
+		return err
+	}
+	return clearMFA(u, ctx)
+}
+
+func clearMFA(u *ent.User, ctx context.Context) error {
+	zerolog.Ctx(ctx).Info().
+		Str("component", "usr.clearMFA").
+		Str("username", u.Username).
+		Msg("Disabling MFA")
+	return u.Update().
+		ClearMfaSecret().
+		SetMfaEnabled(false).
+		ClearMfaRecoveryCodes().
+		ClearMfaLastStep().
+		SetMfaFailures(0).
+		ClearMfaLockedUntil().
+		Exec(ctx)
+}
+
+// CreateMFAChallenge stores the token claims of a password login of username until the challenge is answered
+func (p *ProviderList) CreateMFAChallenge(username string, claims map[string]string, ctx context.Context) (string, error) {
+	db := ent.FromContext(ctx)
+	now := time.Now()
+
+	if _, err := db.MFAChallenge.Delete().Where(mfachallenge.ExpiresLT(now)).Exec(ctx); err != nil {
+		return "", err
+	}
+
+	challenge := base64.RawURLEncoding.EncodeToString(newNonce())
+	err := db.MFAChallenge.Create().
+		SetChallenge(challenge).
+		SetUsername(username).
+		SetClaims(claims).
+		SetExpires(now.Add(p.mfaSettings().challengeTTL())).
+		Exec(ctx)
+	return challenge, err
+}
+
+// AnswerMFAChallenge returns the user and token claims stored with challenge when code is a valid TOTP or unused recovery code of the user.
+// A challenge can only be answered once, and is removed after too many wrong codes.
+// Returns MFAChallengeError if the challenge is unknown or expired, MFACodeError if the code is wrong and MFALockedError if the user made too many wrong guesses.
+func (p *ProviderList) AnswerMFAChallenge(challenge, code string, ctx context.Context) (*ent.User, map[string]string, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "usr.AnswerMFAChallenge").
+		Logger()
+
+	m := p.mfaSettings()
+	db := ent.FromContext(ctx)
+
+	stored, err := db.MFAChallenge.Query().
+		Where(
+			mfachallenge.ChallengeEQ(challenge),
+			mfachallenge.ExpiresGT(time.Now()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, MFAChallengeError
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := retreiveLocalUser(stored.Username, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !m.available(u) || !u.MfaEnabled {
+		return nil, nil, MFAChallengeError
+	}
+
+	if err := m.verifyCode(u, code, ctx); err != nil {
+		logger.Warn().
+			Str("username", u.Username).
+			Int("attempts", stored.Attempts + 1).
+			Msg("Wrong MFA code")
+		if stored.Attempts + 1 >= mfaChallengeAttempts {
+			if derr := db.MFAChallenge.DeleteOne(stored).Exec(ctx); derr != nil {
+				return nil, nil, derr
+			}
+		} else if uerr := db.MFAChallenge.UpdateOne(stored).AddAttempts(1).Exec(ctx); uerr != nil {
+			return nil, nil, uerr
+		}
+		return nil, nil, err
+	}
+
+	deleted, err := db.MFAChallenge.Delete().Where(mfachallenge.IDEQ(stored.ID)).Exec(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if deleted == 0 {
+		return nil, nil, MFAChallengeError
+	}
+	return u, stored.Claims, nil
+}
+
+// verifyCode checks code like checkCode while limiting the guesses of a user.
+// Every mfaLockoutFailures consecutive wrong codes lock the user's MFA for a duration that doubles with each lockout,
+// so logging in again for a new challenge does not allow more guesses.
+func (m MFA) verifyCode(u *ent.User, code string, ctx context.Context) error {
+	if time.Now().Before(u.MfaLockedUntil) {
+		return MFALockedError
+	}
+
+	err := m.checkCode(u, code, ctx)
+	if errors.Is(err, MFACodeError) {
+		return recordMFAFailure(u, ctx)
+	}
+	if err != nil || u.MfaFailures == 0 {
+		return err
+	}
+	return u.Update().
+		SetMfaFailures(0).
+		ClearMfaLockedUntil().
+		Exec(policy.BypassDatabasePolicies(ctx))
+}
+
+func recordMFAFailure(u *ent.User, ctx context.Context) error {
+	updated, err := u.Update().
+		AddMfaFailures(1).
+		Save(policy.BypassDatabasePolicies(ctx))
+	if err != nil {
+		return err
+	}
+	if updated.MfaFailures % mfaLockoutFailures != 0 {
+		return MFACodeError
+	}
+
+	lockout := mfaLockoutMax
+	if lockouts := updated.MfaFailures / mfaLockoutFailures; lockouts <= 20 {
+		lockout = min(mfaLockoutBase << (lockouts - 1), mfaLockoutMax)
+	}
+	zerolog.Ctx(ctx).Warn().
+		Str("component", "usr.MFA").
+		Str("username", u.Username).
+		Int("failures", updated.MfaFailures).
+		Dur("lockout", lockout).
+		Msg("Locking MFA after too many wrong codes")
+	err = updated.Update().
+		SetMfaLockedUntil(time.Now().Add(lockout)).
+		Exec(policy.BypassDatabasePolicies(ctx))
+	if err != nil {
+		return err
+	}
+	return MFACodeError
+}
+
+// checkCode checks a TOTP code or uses up a recovery code of u
+func (m MFA) checkCode(u *ent.User, code string, ctx context.Context) error {
+	normalized := normalizeMFACode(code)
+	if len(normalized) == totpDigits {
+		return m.checkTOTP(u, normalized, ctx)
+	}
+
+	hash := hashRecoveryCode(normalized)
+	i := slices.IndexFunc(u.MfaRecoveryCodes, func(stored string) bool {
+		return subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) == 1
+	})
+	if i < 0 {
+		return MFACodeError
+	}
+
+	// Only remove the code if no other login changed the codes since they were read, so concurrent logins can not both use it
+	updated, err := ent.FromContext(ctx).User.Update().
+		Where(
+			user.IDEQ(u.ID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(s.C(user.FieldMfaRecoveryCodes), hash))
+				s.Where(sqljson.LenEQ(s.C(user.FieldMfaRecoveryCodes), len(u.MfaRecoveryCodes)))
+			},
+		).
+		SetMfaRecoveryCodes(slices.Delete(slices.Clone(u.MfaRecoveryCodes), i, i + 1)).
+		Save(policy.BypassDatabasePolicies(ctx))
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return MFACodeError
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("component", "usr.MFA").
+		Str("username", u.Username).
+		Int("remaining", len(u.MfaRecoveryCodes) - 1).
+		Msg("Used a recovery code")
+	return nil
+}
+
+// checkTOTP checks code against the user's secret. Each time step can only be used once, so a seen code can not be replayed.
+func (m MFA) checkTOTP(u *ent.User, code string, ctx context.Context) error {
+	secret, err := m.Cipher.Decrypt(u.MfaSecret)
+	if err != nil {
+		return err
+	}
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		return err
+	}
+
+	code = normalizeMFACode(code)
+	now := time.Now().Unix() / totpPeriod
+	for step := now - totpSkew; step <= now + totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) != 1 {
+			continue
+		}
+		// Only move forward, so concurrent logins can not both use the step
+		updated, err := ent.FromContext(ctx).User.Update().
+			Where(
+				user.IDEQ(u.ID),
+				user.Or(
+					user.MfaLastStepIsNil(),
+					user.MfaLastStepLT(step),
+				),
+			).
+			SetMfaLastStep(step).
+			Save(policy.BypassDatabasePolicies(ctx))
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return MFACodeError
+		}
+		return nil
+	}
+	return MFACodeError
+}
+
+// TOTPCode returns the RFC 6238 code of the base32 encoded secret at time t
+func TOTPCode(secret string, t time.Time) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+	return totpCode(key, t.Unix() / totpPeriod), nil
+}
+
+func totpCode(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum) - 1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset + 4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value % 1000000)
+}
+
+// newRecoveryCodes returns recovery codes formatted for users and their hashes for storage
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, mfaRecoveryCodes)
+	hashes := make([]string, mfaRecoveryCodes)
+	for i := range codes {
+		raw := make([]byte, 10)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := totpEncoding.EncodeToString(raw)
+		codes[i] = strings.Join([]string{ code[0:4], code[4:8], code[8:12], code[12:16] }, "-")
+		hashes[i] = hashRecoveryCode(code)
+	}
+	return codes, hashes, nil
+}
+
+// Recovery codes hold 80 random bits, so a fast hash is enough
+func hashRecoveryCode(normalized string) string {
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeMFACode(code string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
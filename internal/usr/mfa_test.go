@@ -0,0 +1,401 @@
+package usr_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"stoke/internal/ent"
+	"stoke/internal/ent/schema/policy"
+	"stoke/internal/ent/user"
+	"stoke/internal/key"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mfaTestContext(t *testing.T, mfa usr.MFA, opts ...tu.ContextOption) (*usr.ProviderList, context.Context) {
+	ctx := tu.NewMockContext(append([]tu.ContextOption{
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("local", "user", "localuser", "user@local"),
+				tu.Source("LOCAL"),
+				tu.Password("localpass"),
+				tu.Group(
+					tu.GroupInfo("admins", "stoke admins"),
+					tu.Claim(
+						tu.ClaimInfo("stoke-admin", "stk", "S", "Grants stoke superuser"),
+					),
+				),
+			),
+			tu.User(
+				tu.UserInfo("ldap", "user", "ldapuser", "user@ldap"),
+				tu.Source("LDAP"),
+			),
+		),
+	}, opts...)...)
+	if mfa.Cipher == nil {
+		cipher, err := key.NewKeyCipher("mfa-test-secret")
+		if err != nil {
+			t.Fatalf("Could not create cipher: %v", err)
+		}
+		mfa.Cipher = cipher
+	}
+	pl := usr.NewProviderList()
+	pl.SetMFA(mfa)
+	return pl, pl.WithContext(ctx)
+}
+
+func mfaCode(t *testing.T, secret string, at time.Time) string {
+	code, err := usr.TOTPCode(secret, at)
+	if err != nil {
+		t.Fatalf("Could not create TOTP code: %v", err)
+	}
+	return code
+}
+
+// enableMFA enrolls and activates MFA for localuser, returning the secret, the time of the activation code and the recovery codes
+func enableMFA(t *testing.T, pl *usr.ProviderList, ctx context.Context) (string, time.Time, []string) {
+	secret, uri, err := pl.EnrollMFA("localuser", ctx)
+	if err != nil {
+		t.Fatalf("Could not enroll: %v", err)
+	}
+	if !strings.HasPrefix(uri, "otpauth://totp/") || !strings.Contains(uri, "secret=" + secret) {
+		t.Errorf("Enrollment returned an unexpected uri: %s", uri)
+	}
+	activated := time.Now()
+	codes, err := pl.ActivateMFA("localuser", mfaCode(t, secret, activated), ctx)
+	if err != nil {
+		t.Fatalf("Could not activate: %v", err)
+	}
+	return secret, activated, codes
+}
+
+func localUser(ctx context.Context) *ent.User {
+	return ent.FromContext(ctx).User.Query().Where(user.UsernameEQ("localuser")).OnlyX(ctx)
+}
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	// SHA1 test vectors from RFC 6238 appendix B, truncated to 6 digits
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for ts, expected := range vectors {
+		if code := mfaCode(t, secret, time.Unix(ts, 0)); code != expected {
+			t.Errorf("Wrong code at %d: %s != %s", ts, code, expected)
+		}
+	}
+}
+
+func TestMFAEnrollment(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+
+	secret, _, err := pl.EnrollMFA("localuser", ctx)
+	if err != nil {
+		t.Fatalf("Could not enroll: %v", err)
+	}
+	if stored := localUser(ctx).MfaSecret; stored == "" || stored == secret {
+		t.Errorf("Secret was not stored encrypted: %s", stored)
+	}
+	if _, err := pl.ActivateMFA("localuser", "not-a-code", ctx); !errors.Is(err, usr.MFACodeError) {
+		t.Errorf("Wrong code activated MFA: %v", err)
+	}
+	if pl.MFAEnabled(localUser(ctx)) {
+		t.Fatal("MFA was enabled before a valid code was given")
+	}
+
+	codes, err := pl.ActivateMFA("localuser", mfaCode(t, secret, time.Now()), ctx)
+	if err != nil {
+		t.Fatalf("Could not activate: %v", err)
+	}
+	if len(codes) != 10 {
+		t.Errorf("Unexpected number of recovery codes: %v", codes)
+	}
+	if !pl.MFAEnabled(localUser(ctx)) {
+		t.Error("MFA was not enabled")
+	}
+	if _, _, err := pl.EnrollMFA("localuser", ctx); !errors.Is(err, usr.MFAEnabledError) {
+		t.Errorf("Enrolled again while MFA was enabled: %v", err)
+	}
+}
+
+func TestMFANotAvailable(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+	if _, _, err := pl.EnrollMFA("ldapuser", ctx); !errors.Is(err, usr.MFANotAvailableError) {
+		t.Errorf("Foreign user enrolled: %v", err)
+	}
+
+	pl.SetMFA(usr.MFA{})
+	if _, _, err := pl.EnrollMFA("localuser", ctx); !errors.Is(err, usr.MFANotAvailableError) {
+		t.Errorf("Local user enrolled without MFA configured: %v", err)
+	}
+}
+
+func TestMFAChallenge(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+	secret, activated, _ := enableMFA(t, pl, ctx)
+	next := activated.Add(30 * time.Second)
+
+	challenge, err := pl.CreateMFAChallenge("localuser", map[string]string{ "stk": "S" }, ctx)
+	if err != nil {
+		t.Fatalf("Could not create challenge: %v", err)
+	}
+
+	// The code used for activation can not be replayed
+	if _, _, err := pl.AnswerMFAChallenge(challenge, mfaCode(t, secret, activated), ctx); !errors.Is(err, usr.MFACodeError) {
+		t.Errorf("Replayed code answered challenge: %v", err)
+	}
+
+	u, claims, err := pl.AnswerMFAChallenge(challenge, mfaCode(t, secret, next), ctx)
+	if err != nil {
+		t.Fatalf("Could not answer challenge: %v", err)
+	}
+	if u.Username != "localuser" || claims["stk"] != "S" {
+		t.Errorf("Challenge returned the wrong user or claims: %s %v", u.Username, claims)
+	}
+
+	if _, _, err := pl.AnswerMFAChallenge(challenge, mfaCode(t, secret, next.Add(30 * time.Second)), ctx); !errors.Is(err, usr.MFAChallengeError) {
+		t.Errorf("Challenge was answered twice: %v", err)
+	}
+}
+
+func TestMFAChallengeExpires(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{ ChallengeTTL: time.Millisecond })
+	secret, activated, _ := enableMFA(t, pl, ctx)
+
+	challenge, err := pl.CreateMFAChallenge("localuser", map[string]string{}, ctx)
+	if err != nil {
+		t.Fatalf("Could not create challenge: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if _, _, err := pl.AnswerMFAChallenge(challenge, mfaCode(t, secret, activated.Add(30 * time.Second)), ctx); !errors.Is(err, usr.MFAChallengeError) {
+		t.Errorf("Expired challenge was answered: %v", err)
+	}
+}
+
+func TestMFAChallengeAttempts(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+	secret, activated, _ := enableMFA(t, pl, ctx)
+
+	challenge, err := pl.CreateMFAChallenge("localuser", map[string]string{}, ctx)
+	if err != nil {
+		t.Fatalf("Could not create challenge: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, _, err := pl.AnswerMFAChallenge(challenge, "not-a-code", ctx); !errors.Is(err, usr.MFACodeError) {
+			t.Fatalf("Wrong code was accepted: %v", err)
+		}
+	}
+
+	if _, _, err := pl.AnswerMFAChallenge(challenge, mfaCode(t, secret, activated.Add(30 * time.Second)), ctx); !errors.Is(err, usr.MFAChallengeError) {
+		t.Errorf("Challenge was kept after too many wrong codes: %v", err)
+	}
+}
+
+func TestMFARecoveryCode(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+	_, _, codes := enableMFA(t, pl, ctx)
+
+	for i, expected := range []error{ nil, usr.MFACodeError } {
+		challenge, err := pl.CreateMFAChallenge("localuser", map[string]string{}, ctx)
+		if err != nil {
+			t.Fatalf("Could not create challenge: %v", err)
+		}
+		if _, _, err := pl.AnswerMFAChallenge(challenge, strings.ToLower(codes[0]), ctx); !errors.Is(err, expected) {
+			t.Errorf("Unexpected result using recovery code %d times: %v", i + 1, err)
+		}
+	}
+	if remaining := len(localUser(ctx).MfaRecoveryCodes); remaining != 9 {
+		t.Errorf("Recovery code was not used up: %d remain", remaining)
+	}
+}
+
+func TestMFADisable(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+	_, _, codes := enableMFA(t, pl, ctx)
+
+	if err := pl.DisableMFA("localuser", "ZZZZ-ZZZZ-ZZZZ-ZZZZ", ctx); !errors.Is(err, usr.MFACodeError) {
+		t.Errorf("MFA was disabled with a wrong code: %v", err)
+	}
+	if err := pl.DisableMFA("localuser", codes[1], ctx); err != nil {
+		t.Fatalf("Could not disable MFA: %v", err)
+	}
+	if u := localUser(ctx); pl.MFAEnabled(u) || u.MfaSecret != "" {
+		t.Error("MFA was not disabled")
+	}
+	if err := pl.DisableMFA("localuser", codes[2], ctx); !errors.Is(err, usr.MFANotEnabledError) {
+		t.Errorf("Disabled MFA twice: %v", err)
+	}
+}
+
+func TestMFARequireForAdmins(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{ RequireForAdmins: true })
+	claims := ent.Claims{
+		{ ShortName: "stk", Value: "S" },
+		{ ShortName: "role", Value: "dev" },
+	}
+
+	if !pl.MFAEnrollmentRequired(localUser(ctx), claims) {
+		t.Fatal("Admin without MFA was not required to enroll")
+	}
+	if filtered := usr.WithoutAdminClaims(claims); len(filtered) != 1 || filtered[0].ShortName != "role" {
+		t.Errorf("Admin claims were not removed: %v", filtered)
+	}
+	if pl.MFAEnrollmentRequired(localUser(ctx), claims[1:]) {
+		t.Error("User without admin claims was required to enroll")
+	}
+
+	enableMFA(t, pl, ctx)
+	if pl.MFAEnrollmentRequired(localUser(ctx), claims) {
+		t.Error("Admin with MFA enabled was required to enroll")
+	}
+}
+
+// Wrong codes are counted per user, so logging in again for new challenges does not allow more guesses
+func TestMFALockoutSurvivesNewChallenges(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+	secret, activated, _ := enableMFA(t, pl, ctx)
+
+	for i := 0; i < 5; i++ {
+		challenge, err := pl.CreateMFAChallenge("localuser", map[string]string{}, ctx)
+		if err != nil {
+			t.Fatalf("Could not create challenge: %v", err)
+		}
+		if _, _, err := pl.AnswerMFAChallenge(challenge, "not-a-code", ctx); !errors.Is(err, usr.MFACodeError) {
+			t.Fatalf("Wrong code was accepted: %v", err)
+		}
+	}
+
+	challenge, err := pl.CreateMFAChallenge("localuser", map[string]string{}, ctx)
+	if err != nil {
+		t.Fatalf("Could not create challenge: %v", err)
+	}
+	next := mfaCode(t, secret, activated.Add(30 * time.Second))
+	if _, _, err := pl.AnswerMFAChallenge(challenge, next, ctx); !errors.Is(err, usr.MFALockedError) {
+		t.Fatalf("Locked user answered a challenge: %v", err)
+	}
+	if u := localUser(ctx); u.MfaLockedUntil.Before(time.Now()) || u.MfaLockedUntil.After(time.Now().Add(time.Minute)) {
+		t.Errorf("Unexpected lockout: %v", u.MfaLockedUntil)
+	}
+
+	// Once the lockout is over a valid code resets the count
+	localUser(ctx).Update().SetMfaLockedUntil(time.Now().Add(-time.Second)).ExecX(ctx)
+	if _, _, err := pl.AnswerMFAChallenge(challenge, next, ctx); err != nil {
+		t.Fatalf("Valid code was rejected after the lockout: %v", err)
+	}
+	if failures := localUser(ctx).MfaFailures; failures != 0 {
+		t.Errorf("Wrong codes were not reset: %d", failures)
+	}
+}
+
+// Each lockout lasts twice as long as the one before
+func TestMFALockoutDoubles(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{})
+	enableMFA(t, pl, ctx)
+	localUser(ctx).Update().SetMfaFailures(9).ExecX(ctx)
+
+	challenge, err := pl.CreateMFAChallenge("localuser", map[string]string{}, ctx)
+	if err != nil {
+		t.Fatalf("Could not create challenge: %v", err)
+	}
+	if _, _, err := pl.AnswerMFAChallenge(challenge, "not-a-code", ctx); !errors.Is(err, usr.MFACodeError) {
+		t.Fatalf("Wrong code was accepted: %v", err)
+	}
+	if lockout := time.Until(localUser(ctx).MfaLockedUntil); lockout < time.Minute || lockout > 2 * time.Minute {
+		t.Errorf("Second lockout did not double: %v", lockout)
+	}
+}
+
+// Administrators reset MFA through the user policies, so protected users and read-only mode are respected
+func TestResetMFAPolicies(t *testing.T) {
+	pl, ctx := mfaTestContext(t, usr.MFA{},
+		tu.EnforcePolicies(),
+		tu.WithToken(t,
+			tu.WithTokenClaim("stk", "U"),
+			tu.WithTokenClaim("u", "admin"),
+		),
+	)
+	enableMFA(t, pl, ctx)
+
+	policy.ReconfigurePolicies([]string{ "localuser" }, nil, nil, "u", false, true, ctx)
+	if err := usr.ResetMFA("localuser", ctx); err == nil {
+		t.Error("MFA of a protected user was reset")
+	}
+	policy.ReconfigurePolicies(nil, nil, nil, "u", true, true, ctx)
+	if err := usr.ResetMFA("localuser", ctx); err == nil {
+		t.Error("MFA was reset in read-only mode")
+	}
+	if !localUser(ctx).MfaEnabled {
+		t.Fatal("Denied reset disabled MFA")
+	}
+
+	policy.ReconfigurePolicies(nil, nil, nil, "u", false, true, ctx)
+	if err := usr.ResetMFA("localuser", ctx); err != nil {
+		t.Fatalf("Administrator could not reset MFA: %v", err)
+	}
+	if localUser(ctx).MfaEnabled {
+		t.Error("Reset did not disable MFA")
+	}
+}
+
+// Logins completed by a foreign provider for a local user with MFA enabled return a challenge instead of a login code
+func TestMFAServerCompletedLogin(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	signingKey := fixture.addKey(t, "k1")
+	ctx := usr.WithSessionIssuer(
+		func(*ent.User, ent.Claims, context.Context) (string, string, error) {
+			return "token", "refresh", nil
+		},
+		oidcTestContext(t),
+	)
+	pl := usr.ProviderFromCtx(ctx)
+	cipher, err := key.NewKeyCipher("mfa-test-secret")
+	if err != nil {
+		t.Fatalf("Could not create cipher: %v", err)
+	}
+	pl.SetMFA(usr.MFA{ Cipher: cipher })
+
+	secret, _, err := pl.EnrollMFA("other", ctx)
+	if err != nil {
+		t.Fatalf("Could not enroll: %v", err)
+	}
+	activated := time.Now()
+	if _, err := pl.ActivateMFA("other", mfaCode(t, secret, activated), ctx); err != nil {
+		t.Fatalf("Could not activate: %v", err)
+	}
+	p := fixture.codeFlowProvider("/done")
+	pl.AddForeignProvider("test_oidc", p.(OIDCProvider))
+	if _, err := pl.LinkIdentitySubject("other", "test_oidc", "1234", ctx); err != nil {
+		t.Fatalf("Could not link identity: %v", err)
+	}
+
+	params := startAuth(t, p, ctx, "")
+	claims := validIDClaims()
+	claims["nonce"] = params.Get("nonce")
+	fixture.mu.Lock()
+	fixture.challenge = params.Get("code_challenge")
+	fixture.idToken = signIDToken(t, signingKey, "k1", claims)
+	fixture.mu.Unlock()
+
+	res := callback(p, ctx, params.Get("state"))
+	location, _ := url.Parse(res.Header().Get("Location"))
+	challenge := location.Query().Get("mfa_challenge")
+	if res.Code != http.StatusSeeOther || challenge == "" || location.Query().Has("code") {
+		t.Fatalf("Expected redirect with an MFA challenge, got %d %s", res.Code, location)
+	}
+
+	u, tokenMap, err := pl.AnswerMFAChallenge(challenge, mfaCode(t, secret, activated.Add(30 * time.Second)), ctx)
+	if err != nil {
+		t.Fatalf("Could not answer challenge: %v", err)
+	}
+	if u.Username != "other" || tokenMap["amr"] != usr.AMRFederated || tokenMap["adm"] != "Y" {
+		t.Errorf("Unexpected challenge claims for %s: %v", u.Username, tokenMap)
+	}
+}
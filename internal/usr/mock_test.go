@@ -18,6 +18,10 @@ var (
 type MockLDAPConnector struct{
 	mockClient *MockLDAPClient
 	connectError error
+	// Servers that refuse connections
+	downURLs     map[string]bool
+	// Every url Connect was called with, in order
+	connects     []string
 }
 
 type MockLDAPClient struct{
@@ -27,6 +31,12 @@ type MockLDAPClient struct{
 	userSearchError  error
 	groupSearchError error
 	boundAs          string
+	// Every username Bind was called with, in order
+	binds            []string
+	// Server name of the last StartTLS call
+	startTLSName     string
+	// Every modify request, in order
+	modifies         []*ldap.ModifyRequest
 }
 
 type MockAttributes map[string][]string
@@ -44,6 +54,7 @@ func NewMockLDAPServer(opts ...MockLDAPOption) *MockLDAPConnector {
 			userPassMap: make(map[string]string),
 			searchDB:    make(MockSearchDB),
 		},
+		downURLs: make(map[string]bool),
 	}
 
 	for _, opt := range opts {
@@ -53,13 +64,26 @@ func NewMockLDAPServer(opts ...MockLDAPOption) *MockLDAPConnector {
 }
 
 // "Connects" to a mock client
-func (c MockLDAPConnector) Connect(string, ...ldap.DialOpt) (ldap.Client, error) {
+func (c *MockLDAPConnector) Connect(url string, _ ...ldap.DialOpt) (ldap.Client, error) {
+	c.connects = append(c.connects, url)
 	if c.connectError != nil {
 		return nil, c.connectError
 	}
+	if c.downURLs[url] {
+		return nil, errors.New("connection refused")
+	}
 	return c.mockClient, nil
 }
 
+// Refuse connections to the given urls
+func DownServers(urls ...string) MockLDAPOption {
+	return func (c *MockLDAPConnector) {
+		for _, u := range urls {
+			c.downURLs[u] = true
+		}
+	}
+}
+
 // Return err on call to Connect
 func ConnectError(err error) MockLDAPOption {
 	return func (c *MockLDAPConnector) {
@@ -106,6 +130,13 @@ func LDAPUser(username, first, last, email, password string) MockLDAPOption {
 	}
 }
 
+// Set attribute values of a User created with LDAPUser
+func LDAPUserAttribute(username, attribute string, values ...string) MockLDAPOption {
+	return func(c *MockLDAPConnector) {
+		c.mockClient.searchDB["user:" + username][0][username][attribute] = values
+	}
+}
+
 // Create a group in the mock LDAP
 func LDAPGroup(username, groupName string) MockLDAPOption {
 	return func(c *MockLDAPConnector) {
@@ -120,6 +151,32 @@ func LDAPGroup(username, groupName string) MockLDAPOption {
 	}
 }
 
+// Mark a user as disabled. Disabled users are found with the filter userFilter:disabled-{{ .Username }}
+func LDAPDisabledUser(username string) MockLDAPOption {
+	return func(c *MockLDAPConnector) {
+		c.mockClient.searchDB["user:disabled-" + username] = MockSearchResult{
+			MockEntry{
+				username : MockAttributes{},
+			},
+		}
+	}
+}
+
+// Add a group with a distinguished name to the groups returned when searching for member
+// Unlike LDAPGroup, a member may be in several groups
+func LDAPGroupMember(member, groupDN, groupName string) MockLDAPOption {
+	return func(c *MockLDAPConnector) {
+		c.mockClient.searchDB["group:" + member] = append(
+			c.mockClient.searchDB["group:" + member],
+			MockEntry{
+				groupDN : MockAttributes{
+					"group_name" : []string{ groupName },
+				},
+			},
+		)
+	}
+}
+
 // Convert a mock entry to an ldap entry
 func (e MockEntry) toEntry() *ldap.Entry {
 	// Entries should only have one key
@@ -145,6 +202,7 @@ func (r MockSearchResult) toSearchResult() *ldap.SearchResult {
 // Bind implements ldap.Client.
 // Checks whether the password matches
 func (m *MockLDAPClient) Bind(username string, password string) error {
+	m.binds = append(m.binds, username)
 	if m.bindError != nil{
 		return m.bindError
 	}
@@ -196,11 +254,42 @@ func (m *MockLDAPClient) Close() error {
 // Start implements ldap.Client.
 func (m *MockLDAPClient) Start() {}
 // StartTLS implements ldap.Client.
-func (m *MockLDAPClient) StartTLS(*tls.Config) error { return nil }
+func (m *MockLDAPClient) StartTLS(config *tls.Config) error {
+	m.startTLSName = config.ServerName
+	return nil
+}
 // SetTimeout implements ldap.Client.
 func (m *MockLDAPClient) SetTimeout(time.Duration) {}
 // Unbind implements ldap.Client.
 func (m *MockLDAPClient) Unbind() error { return nil}
+// IsClosing implements ldap.Client.
+func (m *MockLDAPClient) IsClosing() bool { return false }
+
+// PasswordModify implements ldap.Client.
+// Passwords shorter than 8 characters violate the mock password policy
+func (m *MockLDAPClient) PasswordModify(req *ldap.PasswordModifyRequest) (*ldap.PasswordModifyResult, error) {
+	if m.boundAs == "" {
+		return nil, NotBoundError
+	}
+	if m.boundAs != req.UserIdentity && m.boundAs != "adminuser" {
+		return nil, ldap.NewError(ldap.LDAPResultInsufficientAccessRights, errors.New("not allowed"))
+	}
+	if len(req.NewPassword) < 8 {
+		return nil, ldap.NewError(ldap.LDAPResultConstraintViolation, errors.New("password is too short"))
+	}
+	m.userPassMap[req.UserIdentity] = req.NewPassword
+	return &ldap.PasswordModifyResult{}, nil
+}
+
+// Modify implements ldap.Client.
+// Only records the request
+func (m *MockLDAPClient) Modify(req *ldap.ModifyRequest) error {
+	if m.boundAs == "" {
+		return NotBoundError
+	}
+	m.modifies = append(m.modifies, req)
+	return nil
+}
 
 /*
 		Below methods are unimplemented because they are not needed for the current implementation
@@ -241,11 +330,6 @@ func (m *MockLDAPClient) GetLastError() error {
 	panic("unimplemented")
 }
 
-// IsClosing implements ldap.Client.
-func (m *MockLDAPClient) IsClosing() bool {
-	panic("unimplemented")
-}
-
 // NTLMUnauthenticatedBind implements ldap.Client.
 func (m *MockLDAPClient) NTLMUnauthenticatedBind(domain string, username string) error {
 	panic("unimplemented")
@@ -280,20 +364,12 @@ func (m *MockLDAPClient) Del(*ldap.DelRequest) error {
 	panic("Deleting from LDAP is not allowed (Del)")
 }
 
-// PasswordModify implements ldap.Client.
-func (m *MockLDAPClient) PasswordModify(*ldap.PasswordModifyRequest) (*ldap.PasswordModifyResult, error) {
-	panic("LDAP Password Modification is not allowed (PasswordModify)")
-}
 
 // ModifyWithResult implements ldap.Client.
 func (m *MockLDAPClient) ModifyWithResult(*ldap.ModifyRequest) (*ldap.ModifyResult, error) {
 	panic("LDAP Modification is not allowed (ModifyWithResult)")
 }
 
-// Modify implements ldap.Client.
-func (m *MockLDAPClient) Modify(*ldap.ModifyRequest) error {
-	panic("LDAP Modification is not allowed (Modify)")
-}
 
 // ModifyDN implements ldap.Client.
 func (m *MockLDAPClient) ModifyDN(*ldap.ModifyDNRequest) error {
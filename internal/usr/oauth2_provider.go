@@ -0,0 +1,441 @@
+package usr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"stoke/internal/ent"
+	"stoke/internal/ent/grouplink"
+	"stoke/internal/tel"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Plain OAuth2 login sources, i.e. GitHub or Gitea, that do not issue id tokens.
+//
+// The process should go as follows
+// 1. User goes to /oauth2/{provider-name}
+// 2. Stoke redirects the user to the provider's AuthenticationURL using the authorization code flow with PKCE
+// 3. The provider redirects back with an authorization code
+// 4. Stoke exchanges the code for an access token at the TokenURL
+// 5. Stoke calls each user info url with the access token and maps the returned fields to the user and group links
+// 6. Stoke issues a token and redirects to the next url with a one-time code for /api/login/exchange
+//
+// Access tokens from the provider may also be used directly as the password to /api/login.
+
+// OAuth2UserInfo is an API url that is called with the access token to gather user fields
+type OAuth2UserInfo struct {
+	URL *url.URL
+	// Field to store the response under. Object responses are merged into the top level when empty.
+	// Array responses, i.e. a list of orgs, must be stored under a field.
+	As  string
+}
+
+type oauth2UserProvider struct {
+	// Unique name of this user provider
+	Name string
+	// URL to send users to to authorize stoke
+	AuthenticationURL *url.URL
+	// URL to exchange authorization codes for access tokens
+	TokenURL          *url.URL
+	// APIs to call with the access token to gather user fields
+	UserInfo          []OAuth2UserInfo
+
+	// The authorization request to use when redirecting to the AuthenticationURL
+	Request           oidcAuthRequest
+	// Client Secret, given by provider
+	ClientSecret      string
+
+	// Dotted path of the field to use as the first name
+	FNameField    string
+	// Dotted path of the field to use as the last name
+	LNameField    string
+	// Dotted path of the field to use as the email
+	EmailField    string
+	// Dotted path of the field to use as the username. The email is used when empty
+	UsernameField string
+	// Dotted path of the field holding the user's stable id, i.e. id. The username is used when empty
+	SubjectField  string
+	// Dotted paths of fields whose values are matched to group links as path=value, i.e. orgs.login=my-org
+	GroupFields   []string
+	// Rules users must satisfy to log in and the groups new users start with
+	Provisioning  ProvisioningRules
+
+	// Absolute urls users may be sent to after authenticating. Relative paths are always allowed
+	AllowedRedirects []string
+	// Where to send users after logging in when no next url was given
+	CompletionURL    string
+	// Let UpdateUserClaims log users in with an access token of the provider, i.e. as the password of /api/login.
+	// Off by default: any token the provider issued to any application that can read the user info urls would log its user in
+	AcceptAccessTokens bool
+
+	dbSourceName string
+}
+
+func NewOAuth2UserProvider(
+	name, scopes, redirectURI,
+	fNameField, lNameField, emailField, usernameField string,
+	clientID, clientSecret string,
+	extraArgs map[string]string,
+	authURL, tokenURL *url.URL,
+	userInfo []OAuth2UserInfo,
+	groupFields []string,
+	mux *http.ServeMux,
+) *oauth2UserProvider {
+	provider := &oauth2UserProvider{
+		Name: name,
+		AuthenticationURL: authURL,
+		TokenURL: tokenURL,
+		UserInfo: userInfo,
+		Request: oidcAuthRequest{
+			Scope: scopes,
+			RedirectURI: redirectURI,
+			ClientID: clientID,
+			ExtraArgs: extraArgs,
+			responseType: "code",
+		},
+		ClientSecret: clientSecret,
+		FNameField: fNameField,
+		LNameField: lNameField,
+		EmailField: emailField,
+		UsernameField: usernameField,
+		GroupFields: groupFields,
+		dbSourceName: "OAUTH2:" + name,
+	}
+
+	registerRoute(mux, "/oauth2/" + name, provider)
+
+	return provider
+}
+
+// Handles redirect to and from provider
+// Users should navigate to this endpoint to authenticate with the provider
+// Include the following query parameters to control redirect behavior:
+//    * next -- the url to send the user to with the one-time login code. Must be a relative path or match an allowed redirect
+//
+// The redirect uri registered with the provider should be /oauth2/<PROVIDER_NAME>.
+func (o *oauth2UserProvider) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	urlParams := req.URL.Query()
+	urlState := urlParams.Get("state")
+
+	ctx := req.Context()
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "OAuth2Provider").
+		Str("provider", o.Name).
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "oauth2UserProvider.ServeHTTP")
+	defer span.End()
+
+	if urlState == "" {
+		urlNext := urlParams.Get("next")
+		if !isAllowedRedirect(urlNext, o.AllowedRedirects) {
+			logger.Warn().Str("next", urlNext).Msg("Refusing to redirect to url that is not allowed")
+			res.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if urlNext == "" && o.CompletionURL == "" {
+			logger.Warn().Msg("No next url given and no completion url configured")
+			res.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		authState := newAuthState(urlNext, "")
+		if err := saveAuthState(o.Name, authState, ctx); err != nil {
+			logger.Error().Err(err).Msg("Could not save oauth2 state")
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		logger.Info().Msg("Redirecting to AuthURL")
+		http.Redirect(res, req, o.addParamsToAuthURL(authState).String(), http.StatusTemporaryRedirect)
+		return
+	}
+
+	authState, err := takeAuthState(o.Name, urlState, ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not verify state")
+		res.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	target := authState.NextURL
+	if target == "" {
+		target = o.CompletionURL
+	}
+
+	completeServerLogin(res, req, target, func(ctx context.Context) (*ent.User, error) {
+		if urlError := urlParams.Get("error"); urlError != "" {
+			return nil, fmt.Errorf("%w: provider returned %s", AuthenticationError, urlError)
+		}
+		accessToken, err := o.getAccessToken(urlParams.Get("code"), authState.CodeVerifier, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return o.updateUserClaims(accessToken, ctx)
+	}, ctx)
+}
+
+// Update user claims in the database using the fields returned by the user info urls.
+// The password is an access token issued by the provider, the username is ignored.
+// Access tokens are only accepted when AcceptAccessTokens is set.
+func (o *oauth2UserProvider) UpdateUserClaims(_, accessToken string, ctx context.Context) (*ent.User, error) {
+	if !o.AcceptAccessTokens {
+		return nil, fmt.Errorf("%w: provider %s does not accept access tokens", AuthenticationError, o.Name)
+	}
+	return o.updateUserClaims(accessToken, ctx)
+}
+
+func (o *oauth2UserProvider) updateUserClaims(accessToken string, ctx context.Context) (*ent.User, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "OAuth2Provider.UpdateUserClaims").
+		Str("provider", o.Name).
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "oauth2UserProvider.UpdateUserClaims")
+	defer span.End()
+
+	if accessToken == "" {
+		return nil, AuthenticationError
+	}
+
+	fields, err := o.getUserFields(accessToken, ctx)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug().
+		Interface("fields", fields).
+		Msg("Retrieved user fields")
+
+	u, err := o.getOrCreateUser(fields, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []string
+	for _, field := range o.GroupFields {
+		path := strings.Join(claimPath(field), ".")
+		for _, v := range lookupValues(fields, field) {
+			specs = append(specs, path + "=" + v)
+		}
+	}
+
+	foundLinks, err := ent.FromContext(ctx).GroupLink.Query().
+		Where(
+			grouplink.And(
+				grouplink.TypeEQ(o.dbSourceName),
+				grouplink.ResourceSpecIn(specs...),
+			),
+		).
+		WithClaimGroup(func (q *ent.ClaimGroupQuery) {
+			q.WithClaims()
+		}).
+		All(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not get group links.")
+		return nil, err
+	} else if len(foundLinks) == 0 && !o.Provisioning.AllowUnlinked {
+		logger.Error().Strs("specs", specs).Msg("No group links found")
+		return nil, NoLinkedGroupsError
+	}
+
+	add, del := findGroupChanges(u, foundLinks, o.dbSourceName)
+	if u, err = applyGroupChanges(add, del, u, ctx) ; err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Failed to update oauth2 groups to local user")
+		return nil, err
+	}
+
+	return retreiveLocalUser(u.Username, ctx)
+}
+
+func (o *oauth2UserProvider) addParamsToAuthURL(authState oidcAuthState) *url.URL {
+	u, _ := url.Parse(o.AuthenticationURL.String())
+	q := u.Query()
+
+	q.Add("response_type", "code")
+	q.Add("client_id", o.Request.ClientID)
+	q.Add("redirect_uri", o.Request.RedirectURI)
+	if o.Request.Scope != "" {
+		q.Add("scope", o.Request.Scope)
+	}
+	for key, val := range o.Request.ExtraArgs {
+		q.Add(key, val)
+	}
+
+	q.Add("state", authState.State)
+	q.Add("code_challenge", authState.codeChallenge())
+	q.Add("code_challenge_method", "S256")
+
+	u.RawQuery = q.Encode()
+	return u
+}
+
+// Exchanges an authorization code for an access token at the TokenURL
+func (o *oauth2UserProvider) getAccessToken(authCode, codeVerifier string, ctx context.Context) (string, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "OAuth2Provider.getAccessToken").
+		Stringer("token_url", o.TokenURL).
+		Logger()
+
+	values := make(url.Values)
+	values.Add("grant_type", "authorization_code")
+	values.Add("code", authCode)
+	values.Add("redirect_uri", o.Request.RedirectURI)
+	values.Add("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.TokenURL.String(), strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// Some providers, i.e. GitHub, respond with form values unless json is requested
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(o.Request.ClientID, o.ClientSecret)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not post form to token url")
+		return "", AuthSourceError
+	}
+	defer res.Body.Close()
+
+	tokens := struct {
+		AccessToken string `json:"access_token"`
+		Error       string `json:"error"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&tokens); err != nil {
+		logger.Error().Err(err).Msg("Could not unmarshal json from token endpoint")
+		return "", OIDCTokenRetrievalError
+	}
+	if tokens.Error != "" || tokens.AccessToken == "" {
+		logger.Error().
+			Str("response_error", tokens.Error).
+			Msg("Did not receive an access token from the token endpoint")
+		return "", OIDCTokenRetrievalError
+	}
+	return tokens.AccessToken, nil
+}
+
+// Calls each user info url and combines the responses
+func (o *oauth2UserProvider) getUserFields(accessToken string, ctx context.Context) (map[string]interface{}, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "OAuth2Provider.getUserFields").
+		Logger()
+
+	fields := make(map[string]interface{})
+	for _, info := range o.UserInfo {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, info.URL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer " + accessToken)
+		req.Header.Set("Accept", "application/json")
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			logger.Error().Err(err).Stringer("url", info.URL).Msg("Could not get user info")
+			return nil, AuthSourceError
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden:
+			logger.Debug().Stringer("url", info.URL).Int("status", res.StatusCode).Msg("Provider rejected access token")
+			return nil, AuthenticationError
+		case res.StatusCode != http.StatusOK:
+			logger.Error().Stringer("url", info.URL).Int("status", res.StatusCode).Msg("Unexpected status from user info url")
+			return nil, AuthSourceError
+		}
+
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err != nil {
+			logger.Error().Err(err).Stringer("url", info.URL).Msg("Could not unmarshal user info")
+			return nil, AuthSourceError
+		}
+
+		if info.As != "" {
+			fields[info.As] = value
+			continue
+		}
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			logger.Error().Stringer("url", info.URL).Msg("User info response is not an object. Set a field to store it under")
+			return nil, AuthSourceError
+		}
+		for k, v := range obj {
+			fields[k] = v
+		}
+	}
+	return fields, nil
+}
+
+func (o *oauth2UserProvider) getOrCreateUser(fields map[string]interface{}, ctx context.Context) (*ent.User, error) {
+	fname := firstValue(fields, o.FNameField)
+	lname := firstValue(fields, o.LNameField)
+	email := firstValue(fields, o.EmailField)
+	username := email
+	if o.UsernameField != "" {
+		username = firstValue(fields, o.UsernameField)
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "OAuth2Provider.getOrCreateUser").
+		Str("fname", fname).
+		Str("lname", lname).
+		Str("email", email).
+		Str("username", username).
+		Logger()
+
+	if email == "" || username == "" {
+		logger.Error().Msg("Could not determine email or username")
+		return nil, AuthSourceError
+	}
+
+	if err := o.Provisioning.check(o.Name, email, func(name string) []string { return lookupValues(fields, name) }, ctx); err != nil {
+		return nil, err
+	}
+
+	subject := username
+	if o.SubjectField != "" {
+		subject = firstValue(fields, o.SubjectField)
+	}
+
+	return identityUser(
+		externalIdentity{
+			ProviderType: o.identityType(),
+			ProviderName: o.Name,
+			Subject: subject,
+		},
+		o.dbSourceName,
+		username,
+		o.Provisioning.provisionUser(func() (*ent.User, error) {
+			return ent.FromContext(ctx).User.Create().
+				SetFname(fname).
+				SetLname(lname).
+				SetEmail(email).
+				SetUsername(username).
+				SetSource(o.dbSourceName).
+				Save(ctx)
+		}, ctx),
+		ctx,
+	)
+}
+
+func firstValue(fields map[string]interface{}, path string) string {
+	if path == "" {
+		return ""
+	}
+	if values := lookupValues(fields, path); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,203 @@
+package usr_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"stoke/internal/ent"
+	"stoke/internal/ent/user"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+)
+
+type oauth2Fixture struct {
+	server *httptest.Server
+
+	mu        sync.Mutex
+	challenge string
+	orgs      []map[string]string
+
+	acceptAccessTokens bool
+}
+
+// newOAuth2Fixture starts a GitHub-like provider with /token, /user and /user/orgs endpoints
+func newOAuth2Fixture(t *testing.T) *oauth2Fixture {
+	f := &oauth2Fixture{
+		orgs: []map[string]string{ {"login": "platform"}, {"login": "other"} },
+	}
+	authorized := func(req *http.Request) bool {
+		return req.Header.Get("Authorization") == "Bearer gho_access"
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(res http.ResponseWriter, req *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		verifier := sha256.Sum256([]byte(req.PostFormValue("code_verifier")))
+		id, secret, _ := req.BasicAuth()
+		if base64.RawURLEncoding.EncodeToString(verifier[:]) != f.challenge || id != testClientID || secret != "client-secret" {
+			_ = json.NewEncoder(res).Encode(map[string]string{ "error": "bad_verification_code" })
+			return
+		}
+		_ = json.NewEncoder(res).Encode(map[string]string{ "access_token": "gho_access", "token_type": "bearer" })
+	})
+	mux.HandleFunc("/user", func(res http.ResponseWriter, req *http.Request) {
+		if !authorized(req) {
+			res.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(res).Encode(map[string]interface{}{
+			"login": "octo",
+			"email": "octo@example",
+			"name":  map[string]string{ "first": "Octo", "last": "Cat" },
+		})
+	})
+	mux.HandleFunc("/user/orgs", func(res http.ResponseWriter, req *http.Request) {
+		if !authorized(req) {
+			res.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		_ = json.NewEncoder(res).Encode(f.orgs)
+	})
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *oauth2Fixture) provider() interface {
+	http.Handler
+	OIDCProvider
+} {
+	base, _ := url.Parse(f.server.URL)
+	p := usr.NewOAuth2UserProvider(
+		"test_oauth2", "read:org", "http://localhost/oauth2/test_oauth2",
+		"name.first", "name.last", "email", "login",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/authorize"), base.JoinPath("/token"),
+		[]usr.OAuth2UserInfo{
+			{ URL: base.JoinPath("/user") },
+			{ URL: base.JoinPath("/user/orgs"), As: "orgs" },
+		},
+		[]string{ "orgs.login" },
+		http.NewServeMux(),
+	)
+	p.CompletionURL = "/done"
+	p.AcceptAccessTokens = f.acceptAccessTokens
+	return p
+}
+
+// sessionTestContext returns a context with the provider linked group and a session issuer that
+// only issues tokens for logins that got the group's claim
+func sessionTestContext(t *testing.T, link tu.GroupOption) context.Context {
+	return usr.NewProviderList().WithContext(usr.WithSessionIssuer(
+		func(u *ent.User, claims ent.Claims, _ context.Context) (string, string, error) {
+			if len(claims) != 1 || claims[0].ShortName != "plt" {
+				return "", "", errors.New("unexpected claims")
+			}
+			return "token-for-" + u.Username, "refresh", nil
+		},
+		tu.NewMockContext(
+			tu.WithDatabase(t, tu.ProviderLinkedGroup(link)),
+		),
+	))
+}
+
+func oauth2TestContext(t *testing.T) context.Context {
+	return sessionTestContext(t, tu.ProviderLink("OAUTH2", "test_oauth2", "orgs.login=platform"))
+}
+
+func TestOAuth2CodeFlowMapsUserInfoToUserAndGroups(t *testing.T) {
+	fixture := newOAuth2Fixture(t)
+	ctx := oauth2TestContext(t)
+	p := fixture.provider()
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/test_oauth2", nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	if res.Code != http.StatusTemporaryRedirect {
+		t.Fatalf("Expected redirect to provider, got %d", res.Code)
+	}
+	location, _ := url.Parse(res.Header().Get("Location"))
+	params := location.Query()
+	if location.Path != "/authorize" || params.Get("code_challenge_method") != "S256" || params.Get("scope") != "read:org" {
+		t.Fatalf("Unexpected authorization request: %s", location)
+	}
+
+	fixture.mu.Lock()
+	fixture.challenge = params.Get("code_challenge")
+	fixture.mu.Unlock()
+
+	req = httptest.NewRequest(http.MethodGet, "/oauth2/test_oauth2?code=abc&state=" + url.QueryEscape(params.Get("state")), nil).WithContext(ctx)
+	res = httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	if res.Code != http.StatusSeeOther {
+		t.Fatalf("Expected redirect after login, got %d", res.Code)
+	}
+	location, _ = url.Parse(res.Header().Get("Location"))
+	if location.Path != "/done" || location.Query().Get("code") == "" {
+		t.Fatalf("Unexpected redirect location: %s", location)
+	}
+
+	username, token, _, err := usr.RedeemLoginCode(location.Query().Get("code"), ctx)
+	if err != nil || username != "octo" || token != "token-for-octo" {
+		t.Fatalf("Unexpected login code redemption: %s %s %v", username, token, err)
+	}
+
+	u, err := ent.FromContext(ctx).User.Query().Where(user.UsernameEQ("octo")).WithClaimGroups().Only(ctx)
+	if err != nil {
+		t.Fatalf("Expected user to be created: %v", err)
+	}
+	if u.Fname != "Octo" || u.Lname != "Cat" || u.Email != "octo@example" || u.Source != "OAUTH2:test_oauth2" {
+		t.Errorf("User fields were not mapped: %+v", u)
+	}
+	if len(u.Edges.ClaimGroups) != 1 || u.Edges.ClaimGroups[0].Name != "platform" {
+		t.Errorf("User was not added to the linked group: %v", u.Edges.ClaimGroups)
+	}
+}
+
+// Access tokens are not accepted as passwords unless the provider is configured to
+func TestOAuth2UpdateUserClaimsRejectsAccessTokensByDefault(t *testing.T) {
+	fixture := newOAuth2Fixture(t)
+	ctx := oauth2TestContext(t)
+	p := fixture.provider()
+
+	if _, err := p.UpdateUserClaims("", "gho_access", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Access token was accepted: %v", err)
+	}
+}
+
+func TestOAuth2UpdateUserClaimsWithAccessToken(t *testing.T) {
+	fixture := newOAuth2Fixture(t)
+	fixture.acceptAccessTokens = true
+	ctx := oauth2TestContext(t)
+	p := fixture.provider()
+
+	u, err := p.UpdateUserClaims("", "gho_access", ctx)
+	if err != nil || u.Username != "octo" {
+		t.Fatalf("Could not authenticate with access token: %v", err)
+	}
+
+	if _, err := p.UpdateUserClaims("", "bad", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Rejected access token returned %v", err)
+	}
+	if _, err := p.UpdateUserClaims("", "", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Empty access token returned %v", err)
+	}
+
+	fixture.mu.Lock()
+	fixture.orgs = []map[string]string{ {"login": "other"} }
+	fixture.mu.Unlock()
+	if _, err := p.UpdateUserClaims("", "gho_access", ctx); !errors.Is(err, usr.NoLinkedGroupsError) {
+		t.Errorf("User without linked orgs returned %v", err)
+	}
+}
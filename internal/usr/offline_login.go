@@ -0,0 +1,152 @@
+package usr
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+	"stoke/internal/ent"
+	"stoke/internal/ent/cachedcredential"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Claim added to logins that used a cached credential when no claim name is configured
+const defaultOfflineClaim = "degraded"
+
+// OfflineLogin configures the cached credential fallback for foreign providers.
+// After a successful login with one of Providers, a verifier of the password is kept for CredentialTTL.
+// While the provider is unreachable, users that give the same password are logged in with the groups
+// they had at their last login, and the login is marked with Claim.
+type OfflineLogin struct {
+	// Providers whose users may log in with cached credentials
+	Providers     []string
+	// How long a cached credential is accepted after the last successful login with the provider
+	CredentialTTL time.Duration
+	// Short name of the claim that marks a login that used a cached credential
+	Claim         string
+}
+
+func (o OfflineLogin) enabled(providerName string) bool {
+	return o.CredentialTTL > 0 && slices.Contains(o.Providers, providerName)
+}
+
+func (o OfflineLogin) claimName() string {
+	if o.Claim == "" {
+		return defaultOfflineClaim
+	}
+	return o.Claim
+}
+
+// SetOfflineLogin sets the cached credential fallback for foreign providers
+func (p *ProviderList) SetOfflineLogin(offline OfflineLogin) {
+	p.foreignMutex.Lock()
+	defer p.foreignMutex.Unlock()
+	p.offline = offline
+}
+
+// IsOfflineLogin reports whether claims came from a login that used a cached credential
+func IsOfflineLogin(claims ent.Claims, ctx context.Context) bool {
+	name := ProviderFromCtx(ctx).offlineLogin().claimName()
+	for _, c := range claims {
+		if c.ShortName == name && c.Value == "true" && c.ID == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ProviderList) offlineLogin() OfflineLogin {
+	p.foreignMutex.RLock()
+	defer p.foreignMutex.RUnlock()
+	return p.offline
+}
+
+// cacheCredential stores a verifier of password after a successful login with providerName
+func (o OfflineLogin) cacheCredential(providerName, username, password string, ctx context.Context) error {
+	db := ent.FromContext(ctx)
+	salt := GenSalt()
+	verifier := HashPass(password, salt)
+	expires := time.Now().Add(o.CredentialTTL)
+
+	updated, err := db.CachedCredential.Update().
+		Where(
+			cachedcredential.ProviderEQ(providerName),
+			cachedcredential.UsernameEQ(username),
+		).
+		SetSalt(salt).
+		SetVerifier(verifier).
+		SetExpires(expires).
+		Save(ctx)
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	return db.CachedCredential.Create().
+		SetProvider(providerName).
+		SetUsername(username).
+		SetSalt(salt).
+		SetVerifier(verifier).
+		SetExpires(expires).
+		Exec(ctx)
+}
+
+// forgetCredential removes the cached credential of username, i.e. after the provider rejected the password
+func (o OfflineLogin) forgetCredential(providerName, username string, ctx context.Context) error {
+	_, err := ent.FromContext(ctx).CachedCredential.Delete().
+		Where(
+			cachedcredential.ProviderEQ(providerName),
+			cachedcredential.UsernameEQ(username),
+		).
+		Exec(ctx)
+	return err
+}
+
+// offlineUser checks password against the cached credential of username for providerName.
+// Returns the user as of the last successful login, or AuthenticationError if there is no unexpired matching credential.
+func (o OfflineLogin) offlineUser(providerName, username, password string, ctx context.Context) (*ent.User, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "usr.OfflineLogin").
+		Str("provider", providerName).
+		Str("username", username).
+		Logger()
+
+	db := ent.FromContext(ctx)
+	if _, err := db.CachedCredential.Delete().Where(cachedcredential.ExpiresLT(time.Now())).Exec(ctx); err != nil {
+		logger.Error().Err(err).Msg("Could not remove expired cached credentials")
+	}
+
+	cred, err := db.CachedCredential.Query().
+		Where(
+			cachedcredential.ProviderEQ(providerName),
+			cachedcredential.UsernameEQ(username),
+		).
+		Only(ctx)
+	if err != nil {
+		logger.Debug().Err(err).Msg("No cached credential")
+		return nil, AuthenticationError
+	}
+
+	if subtle.ConstantTimeCompare([]byte(HashPass(password, cred.Salt)), []byte(cred.Verifier)) != 1 {
+		logger.Debug().Msg("Password does not match cached credential")
+		return nil, AuthenticationError
+	}
+
+	u, err := retreiveLocalUser(username, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Warn().
+		Time("credentialExpires", cred.Expires).
+		Msg("Provider unreachable. Logged in with cached credential")
+	addPassthroughClaims(ent.Claims{
+		&ent.Claim{
+			Name: "Offline login",
+			ShortName: o.claimName(),
+			Value: "true",
+			Description: "Login used a cached credential while the provider was unreachable",
+		},
+	}, ctx)
+	return u, nil
+}
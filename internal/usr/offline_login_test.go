@@ -0,0 +1,141 @@
+package usr_test
+
+import (
+	"context"
+	"errors"
+	"stoke/internal/ent"
+	"stoke/internal/ent/cachedcredential"
+	"stoke/internal/usr"
+	"testing"
+	"time"
+)
+
+// offlineProvider authenticates with a fixed password while up and returns AuthSourceError while down
+type offlineProvider struct {
+	MockProvider
+	Password string
+	Down     bool
+}
+
+func (o *offlineProvider) UpdateUserClaims(username, password string, ctx context.Context) (*ent.User, error) {
+	if o.Down {
+		o.Calls++
+		return nil, usr.AuthSourceError
+	}
+	if password != o.Password {
+		o.Calls++
+		return nil, usr.AuthenticationError
+	}
+	return o.MockProvider.UpdateUserClaims(username, password, ctx)
+}
+
+func offlineTestList(ttl time.Duration) (*usr.ProviderList, *offlineProvider) {
+	prov := &offlineProvider{
+		MockProvider: MockProvider{ AddGroup: "success group" },
+		Password: "directorypass",
+	}
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("directory", prov)
+	pl.SetOfflineLogin(usr.OfflineLogin{
+		Providers: []string{ "directory" },
+		CredentialTTL: ttl,
+		Claim: "deg",
+	})
+	return pl, prov
+}
+
+func TestOfflineLoginUsesCachedCredential(t *testing.T) {
+	pl, prov := offlineTestList(time.Hour)
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	if _, claims, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err != nil || usr.IsOfflineLogin(claims, ctx) {
+		t.Fatalf("Directory login failed or was marked offline: %v %v", claims, err)
+	}
+
+	prov.Down = true
+	u, claims, err := pl.GetUserClaims("user1", "directorypass", "", ctx)
+	if err != nil {
+		t.Fatalf("Cached credential was not accepted: %v", err)
+	}
+	if u.Username != "user1" || !usr.IsOfflineLogin(claims, ctx) {
+		t.Fatalf("Login was not marked offline: %v %v", u, claims)
+	}
+	var found bool
+	for _, c := range claims {
+		found = found || c.ShortName == "hel"
+	}
+	if !found {
+		t.Errorf("Last known claims were not returned: %v", claims)
+	}
+
+	if _, _, err := pl.GetUserClaims("user1", "wrongpass", "", ctx); err == nil {
+		t.Error("Wrong password was accepted with a cached credential")
+	}
+}
+
+func TestOfflineLoginRequiresPreviousLogin(t *testing.T) {
+	pl, prov := offlineTestList(time.Hour)
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	prov.Down = true
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err == nil {
+		t.Fatal("User without a cached credential logged in")
+	}
+}
+
+func TestOfflineLoginCredentialExpires(t *testing.T) {
+	pl, prov := offlineTestList(time.Hour)
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err != nil {
+		t.Fatalf("Directory login failed: %v", err)
+	}
+	ent.FromContext(ctx).CachedCredential.Update().
+		Where(cachedcredential.UsernameEQ("user1")).
+		SetExpires(time.Now().Add(-time.Minute)).
+		ExecX(ctx)
+
+	prov.Down = true
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err == nil {
+		t.Fatal("Expired cached credential was accepted")
+	}
+	if n := ent.FromContext(ctx).CachedCredential.Query().CountX(ctx); n != 0 {
+		t.Errorf("Expired cached credential was not removed: %d", n)
+	}
+}
+
+// A password the directory rejects removes the cached credential
+func TestOfflineLoginForgetsRejectedCredential(t *testing.T) {
+	pl, prov := offlineTestList(time.Hour)
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err != nil {
+		t.Fatalf("Directory login failed: %v", err)
+	}
+	prov.Password = "changedpass"
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Fatalf("Old password was not rejected: %v", err)
+	}
+
+	prov.Down = true
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err == nil {
+		t.Fatal("Rejected password was accepted from the cache")
+	}
+}
+
+func TestOfflineLoginDisabledForOtherProviders(t *testing.T) {
+	pl, prov := offlineTestList(time.Hour)
+	pl.SetOfflineLogin(usr.OfflineLogin{ Providers: []string{ "other" }, CredentialTTL: time.Hour })
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err != nil {
+		t.Fatalf("Directory login failed: %v", err)
+	}
+	if n := ent.FromContext(ctx).CachedCredential.Query().CountX(ctx); n != 0 {
+		t.Errorf("Credential was cached for a provider without offline login: %d", n)
+	}
+	prov.Down = true
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err == nil {
+		t.Fatal("Offline login was used for a provider without offline login")
+	}
+}
@@ -0,0 +1,243 @@
+package usr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"stoke/internal/ent"
+	"stoke/internal/ent/claimgroup"
+	"stoke/internal/ent/schema/policy"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ClaimMapping links a provider claim to groups. When the claim at Claim equals Value, or matches Match,
+// group links with the Link resource spec are applied to the user.
+// Array claims match when any element matches.
+type ClaimMapping struct {
+	// Dotted path to the provider claim, i.e. realm_access.roles. May start with "$."
+	Claim string
+	// Value the claim must equal or, for arrays, contain
+	Value string
+	// Regular expression the claim must match. Used instead of Value when set
+	Match *regexp.Regexp
+	// Resource spec of the group links to apply
+	Link  string
+}
+
+// ClaimPassthrough copies a provider claim into issued tokens.
+// Persisted claims are stored as provider managed groups so they show up in the database and survive provider outages.
+type ClaimPassthrough struct {
+	// Dotted path to the provider claim. May start with "$."
+	Claim   string
+	// Short name of the stoke claim. Defaults to the last element of Claim
+	As      string
+	// Whether to store the claim in the database
+	Persist bool
+}
+
+// ShortName is the stoke claim name used for the passed through claim
+func (p ClaimPassthrough) ShortName() string {
+	if p.As != "" {
+		return p.As
+	}
+	path := claimPath(p.Claim)
+	return path[len(path)-1]
+}
+
+// linkSpecs returns the group link resource specs that apply to the given provider claims.
+// Every scalar claim and array element is included as "path=value", with nested claims joined by dots.
+func (o *oidcUserProvider) linkSpecs(claimMap jwt.MapClaims) []string {
+	specs := make(map[string]struct{})
+	flattenClaims("", map[string]interface{}(claimMap), specs)
+
+	for _, m := range o.ClaimMappings {
+		for _, v := range lookupValues(claimMap, m.Claim) {
+			if (m.Match != nil && m.Match.MatchString(v)) || (m.Match == nil && v == m.Value) {
+				specs[m.Link] = struct{}{}
+				break
+			}
+		}
+	}
+
+	result := make([]string, 0, len(specs))
+	for spec := range specs {
+		result = append(result, spec)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// passthroughClaims applies the configured passthrough claims.
+// Claims that are not persisted are added to the claims of the current login.
+// Persisted claims get a provider managed group that is linked by the claim value.
+// Returns whether the provider claims had a value for any passthrough claim.
+func (o *oidcUserProvider) passthroughClaims(claimMap jwt.MapClaims, ctx context.Context) (bool, error) {
+	var loginClaims ent.Claims
+	found := false
+	for _, p := range o.Passthrough {
+		for _, v := range lookupValues(claimMap, p.Claim) {
+			found = true
+			if !p.Persist {
+				loginClaims = append(loginClaims, &ent.Claim{
+					Name:        fmt.Sprintf("%s %s", o.Name, p.ShortName()),
+					ShortName:   p.ShortName(),
+					Value:       v,
+					Description: "Passed through from " + o.Name,
+				})
+				continue
+			}
+			if err := o.ensurePassthroughGroup(p, v, ctx); err != nil {
+				return false, err
+			}
+		}
+	}
+	addPassthroughClaims(loginClaims, ctx)
+	return found, nil
+}
+
+// ensurePassthroughGroup creates the group, claim and group link for a persisted passthrough claim value if they do not exist
+func (o *oidcUserProvider) ensurePassthroughGroup(p ClaimPassthrough, value string, ctx context.Context) error {
+	return ensureProviderClaimGroup(o.Name, o.dbSourceName, p.ShortName(), strings.Join(claimPath(p.Claim), ".") + "=" + value, value, ctx)
+}
+
+// ensureProviderClaimGroup creates a provider managed group holding the claim shortName=value,
+// linked to users of the provider by a group link of linkType with resource spec, if it does not exist
+func ensureProviderClaimGroup(providerName, linkType, shortName, spec, value string, ctx context.Context) error {
+	ctx = policy.BypassDatabasePolicies(ctx)
+	db := ent.FromContext(ctx)
+	name := fmt.Sprintf("%s:%s=%s", providerName, shortName, value)
+
+	exists, err := db.ClaimGroup.Query().Where(claimgroup.NameEQ(name)).Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	claim, err := db.Claim.Create().
+		SetName(name).
+		SetShortName(shortName).
+		SetValue(value).
+		SetDescription("Passed through from " + providerName).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	link, err := db.GroupLink.Create().
+		SetType(linkType).
+		SetResourceSpec(spec).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	return db.ClaimGroup.Create().
+		SetName(name).
+		SetDescription("Managed by provider " + providerName).
+		AddClaims(claim).
+		AddGroupLinks(link).
+		Exec(ctx)
+}
+
+// Splits a dotted claim path, i.e. $.realm_access.roles
+func claimPath(path string) []string {
+	return strings.Split(strings.TrimPrefix(path, "$."), ".")
+}
+
+// lookupValues returns the scalar values at the dotted path in nested claim objects.
+// Arrays along the path are searched element by element, i.e. orgs.login finds the login of every org.
+func lookupValues(claims map[string]interface{}, path string) []string {
+	current := []interface{}{ claims }
+	for _, part := range claimPath(path) {
+		var next []interface{}
+		for _, c := range current {
+			for _, elem := range expandArray(c) {
+				if obj, ok := elem.(map[string]interface{}); ok {
+					if v, ok := obj[part]; ok {
+						next = append(next, v)
+					}
+				}
+			}
+		}
+		current = next
+	}
+
+	var values []string
+	for _, c := range current {
+		values = append(values, claimValues(c)...)
+	}
+	return values
+}
+
+func expandArray(value interface{}) []interface{} {
+	if arr, ok := value.([]interface{}); ok {
+		return arr
+	}
+	return []interface{}{ value }
+}
+
+// claimValues returns the string values of a scalar claim or the scalar elements of an array claim
+func claimValues(value interface{}) []string {
+	switch v := value.(type) {
+	case nil, map[string]interface{}:
+		return nil
+	case []interface{}:
+		var values []string
+		for _, elem := range v {
+			switch elem.(type) {
+			case nil, map[string]interface{}, []interface{}:
+				continue
+			}
+			values = append(values, fmt.Sprint(elem))
+		}
+		return values
+	}
+	return []string{ fmt.Sprint(value) }
+}
+
+// flattenClaims adds a "path=value" spec for every scalar and array element under prefix
+func flattenClaims(prefix string, claims map[string]interface{}, specs map[string]struct{}) {
+	for k, v := range claims {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenClaims(path, nested, specs)
+			continue
+		}
+		for _, value := range claimValues(v) {
+			specs[path + "=" + value] = struct{}{}
+		}
+	}
+}
+
+type passthroughCtxKey struct {}
+
+// passthroughCollector gathers claims that providers pass through to the token of the current login
+type passthroughCollector struct {
+	mu     sync.Mutex
+	claims ent.Claims
+}
+
+func withPassthroughCollector(ctx context.Context) (context.Context, *passthroughCollector) {
+	collector := &passthroughCollector{}
+	return context.WithValue(ctx, passthroughCtxKey{}, collector), collector
+}
+
+func addPassthroughClaims(claims ent.Claims, ctx context.Context) {
+	collector, ok := ctx.Value(passthroughCtxKey{}).(*passthroughCollector)
+	if !ok || len(claims) == 0 {
+		return
+	}
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	collector.claims = append(collector.claims, claims...)
+}
+
+func (c *passthroughCollector) Claims() ent.Claims {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.claims
+}
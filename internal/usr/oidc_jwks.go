@@ -0,0 +1,262 @@
+package usr
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+)
+
+var (
+	OIDCKeyNotFoundError = errors.New("No matching key found in provider JWKS")
+)
+
+const (
+	// How long fetched provider keys are trusted before they are refetched
+	jwksCacheDuration = time.Hour
+	// Minimum time between refetches triggered by an unknown key id
+	jwksMinRefetch = time.Second
+)
+
+// Signing algorithms accepted from providers. "none" and symmetric algorithms are never accepted.
+var oidcSigningMethods = []string{ "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA" }
+
+type providerJWK struct {
+	KeyType string `json:"kty"`
+	KeyId   string `json:"kid"`
+	Use     string `json:"use"`
+	Curve   string `json:"crv"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// remoteKeySet caches the signing keys published at a provider's jwks_uri.
+// Keys are refetched when the cache expires or when a token references an unknown key id,
+// which handles provider key rollover.
+type remoteKeySet struct {
+	URL    string
+	Client *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetched   time.Time
+}
+
+func newRemoteKeySet(jwksURL string) *remoteKeySet {
+	return &remoteKeySet{
+		URL:    jwksURL,
+		Client: http.DefaultClient,
+		keys:   make(map[string]crypto.PublicKey),
+	}
+}
+
+// keyFunc returns a jwt.Keyfunc that resolves the token's key id against the provider keys
+func (r *remoteKeySet) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		r.mu.RLock()
+		stale := time.Since(r.fetched) > jwksCacheDuration
+		canRefetch := time.Since(r.fetched) > jwksMinRefetch
+		key, found := r.keys[kid]
+		r.mu.RUnlock()
+
+		if stale || (!found && kid != "" && canRefetch) {
+			if err := r.refresh(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().
+					Err(err).
+					Str("jwks_url", r.URL).
+					Msg("Could not refresh provider keys")
+				if !found {
+					return nil, err
+				}
+			}
+			r.mu.RLock()
+			key, found = r.keys[kid]
+			r.mu.RUnlock()
+		}
+
+		if found {
+			return key, nil
+		}
+		if kid != "" {
+			return nil, OIDCKeyNotFoundError
+		}
+
+		// No key id in the token, let the parser try every key
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		set := jwt.VerificationKeySet{}
+		for _, k := range r.keys {
+			set.Keys = append(set.Keys, k)
+		}
+		return set, nil
+	}
+}
+
+func (r *remoteKeySet) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.URL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := r.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status from jwks url: %s", res.Status)
+	}
+
+	var set struct {
+		Keys []providerJWK `json:"keys"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().
+				Err(err).
+				Str("kid", k.KeyId).
+				Str("kty", k.KeyType).
+				Msg("Skipping unsupported provider key")
+			continue
+		}
+		keys[k.KeyId] = pub
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.fetched = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+func (k providerJWK) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeJWKInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{ N: n, E: int(e.Int64()) }, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %s", k.Curve)
+		}
+		x, err := decodeJWKInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{ Curve: curve, X: x, Y: y }, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve: %s", k.Curve)
+		}
+		x, err := decodeJWKBytes(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("bad ed25519 key length: %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type: %s", k.KeyType)
+}
+
+func decodeJWKBytes(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func decodeJWKInt(s string) (*big.Int, error) {
+	b, err := decodeJWKBytes(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// verifyIDToken validates an id token per OIDC Core 3.1.3.7: signature against the provider keys,
+// iss, aud/azp against the client id, exp and iat.
+// Without provider keys the signature is only skipped when SkipIDTokenVerification is set; the claims are always checked.
+func (o *oidcUserProvider) verifyIDToken(idToken string, ctx context.Context) (jwt.MapClaims, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(oidcSigningMethods),
+		jwt.WithAudience(o.Request.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+		jwt.WithIssuer(o.Issuer),
+	}
+
+	claims := jwt.MapClaims{}
+	switch {
+	case o.Keys != nil:
+		if o.Issuer == "" {
+			return nil, fmt.Errorf("%w: no issuer to verify the id token with", jwt.ErrTokenUnverifiable)
+		}
+		if _, err := jwt.ParseWithClaims(idToken, claims, o.Keys.keyFunc(ctx), opts...); err != nil {
+			return nil, err
+		}
+	case o.SkipIDTokenVerification:
+		if _, _, err := jwt.NewParser().ParseUnverified(idToken, claims); err != nil {
+			return nil, err
+		}
+		if err := jwt.NewValidator(opts...).Validate(claims); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: no provider keys to verify the id token", jwt.ErrTokenUnverifiable)
+	}
+
+	// When there are multiple audiences the authorized party must be us
+	aud, _ := claims.GetAudience()
+	azp, hasAzp := claims["azp"].(string)
+	if (len(aud) > 1 || hasAzp) && azp != o.Request.ClientID {
+		return nil, fmt.Errorf("%w: azp does not match client id", jwt.ErrTokenInvalidAudience)
+	}
+	if iat, _ := claims.GetIssuedAt(); iat == nil {
+		return nil, fmt.Errorf("%w: iat is required", jwt.ErrTokenRequiredClaimMissing)
+	}
+	return claims, nil
+}
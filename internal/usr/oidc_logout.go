@@ -0,0 +1,283 @@
+package usr
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"stoke/internal/ent"
+	"stoke/internal/ent/logouttoken"
+	"stoke/internal/ent/providersession"
+	"stoke/internal/tel"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+)
+
+// Stoke claim that holds the provider session of tokens issued by providers with back-channel logout enabled
+const SessionClaim = "sess"
+
+// Event type that marks a logout token, https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+const backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+// How long a new provider session is kept until a token is issued for it, i.e. while an MFA challenge is answered
+const providerSessionStartDuration = time.Hour
+
+// startSession records the provider session of a login and passes its id through to the issued token.
+// Expired sessions are removed at the same time.
+func (o *oidcUserProvider) startSession(claimMap jwt.MapClaims, ctx context.Context) error {
+	sub, _ := claimMap.GetSubject()
+	if sub == "" {
+		return fmt.Errorf("%w: sub is required for back-channel logout", jwt.ErrTokenRequiredClaimMissing)
+	}
+	sid, _ := claimMap["sid"].(string)
+
+	db := ent.FromContext(ctx)
+	now := time.Now()
+
+	_, err := db.ProviderSession.Delete().
+		Where(
+			providersession.Or(
+				providersession.ExpiresLT(now),
+				providersession.ExpiresIsNil(),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	session := base64.RawURLEncoding.EncodeToString(newNonce())
+	err = db.ProviderSession.Create().
+		SetSession(session).
+		SetProvider(o.Name).
+		SetSubject(sub).
+		SetSid(sid).
+		SetExpires(now.Add(providerSessionStartDuration)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	addPassthroughClaims(ent.Claims{
+		&ent.Claim{
+			Name:        o.Name + " session",
+			ShortName:   SessionClaim,
+			Value:       session,
+			Description: "Session with " + o.Name,
+		},
+	}, ctx)
+	return nil
+}
+
+// CheckSession returns SessionRevokedError if the provider logged out the given session or the session expired
+func CheckSession(session string, ctx context.Context) error {
+	active, err := ent.FromContext(ctx).ProviderSession.Query().
+		Where(
+			providersession.SessionEQ(session),
+			providersession.RevokedEQ(false),
+			providersession.ExpiresGT(time.Now()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !active {
+		return SessionRevokedError
+	}
+	return nil
+}
+
+// KeepSession keeps the provider session at least until the given time.
+// Called with the expiry of every token issued for the session, so it lasts as long as its tokens can be refreshed
+func KeepSession(session string, until time.Time, ctx context.Context) error {
+	return ent.FromContext(ctx).ProviderSession.Update().
+		Where(
+			providersession.SessionEQ(session),
+			providersession.ExpiresLT(until),
+		).
+		SetExpires(until).
+		Exec(ctx)
+}
+
+// serveLogout sends the user to the provider's end session endpoint (RP-initiated logout).
+// Include the following query parameters:
+//    * id_token_hint -- the id token the user logged in with (OPTIONAL)
+//    * next -- where the provider should send the user after logging out. Must be allowed like the login next url (OPTIONAL)
+func (o *oidcUserProvider) serveLogout(res http.ResponseWriter, req *http.Request) {
+	logger := zerolog.Ctx(req.Context()).With().
+		Str("component", "OIDCProvider.serveLogout").
+		Logger()
+
+	if o.EndSessionURL == nil {
+		logger.Debug().Msg("Provider does not have an end session url")
+		res.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	urlParams := req.URL.Query()
+	next := o.PostLogoutRedirectURI
+	if urlNext := urlParams.Get("next"); urlNext != "" {
+		if !isAllowedRedirect(urlNext, o.AllowedRedirects) {
+			logger.Warn().Str("next", urlNext).Msg("Refusing to redirect to url that is not allowed")
+			res.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		next = urlNext
+	}
+
+	u, _ := url.Parse(o.EndSessionURL.String())
+	q := u.Query()
+	q.Set("client_id", o.Request.ClientID)
+	if hint := urlParams.Get("id_token_hint"); hint != "" {
+		q.Set("id_token_hint", hint)
+	}
+	if next != "" {
+		q.Set("post_logout_redirect_uri", next)
+	}
+	u.RawQuery = q.Encode()
+
+	http.Redirect(res, req, u.String(), http.StatusSeeOther)
+}
+
+// serveBackChannelLogout receives logout tokens from the provider and revokes the matching sessions
+func (o *oidcUserProvider) serveBackChannelLogout(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "OIDCProvider.serveBackChannelLogout").
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "oidcUserProvider.serveBackChannelLogout")
+	defer span.End()
+
+	res.Header().Set("Cache-Control", "no-store")
+
+	if req.Method != http.MethodPost {
+		res.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !o.BackChannelLogout || o.Keys == nil {
+		logger.Debug().Msg("Back-channel logout is not enabled")
+		res.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	logout, err := o.verifyLogoutToken(req.PostFormValue("logout_token"), ctx)
+	if err != nil {
+		logger.Debug().Err(err).Msg("Received invalid logout token")
+		res.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := o.recordLogoutToken(logout, ctx); errors.Is(err, LogoutTokenReplayError) {
+		logger.Warn().Str("jti", logout.jti).Msg("Received a logout token that was already used")
+		res.WriteHeader(http.StatusBadRequest)
+		return
+	} else if err != nil {
+		logger.Error().Err(err).Msg("Could not record logout token")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	sub, sid := logout.sub, logout.sid
+
+	update := ent.FromContext(ctx).ProviderSession.Update().
+		Where(providersession.ProviderEQ(o.Name))
+	if sub != "" {
+		update.Where(providersession.SubjectEQ(sub))
+	}
+	if sid != "" {
+		update.Where(providersession.SidEQ(sid))
+	}
+	revoked, err := update.SetRevoked(true).Save(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not revoke sessions")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info().
+		Str("sub", sub).
+		Str("sid", sid).
+		Int("revoked", revoked).
+		Msg("Provider logged out sessions")
+	res.WriteHeader(http.StatusOK)
+}
+
+// How long past their exp logout tokens are accepted
+const logoutTokenLeeway = time.Minute
+
+// logoutToken holds the claims of a verified logout token
+type logoutToken struct {
+	sub     string
+	sid     string
+	jti     string
+	expires time.Time
+}
+
+// recordLogoutToken remembers the jti of a verified logout token until the token expires.
+// Expired records are removed at the same time. Returns LogoutTokenReplayError if the token was already used
+func (o *oidcUserProvider) recordLogoutToken(logout logoutToken, ctx context.Context) error {
+	db := ent.FromContext(ctx)
+
+	if _, err := db.LogoutToken.Delete().Where(logouttoken.ExpiresLT(time.Now())).Exec(ctx); err != nil {
+		return err
+	}
+
+	err := db.LogoutToken.Create().
+		SetProvider(o.Name).
+		SetJti(logout.jti).
+		SetExpires(logout.expires.Add(logoutTokenLeeway)).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return LogoutTokenReplayError
+	}
+	return err
+}
+
+// verifyLogoutToken validates a logout token per OIDC Back-Channel Logout 2.6 and returns its claims
+func (o *oidcUserProvider) verifyLogoutToken(rawToken string, ctx context.Context) (logoutToken, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(oidcSigningMethods),
+		jwt.WithAudience(o.Request.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(logoutTokenLeeway),
+		jwt.WithIssuer(o.Issuer),
+	}
+	if o.Issuer == "" {
+		return logoutToken{}, fmt.Errorf("%w: no issuer to verify the logout token with", jwt.ErrTokenUnverifiable)
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(rawToken, claims, o.Keys.keyFunc(ctx), opts...); err != nil {
+		return logoutToken{}, err
+	}
+
+	if iat, _ := claims.GetIssuedAt(); iat == nil {
+		return logoutToken{}, fmt.Errorf("%w: iat is required", jwt.ErrTokenRequiredClaimMissing)
+	}
+	events, ok := claims["events"].(map[string]interface{})
+	if !ok {
+		return logoutToken{}, fmt.Errorf("%w: events is required", jwt.ErrTokenRequiredClaimMissing)
+	}
+	if _, ok := events[backChannelLogoutEvent].(map[string]interface{}); !ok {
+		return logoutToken{}, fmt.Errorf("%w: not a back-channel logout event", jwt.ErrTokenInvalidClaims)
+	}
+	if _, ok := claims["nonce"]; ok {
+		return logoutToken{}, fmt.Errorf("%w: logout tokens must not have a nonce", jwt.ErrTokenInvalidClaims)
+	}
+
+	sub, _ := claims.GetSubject()
+	sid, _ := claims["sid"].(string)
+	if sub == "" && sid == "" {
+		return logoutToken{}, fmt.Errorf("%w: sub or sid is required", jwt.ErrTokenRequiredClaimMissing)
+	}
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return logoutToken{}, fmt.Errorf("%w: jti is required", jwt.ErrTokenRequiredClaimMissing)
+	}
+	exp, _ := claims.GetExpirationTime()
+	return logoutToken{ sub: sub, sid: sid, jti: jti, expires: exp.Time }, nil
+}
@@ -3,9 +3,6 @@ package usr
 import (
 	"context"
 	"crypto/rand"
-	"crypto/sha256"
-	"encoding/base64"
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"html/template"
@@ -15,10 +12,8 @@ import (
 	"slices"
 	"stoke/internal/ent"
 	"stoke/internal/ent/grouplink"
-	"stoke/internal/ent/predicate"
 	"stoke/internal/tel"
 	"strings"
-	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/rs/zerolog"
@@ -67,7 +62,7 @@ var (
 			window.onload = function() {
 				{{ if .LocalStorage }}
 					window.sessionStorage.setItem("id_token", "{{ .IDToken }}")
-					window.sessionStorage.setItem("access_code", "{{ .AccessCode }}")
+					window.sessionStorage.setItem("access_code", "{{ .AccessToken }}")
 					{{ if ne .NextURL "" }}
 						window.location = "{{ .NextURL }}"
 					{{ end }}
@@ -109,8 +104,6 @@ type oidcUserProvider struct {
 	ClaimSource       ClaimSourceType
 	// The authentication request to use when authenticating to the AuthenticationURL
 	Request           oidcAuthRequest
-	// Salt to be used to generate state hashes. Must be the same accross shared stoke servers
-	StateSalt         []byte
 	// Authenctication flow type
 	FlowType          AuthFlowType
 	// Client Secret, given by provider
@@ -123,25 +116,46 @@ type oidcUserProvider struct {
 	// Email Claim
 	EmailClaim string
 
+	// Expected iss of id and logout tokens. Required when Keys is set. Without keys it is not checked when empty
+	Issuer string
+	// Provider signing keys used to verify id tokens. Id tokens are rejected when nil, unless SkipIDTokenVerification is set
+	Keys *remoteKeySet
+	// Accept id tokens without checking their signature when there are no provider keys. Anyone can forge such tokens; only for testing
+	SkipIDTokenVerification bool
+	// Absolute urls users may be sent to after authenticating. Relative paths are always allowed
+	AllowedRedirects []string
+	// Whether to finish logins on the server and redirect back with a one-time code instead of handing provider tokens to the browser
+	ServerCompletion bool
+	// Where to send users after a server-side login when no next url was given
+	CompletionURL string
+	// Extra rules that link provider claims to groups
+	ClaimMappings []ClaimMapping
+	// Provider claims that are copied into issued tokens
+	Passthrough []ClaimPassthrough
+	// Provider endpoint to send users to when they log out. RP-initiated logout is disabled when nil
+	EndSessionURL *url.URL
+	// Where the provider sends users after logging out when no next url is given
+	PostLogoutRedirectURI string
+	// Whether to track provider sessions and accept back-channel logout tokens
+	BackChannelLogout bool
+	// Rules users must satisfy to log in and the groups new users start with
+	Provisioning ProvisioningRules
+
 	postRedirectTempl *template.Template
 	dbSourceName string
+	routePath string
 }
 
 func NewOIDCUserProvider(
 	name, scopes, redirectURI,
 	fNameClaim, lNameClaim, emailClaim,
-	stateSecret, clientID, clientSecret string,
+	clientID, clientSecret string,
 	extraArgs map[string]string,
 	tokenURL, authURL, userInfoURL *url.URL,
 	mux *http.ServeMux,
 	flowType AuthFlowType,
 	claimSource ClaimSourceType,
 ) *oidcUserProvider {
-	stateSalt := []byte(stateSecret)
-	if stateSecret == "" {
-		stateSalt = make([]byte, 16)
-		_, _ = rand.Read(stateSalt)
-	}
 	prt, _ := template.New("postRedirect").Parse(POST_TEMPLATE)
 	provider := &oidcUserProvider{
 		Name: name,
@@ -155,7 +169,6 @@ func NewOIDCUserProvider(
 			ExtraArgs: extraArgs,
 			responseType: flowType.String(),
 		},
-		StateSalt: stateSalt,
 		FlowType: flowType,
 		ClaimSource: claimSource,
 		ClientSecret: clientSecret,
@@ -164,27 +177,51 @@ func NewOIDCUserProvider(
 		EmailClaim: emailClaim,
 		postRedirectTempl: prt,
 		dbSourceName: "OIDC:" + name,
+		routePath: "/oidc/" + name,
 	}
 
-	mux.Handle("/oidc/" + name, provider)
+	registerRoute(mux, provider.routePath, provider)
+	registerRoute(mux, provider.routePath + "/logout", provider)
+	registerRoute(mux, provider.routePath + "/backchannel_logout", provider)
 
 	return provider
 }
 
+// VerifyIDTokens enables id token signature and claim verification using the keys published at jwksURL.
+// issuer is the expected iss claim and must not be empty; tokens are rejected otherwise.
+func (o *oidcUserProvider) VerifyIDTokens(jwksURL, issuer string) {
+	o.Keys = newRemoteKeySet(jwksURL)
+	o.Issuer = issuer
+}
+
 // Handles redirect to and from provider
 // Users should navigate to this endpoint to authenticate with the provider
 // Include the following query parameters to control redirect behavior:
-// 		* next -- the url the user wants to goto after authenticating
+// 		* next -- the url the user wants to goto after authenticating. Must be a relative path or match an allowed redirect
 //    * xfer -- the transfer method of the request. May be:
 //			* local
 //			* window
 //
+// When ServerCompletion is set, the user is instead redirected to next (or the CompletionURL) with a one-time code query parameter
+// that is exchanged for tokens at /api/login/exchange. Failed logins are redirected with error=access_denied.
+//
 // Clients should register to be returned back to this endpoint after the auth with the provider,
 // i.e. the redirect uri should be registered at /oidc/<PROVIDER_NAME>.
 // Users MUST NOT include a state query parameter when requesting because that indicates a return request from the provider
 //
+// Logout requests are served at /oidc/<PROVIDER_NAME>/logout and /oidc/<PROVIDER_NAME>/backchannel_logout.
+//
 // This function serves as steps 2-5 in the full authentication flow above
 func (o *oidcUserProvider) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case o.routePath + "/logout":
+		o.serveLogout(res, req)
+		return
+	case o.routePath + "/backchannel_logout":
+		o.serveBackChannelLogout(res, req)
+		return
+	}
+
 	urlParams := req.URL.Query()
 	urlState := urlParams.Get("state")
 
@@ -206,36 +243,37 @@ func (o *oidcUserProvider) ServeHTTP(res http.ResponseWriter, req *http.Request)
 
 	// State is used to determine which side of the process we are on.
 	if urlState == "" {
-		nonce := newNonce()
-		logger.Info().Bytes("nonce", nonce).Msg("Redirecting to AuthURL")
-		// Save the generated nonce
-		http.SetCookie(res, &http.Cookie{
-			Name:  o.cookieName("nonce"),
-			Value: base64.URLEncoding.EncodeToString(nonce),
-		})
-		// Save the supplied transfer method
+		urlNext := urlParams.Get("next")
+		if !isAllowedRedirect(urlNext, o.AllowedRedirects) {
+			logger.Warn().Str("next", urlNext).Msg("Refusing to redirect to url that is not allowed")
+			res.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if o.ServerCompletion && urlNext == "" && o.CompletionURL == "" {
+			logger.Warn().Msg("No next url given and no completion url configured")
+			res.WriteHeader(http.StatusBadRequest)
+			return
+		}
 		xferMethod := "local"
 		if urlXfer := urlParams.Get("xfer"); urlXfer != "" && urlXfer == "window"{
 			xferMethod = urlXfer
 		}
-		http.SetCookie(res, &http.Cookie{
-			Name:  o.cookieName("xfer"),
-			Value: xferMethod,
-		})
-		// Save the next url if it was specified
-		if urlNext := urlParams.Get("next"); urlNext != "" {
-			http.SetCookie(res, &http.Cookie{
-				Name:  o.cookieName("next-url"),
-				Value: urlNext,
-			})
+
+		authState := newAuthState(urlNext, xferMethod)
+		if err := saveAuthState(o.Name, authState, ctx); err != nil {
+			logger.Error().Err(err).Msg("Could not save oidc state")
+			res.WriteHeader(http.StatusInternalServerError)
+			return
 		}
-		http.Redirect(res, req, o.addParamsToAuthURL(req, nonce).String(), http.StatusTemporaryRedirect)
+		logger.Info().Msg("Redirecting to AuthURL")
+		http.Redirect(res, req, o.addParamsToAuthURL(authState).String(), http.StatusTemporaryRedirect)
 		return
 	}
 
-	// Validate that the state was generated by us for the given address
-	if !o.hasValidState(req) {
-		logger.Error().Msg("Could not verify state")
+	// Validate that the state was generated by us and has not been used
+	authState, err := takeAuthState(o.Name, urlState, ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not verify state")
 		res.WriteHeader(http.StatusConflict)
 		return
 	}
@@ -244,38 +282,39 @@ func (o *oidcUserProvider) ServeHTTP(res http.ResponseWriter, req *http.Request)
 	idToken := urlParams.Get("id_token")
 	authCode := urlParams.Get("code")
 
-	idToken, accessToken, err := o.getTokens(idToken, accessToken, authCode, ctx)
+	idToken, accessToken, err = o.getTokens(idToken, accessToken, authCode, authState.CodeVerifier, ctx)
 	if err != nil {
 		logger.Error().Err(err).Msg("Could not get tokens")
 		res.WriteHeader(http.StatusInternalServerError)
 		return 
 	}
 
-	xferMethod := "local"
-	next := ""
-	nonceStr := ""
-	if nextCookie, err := req.Cookie(o.cookieName("next-url")) ; err == nil {
-		next = nextCookie.Value
+	if o.ServerCompletion {
+		o.completeLogin(res, req, authState, idToken, accessToken, ctx)
+		return
 	}
-	if xferCookie, err := req.Cookie(o.cookieName("xfer")); err == nil {
-		xferMethod = xferCookie.Value
+
+	// Hand out a one-time value that ties the id token to our nonce. The provider access token stays on the server
+	handoff := newAuthState("", "")
+	handoff.Nonce = authState.Nonce
+	handoff.CodeVerifier = ""
+	if o.ClaimSource == USER_INFO {
+		handoff.AccessToken = accessToken
 	}
-	if nonceCookie, err := req.Cookie(o.cookieName("nonce")); err == nil {
-		nonceStr = nonceCookie.Value
+	if err := saveAuthState(o.Name, handoff, ctx); err != nil {
+		logger.Error().Err(err).Msg("Could not save oidc state")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
 	}
 
-
 	respValues := postRedirectData{
 		LoginURL: "/api/login",
 		IDToken: idToken,
-		AccessToken: accessToken,
-		NextURL: next,
-		LocalStorage: xferMethod == "local",
-		ChildWindow: xferMethod == "window",
+		AccessToken: handoff.State,
+		NextURL: authState.NextURL,
+		LocalStorage: authState.Xfer == "local",
+		ChildWindow: authState.Xfer == "window",
 	}
-	if o.ClaimSource == IDENTITY_TOKEN {
-		respValues.AccessToken = fmt.Sprintf("%s$%s$%s", urlState, req.RemoteAddr, nonceStr)
-	} 
 
 	if err := o.postRedirectTempl.Execute(res, respValues); err != nil {
 		logger.Error().Err(err).Msg("Could not fill template")
@@ -284,38 +323,65 @@ func (o *oidcUserProvider) ServeHTTP(res http.ResponseWriter, req *http.Request)
 }
 
 // Update user claims in the database with the claims from the provider.
-// This function should be called with the idToken and accessToken returned from the provider after the user authorizes access.
-// When the ClaimsSource is ID_TOKEN, the accessToken is used to verify the idToken using the state, network address and nonce.
+// This function should be called with the idToken and accessToken handed out after the callback.
+// The accessToken is a one-time value that references the nonce the id token must carry and, when the ClaimSource is USER_INFO,
+// the provider access token used to get the user info.
 //
 // This function finishes the process (steps 6 and 7 above) and will result in the user getting a token with the most up-to-date claims available from the provider
 func (o *oidcUserProvider) UpdateUserClaims(idToken, accessToken string, ctx context.Context) (*ent.User, error) {
+	handoff, err := takeAuthState(o.Name, accessToken, ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().
+			Str("component", "OIDCProvider.UpdateUserClaims").
+			Err(err).
+			Msg("Received bad access token")
+		return nil, AuthenticationError
+	}
+	return o.updateUserClaims(idToken, handoff.AccessToken, handoff.Nonce, ctx)
+}
+
+// completeLogin finishes a login on the server. Tokens are issued for the user and stored under a one-time code
+// that is sent to the next url, so provider tokens never leave the server.
+func (o *oidcUserProvider) completeLogin(res http.ResponseWriter, req *http.Request, authState oidcAuthState, idToken, accessToken string, ctx context.Context) {
+	target := authState.NextURL
+	if target == "" {
+		target = o.CompletionURL
+	}
+	completeServerLogin(res, req, target, func(ctx context.Context) (*ent.User, error) {
+		return o.updateUserClaims(idToken, accessToken, authState.Nonce, ctx)
+	}, ctx)
+}
+
+// Claims only the id token may set. User info claims never replace them
+var idTokenOnlyClaims = []string{ "sub", "iss", "aud", "nonce" }
+
+// updateUserClaims verifies the provider tokens and persists the user's claims.
+// The id token must carry nonce and user info must be about the id token's subject.
+func (o *oidcUserProvider) updateUserClaims(idToken, accessToken, nonce string, ctx context.Context) (*ent.User, error) {
 	logger := zerolog.Ctx(ctx).With().
-		Str("component", "OIDCProvider.UpdateUserClaims").
+		Str("component", "OIDCProvider.updateUserClaims").
 		Stringer("flow_type", o.FlowType).
 		Stringer("claim_source", o.ClaimSource).
 		Logger()
 
-	ctx, span := tel.GetTracer().Start(ctx, "oidcUserProvider.UpdateUserClaims")
+	ctx, span := tel.GetTracer().Start(ctx, "oidcUserProvider.updateUserClaims")
 	defer span.End()
 
-	var claimMap jwt.MapClaims
-	var err error
-	var ok bool
-
-	jParser := jwt.NewParser()
-	t, _, err := jParser.ParseUnverified(idToken, jwt.MapClaims{})
+	claimMap, err := o.verifyIDToken(idToken, ctx)
 	if err != nil {
-		return nil, err
-	}
-	claimMap, ok = t.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, jwt.ErrTokenMalformed
+		logger.Debug().Err(err).Msg("Could not verify id token")
+		return nil, AuthenticationError
 	}
 
 	logger.Debug().
 		Interface("claim_map", claimMap).
 		Msg("Parsed user claim map.")
 
+	if nonceClaim, _ := claimMap["nonce"].(string); nonce == "" || nonceClaim != nonce {
+		logger.Debug().Interface("nonce", claimMap["nonce"]).Msg("Received bad nonce")
+		return nil, AuthenticationError
+	}
+
 	if o.ClaimSource == USER_INFO {
 		// trust the provider to authenticate the user.
 		infoClaimMap, err := o.getUserInfo(accessToken, ctx)
@@ -323,47 +389,18 @@ func (o *oidcUserProvider) UpdateUserClaims(idToken, accessToken string, ctx con
 			logger.Error().Err(err).Msg("Could not get user info from endpoint")
 			return nil, err
 		}
-		for k,v := range infoClaimMap {
-			claimMap[k] = v
-		}
-	} else {
-		//o.ClaimSource == IDENTITY_TOKEN, verify accessToken as state$addr$nonce
-		accessParts := strings.Split(accessToken, "$")
-		if len(accessParts) != 3 {
-			logger.Debug().Str("token", accessToken).Msg("Received bad access token")
+		idSubject, _ := claimMap["sub"].(string)
+		if infoSubject, _ := infoClaimMap["sub"].(string); idSubject == "" || infoSubject != idSubject {
+			logger.Warn().
+				Interface("id_token_sub", claimMap["sub"]).
+				Interface("user_info_sub", infoClaimMap["sub"]).
+				Msg("User info subject does not match the id token")
 			return nil, AuthenticationError
 		}
-		state := accessParts[0]
-		addr := accessParts[1]
-		nonceStr := accessParts[2]
-
-		if nonceClaim, ok := claimMap["nonce"]; ok && nonceClaim != nonceStr {
-			logger.Debug().Interface("nonce", nonceClaim).Msg("Received bad nonce")
-			return nil, AuthenticationError
-		}
-
-		// The iat should be within the same interval as the generated state (10min)
-		tint := timePeriod(10)
-		if iatClaim, err := claimMap.GetIssuedAt(); err == nil{
-			if time.Now().After(iatClaim.Add(10 * time.Minute)){
-				logger.Debug().Time("issued_at", iatClaim.Time).Msg("Got stale id token")
-				return nil, AuthenticationError
+		for k,v := range infoClaimMap {
+			if !slices.Contains(idTokenOnlyClaims, k) {
+				claimMap[k] = v
 			}
-			tint = timeToBytes(iatClaim.Truncate(10 * time.Minute))
-		}
-
-		nonce, err := base64.URLEncoding.DecodeString(nonceStr)
-		if err != nil {
-			logger.Error().Err(err).Str("nonce", nonceStr).Msg("could not decode nonce")
-			return nil, err
-		}
-		expectedState := stateHash(o.StateSalt, nonce, []byte(addr), tint) 
-		if state != expectedState {
-			logger.Debug().
-				Str("state", state).
-				Str("expected_state", expectedState).
-				Msg("State did not match expected")
-			return nil, AuthenticationError
 		}
 	}
 
@@ -374,7 +411,7 @@ func (o *oidcUserProvider) UpdateUserClaims(idToken, accessToken string, ctx con
 	return o.persistClaims(claimMap, ctx)
 }
 
-func (o *oidcUserProvider) addParamsToAuthURL(req *http.Request, nonce []byte) *url.URL {
+func (o *oidcUserProvider) addParamsToAuthURL(authState oidcAuthState) *url.URL {
 	u, _ := url.Parse(o.AuthenticationURL.String())
 	q := u.Query()
 
@@ -386,7 +423,10 @@ func (o *oidcUserProvider) addParamsToAuthURL(req *http.Request, nonce []byte) *
 		q.Add(key, val)
 	}
 
-	q.Add("state", o.computeState(req, nonce))
+	q.Add("state", authState.State)
+	q.Add("nonce", authState.Nonce)
+	q.Add("code_challenge", authState.codeChallenge())
+	q.Add("code_challenge_method", "S256")
 
 	u.RawQuery = q.Encode()
 	return u
@@ -407,17 +447,17 @@ func (o *oidcUserProvider) persistClaims(claimMap jwt.MapClaims, ctx context.Con
 
 	db := ent.FromContext(ctx)
 
-	claimLinks := []predicate.GroupLink{}
-	
-	for cKey, cValue := range claimMap {
-		claimLinks = append(claimLinks, grouplink.ResourceSpecEQ(fmt.Sprintf("%s=%s", cKey, cValue)))
+	passedThrough, err := o.passthroughClaims(claimMap, ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not pass through provider claims")
+		return nil, err
 	}
 
 	foundLinks, err := db.GroupLink.Query().
 		Where(
 			grouplink.And(
 				grouplink.TypeEQ(o.dbSourceName),
-				grouplink.Or(claimLinks...),
+				grouplink.ResourceSpecIn(o.linkSpecs(claimMap)...),
 			),
 		).
 		WithClaimGroup(func (q *ent.ClaimGroupQuery) {
@@ -427,14 +467,13 @@ func (o *oidcUserProvider) persistClaims(claimMap jwt.MapClaims, ctx context.Con
 	if err != nil {
 		logger.Error().Err(err).Msg("Could not get group links.")
 		return nil, err
-	} else if len(foundLinks) == 0 {
+	} else if len(foundLinks) == 0 && !passedThrough && !o.Provisioning.AllowUnlinked {
 		logger.Error().Msg("No group links found")
 		return nil, NoLinkedGroupsError
 	}
 
 	logger.Debug().Interface("found_links", foundLinks).Msg("Found group links")
 
-	//TODO allow user claim passthrough with or without persistance?
 	add, del := findGroupChanges(u, foundLinks, "OIDC:" + o.Name)
 	if u, err = applyGroupChanges(add, del, u, ctx) ; err != nil {
 		logger.Error().
@@ -445,6 +484,13 @@ func (o *oidcUserProvider) persistClaims(claimMap jwt.MapClaims, ctx context.Con
 
 	logger.Debug().Interface("user", u).Msg("Updated user groups")
 
+	if o.BackChannelLogout {
+		if err := o.startSession(claimMap, ctx); err != nil {
+			logger.Error().Err(err).Msg("Could not record provider session")
+			return nil, err
+		}
+	}
+
 	return retreiveLocalUser(u.Username, ctx)
 }
 
@@ -465,20 +511,64 @@ func (o *oidcUserProvider) getOrCreateUser(claimMap jwt.MapClaims, ctx context.C
 		return nil, jwt.ErrTokenMalformed
 	}
 
-	u, err := retreiveLocalUser(email, ctx)
-	if ent.IsNotFound(err) {
-		logger.Info().Msg("User not found, creating in database.")
-		return ent.FromContext(ctx).User.Create().
-			SetFname(fname).
-			SetLname(lname).
-			SetEmail(email).
-			SetUsername(email).
-			SetSource(o.dbSourceName).
-			Save(ctx)
+	// Emails can change or be given to someone else, so only the sub identifies the provider account
+	subject := safeGetClaim("sub", claimMap)
+	if subject == "" {
+		logger.Error().Msg("Provider did not return a sub claim")
+		return nil, AuthSourceError
+	}
+
+	// Providers may return emails the account holder never proved to own, so those can not pass domain rules or pick a user to link to
+	lookup := email
+	if !emailVerified(claimMap) {
+		if len(o.Provisioning.AllowedDomains) > 0 {
+			logger.Warn().
+				Str("rule", "allowed_domains").
+				Msg("Provisioning rule rejected user with an unverified email")
+			return nil, fmt.Errorf("%w: email is not verified", ProvisioningRejectedError)
+		}
+		lookup = ""
+	}
+
+	if err := o.Provisioning.check(o.Name, email, func(name string) []string { return lookupValues(claimMap, name) }, ctx); err != nil {
+		return nil, err
+	}
+
+	u, err := identityUser(
+		externalIdentity{
+			ProviderType: o.identityType(),
+			ProviderName: o.Name,
+			Subject: subject,
+		},
+		o.dbSourceName,
+		lookup,
+		o.Provisioning.provisionUser(func() (*ent.User, error) {
+			return ent.FromContext(ctx).User.Create().
+				SetFname(fname).
+				SetLname(lname).
+				SetEmail(email).
+				SetUsername(email).
+				SetSource(o.dbSourceName).
+				Save(ctx)
+		}, ctx),
+		ctx,
+	)
+	if err != nil {
+		return nil, err
 	}
 	logger.Debug().Interface("user", u).Msg("User found.")
 	return u, nil
+}
 
+// emailVerified reports whether the provider set email_verified, which some providers send as a string
+func emailVerified(claimMap jwt.MapClaims) bool {
+	switch verified := claimMap["email_verified"].(type) {
+	case bool:
+		return verified
+	case string:
+		return strings.EqualFold(verified, "true")
+	}
+	return false
 }
 
 // Gets user claims from the UserInfoURL
@@ -534,7 +624,7 @@ func (o *oidcUserProvider) getUserInfo(accessToken string, ctx context.Context)
 
 // Gets id token and access token from tokenURL.
 // If we already have the tokens we need, it will do nothing
-func (o *oidcUserProvider) getTokens(idToken, accessToken, authCode string, ctx context.Context) (string, string, error) {
+func (o *oidcUserProvider) getTokens(idToken, accessToken, authCode, codeVerifier string, ctx context.Context) (string, string, error) {
 	logger := zerolog.Ctx(ctx).With().
 		Str("component", "OIDCProvider").
 		Stringer("claim_source", o.ClaimSource).
@@ -556,12 +646,12 @@ func (o *oidcUserProvider) getTokens(idToken, accessToken, authCode string, ctx
 	values.Add("grant_type", "authorization_code")
 	values.Add("code", authCode)
 	values.Add("redirect_uri", o.Request.RedirectURI)
+	values.Add("code_verifier", codeVerifier)
 
 	req, _ := http.NewRequest(http.MethodPost, o.TokenURL.String(), strings.NewReader(values.Encode()))
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	req.SetBasicAuth(o.Request.ClientID, o.ClientSecret)
 
-	logger = logger.With().Str("post_values", values.Encode()).Logger()
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -612,25 +702,6 @@ func (o *oidcUserProvider) getTokens(idToken, accessToken, authCode string, ctx
 	return idToken, accessToken, nil
 }
 
-// Computes a state hash that should be specific to a host and a request
-func (o *oidcUserProvider) computeState(req *http.Request, nonce []byte) string {
-	return requestHash(o.StateSalt, nonce, req)
-}
-
-// Validates whether the state variable was generated by us
-func (o *oidcUserProvider) hasValidState(req *http.Request) bool {
-	nonceB64, err := req.Cookie(o.cookieName("nonce"))
-	if err != nil {
-		return false
-	}
-	nonce, _ := base64.URLEncoding.DecodeString(nonceB64.Value)
-	return req.URL.Query().Get("state") == requestHash(o.StateSalt, nonce, req)
-}
-
-func (o *oidcUserProvider) cookieName(c string) string {
-	return fmt.Sprintf("stoke-oidc-%s-%s", o.Name, c)
-}
-
 // Creates a new random 32 byte string
 func newNonce() []byte {
 	nonce := make([]byte, 32)
@@ -638,28 +709,6 @@ func newNonce() []byte {
 	return nonce
 }
 
-// Returns the time stamp for the given division of time in minutes
-func timePeriod(m int) []byte {
-	return timeToBytes(time.Now().Truncate(time.Duration(m) * time.Minute))
-}
-
-func timeToBytes(t time.Time) []byte {
-	tsBytes := make([]byte, 8)
-	ts := t.Unix()
-	binary.NativeEndian.PutUint64(tsBytes, uint64(ts))
-	return tsBytes
-}
-
-// Creates a request specific hash. Only valid for 10 minutes.
-func requestHash(salt, nonce []byte, req *http.Request) string {
-	return stateHash(salt, nonce, []byte(req.RemoteAddr), timePeriod(10))
-}
-
-func stateHash(salt, nonce, addr, tint []byte) string {
-	hash := sha256.Sum256(slices.Concat( addr, salt, tint, nonce ))
-	return base64.URLEncoding.EncodeToString(hash[:])
-}
-
 func safeGetClaim(key string, claims jwt.MapClaims) string {
 	if valInt, ok := claims[key]; ok {
 		if val, ok := valInt.(string); ok {
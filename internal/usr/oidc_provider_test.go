@@ -0,0 +1,1081 @@
+package usr_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"stoke/internal/ent"
+	"stoke/internal/ent/providersession"
+	"stoke/internal/ent/user"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer   = "https://idp.example"
+	testClientID = "stoke-client"
+)
+
+type oidcFixture struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	keys     map[string]*rsa.PrivateKey
+	jwksHits int
+	// PKCE challenge sent in the last authorization request and the id token to return for it
+	challenge string
+	idToken   string
+	// Claims served at the user info endpoint
+	userInfo  map[string]interface{}
+}
+
+// newOIDCFixture starts a provider that serves a JWKS and a user info document with only the subject of validIDClaims
+func newOIDCFixture(t *testing.T) *oidcFixture {
+	f := &oidcFixture{
+		keys: make(map[string]*rsa.PrivateKey),
+		userInfo: map[string]interface{}{ "sub": "1234" },
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jwks", func(res http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.jwksHits++
+		var keys []map[string]string
+		for kid, k := range f.keys {
+			keys = append(keys, map[string]string{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(res).Encode(map[string]interface{}{ "keys": keys })
+	})
+	mux.HandleFunc("/userinfo", func(res http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		_ = json.NewEncoder(res).Encode(f.userInfo)
+	})
+	mux.HandleFunc("/token", func(res http.ResponseWriter, req *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		verifier := sha256.Sum256([]byte(req.PostFormValue("code_verifier")))
+		if base64.RawURLEncoding.EncodeToString(verifier[:]) != f.challenge {
+			_ = json.NewEncoder(res).Encode(map[string]string{ "error": "invalid_grant" })
+			return
+		}
+		_ = json.NewEncoder(res).Encode(map[string]string{ "id_token": f.idToken, "access_token": "access" })
+	})
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *oidcFixture) addKey(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Could not generate key: %v", err)
+	}
+	f.mu.Lock()
+	f.keys[kid] = key
+	f.mu.Unlock()
+	return key
+}
+
+func (f *oidcFixture) provider(t *testing.T) flowProvider {
+	base, _ := url.Parse(f.server.URL)
+	userInfo := base.JoinPath("/userinfo")
+	p := usr.NewOIDCUserProvider(
+		"test_oidc", "openid", "http://localhost/oidc/test_oidc",
+		"given_name", "family_name", "email",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/token"), base, userInfo,
+		http.NewServeMux(),
+		usr.CODE_FLOW, usr.USER_INFO,
+	)
+	p.VerifyIDTokens(f.server.URL + "/jwks", testIssuer)
+	return p
+}
+
+// codeFlowProvider returns a provider that exchanges codes at the fixture's token endpoint.
+// Logins are completed on the server when completionURL is set.
+func (f *oidcFixture) codeFlowProvider(completionURL string) http.Handler {
+	base, _ := url.Parse(f.server.URL)
+	p := usr.NewOIDCUserProvider(
+		"test_oidc", "openid", "http://localhost/oidc/test_oidc",
+		"given_name", "family_name", "email",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/token"), base.JoinPath("/auth"), base.JoinPath("/userinfo"),
+		http.NewServeMux(),
+		usr.CODE_FLOW, usr.USER_INFO,
+	)
+	p.VerifyIDTokens(f.server.URL + "/jwks", testIssuer)
+	p.AllowedRedirects = []string{ "https://app.example/stoke/" }
+	p.ServerCompletion = completionURL != ""
+	p.CompletionURL = completionURL
+	return p
+}
+
+// mappingProvider returns a provider that verifies id tokens and maps claims with the given rules
+func (f *oidcFixture) mappingProvider(mappings []usr.ClaimMapping, passthrough []usr.ClaimPassthrough) flowProvider {
+	base, _ := url.Parse(f.server.URL)
+	p := usr.NewOIDCUserProvider(
+		"test_oidc", "openid", "http://localhost/oidc/test_oidc",
+		"given_name", "family_name", "email",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/token"), base, base.JoinPath("/userinfo"),
+		http.NewServeMux(),
+		usr.CODE_FLOW, usr.USER_INFO,
+	)
+	p.VerifyIDTokens(f.server.URL + "/jwks", testIssuer)
+	p.ClaimMappings = mappings
+	p.Passthrough = passthrough
+	return p
+}
+
+type OIDCProvider interface {
+	UpdateUserClaims(idToken, accessToken string, ctx context.Context) (*ent.User, error)
+}
+
+type flowProvider interface {
+	OIDCProvider
+	http.Handler
+}
+
+var accessCodePattern = regexp.MustCompile(`setItem\("access_code", "([^"]*)"\)`)
+
+// loginWith runs a code flow login through the provider. The provider returns the id token that sign makes from claims
+// with the nonce of the authorization request added.
+// Returns the id token and the one-time access code that the browser posts to /api/login
+func (f *oidcFixture) loginWith(t *testing.T, p http.Handler, ctx context.Context, claims jwt.MapClaims, sign func(jwt.MapClaims) string) (string, string) {
+	params := startAuth(t, p, ctx, "")
+	claims["nonce"] = params.Get("nonce")
+	idToken := sign(claims)
+	f.mu.Lock()
+	f.challenge = params.Get("code_challenge")
+	f.idToken = idToken
+	f.mu.Unlock()
+
+	res := callback(p, ctx, params.Get("state"))
+	match := accessCodePattern.FindStringSubmatch(res.Body.String())
+	if res.Code != http.StatusOK || match == nil {
+		t.Fatalf("Callback did not hand out an access code: %d %s", res.Code, res.Body.String())
+	}
+	return idToken, match[1]
+}
+
+// login runs a code flow login with an id token signed by key as k1
+func (f *oidcFixture) login(t *testing.T, p http.Handler, ctx context.Context, key *rsa.PrivateKey, claims jwt.MapClaims) (string, string) {
+	return f.loginWith(t, p, ctx, claims, func(c jwt.MapClaims) string { return signIDToken(t, key, "k1", c) })
+}
+
+func validIDClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":         testIssuer,
+		"aud":         testClientID,
+		"sub":         "1234",
+		"iat":         now.Unix(),
+		"exp":         now.Add(time.Hour).Unix(),
+		"given_name":  "oidc",
+		"family_name": "user",
+		"email":       "oidc@example",
+		"email_verified": true,
+		"role":        "admin",
+	}
+}
+
+func signIDToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Could not sign id token: %v", err)
+	}
+	return signed
+}
+
+func oidcTestContext(t *testing.T) context.Context {
+	return usr.NewProviderList().WithContext(tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("other", "user", "other", "other@example"),
+				tu.Source("LOCAL"),
+				tu.Group(
+					tu.GroupInfo("admins", "administrators"),
+					tu.ProviderLink("OIDC", "test_oidc", "role=admin"),
+					tu.Claim(
+						tu.ClaimInfo("Admin Claim", "adm", "Y", "Administrator Claim"),
+					),
+				),
+			),
+		),
+	))
+}
+
+func TestOIDCUpdateUserClaimsVerifiesIDToken(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+
+	p := fixture.provider(t)
+
+	idToken, accessCode := fixture.login(t, p, ctx, key, validIDClaims())
+	u, err := p.UpdateUserClaims(idToken, accessCode, ctx)
+	if err != nil {
+		t.Fatalf("Valid id token was rejected: %v", err)
+	}
+	if u.Username != "oidc@example" {
+		t.Errorf("Unexpected user: %v", u)
+	}
+
+	// Access codes are used once and id tokens are bound to the nonce of their own login
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Access code was used twice: %v", err)
+	}
+	_, otherCode := fixture.login(t, p, ctx, key, validIDClaims())
+	if _, err := p.UpdateUserClaims(idToken, otherCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Replayed id token was accepted: %v", err)
+	}
+	if _, err := p.UpdateUserClaims(signIDToken(t, key, "k1", validIDClaims()), "access", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Id token without a login was accepted: %v", err)
+	}
+}
+
+// User info must be about the id token's subject and can not replace the claims only the id token sets
+func TestOIDCUserInfoMustMatchIDTokenSubject(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.provider(t)
+
+	fixture.userInfo = map[string]interface{}{ "sub": "5678", "email": "other@example" }
+	idToken, accessCode := fixture.login(t, p, ctx, key, validIDClaims())
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("User info about another subject was accepted: %v", err)
+	}
+
+	fixture.userInfo = map[string]interface{}{ "email": "other@example" }
+	idToken, accessCode = fixture.login(t, p, ctx, key, validIDClaims())
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("User info without a subject was accepted: %v", err)
+	}
+
+	fixture.userInfo = map[string]interface{}{ "sub": "1234", "email": "info@example", "iss": "https://evil.example", "nonce": "other" }
+	idToken, accessCode = fixture.login(t, p, ctx, key, validIDClaims())
+	u, err := p.UpdateUserClaims(idToken, accessCode, ctx)
+	if err != nil {
+		t.Fatalf("Matching user info was rejected: %v", err)
+	}
+	if u.Email != "info@example" {
+		t.Errorf("User info claims were not merged: %v", u)
+	}
+}
+
+func TestOIDCRequiresSubject(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	base, _ := url.Parse(fixture.server.URL)
+	// Claims come from the id token, so there is no user info sub to compare with
+	p := usr.NewOIDCUserProvider(
+		"test_oidc", "openid", "http://localhost/oidc/test_oidc",
+		"given_name", "family_name", "email",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/token"), base, base.JoinPath("/userinfo"),
+		http.NewServeMux(),
+		usr.CODE_FLOW, usr.IDENTITY_TOKEN,
+	)
+	p.VerifyIDTokens(fixture.server.URL + "/jwks", testIssuer)
+
+	idClaims := validIDClaims()
+	delete(idClaims, "sub")
+	idToken, accessCode := fixture.login(t, p, ctx, key, idClaims)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthSourceError) {
+		t.Errorf("Login without a sub was not rejected with AuthSourceError: %v", err)
+	}
+	if ent.FromContext(ctx).User.Query().Where(user.EmailEQ("oidc@example")).ExistX(ctx) {
+		t.Errorf("User was created for a login without a sub")
+	}
+}
+
+func TestOIDCUnverifiedEmailFailsDomainRules(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	base, _ := url.Parse(fixture.server.URL)
+	p := usr.NewOIDCUserProvider(
+		"test_oidc", "openid", "http://localhost/oidc/test_oidc",
+		"given_name", "family_name", "email",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/token"), base, base.JoinPath("/userinfo"),
+		http.NewServeMux(),
+		usr.CODE_FLOW, usr.USER_INFO,
+	)
+	p.VerifyIDTokens(fixture.server.URL + "/jwks", testIssuer)
+	p.Provisioning = usr.ProvisioningRules{ AllowedDomains: []string{ "example" } }
+
+	idClaims := validIDClaims()
+	idClaims["email_verified"] = false
+	idToken, accessCode := fixture.login(t, p, ctx, key, idClaims)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.ProvisioningRejectedError) {
+		t.Errorf("Unverified email passed the allowed domains: %v", err)
+	}
+
+	idClaims = validIDClaims()
+	idClaims["email_verified"] = "true"
+	idToken, accessCode = fixture.login(t, p, ctx, key, idClaims)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); err != nil {
+		t.Errorf("Email verified as a string was rejected: %v", err)
+	}
+}
+
+// An unverified email must not pick the user an account is linked to
+func TestOIDCUnverifiedEmailDoesNotLinkExistingUser(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := usr.NewProviderList().WithContext(tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("oidc", "user", "oidc@example", "oidc@example"),
+				tu.Source("OIDC:test_oidc"),
+				tu.Group(
+					tu.GroupInfo("admins", "administrators"),
+					tu.ProviderLink("OIDC", "test_oidc", "role=admin"),
+				),
+			),
+		),
+	))
+	p := fixture.provider(t)
+
+	idClaims := validIDClaims()
+	delete(idClaims, "email_verified")
+	idToken, accessCode := fixture.login(t, p, ctx, key, idClaims)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.IdentityNotLinkedError) {
+		t.Fatalf("Account with an unverified email was linked to the existing user: %v", err)
+	}
+	if identities, _ := usr.ListIdentities("oidc@example", ctx); len(identities) != 0 {
+		t.Errorf("Existing user was linked: %v", identities)
+	}
+
+	// Unverified emails still create new users
+	fixture.userInfo = map[string]interface{}{ "sub": "5678", "email": "new@example" }
+	idClaims = validIDClaims()
+	idClaims["sub"] = "5678"
+	delete(idClaims, "email_verified")
+	idToken, accessCode = fixture.login(t, p, ctx, key, idClaims)
+	u, err := p.UpdateUserClaims(idToken, accessCode, ctx)
+	if err != nil {
+		t.Fatalf("Login with an unverified email did not create a user: %v", err)
+	}
+	if u.Email != "new@example" {
+		t.Errorf("Unexpected user: %v", u)
+	}
+}
+
+func TestOIDCUpdateUserClaimsRejectsInvalidIDTokens(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	tests := map[string]func(jwt.MapClaims) string{
+		"bad signature": func(claims jwt.MapClaims) string {
+			return signIDToken(t, otherKey, "k1", claims)
+		},
+		"wrong audience": func(claims jwt.MapClaims) string {
+			claims["aud"] = "someone-else"
+			return signIDToken(t, key, "k1", claims)
+		},
+		"wrong issuer": func(claims jwt.MapClaims) string {
+			claims["iss"] = "https://evil.example"
+			return signIDToken(t, key, "k1", claims)
+		},
+		"expired": func(claims jwt.MapClaims) string {
+			claims["exp"] = time.Now().Add(-time.Hour).Unix()
+			return signIDToken(t, key, "k1", claims)
+		},
+		"missing iat": func(claims jwt.MapClaims) string {
+			delete(claims, "iat")
+			return signIDToken(t, key, "k1", claims)
+		},
+		"other authorized party": func(claims jwt.MapClaims) string {
+			claims["aud"] = []string{ testClientID, "someone-else" }
+			claims["azp"] = "someone-else"
+			return signIDToken(t, key, "k1", claims)
+		},
+		"missing nonce": func(claims jwt.MapClaims) string {
+			delete(claims, "nonce")
+			return signIDToken(t, key, "k1", claims)
+		},
+		"unsigned": func(claims jwt.MapClaims) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+			signed, _ := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+			return signed
+		},
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := oidcTestContext(t)
+			p := fixture.provider(t)
+			idToken, accessCode := fixture.loginWith(t, p, ctx, validIDClaims(), token)
+			if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+				t.Errorf("Expected authentication error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestOIDCUpdateUserClaimsRefetchesKeysOnRollover(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	provider := fixture.provider(t)
+	ctx := oidcTestContext(t)
+
+	idToken, accessCode := fixture.login(t, provider, ctx, key, validIDClaims())
+	if _, err := provider.UpdateUserClaims(idToken, accessCode, ctx); err != nil {
+		t.Fatalf("Valid id token was rejected: %v", err)
+	}
+
+	// The provider rotates to a new key that we have not seen yet
+	newKey := fixture.addKey(t, "k2")
+	// Unknown key ids only trigger a refetch once per second
+	time.Sleep(1100 * time.Millisecond)
+	idToken, accessCode = fixture.loginWith(t, provider, ctx, validIDClaims(), func(c jwt.MapClaims) string { return signIDToken(t, newKey, "k2", c) })
+	if _, err := provider.UpdateUserClaims(idToken, accessCode, ctx); err != nil {
+		t.Fatalf("Id token signed with rotated key was rejected: %v", err)
+	}
+	if fixture.jwksHits < 2 {
+		t.Errorf("Expected keys to be refetched after rollover, fetched %d times", fixture.jwksHits)
+	}
+}
+
+// startAuth sends a user to the provider and returns the authorization request parameters
+func startAuth(t *testing.T, p http.Handler, ctx context.Context, query string) url.Values {
+	req := httptest.NewRequest(http.MethodGet, "/oidc/test_oidc?" + query, nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	if res.Code != http.StatusTemporaryRedirect {
+		t.Fatalf("Expected redirect to provider, got %d", res.Code)
+	}
+	location, err := url.Parse(res.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("Bad redirect location: %v", err)
+	}
+	return location.Query()
+}
+
+func callback(p http.Handler, ctx context.Context, state string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/oidc/test_oidc?code=abc&state=" + url.QueryEscape(state), nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	return res
+}
+
+func TestOIDCCodeFlowUsesPKCEAndServerSideState(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.codeFlowProvider("")
+
+	params := startAuth(t, p, ctx, "next=" + url.QueryEscape("https://app.example/stoke/home"))
+	if params.Get("code_challenge_method") != "S256" || params.Get("code_challenge") == "" {
+		t.Fatalf("Authorization request is missing PKCE parameters: %v", params)
+	}
+	if params.Get("state") == "" || params.Get("nonce") == "" {
+		t.Fatalf("Authorization request is missing state or nonce: %v", params)
+	}
+
+	fixture.mu.Lock()
+	fixture.challenge = params.Get("code_challenge")
+	fixture.idToken = signIDToken(t, key, "k1", validIDClaims())
+	fixture.mu.Unlock()
+
+	res := callback(p, ctx, params.Get("state"))
+	if res.Code != http.StatusOK {
+		t.Fatalf("Callback failed with %d", res.Code)
+	}
+	if !strings.Contains(res.Body.String(), `app.example\/stoke\/home`) {
+		t.Errorf("Callback did not send the user to the next url")
+	}
+
+	// A state can only be used once
+	if res := callback(p, ctx, params.Get("state")); res.Code != http.StatusConflict {
+		t.Errorf("Replayed state was accepted with %d", res.Code)
+	}
+}
+
+func TestOIDCCallbackRejectsUnknownState(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	ctx := oidcTestContext(t)
+	p := fixture.codeFlowProvider("")
+
+	startAuth(t, p, ctx, "")
+	if res := callback(p, ctx, "not-a-state"); res.Code != http.StatusConflict {
+		t.Errorf("Unknown state was accepted with %d", res.Code)
+	}
+}
+
+func TestOIDCCallbackFailsWithWrongCodeVerifier(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	ctx := oidcTestContext(t)
+	p := fixture.codeFlowProvider("")
+
+	params := startAuth(t, p, ctx, "")
+	fixture.mu.Lock()
+	fixture.challenge = "some-other-challenge"
+	fixture.mu.Unlock()
+
+	if res := callback(p, ctx, params.Get("state")); res.Code != http.StatusInternalServerError {
+		t.Errorf("Token exchange with mismatched verifier did not fail, got %d", res.Code)
+	}
+}
+
+func TestOIDCRejectsNextURLsThatAreNotAllowed(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	ctx := oidcTestContext(t)
+	p := fixture.codeFlowProvider("")
+
+	allowed := []string{ "/admin/", "https://app.example/stoke/", "https://APP.example/stoke/users?id=1" }
+	denied := []string{ "https://evil.example/", "//evil.example/", "https://app.example/other", "https://user@app.example/stoke/", "/\\evil.example", "javascript:alert(1)" }
+
+	for _, next := range allowed {
+		startAuth(t, p, ctx, "next=" + url.QueryEscape(next))
+	}
+	for _, next := range denied {
+		req := httptest.NewRequest(http.MethodGet, "/oidc/test_oidc?next=" + url.QueryEscape(next), nil).WithContext(ctx)
+		res := httptest.NewRecorder()
+		p.ServeHTTP(res, req)
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("Next url %s was not rejected, got %d", next, res.Code)
+		}
+	}
+}
+
+// Completion urls are checked like next urls when the provider is configured
+func TestCheckCompletionURL(t *testing.T) {
+	allowed := []string{ "https://app.example/stoke/" }
+	for _, completion := range []string{ "", "/done", "https://app.example/stoke/done" } {
+		if err := usr.CheckCompletionURL(completion, allowed); err != nil {
+			t.Errorf("Completion url %s was rejected: %v", completion, err)
+		}
+	}
+	for _, completion := range []string{ "https://evil.example/", "//evil.example/", "https://app.example/other" } {
+		if err := usr.CheckCompletionURL(completion, allowed); err == nil {
+			t.Errorf("Completion url %s was not rejected", completion)
+		}
+	}
+}
+
+func TestOIDCServerCompletionRedirectsWithOneTimeCode(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := usr.WithSessionIssuer(
+		func(u *ent.User, claims ent.Claims, _ context.Context) (string, string, error) {
+			if len(claims) != 1 || claims[0].ShortName != "adm" {
+				return "", "", errors.New("unexpected claims")
+			}
+			return "token-for-" + u.Username, "refresh", nil
+		},
+		oidcTestContext(t),
+	)
+	p := fixture.codeFlowProvider("/done")
+
+	params := startAuth(t, p, ctx, "")
+	claims := validIDClaims()
+	claims["nonce"] = params.Get("nonce")
+	fixture.mu.Lock()
+	fixture.challenge = params.Get("code_challenge")
+	fixture.idToken = signIDToken(t, key, "k1", claims)
+	fixture.mu.Unlock()
+
+	res := callback(p, ctx, params.Get("state"))
+	if res.Code != http.StatusSeeOther {
+		t.Fatalf("Expected redirect after login, got %d", res.Code)
+	}
+	location, _ := url.Parse(res.Header().Get("Location"))
+	if location.Path != "/done" || location.Query().Get("code") == "" {
+		t.Fatalf("Unexpected redirect location: %s", location)
+	}
+	if strings.Contains(location.String(), "token-for") {
+		t.Errorf("Redirect leaked the issued token: %s", location)
+	}
+
+	username, token, refresh, err := usr.RedeemLoginCode(location.Query().Get("code"), ctx)
+	if err != nil || username != "oidc@example" || token != "token-for-oidc@example" || refresh != "refresh" {
+		t.Errorf("Unexpected login code redemption: %s %s %s %v", username, token, refresh, err)
+	}
+	if _, _, _, err := usr.RedeemLoginCode(location.Query().Get("code"), ctx); !errors.Is(err, usr.LoginCodeError) {
+		t.Errorf("Login code was redeemed twice: %v", err)
+	}
+}
+
+func TestOIDCServerCompletionRejectsWrongNonce(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := usr.WithSessionIssuer(
+		func(*ent.User, ent.Claims, context.Context) (string, string, error) {
+			return "token", "refresh", nil
+		},
+		oidcTestContext(t),
+	)
+	p := fixture.codeFlowProvider("/done")
+
+	params := startAuth(t, p, ctx, "")
+	claims := validIDClaims()
+	claims["nonce"] = "replayed-nonce"
+	fixture.mu.Lock()
+	fixture.challenge = params.Get("code_challenge")
+	fixture.idToken = signIDToken(t, key, "k1", claims)
+	fixture.mu.Unlock()
+
+	res := callback(p, ctx, params.Get("state"))
+	location, _ := url.Parse(res.Header().Get("Location"))
+	if res.Code != http.StatusSeeOther || location.Query().Get("error") != "access_denied" {
+		t.Errorf("Expected access denied redirect, got %d %s", res.Code, location)
+	}
+}
+
+func TestOIDCClaimMappingMatchesArraysNestedClaimsAndPatterns(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("other", "user", "other", "other@example"),
+				tu.Source("LOCAL"),
+				tu.Group(
+					tu.GroupInfo("devs", "developers"),
+					tu.ProviderLink("OIDC", "test_oidc", "groups=devs"),
+					tu.Claim(tu.ClaimInfo("Dev Claim", "dev", "Y", "Developer")),
+				),
+				tu.Group(
+					tu.GroupInfo("admins", "administrators"),
+					tu.ProviderLink("OIDC", "test_oidc", "realm_access.roles=admin"),
+					tu.Claim(tu.ClaimInfo("Admin Claim", "adm", "Y", "Administrator")),
+				),
+				tu.Group(
+					tu.GroupInfo("teams", "any team"),
+					tu.ProviderLink("OIDC", "test_oidc", "team-member"),
+					tu.Claim(tu.ClaimInfo("Team Claim", "team", "Y", "Team member")),
+				),
+				tu.Group(
+					tu.GroupInfo("ops", "operators"),
+					tu.ProviderLink("OIDC", "test_oidc", "groups=ops"),
+					tu.Claim(tu.ClaimInfo("Ops Claim", "ops", "Y", "Operator")),
+				),
+			),
+		),
+	)
+
+	p := fixture.mappingProvider(
+		[]usr.ClaimMapping{
+			{ Claim: "$.teams", Match: regexp.MustCompile("^team-"), Link: "team-member" },
+		},
+		nil,
+	)
+
+	claims := validIDClaims()
+	claims["groups"] = []string{ "devs", "qa" }
+	claims["realm_access"] = map[string]interface{}{ "roles": []string{ "admin", "viewer" } }
+	claims["teams"] = []string{ "team-blue" }
+
+	idToken, accessCode := fixture.login(t, p, ctx, key, claims)
+	u, err := p.UpdateUserClaims(idToken, accessCode, ctx)
+	if err != nil {
+		t.Fatalf("Could not update user claims: %v", err)
+	}
+	var groups []string
+	for _, g := range u.Edges.ClaimGroups {
+		groups = append(groups, g.Name)
+	}
+	sort.Strings(groups)
+	if !slices.Equal(groups, []string{ "admins", "devs", "teams" }) {
+		t.Errorf("Unexpected groups: %v", groups)
+	}
+}
+
+func TestOIDCClaimPassthrough(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+
+	p := fixture.mappingProvider(
+		nil,
+		[]usr.ClaimPassthrough{
+			{ Claim: "org.department", As: "dept" },
+			{ Claim: "site", Persist: true },
+		},
+	)
+	list := usr.NewProviderList()
+	list.AddForeignProvider("test_oidc", p)
+
+	claims := validIDClaims()
+	claims["org"] = map[string]interface{}{ "department": "engineering" }
+	claims["site"] = "north"
+
+	idToken, accessCode := fixture.login(t, p, ctx, key, claims)
+	u, userClaims, err := list.GetUserClaims(idToken, accessCode, "test_oidc", ctx)
+	if err != nil {
+		t.Fatalf("Could not get user claims: %v", err)
+	}
+
+	found := make(map[string]string)
+	for _, c := range userClaims {
+		found[c.ShortName] = c.Value
+	}
+	if found["dept"] != "engineering" || found["site"] != "north" || found["adm"] != "Y" {
+		t.Errorf("Unexpected claims: %v", found)
+	}
+
+	// Only the persisted claim has a group in the database
+	var groups []string
+	for _, g := range u.Edges.ClaimGroups {
+		groups = append(groups, g.Name)
+	}
+	sort.Strings(groups)
+	if !slices.Equal(groups, []string{ "admins", "test_oidc:site=north" }) {
+		t.Errorf("Unexpected groups: %v", groups)
+	}
+}
+
+// Configuring passthrough claims does not let users without linked groups or passthrough claim values log in
+func TestOIDCClaimPassthroughRequiresClaims(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+
+	p := fixture.mappingProvider(nil, []usr.ClaimPassthrough{ { Claim: "org.department", As: "dept" } })
+
+	claims := validIDClaims()
+	claims["role"] = "viewer"
+	idToken, accessCode := fixture.login(t, p, ctx, key, claims)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.NoLinkedGroupsError) {
+		t.Errorf("Login without linked groups or passthrough claims returned %v", err)
+	}
+
+	claims["org"] = map[string]interface{}{ "department": "engineering" }
+	idToken, accessCode = fixture.login(t, p, ctx, key, claims)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); err != nil {
+		t.Errorf("Login with a passthrough claim failed: %v", err)
+	}
+}
+
+// logoutProvider returns a provider with RP-initiated and back-channel logout enabled
+func (f *oidcFixture) logoutProvider() flowProvider {
+	base, _ := url.Parse(f.server.URL)
+	p := usr.NewOIDCUserProvider(
+		"test_oidc", "openid", "http://localhost/oidc/test_oidc",
+		"given_name", "family_name", "email",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/token"), base, base.JoinPath("/userinfo"),
+		http.NewServeMux(),
+		usr.CODE_FLOW, usr.USER_INFO,
+	)
+	p.VerifyIDTokens(f.server.URL + "/jwks", testIssuer)
+	p.AllowedRedirects = []string{ "https://app.example/stoke/" }
+	p.EndSessionURL = base.JoinPath("/logout")
+	p.PostLogoutRedirectURI = "https://app.example/stoke/"
+	p.BackChannelLogout = true
+	return p
+}
+
+func validLogoutClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":    testIssuer,
+		"aud":    testClientID,
+		"sub":    "1234",
+		"sid":    "session-1",
+		"iat":    now.Unix(),
+		"exp":    now.Add(time.Minute).Unix(),
+		"jti":    "logout-1",
+		"events": map[string]interface{}{ "http://schemas.openid.net/event/backchannel-logout": map[string]interface{}{} },
+	}
+}
+
+func backChannelLogout(p http.Handler, ctx context.Context, logoutToken string) int {
+	form := url.Values{ "logout_token": { logoutToken } }
+	req := httptest.NewRequest(http.MethodPost, "/oidc/test_oidc/backchannel_logout", strings.NewReader(form.Encode())).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	return res.Code
+}
+
+// loginSession logs in through the provider with an id token signed by key and returns the session claim of the issued claims
+func (f *oidcFixture) loginSession(t *testing.T, p flowProvider, ctx context.Context, key *rsa.PrivateKey, idClaims jwt.MapClaims) string {
+	list := usr.NewProviderList()
+	list.AddForeignProvider("test_oidc", p)
+	idToken, accessCode := f.login(t, p, ctx, key, idClaims)
+	_, claims, err := list.GetUserClaims(idToken, accessCode, "test_oidc", ctx)
+	if err != nil {
+		t.Fatalf("Could not log in: %v", err)
+	}
+	for _, c := range claims {
+		if c.ShortName == usr.SessionClaim {
+			return c.Value
+		}
+	}
+	t.Fatalf("Login did not include a session claim: %v", claims)
+	return ""
+}
+
+func TestOIDCBackChannelLogoutRevokesSessions(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.logoutProvider()
+
+	idClaims := validIDClaims()
+	idClaims["sid"] = "session-1"
+	session := fixture.loginSession(t, p, ctx, key, idClaims)
+	idClaims["sid"] = "session-2"
+	otherSession := fixture.loginSession(t, p, ctx, key, idClaims)
+
+	if err := usr.CheckSession(session, ctx); err != nil {
+		t.Fatalf("New session was not valid: %v", err)
+	}
+
+	if code := backChannelLogout(p, ctx, signIDToken(t, key, "k1", validLogoutClaims())); code != http.StatusOK {
+		t.Fatalf("Valid logout token was rejected with %d", code)
+	}
+	if err := usr.CheckSession(session, ctx); !errors.Is(err, usr.SessionRevokedError) {
+		t.Errorf("Logged out session was not revoked: %v", err)
+	}
+	if err := usr.CheckSession(otherSession, ctx); err != nil {
+		t.Errorf("Session with another sid was revoked: %v", err)
+	}
+}
+
+func TestOIDCBackChannelLogoutRejectsReplayedTokens(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.logoutProvider()
+
+	logoutToken := signIDToken(t, key, "k1", validLogoutClaims())
+	if code := backChannelLogout(p, ctx, logoutToken); code != http.StatusOK {
+		t.Fatalf("Valid logout token was rejected with %d", code)
+	}
+	if code := backChannelLogout(p, ctx, logoutToken); code != http.StatusBadRequest {
+		t.Errorf("Replayed logout token was accepted with %d", code)
+	}
+
+	claims := validLogoutClaims()
+	claims["jti"] = "logout-2"
+	if code := backChannelLogout(p, ctx, signIDToken(t, key, "k1", claims)); code != http.StatusOK {
+		t.Errorf("Logout token with a new jti was rejected with %d", code)
+	}
+}
+
+func TestOIDCProviderSessionsExpire(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.logoutProvider()
+	db := ent.FromContext(ctx)
+
+	idClaims := validIDClaims()
+	idClaims["sid"] = "session-1"
+	session := fixture.loginSession(t, p, ctx, key, idClaims)
+
+	if err := usr.KeepSession(session, time.Now().Add(24 * time.Hour), ctx); err != nil {
+		t.Fatalf("Could not keep session: %v", err)
+	}
+	if err := usr.CheckSession(session, ctx); err != nil {
+		t.Errorf("Kept session was not valid: %v", err)
+	}
+
+	db.ProviderSession.Update().Where(providersession.SessionEQ(session)).SetExpires(time.Now().Add(-time.Minute)).ExecX(ctx)
+	if err := usr.CheckSession(session, ctx); !errors.Is(err, usr.SessionRevokedError) {
+		t.Errorf("Expired session was valid: %v", err)
+	}
+
+	db.ProviderSession.Update().Where(providersession.SessionEQ(session)).ClearExpires().ExecX(ctx)
+	if err := usr.CheckSession(session, ctx); !errors.Is(err, usr.SessionRevokedError) {
+		t.Errorf("Session without an expiry was valid: %v", err)
+	}
+
+	idClaims["sid"] = "session-2"
+	fixture.loginSession(t, p, ctx, key, idClaims)
+	if n := db.ProviderSession.Query().Where(providersession.SessionEQ(session)).CountX(ctx); n != 0 {
+		t.Errorf("Expired session was not pruned by a new login")
+	}
+}
+
+// Logins that filter claims keep the session claim, so their tokens can not be refreshed after back-channel logout
+func TestOIDCSessionClaimSurvivesFilteredLogin(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.logoutProvider()
+	list := usr.NewProviderList()
+	list.AddForeignProvider("test_oidc", p)
+
+	idClaims := validIDClaims()
+	idClaims["sid"] = "session-1"
+	idToken, accessCode := fixture.login(t, p, ctx, key, idClaims)
+	_, claims, err := list.GetUserClaims(idToken, accessCode, "test_oidc", ctx)
+	if err != nil {
+		t.Fatalf("Could not log in: %v", err)
+	}
+
+	if tokenMap := usr.FilteredTokenClaims(claims, nil); len(tokenMap) != 2 || tokenMap["adm"] != "Y" {
+		t.Errorf("Unfiltered token claims are missing claims: %v", tokenMap)
+	}
+	tokenMap := usr.FilteredTokenClaims(claims, []string{ "other" })
+	if len(tokenMap) != 1 || tokenMap[usr.SessionClaim] == "" {
+		t.Errorf("Unexpected filtered token claims: %v", tokenMap)
+	}
+}
+
+func TestOIDCBackChannelLogoutRejectsInvalidTokens(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.logoutProvider()
+
+	idClaims := validIDClaims()
+	idClaims["sid"] = "session-1"
+	session := fixture.loginSession(t, p, ctx, key, idClaims)
+
+	tests := map[string]func(jwt.MapClaims){
+		"missing events":  func(c jwt.MapClaims) { delete(c, "events") },
+		"other event":     func(c jwt.MapClaims) { c["events"] = map[string]interface{}{ "urn:other": map[string]interface{}{} } },
+		"has nonce":       func(c jwt.MapClaims) { c["nonce"] = "abc" },
+		"no sub or sid":   func(c jwt.MapClaims) { delete(c, "sub"); delete(c, "sid") },
+		"missing jti":     func(c jwt.MapClaims) { delete(c, "jti") },
+		"wrong audience":  func(c jwt.MapClaims) { c["aud"] = "someone-else" },
+		"wrong issuer":    func(c jwt.MapClaims) { c["iss"] = "https://evil.example" },
+		"expired":         func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			claims := validLogoutClaims()
+			mutate(claims)
+			if code := backChannelLogout(p, ctx, signIDToken(t, key, "k1", claims)); code != http.StatusBadRequest {
+				t.Errorf("Invalid logout token was accepted with %d", code)
+			}
+		})
+	}
+
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	if code := backChannelLogout(p, ctx, signIDToken(t, otherKey, "k1", validLogoutClaims())); code != http.StatusBadRequest {
+		t.Errorf("Logout token with bad signature was accepted with %d", code)
+	}
+	if err := usr.CheckSession(session, ctx); err != nil {
+		t.Errorf("Session was revoked by an invalid logout token: %v", err)
+	}
+}
+
+func TestOIDCLogoutRedirectsToEndSession(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	ctx := oidcTestContext(t)
+	p := fixture.logoutProvider()
+
+	req := httptest.NewRequest(http.MethodGet, "/oidc/test_oidc/logout?id_token_hint=hint&next=" + url.QueryEscape("https://app.example/stoke/bye"), nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	if res.Code != http.StatusSeeOther {
+		t.Fatalf("Expected redirect to end session endpoint, got %d", res.Code)
+	}
+	location, _ := url.Parse(res.Header().Get("Location"))
+	q := location.Query()
+	if location.Path != "/logout" || q.Get("client_id") != testClientID || q.Get("id_token_hint") != "hint" || q.Get("post_logout_redirect_uri") != "https://app.example/stoke/bye" {
+		t.Errorf("Unexpected end session redirect: %s", location)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/oidc/test_oidc/logout?next=" + url.QueryEscape("https://evil.example/"), nil).WithContext(ctx)
+	res = httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	if res.Code != http.StatusBadRequest {
+		t.Errorf("Logout to a url that is not allowed was accepted with %d", res.Code)
+	}
+}
+
+// Providers with keys but no issuer reject id and logout tokens instead of skipping the iss check
+func TestOIDCRequiresIssuerWithKeys(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	key := fixture.addKey(t, "k1")
+	ctx := oidcTestContext(t)
+	p := fixture.logoutProvider()
+	p.(interface{ VerifyIDTokens(string, string) }).VerifyIDTokens(fixture.server.URL + "/jwks", "")
+
+	idToken, accessCode := fixture.login(t, p, ctx, key, validIDClaims())
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Id token was accepted without an issuer: %v", err)
+	}
+	if code := backChannelLogout(p, ctx, signIDToken(t, key, "k1", validLogoutClaims())); code != http.StatusBadRequest {
+		t.Errorf("Logout token was accepted without an issuer with %d", code)
+	}
+}
+
+// Providers without keys reject id tokens unless verification is explicitly skipped. Skipping only skips the signature
+func TestOIDCUpdateUserClaimsWithoutKeys(t *testing.T) {
+	fixture := newOIDCFixture(t)
+	base, _ := url.Parse(fixture.server.URL)
+	p := usr.NewOIDCUserProvider(
+		"test_oidc", "openid", "http://localhost/oidc/test_oidc",
+		"given_name", "family_name", "email",
+		testClientID, "client-secret",
+		nil,
+		base.JoinPath("/token"), base, base.JoinPath("/userinfo"),
+		http.NewServeMux(),
+		usr.CODE_FLOW, usr.USER_INFO,
+	)
+	unsigned := func(claims jwt.MapClaims) string {
+		signed, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		return signed
+	}
+
+	ctx := oidcTestContext(t)
+	idToken, accessCode := fixture.loginWith(t, p, ctx, validIDClaims(), unsigned)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Unverified id token was accepted: %v", err)
+	}
+
+	p.SkipIDTokenVerification = true
+	ctx = oidcTestContext(t)
+	idToken, accessCode = fixture.loginWith(t, p, ctx, validIDClaims(), unsigned)
+	if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); err != nil {
+		t.Errorf("Id token was rejected with verification skipped: %v", err)
+	}
+
+	expired := validIDClaims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	missingIat := validIDClaims()
+	delete(missingIat, "iat")
+	for name, claims := range map[string]jwt.MapClaims{ "expired": expired, "missing iat": missingIat } {
+		ctx := oidcTestContext(t)
+		idToken, accessCode := fixture.loginWith(t, p, ctx, claims, unsigned)
+		if _, err := p.UpdateUserClaims(idToken, accessCode, ctx); !errors.Is(err, usr.AuthenticationError) {
+			t.Errorf("%s id token was accepted with verification skipped: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package usr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"stoke/internal/ent"
+	"stoke/internal/ent/oidcstate"
+	"strings"
+	"time"
+)
+
+// How long a user has to complete authentication with the provider
+const oidcStateDuration = 10 * time.Minute
+
+// oidcAuthState is what we remember about an authorization request while the user is at the provider.
+// OIDC and OAuth2 providers share the same store.
+type oidcAuthState struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+	NextURL      string
+	Xfer         string
+	// Provider access token kept for the user info request of a handed off login
+	AccessToken  string
+}
+
+// newAuthState creates random state, nonce and PKCE code verifier values for a new authorization request
+func newAuthState(next, xfer string) oidcAuthState {
+	return oidcAuthState{
+		State:        base64.RawURLEncoding.EncodeToString(newNonce()),
+		Nonce:        base64.RawURLEncoding.EncodeToString(newNonce()),
+		CodeVerifier: base64.RawURLEncoding.EncodeToString(newNonce()),
+		NextURL:      next,
+		Xfer:         xfer,
+	}
+}
+
+// codeChallenge returns the S256 PKCE challenge for the state's code verifier
+func (s oidcAuthState) codeChallenge() string {
+	hash := sha256.Sum256([]byte(s.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// saveAuthState stores the state in the database so the callback can be handled by any replica.
+// Expired states are removed at the same time.
+func saveAuthState(provider string, s oidcAuthState, ctx context.Context) error {
+	db := ent.FromContext(ctx)
+	now := time.Now()
+
+	if _, err := db.OIDCState.Delete().Where(oidcstate.ExpiresLT(now)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return db.OIDCState.Create().
+		SetState(s.State).
+		SetProvider(provider).
+		SetNonce(s.Nonce).
+		SetCodeVerifier(s.CodeVerifier).
+		SetNextURL(s.NextURL).
+		SetXfer(s.Xfer).
+		SetAccessToken(s.AccessToken).
+		SetExpires(now.Add(oidcStateDuration)).
+		Exec(ctx)
+}
+
+// takeAuthState looks up and removes a stored state. Each state can only be taken once.
+func takeAuthState(provider, state string, ctx context.Context) (oidcAuthState, error) {
+	db := ent.FromContext(ctx)
+
+	stored, err := db.OIDCState.Query().
+		Where(
+			oidcstate.StateEQ(state),
+			oidcstate.ProviderEQ(provider),
+			oidcstate.ExpiresGT(time.Now()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return oidcAuthState{}, OIDCStateError
+	} else if err != nil {
+		return oidcAuthState{}, err
+	}
+
+	// Only the replica that deletes the row may use it
+	deleted, err := db.OIDCState.Delete().Where(oidcstate.IDEQ(stored.ID)).Exec(ctx)
+	if err != nil {
+		return oidcAuthState{}, err
+	}
+	if deleted == 0 {
+		return oidcAuthState{}, OIDCStateError
+	}
+
+	return oidcAuthState{
+		State:        stored.State,
+		Nonce:        stored.Nonce,
+		CodeVerifier: stored.CodeVerifier,
+		NextURL:      stored.NextURL,
+		Xfer:         stored.Xfer,
+		AccessToken:  stored.AccessToken,
+	}, nil
+}
+
+// isAllowedRedirect reports whether users may be sent to next after authenticating.
+// Relative paths on this server are always allowed. Absolute urls must match the scheme and host
+// of an allowed redirect and start with its path.
+func isAllowedRedirect(next string, allowedRedirects []string) bool {
+	if next == "" {
+		return true
+	}
+	if strings.ContainsAny(next, "\\\r\n\t") {
+		return false
+	}
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return false
+	}
+	if nextURL.Scheme == "" && nextURL.Host == "" && nextURL.User == nil {
+		return strings.HasPrefix(next, "/") && !strings.HasPrefix(next, "//")
+	}
+
+	for _, allowed := range allowedRedirects {
+		allowedURL, err := url.Parse(allowed)
+		if err != nil || allowedURL.Host == "" {
+			continue
+		}
+		if nextURL.User == nil &&
+				strings.EqualFold(nextURL.Scheme, allowedURL.Scheme) &&
+				strings.EqualFold(nextURL.Host, allowedURL.Host) &&
+				strings.HasPrefix(nextURL.EscapedPath(), allowedURL.EscapedPath()) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCompletionURL returns an error if completionURL is not a relative path or an allowed redirect,
+// so a misconfigured completion url can not send users and their login codes to another site
+func CheckCompletionURL(completionURL string, allowedRedirects []string) error {
+	if !isAllowedRedirect(completionURL, allowedRedirects) {
+		return fmt.Errorf("completion_url %s must be a relative path or match allowed_redirects", completionURL)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package usr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"stoke/internal/ent"
+	"stoke/internal/tel"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type LoginOutcome uint8
+const (
+	// The provider authenticated the user
+	LOGIN_SUCCESS LoginOutcome = iota
+	// The provider rejected the credentials
+	LOGIN_BAD_CREDENTIALS
+	// The provider does not know the user
+	LOGIN_NOT_FOUND
+	// The provider could not be reached or failed
+	LOGIN_SOURCE_ERROR
+	// The provider was not called because its circuit breaker is open
+	LOGIN_CIRCUIT_OPEN
+	// The provider answered, but the login failed for another reason, i.e. the user has no linked groups
+	LOGIN_ERROR
+	// The provider authenticated the user, but the provider's provisioning rules rejected them
+	LOGIN_REJECTED
+)
+
+func (o LoginOutcome) String() string {
+	switch o {
+	case LOGIN_SUCCESS:
+		return "success"
+	case LOGIN_BAD_CREDENTIALS:
+		return "bad_credentials"
+	case LOGIN_NOT_FOUND:
+		return "not_found"
+	case LOGIN_SOURCE_ERROR:
+		return "source_error"
+	case LOGIN_CIRCUIT_OPEN:
+		return "circuit_open"
+	case LOGIN_ERROR:
+		return "error"
+	case LOGIN_REJECTED:
+		return "rejected"
+	}
+	return "undefined"
+}
+
+func loginOutcome(err error) LoginOutcome {
+	switch {
+	case err == nil:
+		return LOGIN_SUCCESS
+	case errors.Is(err, AuthenticationError):
+		return LOGIN_BAD_CREDENTIALS
+	case errors.Is(err, UserNotFoundError):
+		return LOGIN_NOT_FOUND
+	case errors.Is(err, AuthSourceError):
+		return LOGIN_SOURCE_ERROR
+	case errors.Is(err, ProvisioningRejectedError):
+		return LOGIN_REJECTED
+	}
+	return LOGIN_ERROR
+}
+
+// ProviderHealth is the health of a foreign provider as seen by logins
+type ProviderHealth struct {
+	Name                string
+	State               BreakerState
+	ConsecutiveFailures int
+	LastSuccess         time.Time
+	LastFailure         time.Time
+	LastError           string
+}
+
+// Degraded reports whether logins to the provider are currently failing or rejected
+func (h ProviderHealth) Degraded() bool {
+	return h.State != BREAKER_CLOSED || h.ConsecutiveFailures > 0
+}
+
+// providerHealth tracks login outcomes of a foreign provider.
+// Source errors count towards opening the provider's circuit breaker. Any other outcome means the provider answered and closes it.
+type providerHealth struct {
+	breaker     circuitBreaker
+
+	mu          sync.Mutex
+	failures    int
+	lastSuccess time.Time
+	lastFailure time.Time
+	lastError   string
+}
+
+func (h *providerHealth) record(outcome LoginOutcome, err error, now time.Time) {
+	switch outcome {
+	case LOGIN_CIRCUIT_OPEN:
+		return
+	case LOGIN_SOURCE_ERROR:
+		h.breaker.failure(now)
+	default:
+		h.breaker.success()
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if outcome == LOGIN_SOURCE_ERROR {
+		h.failures++
+		h.lastFailure = now
+		h.lastError = err.Error()
+		return
+	}
+	h.failures = 0
+	h.lastSuccess = now
+}
+
+func (h *providerHealth) snapshot(name string, now time.Time) ProviderHealth {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return ProviderHealth{
+		Name: name,
+		State: h.breaker.state(now),
+		ConsecutiveFailures: h.failures,
+		LastSuccess: h.lastSuccess,
+		LastFailure: h.lastFailure,
+		LastError: h.lastError,
+	}
+}
+
+// callProvider calls prov unless its circuit breaker is open, and records the outcome and latency.
+// Logins rejected by an open breaker return AuthSourceError without calling the provider.
+func (p *ProviderList) callProvider(name string, prov provider, username, password string, ctx context.Context) (*ent.User, error) {
+	p.foreignMutex.RLock()
+	health := p.health[name]
+	metrics := p.metrics
+	p.foreignMutex.RUnlock()
+
+	start := time.Now()
+	if health != nil && !health.breaker.allow(start) {
+		metrics.record(name, LOGIN_CIRCUIT_OPEN, 0, ctx)
+		return nil, fmt.Errorf("%w: provider %s is failing, circuit breaker is open", AuthSourceError, name)
+	}
+
+	u, err := prov.UpdateUserClaims(username, password, ctx)
+
+	end := time.Now()
+	outcome := loginOutcome(err)
+	if health != nil {
+		health.record(outcome, err, end)
+	}
+	metrics.record(name, outcome, end.Sub(start), ctx)
+	return u, err
+}
+
+// ProviderHealth returns the health of every foreign provider, ordered by name
+func (p *ProviderList) ProviderHealth() []ProviderHealth {
+	p.foreignMutex.RLock()
+	defer p.foreignMutex.RUnlock()
+
+	now := time.Now()
+	result := make([]ProviderHealth, 0, len(p.health))
+	for name, h := range p.health {
+		result = append(result, h.snapshot(name, now))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// providerMetrics records provider logins
+type providerMetrics struct {
+	attempts metric.Int64Counter
+	latency  metric.Float64Histogram
+}
+
+func (m *providerMetrics) record(name string, outcome LoginOutcome, latency time.Duration, ctx context.Context) {
+	if m == nil {
+		return
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("provider", name),
+		attribute.String("outcome", outcome.String()),
+	)
+	m.attempts.Add(ctx, 1, attrs)
+	if outcome != LOGIN_CIRCUIT_OPEN {
+		m.latency.Record(ctx, latency.Seconds(), attrs)
+	}
+}
+
+// RegisterMetrics exposes provider login attempts, latency and circuit breaker states.
+// It is called once for the provider list that serves logins; providers swapped in by ReplaceForeignProviders are included.
+func (p *ProviderList) RegisterMetrics() error {
+	meter := tel.GetMeter()
+
+	attempts, err := meter.Int64Counter(
+		"stoke_provider_login_attempts",
+		metric.WithDescription("Number of logins sent to a foreign provider, by outcome"),
+	)
+	if err != nil {
+		return err
+	}
+	latency, err := meter.Float64Histogram(
+		"stoke_provider_login_duration",
+		metric.WithDescription("Time taken by a foreign provider to answer a login"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+	state, err := meter.Int64ObservableGauge(
+		"stoke_provider_circuit_state",
+		metric.WithDescription("Circuit breaker state of a foreign provider: closed (0), open (1) or half open (2)"),
+	)
+	if err != nil {
+		return err
+	}
+	failures, err := meter.Int64ObservableGauge(
+		"stoke_provider_consecutive_failures",
+		metric.WithDescription("Number of consecutive logins that failed with a source error"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(
+		func(_ context.Context, o metric.Observer) error {
+			for _, h := range p.ProviderHealth() {
+				attrs := metric.WithAttributes(attribute.String("provider", h.Name))
+				o.ObserveInt64(state, int64(h.State), attrs)
+				o.ObserveInt64(failures, int64(h.ConsecutiveFailures), attrs)
+			}
+			return nil
+		},
+		state, failures,
+	)
+	if err != nil {
+		return err
+	}
+
+	p.foreignMutex.Lock()
+	defer p.foreignMutex.Unlock()
+	p.metrics = &providerMetrics{
+		attempts: attempts,
+		latency: latency,
+	}
+	return nil
+}
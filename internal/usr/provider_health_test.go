@@ -0,0 +1,141 @@
+package usr_test
+
+import (
+	"context"
+	"errors"
+	"stoke/internal/usr"
+	"testing"
+	"time"
+)
+
+func healthTestList(threshold int, open time.Duration) (*usr.ProviderList, *offlineProvider) {
+	prov := &offlineProvider{
+		MockProvider: MockProvider{ AddGroup: "success group" },
+		Password: "directorypass",
+	}
+	pl := usr.NewProviderList()
+	pl.BreakerThreshold = threshold
+	pl.BreakerOpenDuration = open
+	pl.AddForeignProvider("directory", prov)
+	return pl, prov
+}
+
+func TestProviderHealthOpensBreakerAfterThreshold(t *testing.T) {
+	pl, prov := healthTestList(2, time.Hour)
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	prov.Down = true
+	for i := 0; i < 3; i++ {
+		if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err == nil {
+			t.Fatal("Login succeeded while the provider was down")
+		}
+	}
+	if prov.Calls != 2 {
+		t.Errorf("Provider was called with an open breaker: %d calls", prov.Calls)
+	}
+
+	health := pl.ProviderHealth()
+	if len(health) != 1 || health[0].Name != "directory" {
+		t.Fatalf("Unexpected provider health: %v", health)
+	}
+	if health[0].State != usr.BREAKER_OPEN || !health[0].Degraded() || health[0].ConsecutiveFailures != 2 {
+		t.Errorf("Provider was not reported as failing: %+v", health[0])
+	}
+	if health[0].LastError == "" || health[0].LastFailure.IsZero() {
+		t.Errorf("Last failure was not recorded: %+v", health[0])
+	}
+}
+
+func TestProviderHealthProbeClosesBreaker(t *testing.T) {
+	pl, prov := healthTestList(1, time.Millisecond)
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	prov.Down = true
+	pl.GetUserClaims("user1", "directorypass", "", ctx)
+	if state := pl.ProviderHealth()[0].State; state == usr.BREAKER_CLOSED {
+		t.Fatalf("Breaker did not open: %v", state)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	prov.Down = false
+	if _, _, err := pl.GetUserClaims("user1", "directorypass", "", ctx); err != nil {
+		t.Fatalf("Probe login failed: %v", err)
+	}
+	health := pl.ProviderHealth()[0]
+	if health.State != usr.BREAKER_CLOSED || health.Degraded() || health.LastSuccess.IsZero() {
+		t.Errorf("Successful probe did not close the breaker: %+v", health)
+	}
+}
+
+// Rejected passwords mean the provider answered and do not count as failures
+func TestProviderHealthIgnoresBadCredentials(t *testing.T) {
+	pl, _ := healthTestList(1, time.Hour)
+	ctx := pl.WithContext(chainTestContext(t, "user1"))
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := pl.GetUserClaims("user1", "wrongpass", "", ctx); !errors.Is(err, usr.AuthenticationError) {
+			t.Fatalf("Bad password was not rejected: %v", err)
+		}
+	}
+	if health := pl.ProviderHealth()[0]; health.Degraded() {
+		t.Errorf("Bad credentials degraded the provider: %+v", health)
+	}
+}
+
+func TestLoginOutcomeString(t *testing.T) {
+	outcomes := map[usr.LoginOutcome]string{
+		usr.LOGIN_SUCCESS: "success",
+		usr.LOGIN_BAD_CREDENTIALS: "bad_credentials",
+		usr.LOGIN_NOT_FOUND: "not_found",
+		usr.LOGIN_SOURCE_ERROR: "source_error",
+		usr.LOGIN_CIRCUIT_OPEN: "circuit_open",
+		usr.LOGIN_ERROR: "error",
+		usr.LOGIN_REJECTED: "rejected",
+	}
+	for outcome, name := range outcomes {
+		if outcome.String() != name {
+			t.Errorf("Outcome %d is %s, not %s", outcome, outcome.String(), name)
+		}
+	}
+}
+
+// slowHealthProvider takes block to check its health and ignores the context
+type slowHealthProvider struct {
+	MockProvider
+	block time.Duration
+}
+
+func (s *slowHealthProvider) CheckHealth(ctx context.Context) error {
+	time.Sleep(s.block)
+	return nil
+}
+
+type healthyProvider struct {
+	MockProvider
+}
+
+func (h *healthyProvider) CheckHealth(context.Context) error {
+	return nil
+}
+
+func TestCheckForeignProvidersTimesOutSlowChecks(t *testing.T) {
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("slow", &slowHealthProvider{ block: 5 * time.Second })
+	pl.AddForeignProvider("other_slow", &slowHealthProvider{ block: 5 * time.Second })
+	pl.AddForeignProvider("healthy", &healthyProvider{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100 * time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	results := pl.CheckForeignProviders(ctx)
+	if took := time.Since(start); took > time.Second {
+		t.Errorf("Health checks were not cut short: took %s", took)
+	}
+	if len(results) != 3 || results["slow"] == nil || results["other_slow"] == nil {
+		t.Errorf("Slow health checks were not reported as failed: %v", results)
+	}
+	if err, ok := results["healthy"]; !ok || err != nil {
+		t.Errorf("Healthy provider was not reported as healthy: %v", results)
+	}
+}
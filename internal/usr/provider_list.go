@@ -3,7 +3,15 @@ package usr
 import (
 	"context"
 	"errors"
+	"maps"
+	"net/http"
+	"regexp"
+	"fmt"
+	"slices"
 	"stoke/internal/ent"
+	"stoke/internal/ent/user"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 )
@@ -15,14 +23,46 @@ type provider interface {
 	UpdateUserClaims(user, password string, ctx context.Context) (*ent.User, error)
 }
 
+// closer is implemented by providers that hold connections to release when they are replaced
+type closer interface {
+	Close()
+}
+
+// passwordUpdater is implemented by providers that can change the password of the users they created
+type passwordUpdater interface {
+	userSource() string
+	// Returns UserNotFoundError if the provider does not know the user
+	UpdateUserPassword(username, oldPassword, newPassword string, force bool, ctx context.Context) error
+}
+
+// RealmRule sends logins whose username matches Pattern to Provider
+type RealmRule struct {
+	Pattern  *regexp.Regexp
+	Provider string
+}
+
 type ProviderList struct {
 	*localProvider
+	// Consecutive source errors after which a provider's circuit breaker opens. Defaults to 5
+	BreakerThreshold    int
+	// How long an open circuit breaker rejects logins before letting a probe through. Defaults to 30 seconds
+	BreakerOpenDuration time.Duration
+
 	foreignProviders map[string]provider
+	health           map[string]*providerHealth
+	metrics          *providerMetrics
+	// Providers tried in order when a login does not name a provider
+	chain            []string
+	realmRules       []RealmRule
+	offline          OfflineLogin
+	mfa              MFA
+	foreignMutex     sync.RWMutex
 }
 
 func NewProviderList() *ProviderList {
 	return &ProviderList{
 		foreignProviders: make(map[string]provider),
+		health: make(map[string]*providerHealth),
 		localProvider: &localProvider{},
 	}
 }
@@ -33,6 +73,9 @@ func NewProviderList() *ProviderList {
 // If the foreignProviders fail to produce claims, local claims are given, if and only if the user is a local user
 // Claims are tracked in the local database regardless of which provider the claims were derived from
 // Claims may only be pulled from a single provider at a time
+// Without a named provider, candidate providers are tried in order until one authenticates or rejects the user
+// Providers whose circuit breaker is open are not called and count as unreachable
+// Users of providers with offline login enabled may log in with a cached credential while the provider is unreachable
 func (p *ProviderList) GetUserClaims(username, password, providerID string, ctx context.Context) (*ent.User, ent.Claims, error) {
 	logger := zerolog.Ctx(ctx).With().
 		Str("component", "usr.ProviderList").
@@ -43,20 +86,49 @@ func (p *ProviderList) GetUserClaims(username, password, providerID string, ctx
 	var u *ent.User
 	var err error
 
-	prov, found := p.foreignProviders[providerID]
-	if providerID == "" && len(p.foreignProviders) == 1 {
-		for _, v := range p.foreignProviders {
-			prov = v
-			found = true
+	ctx, passthrough := withPassthroughCollector(ctx)
+	offline := p.offlineLogin()
+
+	for _, name := range p.candidateProviders(username, providerID) {
+		p.foreignMutex.RLock()
+		prov, found := p.foreignProviders[name]
+		p.foreignMutex.RUnlock()
+		if !found {
+			continue
 		}
-	}
 
-	if found {
-		u, err = prov.UpdateUserClaims(username, password, ctx)
+		u, err = p.callProvider(name, prov, username, password, ctx)
 		if errors.Is(err, AuthenticationError) {
-			logger.Debug().Err(err).Msg("Provider returned an error")
+			logger.Debug().Err(err).Str("triedProvider", name).Msg("Provider returned an error")
+			if offline.enabled(name) {
+				if ferr := offline.forgetCredential(name, username, ctx); ferr != nil {
+					logger.Error().Err(ferr).Str("triedProvider", name).Msg("Could not remove cached credential")
+				}
+			}
+			return nil, nil, err
+		}
+		// The account matches a user that must link it first, or may not log in at all. Do not fall back to other providers or local users
+		if errors.Is(err, IdentityNotLinkedError) || errors.Is(err, ProvisioningRejectedError) {
+			logger.Debug().Err(err).Str("triedProvider", name).Msg("Provider account is not linked or not allowed")
 			return nil, nil, err
 		}
+		if errors.Is(err, AuthSourceError) && offline.enabled(name) {
+			if cached, cerr := offline.offlineUser(name, username, password, ctx); cerr == nil {
+				u, err = cached, nil
+				break
+			}
+		}
+		// The user is unknown to or could not be checked with this provider. Try the next one
+		if errors.Is(err, UserNotFoundError) || errors.Is(err, AuthSourceError) {
+			logger.Debug().Err(err).Str("triedProvider", name).Msg("Trying next provider")
+			continue
+		}
+		if err == nil && u != nil && offline.enabled(name) {
+			if cerr := offline.cacheCredential(name, username, password, ctx); cerr != nil {
+				logger.Error().Err(cerr).Str("triedProvider", name).Msg("Could not cache credential")
+			}
+		}
+		break
 	}
 
 	logger.Debug().
@@ -64,9 +136,169 @@ func (p *ProviderList) GetUserClaims(username, password, providerID string, ctx
 		Interface("user", u).
 		Msg("Checked foreign providers")
 
-	return p.localProvider.GetUserClaims(username, password, u, ctx)
+	u, claims, err := p.localProvider.GetUserClaims(username, password, u, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Claims passed through from the provider are only added for this login
+	return u, append(claims, passthrough.Claims()...), nil
+}
+
+// candidateProviders returns the names of the foreign providers to try for a login, in order:
+//   * the provider named in the request
+//   * the provider of the first realm rule matching the username
+//   * the provider chain
+//   * the only foreign provider, if exactly one is configured
+func (p *ProviderList) candidateProviders(username, providerID string) []string {
+	if providerID != "" {
+		return []string{ providerID }
+	}
+
+	p.foreignMutex.RLock()
+	defer p.foreignMutex.RUnlock()
+
+	for _, rule := range p.realmRules {
+		if rule.Pattern.MatchString(username) {
+			return []string{ rule.Provider }
+		}
+	}
+	if len(p.chain) > 0 {
+		return slices.Clone(p.chain)
+	}
+	if len(p.foreignProviders) == 1 {
+		for name := range p.foreignProviders {
+			return []string{ name }
+		}
+	}
+	return nil
+}
+
+// SetProviderChain sets the providers tried in order, and the realm rules that pick a provider,
+// when a login does not name a provider
+func (p *ProviderList) SetProviderChain(chain []string, realmRules []RealmRule) {
+	p.foreignMutex.Lock()
+	defer p.foreignMutex.Unlock()
+	p.chain = chain
+	p.realmRules = realmRules
+}
+
+// Changes the user's password with the provider the user came from.
+// Local users are updated in the local database. Other users are passed to the foreign providers for their source,
+// in order of provider name, until one of them knows the user.
+func (p *ProviderList) UpdateUserPassword(username, oldPassword, newPassword string, force bool, ctx context.Context) error {
+	u, err := ent.FromContext(ctx).User.Query().
+		Where(user.UsernameEQ(username)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if u.Source == LOCAL_SOURCE {
+		return p.localProvider.UpdateUserPassword(username, oldPassword, newPassword, force, ctx)
+	}
+
+	p.foreignMutex.RLock()
+	names := make([]string, 0, len(p.foreignProviders))
+	for name := range p.foreignProviders {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var updaters []passwordUpdater
+	for _, name := range names {
+		if updater, ok := p.foreignProviders[name].(passwordUpdater); ok && updater.userSource() == u.Source {
+			updaters = append(updaters, updater)
+		}
+	}
+	p.foreignMutex.RUnlock()
+
+	for _, updater := range updaters {
+		err := updater.UpdateUserPassword(username, oldPassword, newPassword, force, ctx)
+		if errors.Is(err, UserNotFoundError) {
+			continue
+		}
+		return err
+	}
+	return fmt.Errorf("%w: %s users must change their password with their identity provider", PasswordChangeNotAllowedError, u.Source)
 }
 
 func (p *ProviderList) AddForeignProvider(name string, newProvider provider) {
+	p.foreignMutex.Lock()
+	defer p.foreignMutex.Unlock()
 	p.foreignProviders[name] = newProvider
+	p.health[name] = &providerHealth{
+		breaker: circuitBreaker{
+			Threshold: p.BreakerThreshold,
+			OpenDuration: p.BreakerOpenDuration,
+		},
+	}
+}
+
+// DiscardForeignProviders undoes creating the foreign providers of p when they will not be used, e.g. after a configuration reload failed.
+// Routes they registered serve the handlers they replaced again, and their connections are closed.
+func (p *ProviderList) DiscardForeignProviders() {
+	p.foreignMutex.RLock()
+	providers := maps.Clone(p.foreignProviders)
+	p.foreignMutex.RUnlock()
+
+	closeProviders(providers, nil)
+
+	restoreRoutes(func(h http.Handler) bool {
+		for _, prov := range providers {
+			if ph, ok := prov.(http.Handler); ok && ph == h {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ReplaceForeignProviders swaps in the foreign providers and their health, provider chain, realm rules, offline login and MFA settings from other, e.g. after a configuration reload.
+// Routes registered by providers that are no longer configured stop being served, and their connections are closed.
+func (p *ProviderList) ReplaceForeignProviders(other *ProviderList) {
+	other.foreignMutex.RLock()
+	providers := make(map[string]provider, len(other.foreignProviders))
+	health := make(map[string]*providerHealth, len(other.health))
+	for name, prov := range other.foreignProviders {
+		providers[name] = prov
+		health[name] = other.health[name]
+	}
+	chain, realmRules, offline, mfa := other.chain, other.realmRules, other.offline, other.mfa
+	other.foreignMutex.RUnlock()
+
+	p.foreignMutex.Lock()
+	replaced := p.foreignProviders
+	p.foreignProviders = providers
+	p.health = health
+	p.chain = chain
+	p.realmRules = realmRules
+	p.offline = offline
+	p.mfa = mfa
+	p.foreignMutex.Unlock()
+
+	pruneRoutes(func(h http.Handler) bool {
+		for _, prov := range providers {
+			if ph, ok := prov.(http.Handler); ok && ph == h {
+				return true
+			}
+		}
+		return false
+	})
+	closeProviders(replaced, providers)
+}
+
+// closeProviders closes the providers that are not kept
+func closeProviders(providers, keep map[string]provider) {
+	for _, prov := range providers {
+		c, ok := prov.(closer)
+		if !ok {
+			continue
+		}
+		kept := false
+		for _, k := range keep {
+			kept = kept || k == prov
+		}
+		if !kept {
+			c.Close()
+		}
+	}
 }
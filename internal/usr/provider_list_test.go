@@ -3,11 +3,15 @@ package usr_test
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"stoke/internal/ent"
 	"stoke/internal/ent/claimgroup"
 	"stoke/internal/ent/user"
 	tu "stoke/internal/testutil"
 	"stoke/internal/usr"
+	"regexp"
 	"testing"
 )
 
@@ -55,9 +59,11 @@ type MockProvider struct {
 	AddGroup    string
 	RemoveGroup string
 	ReturnValue error
+	Calls       int
 }
 
 func (m *MockProvider) UpdateUserClaims(username, _ string, ctx context.Context) (*ent.User, error) {
+	m.Calls++
 	foundUser := ent.FromContext(ctx).User.Query().
 		Where(user.UsernameEQ(username)).
 		FirstX(ctx)
@@ -260,3 +266,170 @@ func TestReturnsBadPasswordWhenAProviderReturnsAuthenticationError(t *testing.T)
 		t.Fatal("Did not return authentication error")
 	}
 }
+
+func TestReplaceForeignProvidersUsesNewProviders(t *testing.T) {
+	ctx := tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("foreign", "user", "user1", "hello@local"),
+				tu.Source("CUSTOM"),
+			),
+		),
+	)
+
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("old", &MockProvider{ ReturnValue: usr.AuthenticationError })
+
+	reloaded := usr.NewProviderList()
+	reloaded.AddForeignProvider("new", &MockProvider{})
+	pl.ReplaceForeignProviders(reloaded)
+
+	if _, _, err := pl.GetUserClaims("user1", "chooch", "old", ctx); errors.Is(err, usr.AuthenticationError) {
+		t.Fatal("Removed provider was still used after replace")
+	}
+	if user, _, err := pl.GetUserClaims("user1", "chooch", "new", ctx); err != nil || user == nil {
+		t.Fatalf("New provider was not used after replace: %v", err)
+	}
+}
+
+// Providers created by a failed reload give their routes back to the providers they replaced
+func TestDiscardForeignProvidersRestoresRoutes(t *testing.T) {
+	ctx := oauth2TestContext(t)
+	mux := http.NewServeMux()
+	authURL, _ := url.Parse("https://idp.example/authorize")
+	clientID := func() string {
+		req := httptest.NewRequest(http.MethodGet, "/oauth2/routes?next=/done", nil).WithContext(ctx)
+		res := httptest.NewRecorder()
+		mux.ServeHTTP(res, req)
+		location, _ := url.Parse(res.Header().Get("Location"))
+		return location.Query().Get("client_id")
+	}
+
+	running := usr.NewProviderList()
+	running.AddForeignProvider("routes", usr.NewOAuth2UserProvider(
+		"routes", "", "http://localhost/oauth2/routes",
+		"", "", "email", "",
+		"running", "client-secret",
+		nil,
+		authURL, authURL,
+		nil, nil,
+		mux,
+	))
+	reloaded := usr.NewProviderList()
+	reloaded.AddForeignProvider("routes", usr.NewOAuth2UserProvider(
+		"routes", "", "http://localhost/oauth2/routes",
+		"", "", "email", "",
+		"reloaded", "client-secret",
+		nil,
+		authURL, authURL,
+		nil, nil,
+		mux,
+	))
+	if id := clientID(); id != "reloaded" {
+		t.Fatalf("Route was not served by the new provider: %s", id)
+	}
+
+	reloaded.DiscardForeignProviders()
+	if id := clientID(); id != "running" {
+		t.Errorf("Route was not restored to the running provider: %s", id)
+	}
+}
+
+func chainTestContext(t *testing.T, username string) context.Context {
+	return tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("local", "user", "localuser", "local@local"),
+				tu.Source("LOCAL"),
+				tu.Group(
+					tu.GroupInfo("success group", "this group should be added when the user is successfully found"),
+					tu.Claim(
+						tu.ClaimInfo("Hello", "hel", "wor", "Hello world claim"),
+					),
+				),
+			),
+			tu.User(
+				tu.UserInfo("foreign", "user", username, "hello@local"),
+				tu.Source("CUSTOM"),
+			),
+		),
+	)
+}
+
+// Providers in the chain are tried in order until one of them knows the user
+func TestProviderChainSkipsProvidersThatDoNotKnowTheUser(t *testing.T) {
+	ctx := chainTestContext(t, "user1")
+
+	missing := &MockProvider{ ReturnValue: usr.UserNotFoundError }
+	down := &MockProvider{ ReturnValue: usr.AuthSourceError }
+	success := &MockProvider{ AddGroup: "success group" }
+	unused := &MockProvider{}
+
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("missing", missing)
+	pl.AddForeignProvider("down", down)
+	pl.AddForeignProvider("success", success)
+	pl.AddForeignProvider("unused", unused)
+	pl.SetProviderChain([]string{ "missing", "down", "success", "unused" }, nil)
+
+	u, claims, err := pl.GetUserClaims("user1", "somepass", "", ctx)
+	if err != nil {
+		t.Fatalf("GetUserClaims returned an error: %v", err)
+	}
+	if u.Username != "user1" || len(claims) != 1 || claims[0].ShortName != "hel" {
+		t.Fatalf("Claims were not pulled from the chain: %v %v", u, claims)
+	}
+	if missing.Calls != 1 || down.Calls != 1 || success.Calls != 1 || unused.Calls != 0 {
+		t.Errorf("Unexpected provider calls: %d %d %d %d", missing.Calls, down.Calls, success.Calls, unused.Calls)
+	}
+}
+
+// A bad password ends the chain
+func TestProviderChainStopsOnAuthenticationError(t *testing.T) {
+	ctx := chainTestContext(t, "user1")
+
+	reject := &MockProvider{ ReturnValue: usr.AuthenticationError }
+	success := &MockProvider{ AddGroup: "success group" }
+
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("reject", reject)
+	pl.AddForeignProvider("success", success)
+	pl.SetProviderChain([]string{ "reject", "success" }, nil)
+
+	if _, _, err := pl.GetUserClaims("user1", "somepass", "", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Fatalf("Did not return authentication error: %v", err)
+	}
+	if success.Calls != 0 {
+		t.Error("Provider after a rejected password was tried")
+	}
+}
+
+// Realm rules choose the provider before the chain is used
+func TestRealmRulesChooseProvider(t *testing.T) {
+	ctx := chainTestContext(t, "user1@corp.example")
+
+	corp := &MockProvider{ AddGroup: "success group" }
+	chained := &MockProvider{ ReturnValue: usr.AuthenticationError }
+
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("corp", corp)
+	pl.AddForeignProvider("chained", chained)
+	pl.SetProviderChain(
+		[]string{ "chained" },
+		[]usr.RealmRule{
+			{ Pattern: regexp.MustCompile(`(?i)@corp\.example$`), Provider: "corp" },
+		},
+	)
+
+	if _, claims, err := pl.GetUserClaims("user1@corp.example", "somepass", "", ctx); err != nil || len(claims) != 1 {
+		t.Fatalf("Realm rule was not used: %v %v", claims, err)
+	}
+	if corp.Calls != 1 || chained.Calls != 0 {
+		t.Errorf("Unexpected provider calls: %d %d", corp.Calls, chained.Calls)
+	}
+
+	// Usernames that match no rule use the chain
+	if _, _, err := pl.GetUserClaims("localuser", "somepass", "", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Chain was not used for username without a realm: %v", err)
+	}
+}
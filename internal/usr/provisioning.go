@@ -0,0 +1,103 @@
+package usr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"stoke/internal/ent"
+	"stoke/internal/ent/claimgroup"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// ProvisioningRules decide which provider accounts may log in and which groups users created for them start with
+type ProvisioningRules struct {
+	// Email domains accounts must have, i.e. example.com. Any domain is allowed when empty
+	AllowedDomains    []string
+	// Attributes or claims with a value that must match the pattern, i.e. Google's hd claim. Every pattern must match
+	AttributePatterns map[string]*regexp.Regexp
+	// Names of the claim groups added to users when they are created
+	DefaultGroups     []string
+	// Users without any linked groups log in with the groups they already have, i.e. the default groups, instead of failing with NoLinkedGroupsError
+	AllowUnlinked     bool
+}
+
+// check returns ProvisioningRejectedError, naming the rule, when the account does not satisfy the rules.
+// attribute returns the values of an attribute or claim of the account.
+// Rules are checked on every login, so tightening them also rejects users that were already created.
+func (r ProvisioningRules) check(providerName, email string, attribute func(string) []string, ctx context.Context) error {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "usr.ProvisioningRules").
+		Str("provider", providerName).
+		Str("email", email).
+		Logger()
+
+	if len(r.AllowedDomains) > 0 {
+		_, domain, _ := strings.Cut(email, "@")
+		if !slices.ContainsFunc(r.AllowedDomains, func(allowed string) bool { return strings.EqualFold(allowed, domain) }) {
+			logger.Warn().
+				Str("rule", "allowed_domains").
+				Str("domain", domain).
+				Strs("allowedDomains", r.AllowedDomains).
+				Msg("Provisioning rule rejected user")
+			return fmt.Errorf("%w: email domain is not allowed", ProvisioningRejectedError)
+		}
+	}
+
+	names := make([]string, 0, len(r.AttributePatterns))
+	for name := range r.AttributePatterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pattern := r.AttributePatterns[name]
+		values := attribute(name)
+		if !slices.ContainsFunc(values, pattern.MatchString) {
+			logger.Warn().
+				Str("rule", "attribute_patterns." + name).
+				Str("pattern", pattern.String()).
+				Strs("values", values).
+				Msg("Provisioning rule rejected user")
+			return fmt.Errorf("%w: %s does not match %s", ProvisioningRejectedError, name, pattern)
+		}
+	}
+	return nil
+}
+
+// provisionUser wraps create so that created users are given the default groups.
+// Missing default groups fail the login before the user is created.
+func (r ProvisioningRules) provisionUser(create func() (*ent.User, error), ctx context.Context) func() (*ent.User, error) {
+	if len(r.DefaultGroups) == 0 {
+		return create
+	}
+	return func() (*ent.User, error) {
+		groups, err := ent.FromContext(ctx).ClaimGroup.Query().
+			Where(claimgroup.NameIn(r.DefaultGroups...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var missing []string
+		for _, name := range r.DefaultGroups {
+			if !slices.ContainsFunc(groups, func(g *ent.ClaimGroup) bool { return g.Name == name }) {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			zerolog.Ctx(ctx).Error().
+				Str("component", "usr.ProvisioningRules").
+				Strs("missingGroups", missing).
+				Msg("Default groups do not exist")
+			return nil, fmt.Errorf("default groups do not exist: %s", strings.Join(missing, ", "))
+		}
+
+		u, err := create()
+		if err != nil {
+			return nil, err
+		}
+		return applyGroupChanges(groups, nil, u, ctx)
+	}
+}
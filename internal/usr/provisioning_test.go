@@ -0,0 +1,165 @@
+package usr_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"stoke/internal/ent"
+	"stoke/internal/ent/user"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+	"testing"
+)
+
+func provisioningTestContext(t *testing.T, linked bool) context.Context {
+	operators := []tu.GroupOption{
+		tu.GroupInfo("operators", "operator group"),
+		tu.Claim(
+			tu.ClaimInfo("operator", "op", "yes", "Grants operator"),
+		),
+	}
+	if linked {
+		operators = append(operators, tu.LDAPLink("main_ldap", "ldap_group"))
+	}
+	return tu.NewMockContext(
+		tu.WithDatabase(t,
+			tu.User(
+				tu.UserInfo("local", "user", "localuser", "user@local"),
+				tu.Source("LOCAL"),
+				tu.Group(operators...),
+				tu.Group(
+					tu.GroupInfo("everyone", "default group"),
+					tu.Claim(
+						tu.ClaimInfo("member", "mbr", "yes", "Grants member"),
+					),
+				),
+			),
+		),
+	)
+}
+
+func provisioningTestProvider(rules usr.ProvisioningRules) LDAPUserProvider {
+	groupTemplate, userTemplate := createTemplates()
+	prov := usr.NewLDAPUserProvider(
+		"main_ldap",
+		"ldap://someldap.server",
+		"adminuser", "adminpass", "", "group_name", "", "first_name", "last_name", "email",
+		0,
+		groupTemplate, userTemplate,
+	)
+	prov.Provisioning = rules
+	prov.SetConnector(NewMockLDAPServer(
+		LDAPUser("adminuser", "admin", "user", "admin@hppr.dev", "adminpass"),
+		LDAPUser("ldapuser", "ldap", "user", "luser@hppr.dev", "luserpass"),
+		LDAPGroup("ldapuser", "ldap_group"),
+	))
+	return prov
+}
+
+func ldapUserExists(ctx context.Context) bool {
+	return ent.FromContext(ctx).User.Query().Where(user.UsernameEQ("ldapuser")).ExistX(ctx)
+}
+
+func TestProvisioningRejectsDomain(t *testing.T) {
+	ctx := provisioningTestContext(t, true)
+	prov := provisioningTestProvider(usr.ProvisioningRules{
+		AllowedDomains: []string{ "example.com" },
+	})
+
+	pl := usr.NewProviderList()
+	pl.AddForeignProvider("main_ldap", prov)
+	ctx = pl.WithContext(ctx)
+
+	if _, _, err := pl.GetUserClaims("ldapuser", "luserpass", "main_ldap", ctx); !errors.Is(err, usr.ProvisioningRejectedError) {
+		t.Fatalf("User from another domain was not rejected: %v", err)
+	}
+	if ldapUserExists(ctx) {
+		t.Error("Rejected user was created")
+	}
+}
+
+func TestProvisioningAllowsDomain(t *testing.T) {
+	ctx := provisioningTestContext(t, true)
+	prov := provisioningTestProvider(usr.ProvisioningRules{
+		AllowedDomains: []string{ "example.com", "HPPR.dev" },
+	})
+
+	if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("User from an allowed domain was rejected: %v", err)
+	}
+}
+
+func TestProvisioningAttributePatterns(t *testing.T) {
+	ctx := provisioningTestContext(t, true)
+	prov := provisioningTestProvider(usr.ProvisioningRules{
+		AttributePatterns: map[string]*regexp.Regexp{
+			"username": regexp.MustCompile(`^admin`),
+		},
+	})
+
+	if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); !errors.Is(err, usr.ProvisioningRejectedError) {
+		t.Fatalf("User not matching the attribute pattern was not rejected: %v", err)
+	}
+
+	prov = provisioningTestProvider(usr.ProvisioningRules{
+		AttributePatterns: map[string]*regexp.Regexp{
+			"username": regexp.MustCompile(`^ldap`),
+		},
+	})
+	if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("User matching the attribute pattern was rejected: %v", err)
+	}
+}
+
+// Default groups are kept on later logins because they are not linked to the provider
+func TestProvisioningDefaultGroups(t *testing.T) {
+	ctx := provisioningTestContext(t, true)
+	prov := provisioningTestProvider(usr.ProvisioningRules{
+		DefaultGroups: []string{ "everyone" },
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+			t.Fatalf("Login failed: %v", err)
+		}
+		if _, claims := getUserAndClaims("ldapuser", ctx); len(claims) != 2 {
+			t.Errorf("User did not have linked and default groups on login %d: %v", i + 1, claims)
+		}
+	}
+}
+
+func TestProvisioningMissingDefaultGroup(t *testing.T) {
+	ctx := provisioningTestContext(t, true)
+	prov := provisioningTestProvider(usr.ProvisioningRules{
+		DefaultGroups: []string{ "everyone", "nobody" },
+	})
+
+	if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); err == nil {
+		t.Fatal("User was created with a missing default group")
+	}
+	if ldapUserExists(ctx) {
+		t.Error("User was created with a missing default group")
+	}
+}
+
+func TestProvisioningAllowUnlinked(t *testing.T) {
+	ctx := provisioningTestContext(t, false)
+
+	prov := provisioningTestProvider(usr.ProvisioningRules{
+		DefaultGroups: []string{ "everyone" },
+	})
+	if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); !errors.Is(err, usr.NoLinkedGroupsError) {
+		t.Fatalf("User without linked groups logged in: %v", err)
+	}
+
+	prov = provisioningTestProvider(usr.ProvisioningRules{
+		DefaultGroups: []string{ "everyone" },
+		AllowUnlinked: true,
+	})
+	if _, err := prov.UpdateUserClaims("ldapuser", "luserpass", ctx); err != nil {
+		t.Fatalf("User without linked groups could not log in: %v", err)
+	}
+	if _, claims := getUserAndClaims("ldapuser", ctx); len(claims) != 1 || claims[0].ShortName != "mbr" {
+		t.Errorf("User did not log in with the default groups: %v", claims)
+	}
+}
@@ -0,0 +1,83 @@
+package usr
+
+import (
+	"net/http"
+	"sync"
+)
+
+// routeKey identifies a path registered on a specific mux
+type routeKey struct {
+	mux  *http.ServeMux
+	path string
+}
+
+// swappableHandler lets a provider replace the handler for a path it registered earlier.
+// http.ServeMux panics when the same pattern is registered twice, so providers that are
+// recreated on reload update the existing route instead.
+type swappableHandler struct {
+	mu       sync.RWMutex
+	handler  http.Handler
+	// Handler served before the last registration, restored if the new one is discarded
+	previous http.Handler
+}
+
+func (s *swappableHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	s.mu.RLock()
+	h := s.handler
+	s.mu.RUnlock()
+	if h == nil {
+		http.NotFound(res, req)
+		return
+	}
+	h.ServeHTTP(res, req)
+}
+
+var (
+	routesMutex sync.Mutex
+	routes      = make(map[routeKey]*swappableHandler)
+)
+
+// registerRoute serves handler at path on mux, replacing any handler previously registered for the path
+func registerRoute(mux *http.ServeMux, path string, handler http.Handler) {
+	routesMutex.Lock()
+	defer routesMutex.Unlock()
+
+	key := routeKey{ mux: mux, path: path }
+	route, ok := routes[key]
+	if !ok {
+		route = &swappableHandler{}
+		routes[key] = route
+		mux.Handle(path, route)
+	}
+	route.mu.Lock()
+	route.previous, route.handler = route.handler, handler
+	route.mu.Unlock()
+}
+
+// pruneRoutes stops serving routes whose handler is not kept
+func pruneRoutes(keep func(http.Handler) bool) {
+	routesMutex.Lock()
+	defer routesMutex.Unlock()
+
+	for _, route := range routes {
+		route.mu.Lock()
+		if route.handler != nil && !keep(route.handler) {
+			route.handler = nil
+		}
+		route.mu.Unlock()
+	}
+}
+
+// restoreRoutes serves the previously registered handler again on routes whose handler is discarded
+func restoreRoutes(discard func(http.Handler) bool) {
+	routesMutex.Lock()
+	defer routesMutex.Unlock()
+
+	for _, route := range routes {
+		route.mu.Lock()
+		if route.handler != nil && discard(route.handler) {
+			route.handler, route.previous = route.previous, nil
+		}
+		route.mu.Unlock()
+	}
+}
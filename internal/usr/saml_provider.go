@@ -0,0 +1,671 @@
+package usr
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"stoke/internal/ent"
+	"stoke/internal/ent/grouplink"
+	"stoke/internal/tel"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/rs/zerolog"
+)
+
+// SAML 2.0 service provider login source.
+//
+// The process should go as follows
+// 1. User goes to /saml/{provider-name}
+// 2. Stoke redirects the user to the IdP's single sign on url with an AuthnRequest (HTTP-Redirect binding)
+// 3. The IdP posts a signed response to /saml/{provider-name}/acs (HTTP-POST binding)
+// 4. Stoke validates the signature against the IdP metadata certificates and checks the assertion conditions
+// 5. Stoke maps the assertion attributes to the user and group links
+// 6. Stoke issues a token and redirects to the next url with a one-time code for /api/login/exchange
+//
+// SP metadata to register with the IdP is served at /saml/{provider-name}/metadata.
+// Only responses to requests started by stoke are accepted; IdP-initiated logins and encrypted assertions are not supported.
+
+const (
+	samlProtocolNS      = "urn:oasis:names:tc:SAML:2.0:protocol"
+	samlAssertionNS     = "urn:oasis:names:tc:SAML:2.0:assertion"
+	samlRedirectBinding = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	samlPostBinding     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlSuccess         = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlBearer          = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+)
+
+// Allowed difference between the IdP's clock and ours
+const samlClockSkew = time.Minute
+
+// SAMLIdP is what stoke needs to know about an identity provider, taken from its metadata
+type SAMLIdP struct {
+	EntityID     string
+	// Single sign on url that accepts the HTTP-Redirect binding
+	SSOURL       *url.URL
+	// Signing certificates. Responses must be signed by one of these
+	Certificates []*x509.Certificate
+}
+
+type samlUserProvider struct {
+	// Unique name of this user provider
+	Name string
+	// Entity ID of stoke as a service provider
+	EntityID string
+	// Public url of the assertion consumer service, i.e. https://stoke.example/saml/<name>/acs
+	ACSURL   string
+	// Identity provider to send users to and to verify responses with
+	IdP      *SAMLIdP
+	// Name ID format to request (OPTIONAL)
+	NameIDFormat string
+
+	// Attribute to use as the first name
+	FNameAttribute    string
+	// Attribute to use as the last name
+	LNameAttribute    string
+	// Attribute to use as the email. The name id is used when empty
+	EmailAttribute    string
+	// Attribute to use as the username. The name id is used when empty
+	UsernameAttribute string
+	// Attributes whose values are matched to group link resource specs
+	GroupAttributes   []string
+	// Rules users must satisfy to log in and the groups new users start with
+	Provisioning      ProvisioningRules
+
+	// Absolute urls users may be sent to after authenticating. Relative paths are always allowed
+	AllowedRedirects []string
+	// Where to send users after logging in when no next url was given
+	CompletionURL    string
+
+	dbSourceName string
+	routePath    string
+}
+
+// samlAssertion holds the verified contents of an assertion
+type samlAssertion struct {
+	NameID     string
+	// Attribute values by name and by friendly name
+	Attributes map[string][]string
+}
+
+func NewSAMLUserProvider(
+	name, entityID, acsURL,
+	fNameAttribute, lNameAttribute, emailAttribute, usernameAttribute string,
+	groupAttributes []string,
+	idp *SAMLIdP,
+	mux *http.ServeMux,
+) *samlUserProvider {
+	provider := &samlUserProvider{
+		Name: name,
+		EntityID: entityID,
+		ACSURL: acsURL,
+		IdP: idp,
+		FNameAttribute: fNameAttribute,
+		LNameAttribute: lNameAttribute,
+		EmailAttribute: emailAttribute,
+		UsernameAttribute: usernameAttribute,
+		GroupAttributes: groupAttributes,
+		dbSourceName: "SAML:" + name,
+		routePath: "/saml/" + name,
+	}
+
+	registerRoute(mux, provider.routePath, provider)
+	registerRoute(mux, provider.routePath + "/acs", provider)
+	registerRoute(mux, provider.routePath + "/metadata", provider)
+
+	return provider
+}
+
+// Handles login, the assertion consumer service and SP metadata
+// Users should navigate to /saml/<PROVIDER_NAME> to authenticate with the IdP
+// Include the following query parameters to control redirect behavior:
+//    * next -- the url to send the user to with the one-time login code. Must be a relative path or match an allowed redirect
+func (s *samlUserProvider) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case s.routePath + "/acs":
+		s.serveACS(res, req)
+	case s.routePath + "/metadata":
+		s.serveMetadata(res, req)
+	default:
+		s.serveLogin(res, req)
+	}
+}
+
+// SAML users can only log in through the browser.
+// Returns AuthSourceError so local users can still log in while this is the only provider.
+func (s *samlUserProvider) UpdateUserClaims(_, _ string, _ context.Context) (*ent.User, error) {
+	return nil, AuthSourceError
+}
+
+func (s *samlUserProvider) serveLogin(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "SAMLProvider.serveLogin").
+		Str("provider", s.Name).
+		Logger()
+
+	urlNext := req.URL.Query().Get("next")
+	if !isAllowedRedirect(urlNext, s.AllowedRedirects) {
+		logger.Warn().Str("next", urlNext).Msg("Refusing to redirect to url that is not allowed")
+		res.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if urlNext == "" && s.CompletionURL == "" {
+		logger.Warn().Msg("No next url given and no completion url configured")
+		res.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// The state is sent as the RelayState and the nonce is used for the request ID
+	authState := newAuthState(urlNext, "")
+	if err := saveAuthState(s.Name, authState, ctx); err != nil {
+		logger.Error().Err(err).Msg("Could not save saml state")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	redirect, err := s.authnRequestURL(authState, time.Now())
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not create AuthnRequest")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	logger.Info().Msg("Redirecting to IdP")
+	http.Redirect(res, req, redirect.String(), http.StatusTemporaryRedirect)
+}
+
+func (s *samlUserProvider) serveACS(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "SAMLProvider.serveACS").
+		Str("provider", s.Name).
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "samlUserProvider.serveACS")
+	defer span.End()
+
+	if req.Method != http.MethodPost {
+		res.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	authState, err := takeAuthState(s.Name, req.PostFormValue("RelayState"), ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not verify relay state")
+		res.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	target := authState.NextURL
+	if target == "" {
+		target = s.CompletionURL
+	}
+
+	completeServerLogin(res, req, target, func(ctx context.Context) (*ent.User, error) {
+		raw, err := decodeXMLBase64(req.PostFormValue("SAMLResponse"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", SAMLResponseError, err)
+		}
+		assertion, err := s.verifyResponse(raw, samlRequestID(authState), time.Now())
+		if err != nil {
+			logger.Warn().Err(err).Msg("Rejected SAML response")
+			return nil, err
+		}
+		return s.updateUserClaims(assertion, ctx)
+	}, ctx)
+}
+
+func (s *samlUserProvider) serveMetadata(res http.ResponseWriter, _ *http.Request) {
+	type acs struct {
+		Binding   string `xml:"Binding,attr"`
+		Location  string `xml:"Location,attr"`
+		Index     int    `xml:"index,attr"`
+		IsDefault bool   `xml:"isDefault,attr"`
+	}
+	metadata := struct {
+		XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+		EntityID string   `xml:"entityID,attr"`
+		SP       struct {
+			AuthnRequestsSigned        bool   `xml:"AuthnRequestsSigned,attr"`
+			WantAssertionsSigned       bool   `xml:"WantAssertionsSigned,attr"`
+			ProtocolSupportEnumeration string `xml:"protocolSupportEnumeration,attr"`
+			NameIDFormat               string `xml:"NameIDFormat,omitempty"`
+			AssertionConsumerService   acs    `xml:"AssertionConsumerService"`
+		} `xml:"SPSSODescriptor"`
+	}{ EntityID: s.EntityID }
+	metadata.SP.WantAssertionsSigned = true
+	metadata.SP.ProtocolSupportEnumeration = samlProtocolNS
+	metadata.SP.NameIDFormat = s.NameIDFormat
+	metadata.SP.AssertionConsumerService = acs{ Binding: samlPostBinding, Location: s.ACSURL, IsDefault: true }
+
+	body, err := xml.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	res.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = res.Write([]byte(xml.Header))
+	_, _ = res.Write(body)
+}
+
+// samlRequestID is the AuthnRequest ID for a state. IDs must not start with a digit or dash.
+func samlRequestID(authState oidcAuthState) string {
+	return "_" + authState.Nonce
+}
+
+// authnRequestURL returns the IdP url with a deflated AuthnRequest, per the HTTP-Redirect binding
+func (s *samlUserProvider) authnRequestURL(authState oidcAuthState, now time.Time) (*url.URL, error) {
+	type nameIDPolicy struct {
+		Format      string `xml:"Format,attr,omitempty"`
+		AllowCreate bool   `xml:"AllowCreate,attr"`
+	}
+	request := struct {
+		XMLName                     xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+		ID                          string   `xml:"ID,attr"`
+		Version                     string   `xml:"Version,attr"`
+		IssueInstant                string   `xml:"IssueInstant,attr"`
+		Destination                 string   `xml:"Destination,attr"`
+		ProtocolBinding             string   `xml:"ProtocolBinding,attr"`
+		AssertionConsumerServiceURL string   `xml:"AssertionConsumerServiceURL,attr"`
+		Issuer                      struct {
+			XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+			Value   string   `xml:",chardata"`
+		}
+		NameIDPolicy                nameIDPolicy `xml:"NameIDPolicy"`
+	}{
+		ID: samlRequestID(authState),
+		Version: "2.0",
+		IssueInstant: now.UTC().Format(time.RFC3339),
+		Destination: s.IdP.SSOURL.String(),
+		ProtocolBinding: samlPostBinding,
+		AssertionConsumerServiceURL: s.ACSURL,
+		NameIDPolicy: nameIDPolicy{ Format: s.NameIDFormat, AllowCreate: true },
+	}
+	request.Issuer.Value = s.EntityID
+
+	body, err := xml.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	var deflated bytes.Buffer
+	w, _ := flate.NewWriter(&deflated, flate.BestCompression)
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	u, _ := url.Parse(s.IdP.SSOURL.String())
+	q := u.Query()
+	q.Set("SAMLRequest", base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	q.Set("RelayState", authState.State)
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// verifyResponse checks the response signature and the assertion conditions and returns the assertion contents.
+// Values are only read from the signed copies of the response and assertion, so signed content cannot be swapped for unsigned copies.
+func (s *samlUserProvider) verifyResponse(raw []byte, requestID string, now time.Time) (*samlAssertion, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w: %s", SAMLResponseError, fmt.Sprintf(format, args...))
+	}
+
+	root, err := parseXML(raw)
+	if err != nil {
+		return nil, invalid("%v", err)
+	}
+	if !isElement(root, samlProtocolNS, "Response") {
+		return nil, invalid("not a response")
+	}
+
+	// Signatures reference elements by ID, so IDs must be unique
+	ids := make(map[string]bool)
+	var assertions, encrypted int
+	var dupID string
+	walkElements(root, func(e *etree.Element) {
+		for _, a := range e.Attr {
+			if a.Key != "ID" {
+				continue
+			}
+			if ids[a.Value] {
+				dupID = a.Value
+			}
+			ids[a.Value] = true
+		}
+		if isElement(e, samlAssertionNS, "Assertion") {
+			assertions++
+		}
+		if isElement(e, samlAssertionNS, "EncryptedAssertion") {
+			encrypted++
+		}
+	})
+	if dupID != "" {
+		return nil, invalid("duplicate ID %s", dupID)
+	}
+	if encrypted > 0 {
+		return nil, invalid("encrypted assertions are not supported")
+	}
+	if assertions != 1 {
+		return nil, invalid("response must contain exactly one assertion")
+	}
+
+	response, err := verifySignature(root, s.IdP.Certificates)
+	if err != nil {
+		return nil, invalid("%v", err)
+	}
+	responseSigned := response != nil
+	if !responseSigned {
+		response = root
+	}
+
+	assertion := childElement(response, samlAssertionNS, "Assertion")
+	if assertion == nil {
+		return nil, invalid("assertion is not part of the response")
+	}
+	signedAssertion, err := verifySignature(assertion, s.IdP.Certificates)
+	if err != nil {
+		return nil, invalid("%v", err)
+	}
+	if signedAssertion != nil {
+		assertion = signedAssertion
+	} else if !responseSigned {
+		return nil, invalid("response is not signed")
+	}
+
+	if status := childElement(response, samlProtocolNS, "Status"); status == nil ||
+		childElement(status, samlProtocolNS, "StatusCode") == nil ||
+		attrValue(childElement(status, samlProtocolNS, "StatusCode"), "Value") != samlSuccess {
+		return nil, invalid("response status is not success")
+	}
+	if dest := attrValue(response, "Destination"); dest != "" && dest != s.ACSURL {
+		return nil, invalid("response destination %s does not match", dest)
+	}
+	if irt := attrValue(response, "InResponseTo"); irt != "" && irt != requestID {
+		return nil, invalid("response is not for this request")
+	}
+
+	issuer := childElement(assertion, samlAssertionNS, "Issuer")
+	if issuer == nil || (s.IdP.EntityID != "" && strings.TrimSpace(elementText(issuer)) != s.IdP.EntityID) {
+		return nil, invalid("unexpected issuer")
+	}
+
+	subject := childElement(assertion, samlAssertionNS, "Subject")
+	if subject == nil {
+		return nil, invalid("assertion has no subject")
+	}
+	if err := s.checkSubjectConfirmation(subject, requestID, now); err != nil {
+		return nil, invalid("%v", err)
+	}
+	if err := s.checkConditions(childElement(assertion, samlAssertionNS, "Conditions"), now); err != nil {
+		return nil, invalid("%v", err)
+	}
+
+	result := &samlAssertion{ Attributes: make(map[string][]string) }
+	if nameID := childElement(subject, samlAssertionNS, "NameID"); nameID != nil {
+		result.NameID = strings.TrimSpace(elementText(nameID))
+	}
+	for _, statement := range childElements(assertion, samlAssertionNS, "AttributeStatement") {
+		for _, attr := range childElements(statement, samlAssertionNS, "Attribute") {
+			var values []string
+			for _, v := range childElements(attr, samlAssertionNS, "AttributeValue") {
+				values = append(values, strings.TrimSpace(elementText(v)))
+			}
+			for _, key := range []string{ attrValue(attr, "Name"), attrValue(attr, "FriendlyName") } {
+				if key != "" {
+					result.Attributes[key] = append(result.Attributes[key], values...)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// checkSubjectConfirmation requires a bearer confirmation for this request that was sent to our ACS url and has not expired
+func (s *samlUserProvider) checkSubjectConfirmation(subject *etree.Element, requestID string, now time.Time) error {
+	for _, confirmation := range childElements(subject, samlAssertionNS, "SubjectConfirmation") {
+		data := childElement(confirmation, samlAssertionNS, "SubjectConfirmationData")
+		if attrValue(confirmation, "Method") != samlBearer || data == nil {
+			continue
+		}
+		if attrValue(data, "Recipient") != s.ACSURL || attrValue(data, "InResponseTo") != requestID {
+			continue
+		}
+		notOnOrAfter, err := time.Parse(time.RFC3339, attrValue(data, "NotOnOrAfter"))
+		if err != nil || !now.Add(-samlClockSkew).Before(notOnOrAfter) {
+			continue
+		}
+		return nil
+	}
+	return errors.New("no valid bearer subject confirmation")
+}
+
+// checkConditions requires the assertion to be valid now and restricted to our entity ID
+func (s *samlUserProvider) checkConditions(conditions *etree.Element, now time.Time) error {
+	if conditions == nil {
+		return errors.New("assertion has no conditions")
+	}
+	if nb := attrValue(conditions, "NotBefore"); nb != "" {
+		notBefore, err := time.Parse(time.RFC3339, nb)
+		if err != nil || now.Add(samlClockSkew).Before(notBefore) {
+			return errors.New("assertion is not yet valid")
+		}
+	}
+	if noa := attrValue(conditions, "NotOnOrAfter"); noa != "" {
+		notOnOrAfter, err := time.Parse(time.RFC3339, noa)
+		if err != nil || !now.Add(-samlClockSkew).Before(notOnOrAfter) {
+			return errors.New("assertion has expired")
+		}
+	}
+
+	restrictions := childElements(conditions, samlAssertionNS, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return errors.New("assertion has no audience restriction")
+	}
+	// Every restriction must include us
+	for _, restriction := range restrictions {
+		found := false
+		for _, audience := range childElements(restriction, samlAssertionNS, "Audience") {
+			if strings.TrimSpace(elementText(audience)) == s.EntityID {
+				found = true
+			}
+		}
+		if !found {
+			return errors.New("assertion is not for this service provider")
+		}
+	}
+	return nil
+}
+
+// updateUserClaims creates or updates the user from a verified assertion and applies the linked groups
+func (s *samlUserProvider) updateUserClaims(assertion *samlAssertion, ctx context.Context) (*ent.User, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "SAMLProvider.updateUserClaims").
+		Str("provider", s.Name).
+		Str("name_id", assertion.NameID).
+		Logger()
+
+	u, err := s.getOrCreateUser(assertion, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []string
+	for _, attr := range s.GroupAttributes {
+		specs = append(specs, assertion.Attributes[attr]...)
+	}
+
+	foundLinks, err := ent.FromContext(ctx).GroupLink.Query().
+		Where(
+			grouplink.And(
+				grouplink.TypeEQ(s.dbSourceName),
+				grouplink.ResourceSpecIn(specs...),
+			),
+		).
+		WithClaimGroup(func (q *ent.ClaimGroupQuery) {
+			q.WithClaims()
+		}).
+		All(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not get group links.")
+		return nil, err
+	} else if len(foundLinks) == 0 && !s.Provisioning.AllowUnlinked {
+		logger.Error().Strs("specs", specs).Msg("No group links found")
+		return nil, NoLinkedGroupsError
+	}
+
+	add, del := findGroupChanges(u, foundLinks, s.dbSourceName)
+	if u, err = applyGroupChanges(add, del, u, ctx) ; err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Failed to update saml groups to local user")
+		return nil, err
+	}
+
+	return retreiveLocalUser(u.Username, ctx)
+}
+
+func (s *samlUserProvider) getOrCreateUser(assertion *samlAssertion, ctx context.Context) (*ent.User, error) {
+	first := func(attr string) string {
+		if attr == "" {
+			return assertion.NameID
+		}
+		if values := assertion.Attributes[attr]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	fname := ""
+	if s.FNameAttribute != "" {
+		fname = first(s.FNameAttribute)
+	}
+	lname := ""
+	if s.LNameAttribute != "" {
+		lname = first(s.LNameAttribute)
+	}
+	email := first(s.EmailAttribute)
+	username := first(s.UsernameAttribute)
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "SAMLProvider.getOrCreateUser").
+		Str("fname", fname).
+		Str("lname", lname).
+		Str("email", email).
+		Str("username", username).
+		Logger()
+
+	if email == "" || username == "" {
+		logger.Error().Msg("Could not determine email or username")
+		return nil, AuthSourceError
+	}
+
+	if err := s.Provisioning.check(s.Name, email, func(name string) []string { return assertion.Attributes[name] }, ctx); err != nil {
+		return nil, err
+	}
+
+	subject := assertion.NameID
+	if subject == "" {
+		subject = username
+	}
+
+	return identityUser(
+		externalIdentity{
+			ProviderType: s.identityType(),
+			ProviderName: s.Name,
+			Subject: subject,
+		},
+		s.dbSourceName,
+		username,
+		s.Provisioning.provisionUser(func() (*ent.User, error) {
+			return ent.FromContext(ctx).User.Create().
+				SetFname(fname).
+				SetLname(lname).
+				SetEmail(email).
+				SetUsername(username).
+				SetSource(s.dbSourceName).
+				Save(ctx)
+		}, ctx),
+		ctx,
+	)
+}
+
+// ParseSAMLIdPMetadata reads the entity ID, HTTP-Redirect single sign on url and signing certificates
+// from IdP metadata. An EntitiesDescriptor uses its first entity with an IDPSSODescriptor.
+func ParseSAMLIdPMetadata(data []byte) (*SAMLIdP, error) {
+	var entity samlEntityDescriptor
+	if err := xml.Unmarshal(data, &entity); err != nil {
+		return nil, err
+	}
+	if len(entity.IDPSSODescriptors) == 0 {
+		for _, e := range entity.EntityDescriptors {
+			if len(e.IDPSSODescriptors) > 0 {
+				entity = e
+				break
+			}
+		}
+	}
+	if len(entity.IDPSSODescriptors) == 0 {
+		return nil, errors.New("metadata has no IDPSSODescriptor")
+	}
+
+	idp := &SAMLIdP{ EntityID: entity.EntityID }
+	descriptor := entity.IDPSSODescriptors[0]
+	for _, sso := range descriptor.SingleSignOnServices {
+		if sso.Binding == samlRedirectBinding {
+			u, err := url.Parse(sso.Location)
+			if err != nil {
+				return nil, err
+			}
+			idp.SSOURL = u
+			break
+		}
+	}
+	if idp.SSOURL == nil {
+		return nil, errors.New("metadata has no HTTP-Redirect single sign on service")
+	}
+
+	for _, kd := range descriptor.KeyDescriptors {
+		if kd.Use != "" && kd.Use != "signing" {
+			continue
+		}
+		for _, c := range kd.Certificates {
+			der, err := decodeXMLBase64(c)
+			if err != nil {
+				return nil, err
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, err
+			}
+			idp.Certificates = append(idp.Certificates, cert)
+		}
+	}
+	if len(idp.Certificates) == 0 {
+		return nil, errors.New("metadata has no signing certificates")
+	}
+	return idp, nil
+}
+
+type samlEntityDescriptor struct {
+	EntityID          string `xml:"entityID,attr"`
+	IDPSSODescriptors []struct {
+		KeyDescriptors []struct {
+			Use          string   `xml:"use,attr"`
+			Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		SingleSignOnServices []struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+		} `xml:"SingleSignOnService"`
+	} `xml:"IDPSSODescriptor"`
+	// Entities when the metadata is an EntitiesDescriptor
+	EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+}
@@ -0,0 +1,421 @@
+package usr_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"stoke/internal/ent"
+	"stoke/internal/ent/user"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+)
+
+const (
+	testSAMLEntityID = "https://stoke.example/saml/test_saml"
+	testSAMLACS      = "https://stoke.example/saml/test_saml/acs"
+	testIdPEntityID  = "https://idp.example/saml"
+)
+
+type samlFixture struct {
+	key      *rsa.PrivateKey
+	cert     []byte
+	metadata []byte
+}
+
+// newSAMLFixture creates an IdP signing key with a self-signed certificate and the IdP metadata that publishes it
+func newSAMLFixture(t *testing.T) *samlFixture {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Could not generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{ CommonName: "idp.example" },
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Could not create certificate: %v", err)
+	}
+	metadata := fmt.Sprintf(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example/sso/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example/sso/redirect"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, testIdPEntityID, base64.StdEncoding.EncodeToString(der))
+	return &samlFixture{ key: key, cert: der, metadata: []byte(metadata) }
+}
+
+// GetKeyPair implements dsig.X509KeyStore
+func (f *samlFixture) GetKeyPair() (*rsa.PrivateKey, []byte, error) {
+	return f.key, f.cert, nil
+}
+
+func (f *samlFixture) provider(t *testing.T) http.Handler {
+	idp, err := usr.ParseSAMLIdPMetadata(f.metadata)
+	if err != nil {
+		t.Fatalf("Could not parse idp metadata: %v", err)
+	}
+	p := usr.NewSAMLUserProvider(
+		"test_saml", testSAMLEntityID, testSAMLACS,
+		"givenName", "sn", "mail", "",
+		[]string{ "groups" },
+		idp,
+		http.NewServeMux(),
+	)
+	p.CompletionURL = "/done"
+	return p
+}
+
+// sign returns the document with an enveloped signature over its root element, like an IdP creates it
+func (f *samlFixture) sign(t *testing.T, document string) string {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(document); err != nil {
+		t.Fatalf("Could not parse document to sign: %v", err)
+	}
+	signer := dsig.NewDefaultSigningContext(f)
+	signer.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signed, err := signer.SignEnveloped(doc.Root())
+	if err != nil {
+		t.Fatalf("Could not sign document: %v", err)
+	}
+	doc.SetRoot(signed)
+	out, err := doc.WriteToString()
+	if err != nil {
+		t.Fatalf("Could not write signed document: %v", err)
+	}
+	return out
+}
+
+// signAssertion returns the signed assertion as it appears in a response.
+// The namespace is only declared on the response, so the assertion differs from the form that is signed.
+func (f *samlFixture) signAssertion(t *testing.T, a samlTestAssertion) string {
+	return strings.Replace(f.sign(t, a.document()), ` xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion"`, "", 1)
+}
+
+// samlAssertion describes an assertion issued by the fixture IdP
+type samlTestAssertion struct {
+	requestID string
+	nameID    string
+	audience  string
+	recipient string
+	notAfter  time.Time
+	groups    []string
+}
+
+func validSAMLAssertion(requestID string) samlTestAssertion {
+	return samlTestAssertion{
+		requestID: requestID,
+		nameID:    "jdoe",
+		audience:  testSAMLEntityID,
+		recipient: testSAMLACS,
+		notAfter:  time.Now().Add(5 * time.Minute),
+		groups:    []string{ "platform", "other" },
+	}
+}
+
+// document returns the unsigned assertion
+func (a samlTestAssertion) document() string {
+	var groups string
+	for _, g := range a.groups {
+		groups += `<saml:AttributeValue>` + g + `</saml:AttributeValue>`
+	}
+	notBefore := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	notAfter := a.notAfter.UTC().Format(time.RFC3339)
+	return `<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_assertion1" IssueInstant="` + notBefore + `" Version="2.0">` +
+		`<saml:Issuer>` + testIdPEntityID + `</saml:Issuer>` +
+		`<saml:Subject><saml:NameID>` + a.nameID + `</saml:NameID>` +
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">` +
+		`<saml:SubjectConfirmationData InResponseTo="` + a.requestID + `" NotOnOrAfter="` + notAfter + `" Recipient="` + a.recipient + `"/>` +
+		`</saml:SubjectConfirmation></saml:Subject>` +
+		`<saml:Conditions NotBefore="` + notBefore + `" NotOnOrAfter="` + notAfter + `">` +
+		`<saml:AudienceRestriction><saml:Audience>` + a.audience + `</saml:Audience></saml:AudienceRestriction></saml:Conditions>` +
+		`<saml:AttributeStatement>` +
+		`<saml:Attribute Name="mail"><saml:AttributeValue>jdoe@example</saml:AttributeValue></saml:Attribute>` +
+		`<saml:Attribute FriendlyName="givenName" Name="urn:oid:2.5.4.42"><saml:AttributeValue>Jane</saml:AttributeValue></saml:Attribute>` +
+		`<saml:Attribute Name="sn"><saml:AttributeValue>Doe</saml:AttributeValue></saml:Attribute>` +
+		`<saml:Attribute Name="groups">` + groups + `</saml:Attribute>` +
+		`</saml:AttributeStatement></saml:Assertion>`
+}
+
+func samlResponse(requestID string, assertions ...string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion"` +
+		` Version="2.0" ID="_response1" Destination="` + testSAMLACS + `" InResponseTo="` + requestID + `">` +
+		"\n  <saml:Issuer>" + testIdPEntityID + "</saml:Issuer>\n" +
+		`  <samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` + "\n  " +
+		strings.Join(assertions, "\n  ") +
+		"\n</samlp:Response>"
+}
+
+func samlTestContext(t *testing.T) context.Context {
+	return sessionTestContext(t, tu.ProviderLink("SAML", "test_saml", "platform"))
+}
+
+// startSAMLLogin starts a login and returns the relay state and the ID of the AuthnRequest sent to the IdP
+func startSAMLLogin(t *testing.T, p http.Handler, ctx context.Context) (string, string) {
+	req := httptest.NewRequest(http.MethodGet, "/saml/test_saml", nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	if res.Code != http.StatusTemporaryRedirect {
+		t.Fatalf("Expected redirect to idp, got %d", res.Code)
+	}
+	location, _ := url.Parse(res.Header().Get("Location"))
+	if location.Host != "idp.example" || location.Path != "/sso/redirect" {
+		t.Fatalf("Redirected to the wrong sso url: %s", location)
+	}
+
+	deflated, err := base64.StdEncoding.DecodeString(location.Query().Get("SAMLRequest"))
+	if err != nil {
+		t.Fatalf("Bad SAMLRequest encoding: %v", err)
+	}
+	body, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	if err != nil {
+		t.Fatalf("Could not inflate SAMLRequest: %v", err)
+	}
+	request := struct {
+		XMLName xml.Name
+		ID      string `xml:"ID,attr"`
+		ACS     string `xml:"AssertionConsumerServiceURL,attr"`
+		Issuer  string `xml:"Issuer"`
+	}{}
+	if err := xml.Unmarshal(body, &request); err != nil {
+		t.Fatalf("Could not parse AuthnRequest: %v", err)
+	}
+	if request.XMLName.Local != "AuthnRequest" || request.ACS != testSAMLACS || request.Issuer != testSAMLEntityID || request.ID == "" {
+		t.Fatalf("Unexpected AuthnRequest: %s", body)
+	}
+	return location.Query().Get("RelayState"), request.ID
+}
+
+func postSAMLResponse(p http.Handler, ctx context.Context, relayState, response string) *httptest.ResponseRecorder {
+	form := url.Values{
+		"SAMLResponse": { base64.StdEncoding.EncodeToString([]byte(response)) },
+		"RelayState":   { relayState },
+	}
+	req := httptest.NewRequest(http.MethodPost, "/saml/test_saml/acs", strings.NewReader(form.Encode())).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	return res
+}
+
+func TestSAMLLoginWithSignedAssertion(t *testing.T) {
+	fixture := newSAMLFixture(t)
+	ctx := samlTestContext(t)
+	p := fixture.provider(t)
+
+	relayState, requestID := startSAMLLogin(t, p, ctx)
+	response := samlResponse(requestID, fixture.signAssertion(t, validSAMLAssertion(requestID)))
+
+	res := postSAMLResponse(p, ctx, relayState, response)
+	if res.Code != http.StatusSeeOther {
+		t.Fatalf("Expected redirect after login, got %d", res.Code)
+	}
+	location, _ := url.Parse(res.Header().Get("Location"))
+	if location.Path != "/done" || location.Query().Get("code") == "" {
+		t.Fatalf("Unexpected redirect location: %s", location)
+	}
+
+	username, token, _, err := usr.RedeemLoginCode(location.Query().Get("code"), ctx)
+	if err != nil || username != "jdoe" || token != "token-for-jdoe" {
+		t.Fatalf("Unexpected login code redemption: %s %s %v", username, token, err)
+	}
+
+	u, err := ent.FromContext(ctx).User.Query().Where(user.UsernameEQ("jdoe")).WithClaimGroups().Only(ctx)
+	if err != nil {
+		t.Fatalf("Expected user to be created: %v", err)
+	}
+	if u.Fname != "Jane" || u.Lname != "Doe" || u.Email != "jdoe@example" || u.Source != "SAML:test_saml" {
+		t.Errorf("User attributes were not mapped: %+v", u)
+	}
+	if len(u.Edges.ClaimGroups) != 1 || u.Edges.ClaimGroups[0].Name != "platform" {
+		t.Errorf("User was not added to the linked group: %v", u.Edges.ClaimGroups)
+	}
+
+	// The relay state can only be used once
+	if res := postSAMLResponse(p, ctx, relayState, response); res.Code != http.StatusConflict {
+		t.Errorf("Replayed response was accepted with %d", res.Code)
+	}
+}
+
+func TestSAMLRejectsInvalidResponses(t *testing.T) {
+	fixture := newSAMLFixture(t)
+	otherFixture := newSAMLFixture(t)
+	// Signs with another key but claims the trusted certificate in KeyInfo
+	impostor := &samlFixture{ key: otherFixture.key, cert: fixture.cert }
+	forged := func(requestID string) string {
+		a := validSAMLAssertion(requestID)
+		a.nameID = "admin"
+		return strings.Replace(a.document(), `ID="_assertion1"`, `ID="_forged"`, 1)
+	}
+	signedResponse := func(requestID string, assertions ...string) string {
+		signed := fixture.sign(t, samlResponse(requestID, assertions...))
+		return signed[strings.Index(signed, "<samlp:Response"):]
+	}
+
+	tests := []struct {
+		name     string
+		response func(requestID string) string
+	}{
+		{ "tampered", func(requestID string) string {
+			signed := fixture.signAssertion(t, validSAMLAssertion(requestID))
+			return samlResponse(requestID, strings.Replace(signed, "<saml:NameID>jdoe<", "<saml:NameID>admin<", 1))
+		}},
+		{ "wrong key", func(requestID string) string {
+			return samlResponse(requestID, otherFixture.signAssertion(t, validSAMLAssertion(requestID)))
+		}},
+		{ "unsigned", func(requestID string) string {
+			return samlResponse(requestID, validSAMLAssertion(requestID).document())
+		}},
+		{ "wrong audience", func(requestID string) string {
+			a := validSAMLAssertion(requestID)
+			a.audience = "https://other.example"
+			return samlResponse(requestID, fixture.signAssertion(t, a))
+		}},
+		{ "wrong recipient", func(requestID string) string {
+			a := validSAMLAssertion(requestID)
+			a.recipient = "https://other.example/acs"
+			return samlResponse(requestID, fixture.signAssertion(t, a))
+		}},
+		{ "other request", func(requestID string) string {
+			return samlResponse(requestID, fixture.signAssertion(t, validSAMLAssertion("_other")))
+		}},
+		{ "expired", func(requestID string) string {
+			a := validSAMLAssertion(requestID)
+			a.notAfter = time.Now().Add(-5 * time.Minute)
+			return samlResponse(requestID, fixture.signAssertion(t, a))
+		}},
+		{ "impostor key", func(requestID string) string {
+			return samlResponse(requestID, impostor.signAssertion(t, validSAMLAssertion(requestID)))
+		}},
+		{ "wrapped", func(requestID string) string {
+			return samlResponse(requestID, forged(requestID), fixture.signAssertion(t, validSAMLAssertion(requestID)))
+		}},
+		{ "wrapped in extensions", func(requestID string) string {
+			signed := fixture.signAssertion(t, validSAMLAssertion(requestID))
+			return samlResponse(requestID, "<samlp:Extensions>" + signed + "</samlp:Extensions>", forged(requestID))
+		}},
+		{ "wrapped signed response", func(requestID string) string {
+			// The only assertion is the forged one, so only the signature reference tells the responses apart
+			outer := samlResponse(requestID, "<samlp:Extensions>" + signedResponse(requestID) + "</samlp:Extensions>", forged(requestID))
+			return strings.Replace(outer, `ID="_response1"`, `ID="_outer"`, 1)
+		}},
+		{ "tampered signed response", func(requestID string) string {
+			signed := signedResponse(requestID, validSAMLAssertion(requestID).document())
+			return strings.Replace(signed, "<saml:NameID>jdoe<", "<saml:NameID>admin<", 1)
+		}},
+		{ "duplicate ID", func(requestID string) string {
+			signed := fixture.signAssertion(t, validSAMLAssertion(requestID))
+			return samlResponse(requestID, signed, `<samlp:Extensions ID="_assertion1"/>`)
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := samlTestContext(t)
+			p := fixture.provider(t)
+			relayState, requestID := startSAMLLogin(t, p, ctx)
+
+			res := postSAMLResponse(p, ctx, relayState, test.response(requestID))
+			location, _ := url.Parse(res.Header().Get("Location"))
+			if res.Code != http.StatusSeeOther || location.Query().Get("error") != "access_denied" {
+				t.Errorf("Invalid response was not denied: %d %s", res.Code, location)
+			}
+		})
+	}
+}
+
+func TestSAMLLoginWithSignedResponse(t *testing.T) {
+	fixture := newSAMLFixture(t)
+	ctx := samlTestContext(t)
+	p := fixture.provider(t)
+
+	relayState, requestID := startSAMLLogin(t, p, ctx)
+	response := fixture.sign(t, samlResponse(requestID, validSAMLAssertion(requestID).document()))
+
+	res := postSAMLResponse(p, ctx, relayState, response)
+	location, _ := url.Parse(res.Header().Get("Location"))
+	if res.Code != http.StatusSeeOther || location.Query().Get("code") == "" {
+		t.Fatalf("Response with a signed response was not accepted: %d %s", res.Code, location)
+	}
+	if username, _, _, err := usr.RedeemLoginCode(location.Query().Get("code"), ctx); err != nil || username != "jdoe" {
+		t.Errorf("Unexpected login code redemption: %s %v", username, err)
+	}
+}
+
+// Comments are left out of the signed form of an element, so they must not cut the values read from it short
+func TestSAMLCommentsDoNotTruncateValues(t *testing.T) {
+	fixture := newSAMLFixture(t)
+	ctx := samlTestContext(t)
+	p := fixture.provider(t)
+
+	relayState, requestID := startSAMLLogin(t, p, ctx)
+	a := validSAMLAssertion(requestID)
+	a.nameID = "jdoe.evil"
+	signed := fixture.signAssertion(t, a)
+	injected := strings.Replace(signed, "<saml:NameID>jdoe.evil<", "<saml:NameID>jdoe<!---->.evil<", 1)
+	if injected == signed {
+		t.Fatalf("Could not inject comment into %s", signed)
+	}
+
+	res := postSAMLResponse(p, ctx, relayState, samlResponse(requestID, injected))
+	location, _ := url.Parse(res.Header().Get("Location"))
+	if res.Code != http.StatusSeeOther || location.Query().Get("code") == "" {
+		t.Fatalf("Response with a comment was not accepted: %d %s", res.Code, location)
+	}
+	if username, _, _, err := usr.RedeemLoginCode(location.Query().Get("code"), ctx); err != nil || username != "jdoe.evil" {
+		t.Errorf("Comment changed the name id: %s %v", username, err)
+	}
+}
+
+func TestSAMLServesSPMetadata(t *testing.T) {
+	fixture := newSAMLFixture(t)
+	p := fixture.provider(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/saml/test_saml/metadata", nil)
+	res := httptest.NewRecorder()
+	p.ServeHTTP(res, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("Metadata request failed with %d", res.Code)
+	}
+
+	metadata := struct {
+		EntityID string `xml:"entityID,attr"`
+		ACS      struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+		} `xml:"SPSSODescriptor>AssertionConsumerService"`
+	}{}
+	if err := xml.Unmarshal(res.Body.Bytes(), &metadata); err != nil {
+		t.Fatalf("Could not parse metadata: %v", err)
+	}
+	if metadata.EntityID != testSAMLEntityID || metadata.ACS.Location != testSAMLACS ||
+		metadata.ACS.Binding != "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" {
+		t.Errorf("Unexpected SP metadata: %s", res.Body.String())
+	}
+}
@@ -0,0 +1,322 @@
+package usr
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"stoke/internal/ent"
+	"stoke/internal/ent/grouplink"
+	"stoke/internal/tel"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vincentfree/opentelemetry/otelzerolog"
+)
+
+const (
+	defaultWebhookTimeout      = 5 * time.Second
+	defaultWebhookRetries      = 2
+	defaultWebhookRetryBackoff = 200 * time.Millisecond
+
+	// Unix time the request was signed at
+	WebhookTimestampHeader = "X-Stoke-Timestamp"
+	// sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">
+	WebhookSignatureHeader = "X-Stoke-Signature"
+)
+
+// Sent to the webhook to authenticate a user
+type webhookRequest struct {
+	Provider   string `json:"provider"`
+	Username   string `json:"username"`
+	Credential string `json:"credential"`
+}
+
+// Returned by the webhook for an authenticated user
+type webhookResponse struct {
+	Username  string   `json:"username"`
+	// Stable id of the user. Defaults to the username
+	Subject   string   `json:"subject"`
+	FirstName string   `json:"first_name"`
+	LastName  string   `json:"last_name"`
+	Email     string   `json:"email"`
+	// Group resources matched against WEBHOOK:<name> group links
+	Groups    []string `json:"groups"`
+}
+
+// Authenticates users by posting their credentials to an HTTP endpoint.
+// The endpoint answers 200 with a webhookResponse, 401 or 403 for a bad credential and 404 for an unknown user.
+// Other responses and connection failures are retried and count against the circuit breaker.
+type webhookUserProvider struct {
+	Name             string
+	URL              *url.URL
+	// Key used to sign requests
+	Secret           []byte
+
+	// Timeout for each attempt. Defaults to 5 seconds
+	Timeout          time.Duration
+	// Attempts after the first one. Defaults to 2. Negative disables retries
+	Retries          int
+	// Wait before the first retry, doubled for each following retry. Defaults to 200ms
+	RetryBackoff     time.Duration
+	// Consecutive failed logins before the webhook is no longer called. Defaults to 5
+	FailureThreshold int
+	// How long the webhook is not called after the threshold is reached. Defaults to 30 seconds
+	OpenDuration     time.Duration
+
+	Client           *http.Client
+
+	dbSourceName     string
+	breakerOnce      sync.Once
+	breaker          circuitBreaker
+}
+
+// Creates a new webhook provider
+func NewWebhookUserProvider(name string, endpoint *url.URL, secret []byte) *webhookUserProvider {
+	return &webhookUserProvider{
+		Name:         name,
+		URL:          endpoint,
+		Secret:       secret,
+		Client:       http.DefaultClient,
+		dbSourceName: "WEBHOOK:" + name,
+	}
+}
+
+// Close closes the idle connections of a client made for the provider once it is replaced, e.g. by a configuration reload
+func (w *webhookUserProvider) Close() {
+	if w.Client != http.DefaultClient {
+		w.Client.CloseIdleConnections()
+	}
+}
+
+func (w *webhookUserProvider) timeout() time.Duration {
+	if w.Timeout > 0 {
+		return w.Timeout
+	}
+	return defaultWebhookTimeout
+}
+
+func (w *webhookUserProvider) retries() int {
+	if w.Retries < 0 {
+		return 0
+	}
+	if w.Retries == 0 {
+		return defaultWebhookRetries
+	}
+	return w.Retries
+}
+
+func (w *webhookUserProvider) retryBackoff() time.Duration {
+	if w.RetryBackoff > 0 {
+		return w.RetryBackoff
+	}
+	return defaultWebhookRetryBackoff
+}
+
+// UpdateUserClaims posts the credential to the webhook and applies the returned groups to the local user
+func (w *webhookUserProvider) UpdateUserClaims(username, credential string, ctx context.Context) (*ent.User, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "webhookUserProvider.UpdateUserClaims").
+		Str("provider", w.Name).
+		Str("username", username).
+		Logger()
+
+	ctx, span := tel.GetTracer().Start(ctx, "webhookUserProvider.UpdateUserClaims")
+	defer span.End()
+
+	w.breakerOnce.Do(func() {
+		w.breaker.Threshold = w.FailureThreshold
+		w.breaker.OpenDuration = w.OpenDuration
+	})
+	if !w.breaker.allow(time.Now()) {
+		logger.Warn().
+			Func(otelzerolog.AddTracingContext(span)).
+			Msg("Webhook circuit is open. Not calling webhook")
+		return nil, AuthSourceError
+	}
+
+	profile, err := w.authenticate(username, credential, ctx)
+	if errors.Is(err, AuthSourceError) {
+		w.breaker.failure(time.Now())
+		logger.Error().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Webhook could not be reached")
+		return nil, err
+	}
+	w.breaker.success()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := w.getOrCreateUser(username, profile, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundLinks, err := ent.FromContext(ctx).GroupLink.Query().
+		Where(
+			grouplink.And(
+				grouplink.TypeEQ(w.dbSourceName),
+				grouplink.ResourceSpecIn(profile.Groups...),
+			),
+		).
+		WithClaimGroup(func (q *ent.ClaimGroupQuery) {
+			q.WithClaims()
+		}).
+		All(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not get group links.")
+		return nil, err
+	} else if len(foundLinks) == 0 {
+		logger.Error().Strs("groups", profile.Groups).Msg("No group links found")
+		return nil, NoLinkedGroupsError
+	}
+
+	add, del := findGroupChanges(u, foundLinks, w.dbSourceName)
+	if u, err = applyGroupChanges(add, del, u, ctx) ; err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Failed to update webhook groups to local user")
+		return nil, err
+	}
+
+	return retreiveLocalUser(u.Username, ctx)
+}
+
+// authenticate calls the webhook, retrying failed attempts with exponential backoff
+func (w *webhookUserProvider) authenticate(username, credential string, ctx context.Context) (*webhookResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	body, err := json.Marshal(webhookRequest{
+		Provider: w.Name,
+		Username: username,
+		Credential: credential,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	backoff := w.retryBackoff()
+	var lastErr error
+	for attempt := 0; attempt <= w.retries(); attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("%w: %v", AuthSourceError, ctx.Err())
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		profile, err := w.post(body, ctx)
+		if !errors.Is(err, AuthSourceError) {
+			return profile, err
+		}
+		logger.Debug().
+			Err(err).
+			Int("attempt", attempt + 1).
+			Msg("Webhook attempt failed")
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+func (w *webhookUserProvider) post(body []byte, ctx context.Context) (*webhookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", AuthSourceError, err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, "sha256=" + SignWebhookRequest(w.Secret, timestamp, body))
+
+	res, err := w.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", AuthSourceError, err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, AuthenticationError
+	case http.StatusNotFound:
+		return nil, UserNotFoundError
+	default:
+		return nil, fmt.Errorf("%w: webhook returned %s", AuthSourceError, res.Status)
+	}
+
+	profile := &webhookResponse{}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1 << 20)).Decode(profile); err != nil {
+		return nil, fmt.Errorf("%w: could not decode webhook response: %v", AuthSourceError, err)
+	}
+	return profile, nil
+}
+
+// SignWebhookRequest returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>".
+// Webhooks should recompute it, compare in constant time and reject old timestamps.
+func SignWebhookRequest(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *webhookUserProvider) getOrCreateUser(username string, profile *webhookResponse, ctx context.Context) (*ent.User, error) {
+	if profile.Username != "" {
+		username = profile.Username
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "webhookUserProvider.getOrCreateUser").
+		Str("fname", profile.FirstName).
+		Str("lname", profile.LastName).
+		Str("email", profile.Email).
+		Str("username", username).
+		Logger()
+
+	if profile.Email == "" || username == "" {
+		logger.Error().Msg("Webhook response is missing an email or username")
+		return nil, AuthSourceError
+	}
+
+	subject := profile.Subject
+	if subject == "" {
+		subject = username
+	}
+
+	return identityUser(
+		externalIdentity{
+			ProviderType: w.identityType(),
+			ProviderName: w.Name,
+			Subject: subject,
+		},
+		w.dbSourceName,
+		username,
+		func() (*ent.User, error) {
+			return ent.FromContext(ctx).User.Create().
+				SetFname(profile.FirstName).
+				SetLname(profile.LastName).
+				SetEmail(profile.Email).
+				SetUsername(username).
+				SetSource(w.dbSourceName).
+				Save(ctx)
+		},
+		ctx,
+	)
+}
@@ -0,0 +1,146 @@
+package usr_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"stoke/internal/ent"
+	tu "stoke/internal/testutil"
+	"stoke/internal/usr"
+)
+
+type webhookFixture struct {
+	server *httptest.Server
+	calls  atomic.Int32
+	// Status returned for every request when set
+	status atomic.Int32
+}
+
+func newWebhookFixture(t *testing.T) *webhookFixture {
+	f := &webhookFixture{}
+	f.server = httptest.NewTLSServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		f.calls.Add(1)
+		if status := f.status.Load(); status != 0 {
+			res.WriteHeader(int(status))
+			return
+		}
+
+		body, _ := io.ReadAll(req.Body)
+		expected := "sha256=" + usr.SignWebhookRequest([]byte("webhook-secret"), req.Header.Get(usr.WebhookTimestampHeader), body)
+		if !hmac.Equal([]byte(expected), []byte(req.Header.Get(usr.WebhookSignatureHeader))) {
+			res.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var creds struct {
+			Username   string `json:"username"`
+			Credential string `json:"credential"`
+		}
+		_ = json.Unmarshal(body, &creds)
+		switch {
+		case creds.Username != "hook":
+			res.WriteHeader(http.StatusNotFound)
+		case creds.Credential != "hookpass":
+			res.WriteHeader(http.StatusUnauthorized)
+		default:
+			_ = json.NewEncoder(res).Encode(map[string]interface{}{
+				"first_name": "Web",
+				"last_name": "Hook",
+				"email": "hook@hppr.dev",
+				"groups": []string{ "legacy-admins", "unlinked" },
+			})
+		}
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func newTestWebhookProvider(f *webhookFixture) interface {
+	UpdateUserClaims(string, string, context.Context) (*ent.User, error)
+	CheckHealth(context.Context) error
+} {
+	endpoint, _ := url.Parse(f.server.URL)
+	p := usr.NewWebhookUserProvider("legacy", endpoint, []byte("webhook-secret"))
+	p.Client = f.server.Client()
+	p.RetryBackoff = time.Millisecond
+	p.FailureThreshold = 2
+	p.OpenDuration = 50 * time.Millisecond
+	return p
+}
+
+func webhookTestContext(t *testing.T) context.Context {
+	return tu.NewMockContext(
+		tu.WithDatabase(t, tu.ProviderLinkedGroup(tu.ProviderLink("WEBHOOK", "legacy", "legacy-admins"))),
+	)
+}
+
+func TestWebhookAuthenticatesAndLinksGroups(t *testing.T) {
+	fixture := newWebhookFixture(t)
+	ctx := webhookTestContext(t)
+	p := newTestWebhookProvider(fixture)
+
+	u, err := p.UpdateUserClaims("hook", "hookpass", ctx)
+	if err != nil {
+		t.Fatalf("Could not authenticate with webhook: %v", err)
+	}
+	if u.Source != "WEBHOOK:legacy" || u.Email != "hook@hppr.dev" || u.Fname != "Web" {
+		t.Errorf("User was not created from the webhook profile: %+v", u)
+	}
+	if _, claims := getUserAndClaims("hook", ctx); len(claims) != 1 || claims[0].ShortName != "plt" {
+		t.Errorf("Linked group was not applied: %v", claims)
+	}
+
+	if _, err := p.UpdateUserClaims("hook", "wrong", ctx); !errors.Is(err, usr.AuthenticationError) {
+		t.Errorf("Bad credential returned %v", err)
+	}
+	if _, err := p.UpdateUserClaims("nobody", "hookpass", ctx); !errors.Is(err, usr.UserNotFoundError) {
+		t.Errorf("Unknown user returned %v", err)
+	}
+}
+
+func TestWebhookRetriesAndOpensCircuit(t *testing.T) {
+	fixture := newWebhookFixture(t)
+	ctx := webhookTestContext(t)
+	p := newTestWebhookProvider(fixture)
+
+	fixture.status.Store(http.StatusBadGateway)
+	for i := 0; i < 2; i++ {
+		if _, err := p.UpdateUserClaims("hook", "hookpass", ctx); !errors.Is(err, usr.AuthSourceError) {
+			t.Fatalf("Failing webhook returned %v", err)
+		}
+	}
+	// Each login makes the first attempt and two retries
+	if calls := fixture.calls.Load(); calls != 6 {
+		t.Errorf("Expected 6 webhook calls, got %d", calls)
+	}
+
+	// The circuit is open, so the webhook is not called
+	if _, err := p.UpdateUserClaims("hook", "hookpass", ctx); !errors.Is(err, usr.AuthSourceError) {
+		t.Fatalf("Open circuit returned %v", err)
+	}
+	if calls := fixture.calls.Load(); calls != 6 {
+		t.Errorf("Webhook was called while the circuit was open")
+	}
+	if err := p.CheckHealth(ctx); err == nil {
+		t.Error("Webhook with an open circuit was reported healthy")
+	}
+
+	// After the open duration a probe is let through and closes the circuit
+	fixture.status.Store(0)
+	time.Sleep(60 * time.Millisecond)
+	if _, err := p.UpdateUserClaims("hook", "hookpass", ctx); err != nil {
+		t.Fatalf("Probe after recovery failed: %v", err)
+	}
+	if err := p.CheckHealth(ctx); err != nil {
+		t.Errorf("Recovered webhook was reported unhealthy: %v", err)
+	}
+}
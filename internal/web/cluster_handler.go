@@ -0,0 +1,64 @@
+package web
+
+import (
+	"context"
+	"stoke/internal/cfg"
+	"stoke/internal/ent/ogent"
+	"stoke/internal/key"
+	"time"
+)
+
+// ClusterStatus implements ogent.Handler.
+func (h *entityHandler) ClusterStatus(ctx context.Context) (*ogent.ClusterStatusOK, error) {
+	res := &ogent.ClusterStatusOK{
+		Peers:     []ogent.ClusterStatusOKPeersItem{},
+		Conflicts: []ogent.ClusterStatusOKConflictsItem{},
+	}
+
+	if cl := cfg.ClusterFromContext(ctx); cl != nil {
+		res.Enabled = cl.Enabled
+		res.InstanceID = cl.InstanceID
+	}
+
+	federated, ok := key.IssuerFromCtx(ctx).(*key.FederatedTokenIssuer)
+	if !ok {
+		return res, nil
+	}
+
+	status := federated.Status()
+	res.Merged = status.Merged
+	res.LastMerge = optDateTime(status.LastMerge)
+	res.CacheExpiry = optDateTime(status.CacheExpiry)
+
+	for _, p := range status.Peers {
+		peer := ogent.ClusterStatusOKPeersItem{
+			URL:         p.URL,
+			LastSuccess: optDateTime(p.LastSuccess),
+			KeyIds:      p.KeyIds,
+			Expires:     optDateTime(p.Expires),
+		}
+		if peer.KeyIds == nil {
+			peer.KeyIds = []string{}
+		}
+		if p.LastError != "" {
+			peer.Error = ogent.NewOptString(p.LastError)
+		}
+		res.Peers = append(res.Peers, peer)
+	}
+
+	for _, c := range status.Conflicts {
+		res.Conflicts = append(res.Conflicts, ogent.ClusterStatusOKConflictsItem{
+			Kid:     c.KeyId,
+			Sources: c.Sources,
+		})
+	}
+
+	return res, nil
+}
+
+func optDateTime(t time.Time) ogent.OptDateTime {
+	if t.IsZero() {
+		return ogent.OptDateTime{}
+	}
+	return ogent.NewOptDateTime(t)
+}
@@ -0,0 +1,160 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"stoke/internal/cfg"
+	"stoke/internal/ent"
+	"stoke/internal/ent/schema/policy"
+	"stoke/internal/key"
+	"stoke/internal/usr"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	healthOK          = "ok"
+	healthDegraded    = "degraded"
+	healthUnavailable = "unavailable"
+)
+
+type healthCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type healthReport struct {
+	Status string        `json:"status"`
+	Checks []healthCheck `json:"checks"`
+}
+
+// add records a check result and lowers the overall status if needed
+func (r *healthReport) add(check healthCheck) {
+	r.Checks = append(r.Checks, check)
+	switch {
+	case check.Status == healthUnavailable:
+		r.Status = healthUnavailable
+	case check.Status == healthDegraded && r.Status == healthOK:
+		r.Status = healthDegraded
+	}
+}
+
+func (r *healthReport) write(res http.ResponseWriter, req *http.Request) {
+	res.Header().Set("Content-Type", "application/json")
+	if r.Status == healthUnavailable {
+		res.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		res.WriteHeader(http.StatusOK)
+	}
+	if err := json.NewEncoder(res).Encode(r); err != nil {
+		zerolog.Ctx(req.Context()).Error().Err(err).Msg("Could not write health report")
+	}
+}
+
+// LivenessHandler reports that the process is serving requests
+func LivenessHandler(res http.ResponseWriter, req *http.Request) {
+	report := &healthReport{ Status: healthOK, Checks: []healthCheck{} }
+	report.write(res, req)
+}
+
+// ReadinessHandler reports whether this replica can issue and verify tokens.
+// The database and signing keys must be available. Cluster merges and provider reachability only degrade the status.
+func ReadinessHandler(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	report := &healthReport{ Status: healthOK }
+
+	report.add(checkDatabase(ctx))
+	report.add(checkSigningKey(ctx))
+	if check, ok := checkCluster(ctx); ok {
+		report.add(check)
+	}
+	if cfg.Ctx(ctx).Server.HealthCheckProviders {
+		for _, check := range checkProviders(ctx) {
+			report.add(check)
+		}
+	}
+
+	report.write(res, req)
+}
+
+func checkDatabase(ctx context.Context) healthCheck {
+	check := healthCheck{ Name: "database", Status: healthOK }
+	if _, err := ent.FromContext(ctx).PrivateKey.Query().Exist(policy.BypassDatabasePolicies(ctx)); err != nil {
+		check.Status = healthUnavailable
+		check.Detail = err.Error()
+	}
+	return check
+}
+
+func checkSigningKey(ctx context.Context) healthCheck {
+	check := healthCheck{ Name: "signing_key", Status: healthOK }
+	reporter, ok := key.IssuerFromCtx(ctx).(key.ActiveKeyReporter)
+	if !ok {
+		check.Detail = "issuer does not report key expiry"
+		return check
+	}
+	expires := reporter.ActiveKeyExpires()
+	if !expires.After(time.Now()) {
+		check.Status = healthUnavailable
+		check.Detail = "active signing key expired at " + expires.Format(time.RFC3339)
+		return check
+	}
+	check.Detail = "active signing key expires at " + expires.Format(time.RFC3339)
+	return check
+}
+
+// checkCluster reports whether the merged public key set has been built at least once.
+// Returns false when federated cluster mode is not in use.
+func checkCluster(ctx context.Context) (healthCheck, bool) {
+	federated, ok := key.IssuerFromCtx(ctx).(*key.FederatedTokenIssuer)
+	if !ok {
+		return healthCheck{}, false
+	}
+	check := healthCheck{ Name: "cluster", Status: healthOK }
+
+	if !federated.Status().Merged {
+		// Merges happen on demand, so try one before reporting
+		if _, err := federated.PublicKeys(ctx); err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("Could not merge public keys for readiness check")
+		}
+	}
+
+	status := federated.Status()
+	if !status.Merged {
+		check.Status = healthDegraded
+		check.Detail = "no public key merge has completed"
+		return check, true
+	}
+	if len(status.Conflicts) > 0 {
+		check.Status = healthDegraded
+		check.Detail = "key id conflicts between peers"
+		return check, true
+	}
+	check.Detail = "last merge at " + status.LastMerge.Format(time.RFC3339)
+	return check, true
+}
+
+func checkProviders(ctx context.Context) []healthCheck {
+	results := usr.ProviderFromCtx(ctx).CheckForeignProviders(ctx)
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	checks := make([]healthCheck, 0, len(names))
+	for _, name := range names {
+		check := healthCheck{ Name: "provider:" + name, Status: healthOK }
+		if err := results[name]; err != nil {
+			check.Status = healthDegraded
+			check.Detail = err.Error()
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
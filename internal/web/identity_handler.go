@@ -0,0 +1,152 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"stoke/internal/cfg"
+	"stoke/internal/ent"
+	"stoke/internal/ent/ogent"
+	"stoke/internal/tel"
+	"stoke/internal/usr"
+
+	"github.com/rs/zerolog"
+	"github.com/vincentfree/opentelemetry/otelzerolog"
+	"hppr.dev/stoke"
+)
+
+// ListIdentities implements ogent.Handler.
+func (h *entityHandler) ListIdentities(ctx context.Context, params ogent.ListIdentitiesParams) (*ogent.ListIdentitiesOK, error) {
+	identities, err := usr.ListIdentities(params.Username.Or(""), ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ogent.ListIdentitiesOK{
+		Identities: []ogent.ListIdentitiesOKIdentitiesItem{},
+	}
+	for _, i := range identities {
+		res.Identities = append(res.Identities, ogent.ListIdentitiesOKIdentitiesItem(identityItem(i)))
+	}
+	return res, nil
+}
+
+// LinkIdentity implements ogent.Handler.
+func (h *entityHandler) LinkIdentity(ctx context.Context, req *ogent.LinkIdentityReq) (ogent.LinkIdentityRes, error) {
+	linked, err := usr.ProviderFromCtx(ctx).LinkIdentitySubject(req.Username, req.Provider, req.Subject, ctx)
+	if err != nil {
+		return &ogent.LinkIdentityBadRequest{
+			Message: err.Error(),
+		}, nil
+	}
+
+	res := ogent.LinkIdentityOK(identityItem(linked))
+	return &res, nil
+}
+
+// UnlinkIdentity implements ogent.Handler.
+func (h *entityHandler) UnlinkIdentity(ctx context.Context, params ogent.UnlinkIdentityParams) (ogent.UnlinkIdentityRes, error) {
+	err := usr.UnlinkIdentity(params.ID, "", ctx)
+	switch {
+	case errors.Is(err, usr.IdentityNotFoundError):
+		return &ogent.UnlinkIdentityNotFound{ Message: err.Error() }, nil
+	case err != nil:
+		return &ogent.UnlinkIdentityBadRequest{ Message: err.Error() }, nil
+	}
+	return &ogent.UnlinkIdentityOK{ Message: "Identity Unlinked" }, nil
+}
+
+// ListMyIdentities implements ogent.Handler.
+func (h *entityHandler) ListMyIdentities(ctx context.Context) (ogent.ListMyIdentitiesRes, error) {
+	username, err := tokenUsername(ctx)
+	if err != nil {
+		return &ogent.ListMyIdentitiesBadRequest{ Message: err.Error() }, nil
+	}
+
+	identities, err := usr.ListIdentities(username, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ogent.ListMyIdentitiesOK{
+		Identities: []ogent.ListMyIdentitiesOKIdentitiesItem{},
+	}
+	for _, i := range identities {
+		res.Identities = append(res.Identities, ogent.ListMyIdentitiesOKIdentitiesItem(identityItem(i)))
+	}
+	return res, nil
+}
+
+// LinkMyIdentity implements ogent.Handler.
+// The signed in user logs in to the provider to prove that the account is theirs.
+func (h *entityHandler) LinkMyIdentity(ctx context.Context, req *ogent.LinkMyIdentityReq) (ogent.LinkMyIdentityRes, error) {
+	ctx, span := tel.GetTracer().Start(ctx, "LinkMyIdentityHandler")
+	defer span.End()
+
+	username, err := tokenUsername(ctx)
+	if err != nil {
+		return &ogent.LinkMyIdentityBadRequest{ Message: err.Error() }, nil
+	}
+
+	linked, err := usr.ProviderFromCtx(ctx).LinkIdentity(username, req.Provider, req.Username, req.Password, ctx)
+	switch {
+	case errors.Is(err, usr.AuthenticationError), errors.Is(err, usr.UserNotFoundError):
+		zerolog.Ctx(ctx).Debug().
+			Str("component", "LinkMyIdentity").
+			Func(otelzerolog.AddTracingContext(span)).
+			Str("username", username).
+			Str("provider", req.Provider).
+			Err(err).
+			Msg("Provider login failed")
+		return &ogent.LinkMyIdentityUnauthorized{}, nil
+	case err != nil:
+		return &ogent.LinkMyIdentityBadRequest{ Message: err.Error() }, nil
+	}
+
+	res := ogent.LinkMyIdentityOK(identityItem(linked))
+	return &res, nil
+}
+
+// UnlinkMyIdentity implements ogent.Handler.
+func (h *entityHandler) UnlinkMyIdentity(ctx context.Context, params ogent.UnlinkMyIdentityParams) (ogent.UnlinkMyIdentityRes, error) {
+	username, err := tokenUsername(ctx)
+	if err != nil {
+		return &ogent.UnlinkMyIdentityBadRequest{ Message: err.Error() }, nil
+	}
+
+	err = usr.UnlinkIdentity(params.ID, username, ctx)
+	switch {
+	case errors.Is(err, usr.IdentityNotFoundError):
+		return &ogent.UnlinkMyIdentityNotFound{ Message: err.Error() }, nil
+	case err != nil:
+		return &ogent.UnlinkMyIdentityBadRequest{ Message: err.Error() }, nil
+	}
+	return &ogent.UnlinkMyIdentityOK{ Message: "Identity Unlinked" }, nil
+}
+
+// tokenUsername returns the username of the signed in user from the request token.
+// Tokens only carry the username when tokens.user_info includes it.
+func tokenUsername(ctx context.Context) (string, error) {
+	usernameKey, ok := cfg.Ctx(ctx).Tokens.UserInfo["username"]
+	if !ok {
+		return "", errors.New("Tokens do not include the username. Add username to tokens.user_info")
+	}
+	claims, ok := stoke.Token(ctx).Claims.(*stoke.Claims)
+	if !ok || claims.StokeClaims[usernameKey] == "" {
+		return "", errors.New("Token does not include the username")
+	}
+	return claims.StokeClaims[usernameKey], nil
+}
+
+func identityItem(i *ent.Identity) ogent.LinkIdentityOK {
+	item := ogent.LinkIdentityOK{
+		ID:           i.ID,
+		ProviderType: i.ProviderType,
+		Provider:     i.ProviderName,
+		Subject:      i.Subject,
+		Created:      i.Created,
+	}
+	if i.Edges.User != nil {
+		item.Username = i.Edges.User.Username
+	}
+	return item
+}
@@ -3,7 +3,6 @@ package web
 import (
 	"context"
 	"fmt"
-	"slices"
 	"stoke/internal/cfg"
 	"stoke/internal/ent"
 	"stoke/internal/ent/ogent"
@@ -23,7 +22,8 @@ type LoginApiHandler struct {}
 //   1. Retrieves claims from user provider using username and password
 //   2. Verifies required user claims match the requested values. If a the required value is "", the key is required but no further verification is needed.
 //   3. Removes any claims that do not match the claim_filter, if given
-//   3. Issues a token
+//   4. Returns an MFA challenge instead of a token if the user has MFA enabled
+//   5. Issues a token
 // Schema definition in internal/schema/openapi/login.go and internal/ent/openapi.json (operation id login)
 func (h *entityHandler) Login(ctx context.Context, req *ogent.LoginReq) (ogent.LoginRes, error) {
 	logger := zerolog.Ctx(ctx).With().
@@ -45,24 +45,21 @@ func (h *entityHandler) Login(ctx context.Context, req *ogent.LoginReq) (ogent.L
 	}
 
 	logger = logger.With().Str("username", user.Username).Logger()
-	
-	tokenMap := make(map[string]string)
 
+	provider := usr.ProviderFromCtx(ctx)
+	if provider.MFAEnrollmentRequired(user, pvClaims) {
+		logger.Warn().Msg("Leaving stk claims out of token until the user enables MFA")
+		pvClaims = usr.WithoutAdminClaims(pvClaims)
+	}
+	
 	matchedOne := len(req.RequiredClaims) == 0
 	for _, pvClaim := range pvClaims {
-		if !matchedOne {
-			for _, claimReq := range req.RequiredClaims {
-				if value, exist := claimReq[pvClaim.ShortName]; exist {
-					matchedOne = value == "" || pvClaim.Value == value
-				}
-			}
+		if matchedOne {
+			break
 		}
-
-		if len(req.FilterClaims) == 0 || slices.Contains(req.FilterClaims, pvClaim.ShortName) {
-			if value, exists := tokenMap[pvClaim.ShortName]; exists {
-				tokenMap[pvClaim.ShortName] = value + "," + pvClaim.Value
-			} else {
-				tokenMap[pvClaim.ShortName] = pvClaim.Value
+		for _, claimReq := range req.RequiredClaims {
+			if value, exist := claimReq[pvClaim.ShortName]; exist {
+				matchedOne = value == "" || pvClaim.Value == value
 			}
 		}
 	}
@@ -75,12 +72,36 @@ func (h *entityHandler) Login(ctx context.Context, req *ogent.LoginReq) (ogent.L
 		return &ogent.LoginUnauthorized{}, nil
 	}
 
-	populateUserInfo(cfg.Ctx(ctx), user, tokenMap)
+	tokenMap := usr.FilteredTokenClaims(pvClaims, req.FilterClaims)
+	// Always set, even if the claim was filtered out
+	tokenMap["amr"] = usr.AMRPassword
 
-	token, refresh, err := key.IssuerFromCtx(ctx).IssueToken(&stoke.Claims{
-		StokeClaims : tokenMap,
-		RegisteredClaims: createRegisteredClaims(cfg.Ctx(ctx).Tokens),
-	}, ctx)
+	if provider.MFAEnabled(user) {
+		challenge, err := provider.CreateMFAChallenge(user.Username, tokenMap, ctx)
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Msg("Could not create MFA challenge")
+			return &ogent.LoginBadRequest{}, nil
+		}
+		return &ogent.LoginAccepted{
+			MfaChallenge: challenge,
+			Username: user.Username,
+		}, nil
+	}
+
+	tokenDur := cfg.Ctx(ctx).Tokens.TokenDuration
+	if usr.IsOfflineLogin(pvClaims, ctx) {
+		offline := cfg.Ctx(ctx).CurrentUsers().OfflineLogin
+		// Tokens from cached credentials are always marked, even if the claim was filtered out
+		tokenMap[offline.ClaimName()] = "true"
+		tokenDur = offline.TokenDuration(tokenDur)
+		logger.Warn().
+			Dur("tokenDuration", tokenDur).
+			Msg("Issuing degraded token from cached credential")
+	}
+
+	token, refresh, err := issueUserToken(user, tokenMap, tokenDur, ctx)
 	if err != nil {
 		logger.Error().
 			Err(err).
@@ -95,10 +116,101 @@ func (h *entityHandler) Login(ctx context.Context, req *ogent.LoginReq) (ogent.L
 	}, nil
 }
 
-func createRegisteredClaims(c cfg.Tokens) jwt.RegisteredClaims {
+// LoginMFA implements ogent.Handler.
+// Issues the token of a login that returned an MFA challenge once the challenge is answered.
+// Schema definition in internal/schema/openapi/mfa.go and internal/ent/openapi.json (operation id loginMFA)
+func (h *entityHandler) LoginMFA(ctx context.Context, req *ogent.LoginMFAReq) (ogent.LoginMFARes, error) {
+	ctx, span := tel.GetTracer().Start(ctx, "LoginMFAHandler")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("component", "LoginMFA").
+		Logger()
+
+	user, tokenMap, err := usr.ProviderFromCtx(ctx).AnswerMFAChallenge(req.Challenge, req.Code, ctx)
+	if err != nil {
+		logger.Debug().
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not answer MFA challenge")
+		return &ogent.LoginMFAUnauthorized{}, nil
+	}
+	if amr := tokenMap["amr"]; amr != "" {
+		tokenMap["amr"] = amr + "," + usr.AMRMFA
+	} else {
+		tokenMap["amr"] = usr.AMRMFA
+	}
+
+	token, refresh, err := issueUserToken(user, tokenMap, cfg.Ctx(ctx).Tokens.TokenDuration, ctx)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Str("username", user.Username).
+			Msg("Could not issue token")
+		return nil, err
+	}
+
+	return &ogent.LoginMFAOK{
+		Token: token,
+		Refresh: refresh,
+		Username: user.Username,
+	}, nil
+}
+
+// LoginExchange implements ogent.Handler.
+// Redeems a one-time code issued after a server completed provider login.
+// Schema definition in internal/schema/openapi/login_exchange.go and internal/ent/openapi.json (operation id loginExchange)
+func (h *entityHandler) LoginExchange(ctx context.Context, req *ogent.LoginExchangeReq) (ogent.LoginExchangeRes, error) {
+	ctx, span := tel.GetTracer().Start(ctx, "LoginExchangeHandler")
+	defer span.End()
+
+	username, token, refresh, err := usr.RedeemLoginCode(req.Code, ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().
+			Str("component", "LoginExchange").
+			Func(otelzerolog.AddTracingContext(span)).
+			Err(err).
+			Msg("Could not redeem login code")
+		return &ogent.LoginExchangeUnauthorized{}, nil
+	}
+
+	return &ogent.LoginExchangeOK{
+		Token: token,
+		Refresh: refresh,
+		Username: username,
+	}, nil
+}
+
+// issueSessionToken issues a token with all of the user's claims.
+// Used by providers that complete logins on the server.
+func issueSessionToken(user *ent.User, claims ent.Claims, ctx context.Context) (string, string, error) {
+	tokenMap := usr.TokenClaims(claims)
+	tokenMap["amr"] = usr.AMRFederated
+	return issueUserToken(user, tokenMap, cfg.Ctx(ctx).Tokens.TokenDuration, ctx)
+}
+
+// issueUserToken adds the configured user info to tokenMap and issues a token valid for tokenDur and a refresh token.
+// A provider session in tokenMap is kept at least as long as the token
+func issueUserToken(user *ent.User, tokenMap map[string]string, tokenDur time.Duration, ctx context.Context) (string, string, error) {
+	populateUserInfo(cfg.Ctx(ctx), user, tokenMap)
+
+	registeredClaims := createRegisteredClaims(cfg.Ctx(ctx).Tokens, tokenDur)
+	if session := tokenMap[usr.SessionClaim]; session != "" {
+		if err := usr.KeepSession(session, registeredClaims.ExpiresAt.Time, ctx); err != nil {
+			return "", "", err
+		}
+	}
+
+	return key.IssuerFromCtx(ctx).IssueToken(&stoke.Claims{
+		StokeClaims : tokenMap,
+		RegisteredClaims: registeredClaims,
+	}, ctx)
+}
+
+func createRegisteredClaims(c cfg.Tokens, tokenDur time.Duration) jwt.RegisteredClaims {
 	now := time.Now()
 	minClaims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(now.Add(c.TokenDuration)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(tokenDur)),
 
 		// Below fields are omitted if they are not included in config
 		Issuer:    c.Issuer,
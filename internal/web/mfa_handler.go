@@ -0,0 +1,61 @@
+package web
+
+import (
+	"context"
+	"stoke/internal/ent/ogent"
+	"stoke/internal/usr"
+)
+
+// EnrollMFA implements ogent.Handler.
+func (h *entityHandler) EnrollMFA(ctx context.Context) (ogent.EnrollMFARes, error) {
+	username, err := tokenUsername(ctx)
+	if err != nil {
+		return &ogent.EnrollMFABadRequest{ Message: err.Error() }, nil
+	}
+
+	secret, uri, err := usr.ProviderFromCtx(ctx).EnrollMFA(username, ctx)
+	if err != nil {
+		return &ogent.EnrollMFABadRequest{ Message: err.Error() }, nil
+	}
+	return &ogent.EnrollMFAOK{
+		Secret: secret,
+		URI: uri,
+	}, nil
+}
+
+// ActivateMFA implements ogent.Handler.
+func (h *entityHandler) ActivateMFA(ctx context.Context, req *ogent.ActivateMFAReq) (ogent.ActivateMFARes, error) {
+	username, err := tokenUsername(ctx)
+	if err != nil {
+		return &ogent.ActivateMFABadRequest{ Message: err.Error() }, nil
+	}
+
+	codes, err := usr.ProviderFromCtx(ctx).ActivateMFA(username, req.Code, ctx)
+	if err != nil {
+		return &ogent.ActivateMFABadRequest{ Message: err.Error() }, nil
+	}
+	return &ogent.ActivateMFAOK{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// DisableMFA implements ogent.Handler.
+func (h *entityHandler) DisableMFA(ctx context.Context, req *ogent.DisableMFAReq) (ogent.DisableMFARes, error) {
+	username, err := tokenUsername(ctx)
+	if err != nil {
+		return &ogent.DisableMFABadRequest{ Message: err.Error() }, nil
+	}
+
+	if err := usr.ProviderFromCtx(ctx).DisableMFA(username, req.Code, ctx); err != nil {
+		return &ogent.DisableMFABadRequest{ Message: err.Error() }, nil
+	}
+	return &ogent.DisableMFAOK{ Message: "MFA Disabled" }, nil
+}
+
+// ResetMFA implements ogent.Handler.
+func (h *entityHandler) ResetMFA(ctx context.Context, params ogent.ResetMFAParams) (ogent.ResetMFARes, error) {
+	if err := usr.ResetMFA(params.Username, ctx); err != nil {
+		return &ogent.ResetMFABadRequest{ Message: err.Error() }, nil
+	}
+	return &ogent.ResetMFAOK{ Message: "MFA Disabled" }, nil
+}
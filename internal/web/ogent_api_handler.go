@@ -59,16 +59,19 @@ func (s *secHandler) HandleToken(ctx context.Context, operationName string, t og
 	case "CreateClaim", "DeleteClaim", "UpdateClaim":
 		claims.Or(stoke.RequireToken().WithClaim("stk", "C"))
 
-	case "ReadUser", "ListUser", "ListUserClaimGroups":
+	case "ReadUser", "ListUser", "ListUserClaimGroups", "ListIdentities":
 		claims.Or(stoke.RequireToken().WithClaimMatch("stk", "^[suU]$"))
 
-	case "DeleteUser", "UpdateUser", "CreateLocalUser", "UpdateLocalUserPassword":
+	case "DeleteUser", "UpdateUser", "CreateLocalUser", "UpdateLocalUserPassword", "LinkIdentity", "UnlinkIdentity", "ResetMFA":
 		claims.Or(stoke.RequireToken().WithClaim("stk", "U"))
 
-	case "Capabilities", "Totals":
+	case "ClusterStatus":
+		claims.Or(stoke.RequireToken().WithClaim("stk", "s"))
+
+	case "Capabilities", "Totals", "ProviderHealth":
 		claims.Or(stoke.RequireToken().WithClaimMatch("stk", "^[sSuUgGcC]$"))
 
-	case "Refresh":
+	case "Refresh", "ListMyIdentities", "LinkMyIdentity", "UnlinkMyIdentity", "EnrollMFA", "ActivateMFA", "DisableMFA":
 		claims = stoke.RequireToken()
 
 	//case "listPrivateKey", "readPrivateKey":
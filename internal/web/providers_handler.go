@@ -4,22 +4,51 @@ import (
 	"context"
 	"stoke/internal/cfg"
 	"stoke/internal/ent/ogent"
+	"stoke/internal/usr"
 	"strings"
 )
 
 func (h *entityHandler) AvailableProviders(ctx context.Context) (*ogent.AvailableProvidersOK, error) {
 	config := cfg.Ctx(ctx)
-	providers := []ogent.AvailableProvidersOKItem{}
-	for _, p := range config.Users.Providers {
-		providers = append(providers, ogent.AvailableProvidersOKItem{
+	degraded := make(map[string]bool)
+	for _, h := range usr.ProviderFromCtx(ctx).ProviderHealth() {
+		degraded[h.Name] = h.Degraded()
+	}
+
+	providers := []ogent.AvailableProvidersOKProvidersItem{}
+	for _, p := range config.CurrentUsers().Providers {
+		providers = append(providers, ogent.AvailableProvidersOKProvidersItem{
 			Name:         p.Name,
 			ProviderType: strings.ToUpper(p.ProviderType),
 			TypeSpec:     p.TypeSpec(),
+			Degraded:     ogent.NewOptBool(degraded[p.Name]),
 		})
 	}
 	basePath := strings.TrimRight(config.Server.BasePath, "/")
 	return &ogent.AvailableProvidersOK{
 		Providers:     providers,
-		BaseAdminPath: basePath,
+		BaseAdminPath: ogent.NewOptString(basePath),
 	}, nil
 }
+
+// ProviderHealth implements ogent.Handler.
+func (h *entityHandler) ProviderHealth(ctx context.Context) (*ogent.ProviderHealthOK, error) {
+	res := &ogent.ProviderHealthOK{
+		Providers: []ogent.ProviderHealthOKProvidersItem{},
+	}
+	for _, p := range usr.ProviderFromCtx(ctx).ProviderHealth() {
+		item := ogent.ProviderHealthOKProvidersItem{
+			Name:                p.Name,
+			State:               p.State.String(),
+			Degraded:            p.Degraded(),
+			ConsecutiveFailures: p.ConsecutiveFailures,
+			LastSuccess:         optDateTime(p.LastSuccess),
+			LastFailure:         optDateTime(p.LastFailure),
+		}
+		if p.LastError != "" {
+			item.Error = ogent.NewOptString(p.LastError)
+		}
+		res.Providers = append(res.Providers, item)
+	}
+	return res, nil
+}
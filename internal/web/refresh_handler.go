@@ -6,6 +6,7 @@ import (
 	"stoke/internal/ent/ogent"
 	"stoke/internal/key"
 	"stoke/internal/tel"
+	"stoke/internal/usr"
 
 	"hppr.dev/stoke"
 	"github.com/rs/zerolog"
@@ -19,7 +20,37 @@ func (h *entityHandler) Refresh(ctx context.Context, req *ogent.RefreshReq) (oge
 	ctx, span := tel.GetTracer().Start(ctx, "RefreshHandler")
 	defer span.End()
 
-	newToken, newRefresh, err := key.IssuerFromCtx(ctx).RefreshToken(stoke.Token(ctx), req.Refresh, cfg.Ctx(ctx).Tokens.TokenDuration, ctx)
+	tokenDur := cfg.Ctx(ctx).Tokens.TokenDuration
+	if claims, ok := stoke.Token(ctx).Claims.(*stoke.Claims); ok {
+		// Tokens issued from cached credentials are not refreshed, so the user has to log in again and the provider,
+		// or the cached credential's expiry, is checked
+		offline := cfg.Ctx(ctx).CurrentUsers().OfflineLogin
+		if claims.StokeClaims[offline.ClaimName()] == "true" {
+			logger.Debug().
+				Func(otelzerolog.AddTracingContext(span)).
+				Msg("Refusing to refresh token issued from cached credentials")
+			return &ogent.RefreshUnauthorized{}, nil
+		}
+		if session, ok := claims.StokeClaims[usr.SessionClaim]; ok {
+			if err := usr.CheckSession(session, ctx); err != nil {
+				logger.Debug().
+					Func(otelzerolog.AddTracingContext(span)).
+					Err(err).
+					Msg("Refusing to refresh token")
+				return &ogent.RefreshUnauthorized{}, nil
+			}
+			// The refreshed token expires tokenDur after the current one, so the session has to last as long
+			if err := usr.KeepSession(session, claims.ExpiresAt.Add(tokenDur), ctx); err != nil {
+				logger.Error().
+					Func(otelzerolog.AddTracingContext(span)).
+					Err(err).
+					Msg("Could not extend provider session")
+				return nil, err
+			}
+		}
+	}
+
+	newToken, newRefresh, err := key.IssuerFromCtx(ctx).RefreshToken(stoke.Token(ctx), req.Refresh, tokenDur, ctx)
 	if err != nil {
 		logger.Debug().
 			Func(otelzerolog.AddTracingContext(span)).
@@ -15,6 +15,7 @@ import (
 	"stoke/internal/admin"
 	"stoke/internal/cfg"
 	"stoke/internal/key"
+	"stoke/internal/usr"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
@@ -38,6 +39,7 @@ func NewServer(ctx context.Context) *http.Server {
 	issuer := key.IssuerFromCtx(ctx)
 
 	mux := cfg.MuxFromContext(ctx)
+	ctx = usr.WithSessionIssuer(issueSessionToken, ctx)
 
 	dLogger := debugLogger{ logger: logger.With().Str("component", "http.Server").Logger() }
 
@@ -75,6 +77,8 @@ func NewServer(ctx context.Context) *http.Server {
 	fullAPIPath, _ := url.JoinPath("/", config.BasePath, "/api/")
 	fullMetricsPath, _ := url.JoinPath("/", config.BasePath, "/metrics/")
 	fullLogsPath, _ := url.JoinPath("/", config.BasePath, "/metrics/", "logs")
+	fullHealthzPath, _ := url.JoinPath("/", config.BasePath, "/healthz")
+	fullReadyzPath, _ := url.JoinPath("/", config.BasePath, "/readyz")
 	fullAdminPath, err := url.JoinPath("/", config.BasePath, "/admin/")
 	if err != nil {
 		logger.Panic().
@@ -87,8 +91,14 @@ func NewServer(ctx context.Context) *http.Server {
 		Str("metricsPath", fullMetricsPath).
 		Str("logsPath", fullLogsPath).
 		Str("adminPath", fullAdminPath).
+		Str("healthzPath", fullHealthzPath).
+		Str("readyzPath", fullReadyzPath).
 		Msg("Initializing routes.")
 
+	// Health endpoints are unauthenticated so they can be used by load balancer and kubernetes probes
+	mux.HandleFunc(fullHealthzPath, LivenessHandler)
+	mux.HandleFunc(fullReadyzPath, ReadinessHandler)
+
 	if !config.DisableAdmin {
 		mux.Handle(fullAdminPath, http.StripPrefix(fullAdminPath, http.FileServerFS(admin.Pages)))
 	}